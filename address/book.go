@@ -395,17 +395,6 @@ func (b *Book) NewAddressWithKeys(ctx context.Context, addrVersion Version,
 			" %w", err)
 	}
 
-	// We also want to import the two keys, so we can identify them as
-	// belonging to the wallet later on.
-	err = b.cfg.Store.InsertInternalKey(ctx, internalKeyDesc)
-	if err != nil {
-		return nil, fmt.Errorf("unable to insert internal key: %w", err)
-	}
-	err = b.cfg.Store.InsertScriptKey(ctx, scriptKey, true)
-	if err != nil {
-		return nil, fmt.Errorf("unable to insert script key: %w", err)
-	}
-
 	addr := AddrWithKeyInfo{
 		Tap:              baseAddr,
 		ScriptKeyTweak:   *scriptKey.TweakedScriptKey,
@@ -414,18 +403,77 @@ func (b *Book) NewAddressWithKeys(ctx context.Context, addrVersion Version,
 		CreationTime:     time.Now(),
 	}
 
-	if err := b.cfg.Store.InsertAddrs(ctx, addr); err != nil {
-		return nil, fmt.Errorf("unable to insert addr: %w", err)
+	if err := b.insertAddr(ctx, &addr); err != nil {
+		return nil, err
+	}
+
+	return &addr, nil
+}
+
+// ImportAddress imports an address that was created before, for example by a
+// daemon that used the same seed and whose state is being restored from a
+// backup. The address keys are inserted as known keys and all subscribers are
+// notified about the address, so it is imported into the wallet as if it was
+// just created.
+func (b *Book) ImportAddress(ctx context.Context,
+	addr *AddrWithKeyInfo) error {
+
+	// The asset genesis needs to be known before we can insert the
+	// address, so we'll fetch it from the universe if we don't have it
+	// yet.
+	assetGroup, err := b.QueryAssetInfo(ctx, addr.AssetID)
+	if err != nil {
+		return err
+	}
+	addr.AttachGenesis(*assetGroup.Genesis)
+
+	// We always re-derive the Taproot output key from the address itself
+	// instead of trusting what we were given.
+	taprootOutputKey, err := addr.Tap.TaprootOutputKey()
+	if err != nil {
+		return fmt.Errorf("unable to derive Taproot output key: %w",
+			err)
+	}
+	if !taprootOutputKey.IsEqual(&addr.TaprootOutputKey) {
+		return fmt.Errorf("Taproot output key mismatch, expected %x "+
+			"got %x", taprootOutputKey.SerializeCompressed(),
+			addr.TaprootOutputKey.SerializeCompressed())
+	}
+
+	return b.insertAddr(ctx, addr)
+}
+
+// insertAddr inserts the given address and its keys into the database and
+// informs all subscribers about it.
+func (b *Book) insertAddr(ctx context.Context, addr *AddrWithKeyInfo) error {
+	// We also want to import the two keys, so we can identify them as
+	// belonging to the wallet later on.
+	err := b.cfg.Store.InsertInternalKey(ctx, addr.InternalKeyDesc)
+	if err != nil {
+		return fmt.Errorf("unable to insert internal key: %w", err)
+	}
+
+	scriptKey := asset.ScriptKey{
+		PubKey:           &addr.ScriptKey,
+		TweakedScriptKey: &addr.ScriptKeyTweak,
+	}
+	err = b.cfg.Store.InsertScriptKey(ctx, scriptKey, true)
+	if err != nil {
+		return fmt.Errorf("unable to insert script key: %w", err)
+	}
+
+	if err := b.cfg.Store.InsertAddrs(ctx, *addr); err != nil {
+		return fmt.Errorf("unable to insert addr: %w", err)
 	}
 
 	// Inform our subscribers about the new address.
 	b.subscriberMtx.Lock()
 	for _, sub := range b.subscribers {
-		sub.NewItemCreated.ChanIn() <- &addr
+		sub.NewItemCreated.ChanIn() <- addr
 	}
 	b.subscriberMtx.Unlock()
 
-	return &addr, nil
+	return nil
 }
 
 // IsLocalKey returns true if the key is under the control of the wallet and can
@@ -436,6 +484,14 @@ func (b *Book) IsLocalKey(ctx context.Context,
 	return b.cfg.KeyRing.IsLocalKey(ctx, key)
 }
 
+// InsertInternalKey inserts an internal key into the database to make sure it
+// is identified as a local key later on when importing proofs.
+func (b *Book) InsertInternalKey(ctx context.Context,
+	keyDesc keychain.KeyDescriptor) error {
+
+	return b.cfg.Store.InsertInternalKey(ctx, keyDesc)
+}
+
 // InsertScriptKey inserts an address related script key into the database.
 func (b *Book) InsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
 	declareAsKnown bool) error {
@@ -10,7 +10,9 @@ import (
 
 	taprootassets "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/urfave/cli"
@@ -29,8 +31,11 @@ var assetsCommands = []cli.Command{
 			listGroupsCommand,
 			listAssetBalancesCommand,
 			sendAssetsCommand,
+			sendAssetBatchCommand,
+			consolidateAssetsCommand,
 			burnAssetsCommand,
 			listBurnsCommand,
+			recoverAssetsCommand,
 			listTransfersCommand,
 			fetchMetaCommand,
 		},
@@ -62,6 +67,9 @@ var (
 	feeRateName                  = "sat_per_vbyte"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
+	coinSelectStrategyName       = "coin_select_strategy"
+	dryRunName                   = "dry_run"
+	maxAnchorTxWeightName        = "max_anchor_tx_weight"
 )
 
 var mintAssetCommand = cli.Command{
@@ -201,6 +209,27 @@ func parseMetaType(metaType string) (taprpc.AssetMetaType, error) {
 	}
 }
 
+// coinSelectStrategies maps the user facing coin select strategy names to
+// their RPC values.
+var coinSelectStrategies = map[string]taprpc.CoinSelectStrategy{
+	"default": taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT,
+	"max":     taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_PREFER_MAX_AMOUNT,
+	"min":     taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_PREFER_MIN_AMOUNT,
+	"exact":   taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_EXACT_MATCH,
+}
+
+func parseCoinSelectStrategy(
+	strategy string) (taprpc.CoinSelectStrategy, error) {
+
+	rpcStrategy, ok := coinSelectStrategies[strategy]
+	if !ok {
+		return 0, fmt.Errorf("invalid coin select strategy: %s",
+			strategy)
+	}
+
+	return rpcStrategy, nil
+}
+
 func parseFeeRate(ctx *cli.Context) (uint32, error) {
 	if ctx.IsSet(feeRateName) {
 		userFeeRate := ctx.Uint64(feeRateName)
@@ -813,6 +842,15 @@ var sendAssetsCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the anchor transaction",
 		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset " +
+				"inputs, must be either: max (largest inputs " +
+				"first), min (smallest inputs first, " +
+				"consolidates dust) or exact (avoid change " +
+				"if possible)",
+			Value: "default",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
@@ -834,9 +872,17 @@ func sendAssets(ctx *cli.Context) error {
 		return err
 	}
 
+	selectStrategy, err := parseCoinSelectStrategy(
+		ctx.String(coinSelectStrategyName),
+	)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
-		FeeRate:  feeRate,
+		TapAddrs:           addrs,
+		FeeRate:            feeRate,
+		CoinSelectStrategy: selectStrategy,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
@@ -846,6 +892,126 @@ func sendAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var sendAssetBatchCommand = cli.Command{
+	Name:  "sendbatch",
+	Usage: "send assets to many addresses in batches",
+	Description: `
+	Send to an arbitrary set of Taproot Asset addresses, which may be for
+	different assets. The addresses are split into batches, each of which
+	is sent within its own anchor transaction. A failure to send one batch
+	does not affect the other batches. Use 'tapcli events send' to follow
+	the proof delivery to the individual recipients.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; must be specified at least " +
+				"once, can be specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transactions",
+		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset " +
+				"inputs, must be either: max, min or exact",
+			Value: "default",
+		},
+		cli.Uint64Flag{
+			Name: maxAnchorTxWeightName,
+			Usage: "the maximum estimated weight of a single " +
+				"anchor transaction in weight units; the " +
+				"default is used if unset",
+		},
+	},
+	Action: sendAssetBatch,
+}
+
+func sendAssetBatch(ctx *cli.Context) error {
+	addrs := ctx.StringSlice(addrName)
+	if ctx.NArg() != 0 || len(addrs) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	selectStrategy, err := parseCoinSelectStrategy(
+		ctx.String(coinSelectStrategyName),
+	)
+	if err != nil {
+		return err
+	}
+
+	maxWeight := ctx.Uint64(maxAnchorTxWeightName)
+	if maxWeight > math.MaxUint32 {
+		return fmt.Errorf("max anchor tx weight too large")
+	}
+
+	resp, err := client.SendAssetBatch(ctxc, &taprpc.SendAssetBatchRequest{
+		TapAddrs:           addrs,
+		FeeRate:            feeRate,
+		CoinSelectStrategy: selectStrategy,
+		MaxAnchorTxWeight:  uint32(maxWeight),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var consolidateAssetsCommand = cli.Command{
+	Name:  "consolidate",
+	Usage: "consolidate fragmented asset UTXOs",
+	Description: `
+	Merge all anchor outputs that carry the same asset ID or asset group
+	into a single anchor output, for every asset that is spread over at
+	least the configured threshold of anchor outputs. The consolidation is
+	only performed if the current on-chain fee rate is at or below the
+	configured ceiling.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: dryRunName,
+			Usage: "only show the planned consolidations and " +
+				"their estimated fees without performing them",
+		},
+	},
+	Action: consolidateAssets,
+}
+
+func consolidateAssets(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ConsolidateAssetUtxos(
+		ctxc, &wrpc.ConsolidateAssetUtxosRequest{
+			DryRun: ctx.Bool(dryRunName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to consolidate assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var burnAssetsCommand = cli.Command{
 	Name:  "burn",
 	Usage: "burn a number of asset units",
@@ -1011,6 +1177,66 @@ func listBurns(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	gapLimitName = "gap_limit"
+
+	scanFederationName = "scan_federation"
+)
+
+var recoverAssetsCommand = cli.Command{
+	Name:  "recover",
+	Usage: "recover assets from the seed of the backing lnd node",
+	Description: `
+	Attempt to recover all assets owned by keys derived from the seed of
+	the backing lnd node, for example after the database was lost.
+
+	The script keys of the Taproot Assets key family are derived up to the
+	gap limit and matched against the leaves of the local universe, or of
+	all universe federation servers if --scan_federation is set. The full
+	proof files of all matching assets that aren't spent yet are then
+	fetched from the universe proof courier, verified and imported.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: gapLimitName,
+			Usage: "the number of consecutive unused key indexes " +
+				"after which the key derivation stops",
+			Value: tapgarden.DefaultRecoveryGapLimit,
+		},
+		cli.BoolFlag{
+			Name: scanFederationName,
+			Usage: "scan the universe federation servers instead " +
+				"of the local universe",
+		},
+		cli.StringFlag{
+			Name: proofCourierAddrName,
+			Usage: "the universe proof courier address to fetch " +
+				"the proof files from; if not set, the " +
+				"default proof courier address of the " +
+				"daemon is used",
+		},
+	},
+	Action: recoverAssets,
+}
+
+func recoverAssets(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RecoverAssets(ctxc, &taprpc.RecoverAssetsRequest{
+		GapLimit:         uint32(ctx.Uint64(gapLimitName)),
+		ScanFederation:   ctx.Bool(scanFederationName),
+		ProofCourierAddr: ctx.String(proofCourierAddrName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to recover assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listTransfersCommand = cli.Command{
 	Name:      "transfers",
 	ShortName: "t",
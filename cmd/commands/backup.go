@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var backupCommands = []cli.Command{
+	{
+		Name:     "backup",
+		Usage:    "Export and restore encrypted backups of the daemon.",
+		Category: "Backup",
+		Subcommands: []cli.Command{
+			exportBackupCommand,
+			restoreBackupCommand,
+		},
+	},
+}
+
+const (
+	backupOutputFileName = "output_file"
+
+	backupFileName = "backup_file"
+)
+
+var exportBackupCommand = cli.Command{
+	Name:  "export",
+	Usage: "export an encrypted backup of all assets and addresses",
+	Description: `
+	Export an encrypted backup of all assets owned by the daemon, including
+	their proof files and key derivation information, as well as all
+	address book entries and pending transfers.
+
+	The backup is encrypted with a key derived from the seed of the backing
+	lnd node, so it can only be restored by a daemon that is connected to
+	an lnd node with the same seed.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: backupOutputFileName,
+			Usage: "the path to the file the backup is written to; " +
+				"use the dash character (-) to write to stdout " +
+				"instead",
+		},
+	},
+	Action: exportBackup,
+}
+
+func exportBackup(ctx *cli.Context) error {
+	if ctx.String(backupOutputFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportBackup(ctxc, &taprpc.ExportBackupRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to export backup: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(backupOutputFileName))
+	return writeToFile(filePath, resp.Backup)
+}
+
+var restoreBackupCommand = cli.Command{
+	Name:  "restore",
+	Usage: "restore an encrypted backup",
+	Description: `
+	Restore an encrypted backup that was created with the "backup export"
+	command. Every proof contained in the backup is fully verified before
+	it is imported. Assets, addresses and transfers that are already known
+	to the daemon are skipped.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: backupFileName,
+			Usage: "the path to the backup file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+	},
+	Action: restoreBackup,
+}
+
+func restoreBackup(ctx *cli.Context) error {
+	if ctx.String(backupFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(backupFileName))
+	backup, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read backup file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RestoreBackup(ctxc, &taprpc.RestoreBackupRequest{
+		Backup: backup,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore backup: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -99,6 +99,7 @@ func NewApp(actionOpts ...ActionOption) cli.App {
 	app.Commands = append(app.Commands, proofCommands...)
 	app.Commands = append(app.Commands, rfqCommands...)
 	app.Commands = append(app.Commands, universeCommands...)
+	app.Commands = append(app.Commands, backupCommands...)
 	app.Commands = append(app.Commands, devCommands...)
 
 	return *app
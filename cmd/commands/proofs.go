@@ -23,6 +23,7 @@ var proofCommands = []cli.Command{
 			verifyProofCommand,
 			decodeProofCommand,
 			exportProofCommand,
+			convertProofCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
 		},
@@ -277,6 +278,81 @@ func exportProof(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	proofVersionName = "version"
+
+	outputPathName = "output_file"
+)
+
+var convertProofCommand = cli.Command{
+	Name:      "convert",
+	ShortName: "c",
+	Usage:     "convert a taproot asset proof file to another version",
+	Description: `
+	Convert a taproot asset proof file to the given proof file version.
+	Version 0 is the uncompressed encoding, version 1 is the compressed
+	encoding that only contains duplicate block headers and exclusion
+	proofs once. The command also reports the size of the proof file in
+	both encodings.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.UintFlag{
+			Name:  proofVersionName,
+			Value: 1,
+			Usage: "the proof file version to convert the file to",
+		},
+		cli.StringFlag{
+			Name: outputPathName,
+			Usage: "(optional) the file to write the converted " +
+				"raw proof file to; use the dash character " +
+				"(-) to write the raw binary proof to stdout " +
+				"instead of the default JSON format",
+		},
+	},
+	Action: convertProof,
+}
+
+func convertProof(ctx *cli.Context) error {
+	if ctx.String(proofPathName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ConvertProofFile(
+		ctxc, &taprpc.ConvertProofFileRequest{
+			RawProofFile: rawFile,
+			Version:      uint32(ctx.Uint(proofVersionName)),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to convert proof file: %w", err)
+	}
+
+	// Write the raw (binary) proof to a file (or stdout) instead of in the
+	// JSON format.
+	if ctx.String(outputPathName) != "" {
+		outPath := lncfg.CleanAndExpandPath(ctx.String(outputPathName))
+		return writeToFile(outPath, resp.RawProofFile)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var proveOwnershipCommand = cli.Command{
 	Name:      "proveownership",
 	ShortName: "po",
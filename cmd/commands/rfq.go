@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
 	"github.com/urfave/cli"
@@ -15,6 +17,7 @@ var rfqCommands = []cli.Command{
 		Category:  "Channels",
 		Subcommands: []cli.Command{
 			acceptedQuotesCommand,
+			quoteHistoryCommand,
 		},
 	},
 }
@@ -45,3 +48,87 @@ func acceptedQuotes(ctx *cli.Context) error {
 
 	return nil
 }
+
+const peerName = "peer"
+
+var quoteHistoryCommand = cli.Command{
+	Name:      "quotehistory",
+	ShortName: "qh",
+	Usage:     "show the history of accepted quotes",
+	Description: `
+	Lists the quotes that have been accepted by the node or by the node's
+	peers, most recently accepted first. Expired quotes are included until
+	they are pruned.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  peerName,
+			Usage: "only list the quotes of this peer (hex pubkey)",
+		},
+		cli.StringFlag{
+			Name:  createdAfterName,
+			Usage: "a duration short hand (-1h, -24h, etc)",
+		},
+		cli.StringFlag{
+			Name:  createdBeforeName,
+			Usage: "a duration short hand (-1h, -24h, etc)",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the max number of quotes to return",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the number of quotes to skip",
+		},
+	},
+	Action: quoteHistory,
+}
+
+func quoteHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	req := &rfqrpc.QueryQuoteHistoryRequest{
+		Limit:  int32(ctx.Int64(limitName)),
+		Offset: int32(ctx.Int64(offsetName)),
+	}
+
+	if ctx.IsSet(peerName) {
+		peer, err := hex.DecodeString(ctx.String(peerName))
+		if err != nil {
+			return fmt.Errorf("unable to decode peer: %w", err)
+		}
+		req.Peer = peer
+	}
+
+	if ctx.IsSet(createdAfterName) {
+		startOffset, err := time.ParseDuration(
+			ctx.String(createdAfterName),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to parse start: %w", err)
+		}
+		req.StartTimestamp = time.Now().Add(startOffset).Unix()
+	}
+
+	if ctx.IsSet(createdBeforeName) {
+		endOffset, err := time.ParseDuration(
+			ctx.String(createdBeforeName),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to parse end: %w", err)
+		}
+		req.EndTimestamp = time.Now().Add(endOffset).Unix()
+	}
+
+	resp, err := client.QueryQuoteHistory(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to query quote history: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -3,21 +3,31 @@ package commands
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/btcsuite/btcd/wire"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	proofTypeName       = "proof_type"
 	skipAmountsByIdName = "skip_amounts_by_id"
+	anchorPrevOutName   = "anchor_prev_out"
+	paymentPreimageName = "payment_preimage"
 )
 
 func getUniverseClient(ctx *cli.Context) (unirpc.UniverseClient, func()) {
@@ -47,7 +57,10 @@ var universeCommands = []cli.Command{
 			universeSyncCommand,
 			universeFederationCommand,
 			universeInfoCommand,
+			universeSignedRootsCommand,
 			universeStatsCommand,
+			universeSearchCommand,
+			universeSnapshotCommand,
 		},
 	},
 }
@@ -521,10 +534,53 @@ var universeProofInsertCommand = cli.Command{
 	`,
 	Flags: append(universeProofArgs, cli.StringFlag{
 		Name: proofPathName,
+	}, cli.StringSliceFlag{
+		Name: anchorPrevOutName,
+		Usage: "an output spent by the anchor transaction of the " +
+			"proof, in the form of value:pk_script_hex, in the " +
+			"order of its inputs; only needed if the universe " +
+			"server requires a minimum anchor fee rate; can " +
+			"be specified multiple times",
+	}, cli.StringFlag{
+		Name: paymentPreimageName,
+		Usage: "the hex encoded preimage of a paid invoice the " +
+			"universe server requested for the insertion",
 	}),
 	Action: universeProofInsert,
 }
 
+// parseAnchorPrevOuts parses the outputs spent by the anchor transaction of a
+// proof from their value:pk_script_hex form.
+func parseAnchorPrevOuts(prevOutStrs []string) ([]*taprpc.TxOut, error) {
+	prevOuts := make([]*taprpc.TxOut, 0, len(prevOutStrs))
+	for _, prevOutStr := range prevOutStrs {
+		valueStr, scriptStr, ok := strings.Cut(prevOutStr, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid spent output %v, "+
+				"expected value:pk_script_hex", prevOutStr)
+		}
+
+		value, err := strconv.ParseInt(valueStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of spent "+
+				"output %v: %w", prevOutStr, err)
+		}
+
+		pkScript, err := hex.DecodeString(scriptStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script of spent "+
+				"output %v: %w", prevOutStr, err)
+		}
+
+		prevOuts = append(prevOuts, &taprpc.TxOut{
+			Value:    value,
+			PkScript: pkScript,
+		})
+	}
+
+	return prevOuts, nil
+}
+
 func universeProofInsert(ctx *cli.Context) error {
 	if ctx.String(proofPathName) == "" {
 		return cli.ShowSubcommandHelp(ctx)
@@ -566,15 +622,31 @@ func universeProofInsert(ctx *cli.Context) error {
 		return fmt.Errorf("invalid proof file format")
 	}
 
+	prevOuts, err := parseAnchorPrevOuts(
+		ctx.StringSlice(anchorPrevOutName),
+	)
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
+	// If the universe server requested a payment for the insertion, we
+	// present the preimage of the paid invoice the L402 way.
+	if preimage := ctx.String(paymentPreimageName); preimage != "" {
+		ctxc = metadata.AppendToOutgoingContext(
+			ctxc, "authorization", "L402 "+preimage,
+		)
+	}
+
 	req := &unirpc.AssetProof{
 		Key: uKey,
 		AssetLeaf: &unirpc.AssetLeaf{
 			Proof: rawProof,
 		},
+		AnchorPrevOuts: prevOuts,
 	}
 	resp, err := client.InsertProof(ctxc, req)
 	if err != nil {
@@ -640,6 +712,8 @@ func universeProofPush(ctx *cli.Context) error {
 
 var (
 	universeHostName = "universe_host"
+
+	universeIdentityKeyName = "identity_key"
 )
 
 var universeSyncCommand = cli.Command{
@@ -762,6 +836,10 @@ var universeFederationAddCommand = cli.Command{
 	Description: `
 	Add a new server to the Federation. Newly added servers will be synced
 	automatically, will also be used to push out newly validated proofs.
+
+	The server must authenticate with the given identity key on every
+	connection. If no identity key is given, the key the server
+	authenticates with when it is added is pinned.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -770,15 +848,28 @@ var universeFederationAddCommand = cli.Command{
 				"testnet.mydomain.com:10029. The default port " +
 				"(10029) will be used if none is provided",
 		},
+		cli.StringFlag{
+			Name: universeIdentityKeyName,
+			Usage: "the hex encoded node public key the universe " +
+				"server must authenticate with",
+		},
 	},
 	Action: universeFederationAdd,
 }
 
 func universeFederationAdd(ctx *cli.Context) error {
-	if ctx.String(universeHostName) == "" {
+	host := ctx.String(universeHostName)
+	if host == "" {
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
+	identityKey, err := hex.DecodeString(
+		ctx.String(universeIdentityKeyName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid identity key: %w", err)
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
@@ -787,7 +878,8 @@ func universeFederationAdd(ctx *cli.Context) error {
 		ctxc, &unirpc.AddFederationServerRequest{
 			Servers: []*unirpc.UniverseFederationServer{
 				{
-					Host: ctx.String(universeHostName),
+					Host:        host,
+					IdentityKey: identityKey,
 				},
 			},
 		},
@@ -1296,6 +1388,46 @@ func universeInfo(ctx *cli.Context) error {
 	return nil
 }
 
+const minEpochName = "min_epoch"
+
+var universeSignedRootsCommand = cli.Command{
+	Name:      "signedroots",
+	ShortName: "sr",
+	Usage:     "list the signed multiverse roots known to the Universe",
+	Description: `
+	List the multiverse root snapshot signed by the Universe server for the
+	current epoch, along with the signed snapshots of other federation
+	members it has collected. An epoch is the number of hours since the unix
+	epoch.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: minEpochName,
+			Usage: "only list the signed roots of this epoch or " +
+				"later",
+		},
+	},
+	Action: universeSignedRoots,
+}
+
+func universeSignedRoots(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SignedMultiverseRoots(
+		ctxc, &unirpc.SignedMultiverseRootsRequest{
+			MinEpoch: ctx.Uint64(minEpochName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeStatsCommand = cli.Command{
 	Name:      "stats",
 	ShortName: "s",
@@ -1486,3 +1618,354 @@ func universeEventStatsQueryCommand(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+const (
+	searchQueryName = "query"
+
+	descendingName = "descending"
+)
+
+var universeSearchCommand = cli.Command{
+	Name:  "search",
+	Usage: "search the assets of the universe",
+	Description: `
+	Search the assets of the issuance universes by their name and the keys
+	and values of their revealed JSON meta data. Every word of the query
+	must match. The results can be filtered by asset type, group key and
+	decimal display. This command supports pagination (via the
+	offset+limit) flags, and also allows for sorting by a given field.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  searchQueryName,
+			Usage: "the words to search for",
+		},
+		cli.StringFlag{
+			Name: assetType,
+			Usage: "only return assets of the given type, " +
+				"[--asset_type=normal|collectible]",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "only return assets of the given group",
+		},
+		cli.Int64Flag{
+			Name: assetDecimalDisplayName,
+			Usage: "only return assets with the given decimal " +
+				"display",
+			Value: -1,
+		},
+		cli.StringFlag{
+			Name: sortByName,
+			Usage: "the name of the field to sort by, " +
+				"[--sort_by=relevance|asset_name|asset_id|" +
+				"genesis_height]",
+			Value: "relevance",
+		},
+		cli.BoolFlag{
+			Name:  descendingName,
+			Usage: "sort the results in descending order",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the maximum number of results to return",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the offset to start returning results from",
+		},
+	},
+	Action: universeSearchQueryCommand,
+}
+
+func universeSearchQueryCommand(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	req := &unirpc.SearchAssetsRequest{
+		Query:  ctx.String(searchQueryName),
+		Limit:  int32(ctx.Int64(limitName)),
+		Offset: int32(ctx.Int64(offsetName)),
+	}
+
+	switch ctx.String(sortByName) {
+	case "relevance":
+		req.SortBy = unirpc.AssetSearchSort_SEARCH_SORT_BY_RELEVANCE
+
+	case "asset_name":
+		req.SortBy = unirpc.AssetSearchSort_SEARCH_SORT_BY_ASSET_NAME
+
+	case "asset_id":
+		req.SortBy = unirpc.AssetSearchSort_SEARCH_SORT_BY_ASSET_ID
+
+	case "genesis_height":
+		req.SortBy = unirpc.AssetSearchSort_SEARCH_SORT_BY_GENESIS_HEIGHT
+
+	default:
+		return fmt.Errorf("invalid sort_by value: %v",
+			ctx.String(sortByName))
+	}
+
+	if ctx.Bool(descendingName) {
+		req.Direction = unirpc.SortDirection_SORT_DIRECTION_DESC
+	}
+
+	switch ctx.String(assetType) {
+	case "":
+
+	case "normal":
+		req.AssetTypeFilter = unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL
+
+	case "collectible":
+		req.AssetTypeFilter =
+			unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE
+
+	default:
+		return fmt.Errorf("invalid asset_type value: %v",
+			ctx.String(assetType))
+	}
+
+	if ctx.String(groupKeyName) != "" {
+		groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return fmt.Errorf("unable to decode group key: %w", err)
+		}
+
+		req.GroupKeyFilter = groupKey
+	}
+
+	if decDisplay := ctx.Int64(assetDecimalDisplayName); decDisplay >= 0 {
+		req.DecimalDisplayFilter = &taprpc.DecimalDisplay{
+			DecimalDisplay: uint32(decDisplay),
+		}
+	}
+
+	resp, err := client.SearchAssets(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	snapshotOutputFileName = "output_file"
+
+	snapshotFileName = "snapshot_file"
+
+	snapshotChunkSizeName = "chunk_size"
+
+	// snapshotPieceSize is the size of the pieces a snapshot is streamed to
+	// the daemon in.
+	snapshotPieceSize = 1024 * 1024
+)
+
+var universeSnapshotCommand = cli.Command{
+	Name:      "snapshot",
+	ShortName: "sn",
+	Usage:     "export or import a snapshot of the universe",
+	Description: `
+	Export every leaf of every universe, along with the universe and
+	multiverse roots, to a portable snapshot file, or import such a
+	snapshot into the local universe. This can be used to bootstrap a new
+	universe server without syncing from a federation.
+	`,
+	Subcommands: []cli.Command{
+		universeSnapshotExportCommand,
+		universeSnapshotImportCommand,
+	},
+}
+
+var universeSnapshotExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "export a snapshot of the universe",
+	Description: `
+	Write every leaf of every known universe, its proof and the universe
+	and multiverse roots to a versioned, chunked and checksummed snapshot
+	file. The summary of the snapshot, which includes the multiverse roots,
+	is printed once the export is complete.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: snapshotOutputFileName,
+			Usage: "the path to the file the snapshot is written " +
+				"to; use the dash character (-) to write to " +
+				"stdout instead",
+		},
+		cli.Uint64Flag{
+			Name: snapshotChunkSizeName,
+			Usage: "the maximum number of leaves per chunk of " +
+				"the snapshot",
+			Value: universe.DefaultSnapshotChunkSize,
+		},
+	},
+	Action: universeSnapshotExport,
+}
+
+func universeSnapshotExport(ctx *cli.Context) (err error) {
+	if ctx.String(snapshotOutputFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.ExportSnapshot(
+		ctxc, &unirpc.ExportSnapshotRequest{
+			ChunkSize: uint32(ctx.Uint64(snapshotChunkSizeName)),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export snapshot: %w", err)
+	}
+
+	// The snapshot is first written to a temporary file that is only
+	// moved to its final location once the export is complete, so an
+	// aborted export doesn't leave a partial snapshot behind.
+	filePath := lncfg.CleanAndExpandPath(ctx.String(snapshotOutputFileName))
+	toStdout := filePath == "-"
+
+	out := os.Stdout
+	if !toStdout {
+		err := os.MkdirAll(filepath.Dir(filePath), defaultDirPerms)
+		if err != nil {
+			return fmt.Errorf("unable to create directory %v: %w",
+				filepath.Dir(filePath), err)
+		}
+
+		out, err = os.CreateTemp(
+			filepath.Dir(filePath), filepath.Base(filePath)+".*",
+		)
+		if err != nil {
+			return fmt.Errorf("unable to create snapshot file: %w",
+				err)
+		}
+		defer func() {
+			if err != nil {
+				_ = out.Close()
+				_ = os.Remove(out.Name())
+			}
+		}()
+	}
+
+	var summary *unirpc.SnapshotSummary
+	for summary == nil {
+		resp, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive snapshot: %w", err)
+		}
+
+		if _, err := out.Write(resp.Data); err != nil {
+			return fmt.Errorf("unable to write snapshot: %w", err)
+		}
+
+		summary = resp.Summary
+	}
+
+	// The summary would be mixed up with the snapshot on stdout, so we
+	// only print it if the snapshot is written to a file.
+	if toStdout {
+		return nil
+	}
+
+	if err := out.Chmod(defaultFilePerms); err != nil {
+		return fmt.Errorf("unable to set snapshot file permissions: "+
+			"%w", err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("unable to close snapshot file: %w", err)
+	}
+	if err := os.Rename(out.Name(), filePath); err != nil {
+		return fmt.Errorf("unable to store snapshot file %v: %w",
+			filePath, err)
+	}
+
+	printRespJSON(summary)
+	return nil
+}
+
+var universeSnapshotImportCommand = cli.Command{
+	Name:  "import",
+	Usage: "import a snapshot into the universe",
+	Description: `
+	Import a snapshot that was created with the "universe snapshot export"
+	command. The leaves of each universe are only inserted once they match
+	the universe root stored in the snapshot, and every proof is fully
+	verified. The summary of the snapshot, which includes the multiverse
+	roots, is printed once the import is complete.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: snapshotFileName,
+			Usage: "the path to the snapshot file on disk; use " +
+				"the dash character (-) to read from stdin " +
+				"instead",
+		},
+	},
+	Action: universeSnapshotImport,
+}
+
+func universeSnapshotImport(ctx *cli.Context) error {
+	if ctx.String(snapshotFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(snapshotFileName))
+	in := os.Stdin
+	if filePath != "-" {
+		var err error
+		in, err = os.Open(filePath)
+		if err != nil {
+			return fmt.Errorf("unable to open snapshot file: %w",
+				err)
+		}
+		defer in.Close()
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.ImportSnapshot(ctxc)
+	if err != nil {
+		return fmt.Errorf("unable to import snapshot: %w", err)
+	}
+
+	piece := make([]byte, snapshotPieceSize)
+	for {
+		n, err := in.Read(piece)
+		if n > 0 {
+			sendErr := stream.Send(&unirpc.ImportSnapshotRequest{
+				Data: fn.CopySlice(piece[:n]),
+			})
+
+			// If the daemon rejected the snapshot, the actual
+			// error is returned when closing the stream.
+			if errors.Is(sendErr, io.EOF) {
+				break
+			}
+			if sendErr != nil {
+				return fmt.Errorf("unable to send snapshot: %w",
+					sendErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read snapshot file: %w",
+				err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("unable to import snapshot: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/tapbackup"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
@@ -183,12 +185,41 @@ type Config struct {
 
 	ChainPorter tapfreighter.Porter
 
+	Consolidator *tapfreighter.Consolidator
+
+	BackupManager *tapbackup.Manager
+
 	UniverseArchive *universe.Archive
 
 	UniverseSyncer universe.Syncer
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseCommitter periodically commits the multiverse roots to the
+	// chain.
+	UniverseCommitter *tapgarden.UniverseCommitter
+
+	// UniverseCommitments is the log of the chain commitments to the
+	// multiverse roots, used to serve proofs along with the commitment
+	// they're included in.
+	UniverseCommitments universe.Canonical
+
+	// UniverseGossiper exchanges signed multiverse roots with the
+	// federation servers to detect equivocation.
+	UniverseGossiper *universe.RootGossiper
+
+	// UniverseAdmitter decides whether proofs inserted by remote clients
+	// are admitted into the local universes.
+	UniverseAdmitter *universe.InsertAdmitter
+
+	// UniversePruner periodically applies the retention policy to the
+	// local universes.
+	UniversePruner *universe.Pruner
+
+	// SpvHeaderChain is the SPV header chain universe proofs are verified
+	// against, if it is enabled.
+	SpvHeaderChain fn.Option[*proof.HeaderChain]
+
 	// UniFedSyncAllAssets is a flag that indicates whether the
 	// universe federation syncer should default to syncing all assets.
 	UniFedSyncAllAssets bool
@@ -199,6 +230,8 @@ type Config struct {
 
 	UniverseStats universe.Telemetry
 
+	UniverseSearcher universe.AssetSearcher
+
 	AuxLeafSigner *tapchannel.AuxLeafSigner
 
 	AuxFundingController *tapchannel.FundingController
@@ -211,6 +244,8 @@ type Config struct {
 
 	AuxSweeper *tapchannel.AuxSweeper
 
+	ChannelBackupManager *tapchannel.ChannelBackupManager
+
 	// UniversePublicAccess is a field that indicates the status of public
 	// access (i.e. read/write) to the universe server.
 	//
@@ -227,6 +262,10 @@ type Config struct {
 	// limiting.
 	UniverseQueriesBurst int
 
+	// UniverseBanThreshold is the reputation score below which a
+	// federation server is banned from syncing.
+	UniverseBanThreshold int32
+
 	Prometheus monitoring.PrometheusConfig
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
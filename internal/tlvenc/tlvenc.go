@@ -0,0 +1,110 @@
+// Package tlvenc contains the TLV helpers that are shared by the serialized
+// formats of the daemon, like backups, universe snapshots and the multiverse
+// cache state.
+package tlvenc
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// EncodeStream encodes the given records as a TLV stream.
+func EncodeStream(records ...tlv.Record) ([]byte, error) {
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeStream decodes the given bytes as a TLV stream into the given records
+// and returns the types that were present in the stream.
+func DecodeStream(b []byte, records ...tlv.Record) (tlv.TypeMap, error) {
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.DecodeWithParsedTypes(bytes.NewReader(b))
+}
+
+// EncodeList encodes the given items as a var int count followed by the
+// length prefixed encoding of each item.
+func EncodeList[T any](items []T, encode func(T) ([]byte, error)) ([]byte,
+	error) {
+
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	if err := tlv.WriteVarInt(&b, uint64(len(items)), &buf); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		itemBytes, err := encode(item)
+		if err != nil {
+			return nil, err
+		}
+
+		err = asset.InlineVarBytesEncoder(&b, &itemBytes, &buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeList decodes a list of items that was encoded with EncodeList.
+func DecodeList[T any](b []byte, decode func([]byte) (T, error)) ([]T,
+	error) {
+
+	var (
+		r   = bytes.NewReader(b)
+		buf [8]byte
+	)
+	numItems, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every item takes up at least one byte, so a count that is larger
+	// than the remaining data can only be the result of corruption.
+	if numItems > uint64(r.Len()) {
+		return nil, fmt.Errorf("invalid number of items: %d", numItems)
+	}
+
+	items := make([]T, 0, numItems)
+	for i := uint64(0); i < numItems; i++ {
+		var itemBytes []byte
+		err := asset.InlineVarBytesDecoder(
+			r, &itemBytes, &buf, uint64(len(b)),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := decode(itemBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after list", r.Len())
+	}
+
+	return items, nil
+}
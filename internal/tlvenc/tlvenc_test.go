@@ -0,0 +1,52 @@
+package tlvenc
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListRoundTrip tests that a list survives an encode and decode round
+// trip and that corrupted lists are rejected.
+func TestListRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	encodeItem := func(v uint32) ([]byte, error) {
+		return EncodeStream(tlv.MakePrimitiveRecord(0, &v))
+	}
+	decodeItem := func(b []byte) (uint32, error) {
+		var v uint32
+		_, err := DecodeStream(b, tlv.MakePrimitiveRecord(0, &v))
+		return v, err
+	}
+
+	items := []uint32{1, 2, 3, 1 << 31}
+	b, err := EncodeList(items, encodeItem)
+	require.NoError(t, err)
+
+	decoded, err := DecodeList(b, decodeItem)
+	require.NoError(t, err)
+	require.Equal(t, items, decoded)
+
+	// An empty list encodes to just its count.
+	b, err = EncodeList([]uint32{}, encodeItem)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, b)
+
+	decoded, err = DecodeList(b, decodeItem)
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	// A count that is larger than the remaining data is rejected before
+	// anything is allocated.
+	_, err = DecodeList([]byte{0xfc}, decodeItem)
+	require.ErrorContains(t, err, "invalid number of items")
+
+	// Trailing bytes after the last item are rejected as well.
+	b, err = EncodeList(items[:1], encodeItem)
+	require.NoError(t, err)
+
+	_, err = DecodeList(append(b, 0), decodeItem)
+	require.ErrorContains(t, err, "trailing bytes")
+}
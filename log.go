@@ -7,6 +7,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/tapbackup"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
@@ -113,6 +114,9 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(
 		root, tapchannel.Subsystem, interceptor, tapchannel.UseLogger,
 	)
+	AddSubLogger(
+		root, tapbackup.Subsystem, interceptor, tapbackup.UseLogger,
+	)
 }
 
 // AddSubLogger is a helper method to conveniently create and register the
@@ -0,0 +1,80 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	rejectedInsertsMetric = "universe_rejected_inserts"
+)
+
+// admissionCollector is a Prometheus collector that exports the number of
+// proof insertions rejected by the universe admission policy.
+type admissionCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	rejectedInserts *prometheus.GaugeVec
+}
+
+func newAdmissionCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*admissionCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("admission collector prometheus cfg " +
+			"is nil")
+	}
+
+	if cfg.UniverseAdmitter == nil {
+		return nil, errors.New("admission collector universe " +
+			"admitter is nil")
+	}
+
+	return &admissionCollector{
+		cfg:      cfg,
+		registry: registry,
+		rejectedInserts: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: rejectedInsertsMetric,
+				Help: "Total number of rejected universe " +
+					"proof insertions",
+			},
+			[]string{"reason"},
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (a *admissionCollector) Describe(ch chan<- *prometheus.Desc) {
+	a.collectMx.Lock()
+	defer a.collectMx.Unlock()
+
+	a.rejectedInserts.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (a *admissionCollector) Collect(ch chan<- prometheus.Metric) {
+	a.collectMx.Lock()
+	defer a.collectMx.Unlock()
+
+	rejected := a.cfg.UniverseAdmitter.RejectedInserts()
+	for _, reason := range universe.AllRejectReasons {
+		a.rejectedInserts.WithLabelValues(reason.String()).Set(
+			float64(rejected[reason]),
+		)
+	}
+
+	a.rejectedInserts.Collect(ch)
+}
@@ -3,6 +3,7 @@ package monitoring
 import (
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/universe"
@@ -35,6 +36,19 @@ type PrometheusConfig struct {
 	// universe.
 	UniverseStats universe.Telemetry
 
+	// UniverseAdmitter is used to collect the number of proof insertions
+	// that were rejected by the universe admission policy.
+	UniverseAdmitter *universe.InsertAdmitter
+
+	// Multiverse is used to collect the statistics about the multiverse
+	// cache entries that were loaded from disk on startup.
+	Multiverse *tapdb.MultiverseStore
+
+	// PriceOracle is used to collect the query statistics of the upstream
+	// sources of the composite price oracle. It is nil if the composite
+	// price oracle isn't used.
+	PriceOracle *rfq.CompositePriceOracle
+
 	// AssetStore is used to collect any stats that are relevant to the
 	// asset store.
 	AssetStore *tapdb.AssetStore
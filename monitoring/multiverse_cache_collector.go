@@ -0,0 +1,98 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	warmStartEntriesMetric = "multiverse_cache_warm_start_entries"
+	warmStartHitsMetric    = "multiverse_cache_warm_start_hits"
+)
+
+// multiverseCacheCollector is a Prometheus collector that exports the
+// statistics about the multiverse cache entries that were loaded from disk on
+// startup.
+type multiverseCacheCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	warmStartEntries *prometheus.GaugeVec
+	warmStartHits    *prometheus.GaugeVec
+}
+
+func newMultiverseCacheCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*multiverseCacheCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("multiverse cache collector " +
+			"prometheus cfg is nil")
+	}
+
+	if cfg.Multiverse == nil {
+		return nil, errors.New("multiverse cache collector " +
+			"multiverse is nil")
+	}
+
+	return &multiverseCacheCollector{
+		cfg:      cfg,
+		registry: registry,
+		warmStartEntries: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: warmStartEntriesMetric,
+				Help: "Number of multiverse cache entries " +
+					"read from disk on startup",
+			},
+			[]string{"cache", "status"},
+		),
+		warmStartHits: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: warmStartHitsMetric,
+				Help: "Number of multiverse cache entries " +
+					"loaded from disk that served at " +
+					"least one request",
+			},
+			[]string{"cache"},
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (m *multiverseCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	m.collectMx.Lock()
+	defer m.collectMx.Unlock()
+
+	m.warmStartEntries.Describe(ch)
+	m.warmStartHits.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (m *multiverseCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	m.collectMx.Lock()
+	defer m.collectMx.Unlock()
+
+	for _, stats := range m.cfg.Multiverse.WarmStartStats() {
+		m.warmStartEntries.WithLabelValues(stats.Cache, "loaded").Set(
+			float64(stats.Loaded),
+		)
+		m.warmStartEntries.WithLabelValues(
+			stats.Cache, "discarded",
+		).Set(float64(stats.Discarded))
+		m.warmStartHits.WithLabelValues(stats.Cache).Set(
+			float64(stats.Hits),
+		)
+	}
+
+	m.warmStartEntries.Collect(ch)
+	m.warmStartHits.Collect(ch)
+}
@@ -0,0 +1,123 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	oracleSourceQueriesMetric  = "price_oracle_source_queries"
+	oracleSourceFailuresMetric = "price_oracle_source_failures"
+	oracleSourceLatencyMetric  = "price_oracle_source_latency_seconds"
+)
+
+// priceOracleCollector is a Prometheus collector that exports the query
+// statistics of the upstream sources of the composite price oracle.
+type priceOracleCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	queries  *prometheus.GaugeVec
+	failures *prometheus.GaugeVec
+	latency  *prometheus.GaugeVec
+}
+
+func newPriceOracleCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*priceOracleCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("price oracle collector prometheus " +
+			"cfg is nil")
+	}
+
+	if cfg.PriceOracle == nil {
+		return nil, errors.New("price oracle collector price oracle " +
+			"is nil")
+	}
+
+	return &priceOracleCollector{
+		cfg:      cfg,
+		registry: registry,
+		queries: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: oracleSourceQueriesMetric,
+				Help: "Total number of queries sent to a " +
+					"price oracle source",
+			},
+			[]string{"source"},
+		),
+		failures: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: oracleSourceFailuresMetric,
+				Help: "Total number of queries to a price " +
+					"oracle source that didn't provide a " +
+					"usable asset rate",
+			},
+			[]string{"source", "reason"},
+		),
+		latency: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: oracleSourceLatencyMetric,
+				Help: "Latency of the queries sent to a " +
+					"price oracle source",
+			},
+			[]string{"source", "stat"},
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (p *priceOracleCollector) Describe(ch chan<- *prometheus.Desc) {
+	p.collectMx.Lock()
+	defer p.collectMx.Unlock()
+
+	p.queries.Describe(ch)
+	p.failures.Describe(ch)
+	p.latency.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (p *priceOracleCollector) Collect(ch chan<- prometheus.Metric) {
+	p.collectMx.Lock()
+	defer p.collectMx.Unlock()
+
+	for _, stats := range p.cfg.PriceOracle.SourceStats() {
+		p.queries.WithLabelValues(stats.Name).Set(
+			float64(stats.Queries),
+		)
+
+		p.failures.WithLabelValues(stats.Name, "error").Set(
+			float64(stats.Errors),
+		)
+		p.failures.WithLabelValues(stats.Name, "stale").Set(
+			float64(stats.Stale),
+		)
+		p.failures.WithLabelValues(stats.Name, "outlier").Set(
+			float64(stats.Outliers),
+		)
+
+		var avgLatency float64
+		if stats.Queries > 0 {
+			avgLatency = stats.TotalLatency.Seconds() /
+				float64(stats.Queries)
+		}
+		p.latency.WithLabelValues(stats.Name, "last").Set(
+			stats.LastLatency.Seconds(),
+		)
+		p.latency.WithLabelValues(stats.Name, "avg").Set(avgLatency)
+	}
+
+	p.queries.Collect(ch)
+	p.failures.Collect(ch)
+	p.latency.Collect(ch)
+}
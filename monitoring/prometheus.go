@@ -68,6 +68,32 @@ func (p *PrometheusExporter) Start() error {
 	}
 	p.registry.MustRegister(uniStatsCollector)
 
+	admissionCollector, err := newAdmissionCollector(p.config, p.registry)
+	if err != nil {
+		return err
+	}
+	p.registry.MustRegister(admissionCollector)
+
+	cacheCollector, err := newMultiverseCacheCollector(
+		p.config, p.registry,
+	)
+	if err != nil {
+		return err
+	}
+	p.registry.MustRegister(cacheCollector)
+
+	// The price oracle collector is only registered if the composite
+	// price oracle is used, as it has no statistics otherwise.
+	if p.config.PriceOracle != nil {
+		oracleCollector, err := newPriceOracleCollector(
+			p.config, p.registry,
+		)
+		if err != nil {
+			return err
+		}
+		p.registry.MustRegister(oracleCollector)
+	}
+
 	assetBalancesCollecor, err :=
 		newAssetBalancesCollector(p.config, p.registry)
 	if err != nil {
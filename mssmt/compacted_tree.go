@@ -392,3 +392,109 @@ func (t *CompactedTree) MerkleProof(ctx context.Context, key [hashSize]byte) (
 
 	return NewProof(proof), nil
 }
+
+// MaxBranchNodesDepth is the maximum number of levels below a branch that the
+// nodes can be fetched for in one go.
+const MaxBranchNodesDepth = 16
+
+// children returns the left and right child of the given node at the passed
+// height.
+func (t *CompactedTree) children(tx TreeStoreViewTx, height int,
+	node Node) (Node, Node, error) {
+
+	switch node := node.(type) {
+	// A compacted leaf represents a subtree with a single leaf, so the
+	// child on the path to the leaf is a compacted leaf one level lower,
+	// and the other child is empty.
+	case *CompactedLeafNode:
+		key := node.Key()
+		child := NewCompactedLeafNode(height+1, &key, node.LeafNode)
+
+		if bitIndex(uint8(height), &key) == 0 {
+			return child, EmptyTree[height+1], nil
+		}
+
+		return EmptyTree[height+1], child, nil
+
+	default:
+		if node.NodeHash() == EmptyTree[height].NodeHash() {
+			return EmptyTree[height+1], EmptyTree[height+1], nil
+		}
+
+		return tx.GetChildren(height, node.NodeHash())
+	}
+}
+
+// BranchNodes returns all nodes that are `depth` levels below the branch that
+// is reached by following the first `prefixLen` bits of the given key prefix.
+// The remaining bits of the prefix are ignored. The node at index i of the
+// returned slice is reached by following bit j of i at the j-th level below
+// the branch, which is the same bit order that is used for the leaf keys. For
+// a byte aligned prefix and a depth of 8, the index of a node therefore is the
+// value of the byte that follows the prefix in all keys of its subtree.
+func (t *CompactedTree) BranchNodes(ctx context.Context,
+	prefix [hashSize]byte, prefixLen, depth int) ([]Node, error) {
+
+	switch {
+	case prefixLen < 0 || prefixLen > MaxTreeLevels:
+		return nil, fmt.Errorf("invalid prefix length: %d", prefixLen)
+
+	case depth < 0 || depth > MaxBranchNodesDepth:
+		return nil, fmt.Errorf("invalid depth: %d", depth)
+
+	case prefixLen+depth > MaxTreeLevels:
+		return nil, fmt.Errorf("depth %d below prefix of length %d "+
+			"exceeds tree height", depth, prefixLen)
+	}
+
+	nodes := make([]Node, 1<<depth)
+	err := t.store.View(ctx, func(tx TreeStoreViewTx) error {
+		current, err := tx.RootNode()
+		if err != nil {
+			return err
+		}
+
+		// First, we walk down to the branch identified by the prefix.
+		for i := 0; i < prefixLen; i++ {
+			left, right, err := t.children(tx, i, current)
+			if err != nil {
+				return err
+			}
+
+			current, _ = stepOrder(i, &prefix, left, right)
+		}
+
+		// From there, we collect all nodes at the target height, each
+		// one placed at the index given by its path.
+		var collect func(height, index int, node Node) error
+		collect = func(height, index int, node Node) error {
+			level := height - prefixLen
+			if level == depth {
+				nodes[index] = NewComputedNode(
+					node.NodeHash(), node.NodeSum(),
+				)
+
+				return nil
+			}
+
+			left, right, err := t.children(tx, height, node)
+			if err != nil {
+				return err
+			}
+
+			err = collect(height+1, index, left)
+			if err != nil {
+				return err
+			}
+
+			return collect(height+1, index|1<<level, right)
+		}
+
+		return collect(prefixLen, 0, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
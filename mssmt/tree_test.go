@@ -996,3 +996,147 @@ func runBIPTestVector(t *testing.T, testVectors *mssmt.TestVectors) {
 		})
 	}
 }
+
+// combineBranchNodes hashes the nodes returned by BranchNodes up to the branch
+// they are below of.
+func combineBranchNodes(nodes []mssmt.Node) mssmt.Node {
+	for len(nodes) > 1 {
+		half := len(nodes) / 2
+		parents := make([]mssmt.Node, half)
+		for i := range parents {
+			parents[i] = mssmt.NewBranch(nodes[i], nodes[i+half])
+		}
+
+		nodes = parents
+	}
+
+	return nodes[0]
+}
+
+// pathIndex returns the index of the node at the given depth below the branch
+// at the given prefix length that is on the path to the given key.
+func pathIndex(key [hashSize]byte, prefixLen, depth int) int {
+	var index int
+	for j := 0; j < depth; j++ {
+		bit := prefixLen + j
+		index |= int((key[bit/8]>>(bit%8))&1) << j
+	}
+
+	return index
+}
+
+// TestBranchNodes tests that the nodes below a branch of a compacted tree can
+// be fetched and are consistent with the rest of the tree.
+func TestBranchNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leaves := randTree(100)
+
+	for storeName, makeStore := range genTestStores(t) {
+		t.Run(storeName, func(t *testing.T) {
+			store, err := makeStore()
+			require.NoError(t, err)
+
+			tree := mssmt.NewCompactedTree(store)
+
+			// The nodes of an empty tree are all empty.
+			var zeroKey [hashSize]byte
+			nodes, err := tree.BranchNodes(ctx, zeroKey, 0, 8)
+			require.NoError(t, err)
+			require.Len(t, nodes, 256)
+			for _, node := range nodes {
+				require.True(t, mssmt.IsEqualNode(
+					mssmt.EmptyTree[8], node,
+				))
+			}
+
+			for _, item := range leaves {
+				_, err := tree.Insert(ctx, item.key, item.leaf)
+				require.NoError(t, err)
+			}
+
+			root, err := tree.Root(ctx)
+			require.NoError(t, err)
+
+			// The nodes below the root hash up to the root.
+			for _, depth := range []int{0, 1, 4, 8} {
+				nodes, err := tree.BranchNodes(
+					ctx, zeroKey, 0, depth,
+				)
+				require.NoError(t, err)
+				require.Len(t, nodes, 1<<depth)
+				require.True(t, mssmt.IsEqualNode(
+					root, combineBranchNodes(nodes),
+				))
+			}
+
+			// The nodes along the path of a leaf are consistent
+			// with each other, and end in the leaf itself.
+			prefixLens := []int{8, 16, 250, 256}
+			for _, item := range leaves[:10] {
+				for _, prefixLen := range prefixLens {
+					depth := 8
+					if prefixLen > 248 {
+						depth = 6
+					}
+
+					nodes, err := tree.BranchNodes(
+						ctx, item.key, prefixLen, 0,
+					)
+					require.NoError(t, err)
+					branch := nodes[0]
+
+					parentLen := prefixLen - depth
+					nodes, err = tree.BranchNodes(
+						ctx, item.key, parentLen, depth,
+					)
+					require.NoError(t, err)
+
+					index := pathIndex(
+						item.key, parentLen, depth,
+					)
+					require.True(t, mssmt.IsEqualNode(
+						branch, nodes[index],
+					))
+
+					parents, err := tree.BranchNodes(
+						ctx, item.key, parentLen, 0,
+					)
+					require.NoError(t, err)
+					require.True(t, mssmt.IsEqualNode(
+						parents[0],
+						combineBranchNodes(nodes),
+					))
+				}
+
+				nodes, err := tree.BranchNodes(
+					ctx, item.key, mssmt.MaxTreeLevels, 0,
+				)
+				require.NoError(t, err)
+				require.True(t, mssmt.IsEqualNode(
+					item.leaf, nodes[0],
+				))
+			}
+
+			// Keys that aren't in the tree lead to an empty leaf.
+			nodes, err = tree.BranchNodes(
+				ctx, test.RandHash(), mssmt.MaxTreeLevels, 0,
+			)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(
+				mssmt.EmptyLeafNode, nodes[0],
+			))
+
+			// The depth is limited, both by the maximum and the
+			// height of the tree.
+			_, err = tree.BranchNodes(
+				ctx, zeroKey, 0, mssmt.MaxBranchNodesDepth+1,
+			)
+			require.Error(t, err)
+
+			_, err = tree.BranchNodes(ctx, zeroKey, 250, 8)
+			require.Error(t, err)
+		})
+	}
+}
@@ -68,18 +68,53 @@ var (
 			Entity: "proofs",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ConvertProofFile": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/SendAssetBatch": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/BurnAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/RecoverAssets": {{
+			Entity: "assets",
+			Action: "write",
+		}, {
+			Entity: "proofs",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/ListBurns": {{
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ExportBackup": {{
+			Entity: "assets",
+			Action: "read",
+		}, {
+			Entity: "addresses",
+			Action: "read",
+		}, {
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/RestoreBackup": {{
+			Entity: "assets",
+			Action: "write",
+		}, {
+			Entity: "addresses",
+			Action: "write",
+		}, {
+			Entity: "proofs",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/FetchAssetMeta": {{
 			Entity: "assets",
 			Action: "read",
@@ -144,6 +179,10 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/ConsolidateAssetUtxos": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/MintAsset": {{
 			Entity: "mint",
 			Action: "write",
@@ -192,6 +231,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/BranchNodes": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AssetLeaves": {{
 			Entity: "universe",
 			Action: "read",
@@ -200,6 +243,14 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/SubscribeUniverseLeaves": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SignedMultiverseRoots": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/InsertProof": {{
 			Entity: "universe",
 			Action: "write",
@@ -232,6 +283,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/SearchAssets": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/QueryEvents": {{
 			Entity: "universe",
 			Action: "read",
@@ -244,6 +299,14 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/ExportSnapshot": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ImportSnapshot": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/rfqrpc.Rfq/AddAssetBuyOrder": {{
 			Entity: "rfq",
 			Action: "write",
@@ -264,6 +327,10 @@ var (
 			Entity: "rfq",
 			Action: "read",
 		}},
+		"/rfqrpc.Rfq/QueryQuoteHistory": {{
+			Entity: "rfq",
+			Action: "read",
+		}},
 		"/rfqrpc.Rfq/SubscribeRfqEventNtfns": {{
 			Entity: "rfq",
 			Action: "write",
@@ -284,6 +351,14 @@ var (
 			Entity: "channels",
 			Action: "read",
 		}},
+		"/tapchannelrpc.TaprootAssetChannels/ExportChannelBackup": {{
+			Entity: "channels",
+			Action: "read",
+		}},
+		"/tapchannelrpc.TaprootAssetChannels/RestoreChannelBackup": {{
+			Entity: "channels",
+			Action: "write",
+		}},
 		"/tapchannelrpc.TaprootAssetChannels/EncodeCustomRecords": {
 			// This RPC is completely stateless and doesn't require
 			// any permissions to use.
@@ -312,8 +387,13 @@ var (
 		"/universerpc.Universe/AssetRoots":      {},
 		"/universerpc.Universe/QueryAssetRoots": {},
 		"/universerpc.Universe/AssetLeafKeys":   {},
+		"/universerpc.Universe/BranchNodes":     {},
 		"/universerpc.Universe/AssetLeaves":     {},
 		"/universerpc.Universe/Info":            {},
+
+		// Federation members exchange their signed multiverse roots to
+		// detect equivocation, so these need to be public as well.
+		"/universerpc.Universe/SignedMultiverseRoots": {},
 	}
 )
 
@@ -334,6 +414,13 @@ func MacaroonWhitelist(allowUniPublicAccessRead bool,
 		whitelist["/universerpc.Universe/QueryProof"] = struct{}{}
 	}
 
+	// The leaf subscription is only needed by other universe servers, so
+	// it isn't made available to proof couriers.
+	if allowUniPublicAccessRead {
+		whitelist["/universerpc.Universe/SubscribeUniverseLeaves"] =
+			struct{}{}
+	}
+
 	// Conditionally whitelist universe server write methods.
 	if allowUniPublicAccessWrite || allowPublicUniProofCourier {
 		whitelist["/universerpc.Universe/InsertProof"] = struct{}{}
@@ -344,6 +431,7 @@ func MacaroonWhitelist(allowUniPublicAccessRead bool,
 		whitelist["/universerpc.Universe/QueryAssetStats"] = struct{}{}
 		whitelist["/universerpc.Universe/UniverseStats"] = struct{}{}
 		whitelist["/universerpc.Universe/QueryEvents"] = struct{}{}
+		whitelist["/universerpc.Universe/SearchAssets"] = struct{}{}
 	}
 
 	return whitelist
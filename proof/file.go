@@ -41,6 +41,12 @@ const (
 	// V0 is the first version of the proof file.
 	V0 Version = 0
 
+	// V1 is the compressed version of the proof file. It contains the same
+	// proofs as a V0 file, but block headers and exclusion proofs that are
+	// used multiple times within the file (for example by the proofs of
+	// additional inputs) are only encoded once.
+	V1 Version = 1
+
 	// FileMaxNumProofs is the maximum number of proofs we expect/allow to
 	// be encoded within a single proof file. Given that there can only be
 	// one transfer per block, this value would be enough to transfer an
@@ -126,23 +132,16 @@ func NewFile(v Version, proofs ...Proof) (*File, error) {
 
 // Encode encodes the proof file into `w` including its checksum.
 func (f *File) Encode(w io.Writer) error {
-	num, err := w.Write(FilePrefixMagicBytes[:])
-	if err != nil {
+	if err := f.encodeHeader(w, f.Version); err != nil {
 		return err
 	}
-	if num != PrefixMagicBytesLength {
-		return errors.New("failed to write prefix magic bytes")
-	}
 
-	err = binary.Write(w, binary.BigEndian, uint32(f.Version))
-	if err != nil {
+	if f.Version == V1 {
+		_, err := f.encodeCompressed(w)
 		return err
 	}
 
 	var tlvBuf [8]byte
-	if err := tlv.WriteVarInt(w, uint64(len(f.proofs)), &tlvBuf); err != nil {
-		return err
-	}
 	for _, proof := range f.proofs {
 		proof := proof
 
@@ -172,97 +171,171 @@ func (f *File) Encode(w io.Writer) error {
 	return nil
 }
 
+// encodeHeader writes the prefix magic bytes, the given version and the number
+// of proofs of the file to `w`.
+func (f *File) encodeHeader(w io.Writer, version Version) error {
+	num, err := w.Write(FilePrefixMagicBytes[:])
+	if err != nil {
+		return err
+	}
+	if num != PrefixMagicBytesLength {
+		return errors.New("failed to write prefix magic bytes")
+	}
+
+	err = binary.Write(w, binary.BigEndian, uint32(version))
+	if err != nil {
+		return err
+	}
+
+	var tlvBuf [8]byte
+	return tlv.WriteVarInt(w, uint64(len(f.proofs)), &tlvBuf)
+}
+
 // Decode decodes a proof file from `r`.
 func (f *File) Decode(r io.Reader) error {
+	version, numProofs, err := decodeFileHeader(r)
+	if err != nil {
+		return err
+	}
+	f.Version = version
+
+	readProof, err := newProofReader(r, version)
+	if err != nil {
+		return err
+	}
+
+	var prevHash [sha256.Size]byte
+	f.proofs = make([]*hashedProof, numProofs)
+	for i := uint64(0); i < numProofs; i++ {
+		f.proofs[i], err = readProof(prevHash)
+		if err != nil {
+			return err
+		}
+		prevHash = f.proofs[i].hash
+	}
+
+	return nil
+}
+
+// proofReader is a function that reads the next proof of a proof file and
+// verifies its checksum against the given previous hash.
+type proofReader func(prevHash [sha256.Size]byte) (*hashedProof, error)
+
+// newProofReader returns a proofReader that reads the proofs of a file with the
+// given version from `r`. The file header must already have been read.
+func newProofReader(r io.Reader, version Version) (proofReader, error) {
+	if version != V1 {
+		return func(prevHash [sha256.Size]byte) (*hashedProof, error) {
+			return decodeHashedProof(r, prevHash)
+		}, nil
+	}
+
+	// The proofs of a compressed file reference its dictionary, which
+	// comes right after the header.
+	d, err := decodeFileDictionary(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode file dictionary: %w",
+			err)
+	}
+
+	return func(prevHash [sha256.Size]byte) (*hashedProof, error) {
+		return decodeCompressedHashedProof(r, d, prevHash)
+	}, nil
+}
+
+// decodeFileHeader decodes the prefix magic bytes, the version and the number
+// of proofs of a proof file from `r`.
+func decodeFileHeader(r io.Reader) (Version, uint64, error) {
 	var prefixMagicBytes [PrefixMagicBytesLength]byte
 	num, err := r.Read(prefixMagicBytes[:])
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	if num != PrefixMagicBytesLength {
-		return errors.New("failed to read prefix magic bytes")
+		return 0, 0, errors.New("failed to read prefix magic bytes")
 	}
 
 	if prefixMagicBytes != FilePrefixMagicBytes {
-		return fmt.Errorf("invalid prefix magic bytes, expected %s, "+
-			"got %s", string(FilePrefixMagicBytes[:]),
+		return 0, 0, fmt.Errorf("invalid prefix magic bytes, expected "+
+			"%s, got %s", string(FilePrefixMagicBytes[:]),
 			string(prefixMagicBytes[:]))
 	}
 
 	var version uint32
 	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
-		return err
+		return 0, 0, err
 	}
-	f.Version = Version(version)
 
 	var tlvBuf [8]byte
 	numProofs, err := tlv.ReadVarInt(r, &tlvBuf)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
 	// Cap the number of proofs there can be within a single file to avoid
 	// OOM attacks. See the comment for FileMaxNumProofs for the reasoning
 	// behind the value chosen.
 	if numProofs > FileMaxNumProofs {
-		return fmt.Errorf("%w: too many proofs in file",
+		return 0, 0, fmt.Errorf("%w: too many proofs in file",
 			ErrProofFileInvalid)
 	}
 
-	var prevHash, currentHash, proofHash [sha256.Size]byte
-	f.proofs = make([]*hashedProof, numProofs)
-	for i := uint64(0); i < numProofs; i++ {
-		// We need to find out how many bytes we expect for the proof,
-		// so we can limit the TLV reader.
-		numProofBytes, err := tlv.ReadVarInt(r, &tlvBuf)
-		if err != nil {
-			return err
-		}
+	return Version(version), numProofs, nil
+}
 
-		// We also need to cap the size of an individual proof. See the
-		// comment for FileMaxProofSizeBytes for the reasoning behind the
-		// value chosen.
-		if numProofBytes > FileMaxProofSizeBytes {
-			return fmt.Errorf("%w: proof in file too large",
-				ErrProofFileInvalid)
-		}
+// decodeHashedProof decodes the next proof and its checksum from `r`. The
+// checksum is verified against the checksum of the given previous hash and the
+// proof.
+func decodeHashedProof(r io.Reader,
+	prevHash [sha256.Size]byte) (*hashedProof, error) {
 
-		// Read all bytes that belong to the proof. We don't decode the
-		// proof itself as we usually only need the last proof anyway.
-		proofBytes := make([]byte, numProofBytes)
-		if _, err := io.ReadFull(r, proofBytes); err != nil {
-			return err
-		}
+	// We need to find out how many bytes we expect for the proof, so we
+	// can limit the TLV reader.
+	var tlvBuf [8]byte
+	numProofBytes, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return nil, err
+	}
 
-		// We now read the proof's hash in the file which reflects the
-		// current checksum.
-		if _, err := io.ReadFull(r, proofHash[:]); err != nil {
-			return err
-		}
+	// We also need to cap the size of an individual proof. See the comment
+	// for FileMaxProofSizeBytes for the reasoning behind the value chosen.
+	if numProofBytes > FileMaxProofSizeBytes {
+		return nil, fmt.Errorf("%w: proof in file too large",
+			ErrProofFileInvalid)
+	}
 
-		// Now that we have read both the proof and the expected
-		// checksum of it, we calculate our own checksum and verify they
-		// match.
-		currentHash = hashProof(proofBytes, prevHash)
-		if proofHash != currentHash {
-			return ErrInvalidChecksum
-		}
+	// Read all bytes that belong to the proof. We don't decode the proof
+	// itself as we usually only need the last proof anyway.
+	proofBytes := make([]byte, numProofBytes)
+	if _, err := io.ReadFull(r, proofBytes); err != nil {
+		return nil, err
+	}
 
-		f.proofs[i] = &hashedProof{
-			proofBytes: proofBytes,
-			hash:       currentHash,
-		}
-		prevHash = currentHash
+	// We now read the proof's hash in the file which reflects the current
+	// checksum.
+	var proofHash [sha256.Size]byte
+	if _, err := io.ReadFull(r, proofHash[:]); err != nil {
+		return nil, err
 	}
 
-	return nil
+	// Now that we have read both the proof and the expected checksum of
+	// it, we calculate our own checksum and verify they match.
+	currentHash := hashProof(proofBytes, prevHash)
+	if proofHash != currentHash {
+		return nil, ErrInvalidChecksum
+	}
+
+	return &hashedProof{
+		proofBytes: proofBytes,
+		hash:       currentHash,
+	}, nil
 }
 
 // IsUnknownVersion returns true if a proof has a version that is not
 // recognized by this implementation of tap.
 func (f *File) IsUnknownVersion() bool {
 	switch f.Version {
-	case V0:
+	case V0, V1:
 		return false
 	default:
 		return true
@@ -0,0 +1,776 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// The compressed proof file format (V1) is a different encoding of the same
+// proofs as the V0 format. In memory, the proofs of a file are always kept in
+// their canonical encoding, so the chained checksums of a file are the same in
+// both versions and a file can be converted between the versions at any time.
+//
+// A compressed file starts with the same header as a V0 file, followed by a
+// dictionary of all unique block headers and exclusion proofs of the file,
+// including the ones of the proofs of additional inputs:
+//
+//	magic_bytes || version || num_proofs ||
+//	num_headers || header... ||
+//	num_exclusion_proofs || (len || exclusion_proof)... ||
+//	(len || compressed_proof || checksum)...
+//
+// A compressed proof is the TLV stream of the proof without the prefix magic
+// bytes, where the block header record contains an index into the header
+// dictionary, the exclusion proofs record contains a list of indexes into the
+// exclusion proof dictionary and the additional inputs are compressed
+// recursively using the same dictionary. The MS-SMT proofs within the proofs
+// are always serialized in their compressed form, in both versions.
+
+const (
+	// maxNumDictionaryEntries is the maximum number of entries we allow in
+	// each of the dictionaries of a compressed proof file. Every proof
+	// references exactly one block header and usually only a handful of
+	// exclusion proofs, and the dictionaries only contain unique entries,
+	// so a file with more entries than proofs isn't realistic.
+	maxNumDictionaryEntries = FileMaxNumProofs
+)
+
+// expansionBudget limits the number of bytes that the proofs of a compressed
+// proof file can expand to while they are decompressed. Because a dictionary
+// entry can be referenced any number of times, a small file could otherwise
+// expand to an amount of memory that is many orders of magnitude larger than
+// the file itself.
+type expansionBudget struct {
+	// proofRemaining is the number of bytes the current top level proof,
+	// including the proofs of its additional inputs, can still expand to.
+	proofRemaining uint64
+
+	// fileRemaining is the number of bytes the whole file, including its
+	// dictionary, can still expand to.
+	fileRemaining uint64
+}
+
+// newExpansionBudget creates a new expansion budget for a single file.
+func newExpansionBudget() *expansionBudget {
+	return &expansionBudget{
+		proofRemaining: FileMaxProofSizeBytes,
+		fileRemaining:  FileMaxSizeBytes,
+	}
+}
+
+// startProof resets the budget of a single proof before the next top level
+// proof of the file is decompressed from the given compressed encoding.
+func (b *expansionBudget) startProof(compressedLen uint64) error {
+	b.proofRemaining = FileMaxProofSizeBytes
+
+	return b.charge(compressedLen)
+}
+
+// chargeFile deducts the given number of bytes from the budget of the file
+// only, and fails if it is exceeded.
+func (b *expansionBudget) chargeFile(numBytes uint64) error {
+	if numBytes > b.fileRemaining {
+		return fmt.Errorf("%w: decompressed file too large",
+			ErrProofFileInvalid)
+	}
+
+	b.fileRemaining -= numBytes
+
+	return nil
+}
+
+// charge deducts the given number of bytes from the budget of both the current
+// proof and the file, and fails if either of them is exceeded.
+func (b *expansionBudget) charge(numBytes uint64) error {
+	if numBytes > b.proofRemaining {
+		return fmt.Errorf("%w: decompressed proof too large",
+			ErrProofFileInvalid)
+	}
+	if numBytes > b.fileRemaining {
+		return fmt.Errorf("%w: decompressed file too large",
+			ErrProofFileInvalid)
+	}
+
+	b.proofRemaining -= numBytes
+	b.fileRemaining -= numBytes
+
+	return nil
+}
+
+// fileDictionary holds the block headers and exclusion proofs that are shared
+// between the proofs of a compressed proof file.
+type fileDictionary struct {
+	// headers are the unique block headers of the file.
+	headers []wire.BlockHeader
+
+	// headerIndex maps the hash of a block header to its index in the
+	// headers slice.
+	headerIndex map[chainhash.Hash]uint64
+
+	// exclusionProofs are the unique encoded exclusion proofs of the file.
+	exclusionProofs [][]byte
+
+	// exclusionProofIndex maps an encoded exclusion proof to its index in
+	// the exclusionProofs slice.
+	exclusionProofIndex map[string]uint64
+
+	// numHeaderRefs is the total number of block headers that were
+	// referenced while compressing proofs.
+	numHeaderRefs uint64
+
+	// numExclusionProofRefs is the total number of exclusion proofs that
+	// were referenced while compressing proofs.
+	numExclusionProofRefs uint64
+
+	// budget limits the size the proofs can expand to while they are
+	// decompressed using the dictionary.
+	budget *expansionBudget
+}
+
+// newFileDictionary creates a new empty file dictionary.
+func newFileDictionary() *fileDictionary {
+	return &fileDictionary{
+		headerIndex:         make(map[chainhash.Hash]uint64),
+		exclusionProofIndex: make(map[string]uint64),
+		budget:              newExpansionBudget(),
+	}
+}
+
+// addHeader adds the given block header to the dictionary if it isn't known
+// yet and returns its index.
+func (d *fileDictionary) addHeader(header wire.BlockHeader) uint64 {
+	d.numHeaderRefs++
+
+	blockHash := header.BlockHash()
+	if idx, ok := d.headerIndex[blockHash]; ok {
+		return idx
+	}
+
+	idx := uint64(len(d.headers))
+	d.headers = append(d.headers, header)
+	d.headerIndex[blockHash] = idx
+
+	return idx
+}
+
+// addExclusionProof adds the given encoded exclusion proof to the dictionary if
+// it isn't known yet and returns its index.
+func (d *fileDictionary) addExclusionProof(proofBytes []byte) uint64 {
+	d.numExclusionProofRefs++
+
+	if idx, ok := d.exclusionProofIndex[string(proofBytes)]; ok {
+		return idx
+	}
+
+	idx := uint64(len(d.exclusionProofs))
+	d.exclusionProofs = append(d.exclusionProofs, proofBytes)
+	d.exclusionProofIndex[string(proofBytes)] = idx
+
+	return idx
+}
+
+// header returns the block header with the given index, charging its size to
+// the expansion budget.
+func (d *fileDictionary) header(idx uint64) (wire.BlockHeader, error) {
+	if idx >= uint64(len(d.headers)) {
+		return wire.BlockHeader{}, fmt.Errorf("%w: invalid block "+
+			"header index %d", ErrProofFileInvalid, idx)
+	}
+
+	if err := d.budget.charge(wire.MaxBlockHeaderPayload); err != nil {
+		return wire.BlockHeader{}, err
+	}
+
+	return d.headers[idx], nil
+}
+
+// exclusionProof decodes the exclusion proof with the given index, charging its
+// size to the expansion budget before it is decoded.
+func (d *fileDictionary) exclusionProof(idx uint64) (TaprootProof, error) {
+	var proof TaprootProof
+	if idx >= uint64(len(d.exclusionProofs)) {
+		return proof, fmt.Errorf("%w: invalid exclusion proof index %d",
+			ErrProofFileInvalid, idx)
+	}
+
+	err := d.budget.charge(uint64(len(d.exclusionProofs[idx])))
+	if err != nil {
+		return proof, err
+	}
+
+	err = proof.Decode(bytes.NewReader(d.exclusionProofs[idx]))
+	if err != nil {
+		return proof, fmt.Errorf("unable to decode exclusion proof: "+
+			"%w", err)
+	}
+
+	return proof, nil
+}
+
+// encode writes the dictionary to `w`.
+func (d *fileDictionary) encode(w io.Writer) error {
+	var buf [8]byte
+	err := tlv.WriteVarInt(w, uint64(len(d.headers)), &buf)
+	if err != nil {
+		return err
+	}
+	for _, header := range d.headers {
+		if err := header.Serialize(w); err != nil {
+			return err
+		}
+	}
+
+	err = tlv.WriteVarInt(w, uint64(len(d.exclusionProofs)), &buf)
+	if err != nil {
+		return err
+	}
+	for _, proofBytes := range d.exclusionProofs {
+		proofBytes := proofBytes
+		err := asset.InlineVarBytesEncoder(w, &proofBytes, &buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeFileDictionary decodes a dictionary of a compressed proof file from
+// `r`.
+func decodeFileDictionary(r io.Reader) (*fileDictionary, error) {
+	d := newFileDictionary()
+
+	var buf [8]byte
+	numHeaders, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if numHeaders > maxNumDictionaryEntries {
+		return nil, fmt.Errorf("%w: too many block headers in file",
+			ErrProofFileInvalid)
+	}
+
+	for i := uint64(0); i < numHeaders; i++ {
+		var header wire.BlockHeader
+		if err := header.Deserialize(r); err != nil {
+			return nil, err
+		}
+		d.headers = append(d.headers, header)
+	}
+
+	// The dictionary itself is kept in memory while the proofs are
+	// decompressed, so it counts towards the size of the file.
+	err = d.budget.chargeFile(numHeaders * wire.MaxBlockHeaderPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	numExclusionProofs, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if numExclusionProofs > maxNumDictionaryEntries {
+		return nil, fmt.Errorf("%w: too many exclusion proofs in file",
+			ErrProofFileInvalid)
+	}
+
+	for i := uint64(0); i < numExclusionProofs; i++ {
+		var proofBytes []byte
+		err := asset.InlineVarBytesDecoder(
+			r, &proofBytes, &buf, MaxTaprootProofSizeBytes,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.budget.chargeFile(uint64(len(proofBytes)))
+		if err != nil {
+			return nil, err
+		}
+		d.exclusionProofs = append(d.exclusionProofs, proofBytes)
+	}
+
+	return d, nil
+}
+
+// compressProof creates the compressed encoding of the given canonically
+// encoded proof, adding its block headers and exclusion proofs to the given
+// dictionary.
+func compressProof(proofBytes []byte, d *fileDictionary) ([]byte, error) {
+	var p Proof
+	if err := p.Decode(bytes.NewReader(proofBytes)); err != nil {
+		return nil, fmt.Errorf("error decoding proof: %w", err)
+	}
+
+	headerIdx := d.addHeader(p.BlockHeader)
+
+	var exclusionRefs bytes.Buffer
+	if len(p.ExclusionProofs) > 0 {
+		refs, err := compressExclusionProofs(p.ExclusionProofs, d)
+		if err != nil {
+			return nil, err
+		}
+		exclusionRefs.Write(refs)
+	}
+
+	var additionalInputs bytes.Buffer
+	if len(p.AdditionalInputs) > 0 {
+		err := compressAdditionalInputs(
+			&additionalInputs, p.AdditionalInputs, d,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// We encode the same records as the canonical encoding, but replace
+	// the ones that are deduplicated with references to the dictionary.
+	var (
+		exclusionRefBytes  = exclusionRefs.Bytes()
+		additionalInBytes  = additionalInputs.Bytes()
+		records            = p.EncodeRecords()
+		compressedRecords  = make([]tlv.Record, 0, len(records))
+		compressedProofBuf bytes.Buffer
+	)
+	for _, record := range records {
+		switch record.Type() {
+		case BlockHeaderType:
+			record = tlv.MakeBigSizeRecord(
+				BlockHeaderType, &headerIdx,
+			)
+
+		case ExclusionProofsType:
+			record = tlv.MakePrimitiveRecord(
+				ExclusionProofsType, &exclusionRefBytes,
+			)
+
+		case AdditionalInputsType:
+			record = tlv.MakePrimitiveRecord(
+				AdditionalInputsType, &additionalInBytes,
+			)
+		}
+
+		compressedRecords = append(compressedRecords, record)
+	}
+
+	stream, err := tlv.NewStream(compressedRecords...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Encode(&compressedProofBuf); err != nil {
+		return nil, err
+	}
+
+	return compressedProofBuf.Bytes(), nil
+}
+
+// compressExclusionProofs adds the given exclusion proofs to the dictionary and
+// returns the encoded list of their indexes.
+func compressExclusionProofs(proofs []TaprootProof,
+	d *fileDictionary) ([]byte, error) {
+
+	var (
+		refs bytes.Buffer
+		buf  [8]byte
+	)
+	err := tlv.WriteVarInt(&refs, uint64(len(proofs)), &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proof := range proofs {
+		var proofBuf bytes.Buffer
+		if err := proof.Encode(&proofBuf); err != nil {
+			return nil, err
+		}
+
+		idx := d.addExclusionProof(proofBuf.Bytes())
+		if err := tlv.WriteVarInt(&refs, idx, &buf); err != nil {
+			return nil, err
+		}
+	}
+
+	return refs.Bytes(), nil
+}
+
+// compressAdditionalInputs writes the compressed encoding of the given input
+// proof files to `w`, using the given dictionary.
+func compressAdditionalInputs(w io.Writer, inputs []File,
+	d *fileDictionary) error {
+
+	var buf [8]byte
+	if err := tlv.WriteVarInt(w, uint64(len(inputs)), &buf); err != nil {
+		return err
+	}
+
+	for _, inputFile := range inputs {
+		err := tlv.WriteVarInt(w, uint64(inputFile.Version), &buf)
+		if err != nil {
+			return err
+		}
+
+		numProofs := uint64(len(inputFile.proofs))
+		if err := tlv.WriteVarInt(w, numProofs, &buf); err != nil {
+			return err
+		}
+
+		for _, hashed := range inputFile.proofs {
+			compressed, err := compressProof(hashed.proofBytes, d)
+			if err != nil {
+				return err
+			}
+
+			err = asset.InlineVarBytesEncoder(w, &compressed, &buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// decompressProof decodes the given compressed proof using the given
+// dictionary and returns its canonical encoding.
+func decompressProof(compressed []byte, d *fileDictionary) ([]byte, error) {
+	var (
+		p                 Proof
+		headerIdx         uint64
+		exclusionRefBytes []byte
+		additionalInBytes []byte
+		records           = p.DecodeRecords()
+	)
+	for idx, record := range records {
+		switch record.Type() {
+		case BlockHeaderType:
+			records[idx] = tlv.MakeBigSizeRecord(
+				BlockHeaderType, &headerIdx,
+			)
+
+		case ExclusionProofsType:
+			records[idx] = tlv.MakePrimitiveRecord(
+				ExclusionProofsType, &exclusionRefBytes,
+			)
+
+		case AdditionalInputsType:
+			records[idx] = tlv.MakePrimitiveRecord(
+				AdditionalInputsType, &additionalInBytes,
+			)
+		}
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	unknownOddTypes, err := asset.TlvStrictDecode(
+		stream, bytes.NewReader(compressed), KnownProofTypes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding compressed proof: %w",
+			err)
+	}
+	p.UnknownOddTypes = unknownOddTypes
+
+	p.BlockHeader, err = d.header(headerIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(exclusionRefBytes) > 0 {
+		p.ExclusionProofs, err = decompressExclusionProofs(
+			exclusionRefBytes, d,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(additionalInBytes) > 0 {
+		p.AdditionalInputs, err = decompressAdditionalInputs(
+			bytes.NewReader(additionalInBytes), d,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proofBytes, err := encodeProof(&p)
+	if err != nil {
+		return nil, err
+	}
+
+	// The budget is charged with the size of the dictionary entries that
+	// are referenced, so the final encoding should never exceed it. But we
+	// enforce the same limit as for uncompressed files to be safe.
+	if len(proofBytes) > FileMaxProofSizeBytes {
+		return nil, fmt.Errorf("%w: proof in file too large",
+			ErrProofFileInvalid)
+	}
+
+	return proofBytes, nil
+}
+
+// decompressExclusionProofs decodes the exclusion proofs referenced by the
+// given encoded list of dictionary indexes.
+func decompressExclusionProofs(refBytes []byte,
+	d *fileDictionary) ([]TaprootProof, error) {
+
+	var (
+		r   = bytes.NewReader(refBytes)
+		buf [8]byte
+	)
+	numProofs, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if numProofs > MaxNumTaprootProofs {
+		return nil, fmt.Errorf("%w: too many taproot proofs",
+			ErrProofInvalid)
+	}
+
+	proofs := make([]TaprootProof, 0, numProofs)
+	for i := uint64(0); i < numProofs; i++ {
+		idx, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+
+		proof, err := d.exclusionProof(idx)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+
+	return proofs, nil
+}
+
+// decompressAdditionalInputs decodes compressed input proof files from `r`,
+// using the given dictionary.
+func decompressAdditionalInputs(r io.Reader,
+	d *fileDictionary) ([]File, error) {
+
+	var buf [8]byte
+	numInputs, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only allow this many previous witnesses, so there can't be more
+	// additional inputs as witnesses.
+	if numInputs > math.MaxUint16 {
+		return nil, tlv.ErrRecordTooLarge
+	}
+
+	inputs := make([]File, 0, numInputs)
+	for i := uint64(0); i < numInputs; i++ {
+		version, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		if version > math.MaxUint32 {
+			return nil, fmt.Errorf("%w: invalid input file version",
+				ErrProofFileInvalid)
+		}
+
+		numProofs, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		if numProofs > FileMaxNumProofs {
+			return nil, fmt.Errorf("%w: too many proofs in file",
+				ErrProofFileInvalid)
+		}
+
+		inputFile := NewEmptyFile(Version(version))
+		for j := uint64(0); j < numProofs; j++ {
+			var compressed []byte
+			err := asset.InlineVarBytesDecoder(
+				r, &compressed, &buf, FileMaxProofSizeBytes,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			proofBytes, err := decompressProof(compressed, d)
+			if err != nil {
+				return nil, err
+			}
+
+			err = inputFile.AppendProofRaw(proofBytes)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		inputs = append(inputs, *inputFile)
+	}
+
+	return inputs, nil
+}
+
+// encodeCompressed writes the compressed (V1) encoding of the file's proofs to
+// `w`, after the file header was written.
+func (f *File) encodeCompressed(w io.Writer) (*fileDictionary, error) {
+	d := newFileDictionary()
+	compressedProofs := make([][]byte, len(f.proofs))
+	for idx, hashed := range f.proofs {
+		compressed, err := compressProof(hashed.proofBytes, d)
+		if err != nil {
+			return nil, err
+		}
+		compressedProofs[idx] = compressed
+	}
+
+	if err := d.encode(w); err != nil {
+		return nil, err
+	}
+
+	var buf [8]byte
+	for idx, compressed := range compressedProofs {
+		compressed := compressed
+		err := asset.InlineVarBytesEncoder(w, &compressed, &buf)
+		if err != nil {
+			return nil, err
+		}
+
+		// The checksum is the same as in the uncompressed encoding, so
+		// it covers the canonical encoding of the proof.
+		if _, err := w.Write(f.proofs[idx].hash[:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// decodeCompressedHashedProof decodes the next compressed proof and its
+// checksum from `r`. The checksum is verified against the checksum of the
+// given previous hash and the canonical encoding of the proof.
+func decodeCompressedHashedProof(r io.Reader, d *fileDictionary,
+	prevHash [sha256.Size]byte) (*hashedProof, error) {
+
+	var (
+		compressed []byte
+		buf        [8]byte
+	)
+	err := asset.InlineVarBytesDecoder(
+		r, &compressed, &buf, FileMaxProofSizeBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every top level proof gets its own budget, which also covers the
+	// proofs of its additional inputs that are decompressed recursively.
+	if err := d.budget.startProof(uint64(len(compressed))); err != nil {
+		return nil, err
+	}
+
+	proofBytes, err := decompressProof(compressed, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var proofHash [sha256.Size]byte
+	if _, err := io.ReadFull(r, proofHash[:]); err != nil {
+		return nil, err
+	}
+
+	currentHash := hashProof(proofBytes, prevHash)
+	if proofHash != currentHash {
+		return nil, ErrInvalidChecksum
+	}
+
+	return &hashedProof{
+		proofBytes: proofBytes,
+		hash:       currentHash,
+	}, nil
+}
+
+// FileSizeReport compares the size of a proof file in the uncompressed (V0) and
+// the compressed (V1) encoding.
+type FileSizeReport struct {
+	// NumProofs is the number of proofs in the file, not including the
+	// proofs of additional inputs.
+	NumProofs uint64
+
+	// UncompressedSize is the size of the file in the V0 encoding.
+	UncompressedSize uint64
+
+	// CompressedSize is the size of the file in the V1 encoding.
+	CompressedSize uint64
+
+	// NumBlockHeaders is the number of block headers in the file,
+	// including the ones of the proofs of additional inputs.
+	NumBlockHeaders uint64
+
+	// NumUniqueBlockHeaders is the number of unique block headers in the
+	// file.
+	NumUniqueBlockHeaders uint64
+
+	// NumExclusionProofs is the number of exclusion proofs in the file,
+	// including the ones of the proofs of additional inputs.
+	NumExclusionProofs uint64
+
+	// NumUniqueExclusionProofs is the number of unique exclusion proofs in
+	// the file.
+	NumUniqueExclusionProofs uint64
+}
+
+// Convert returns a copy of the file with the given version. The proofs of the
+// file are the same in all versions, only their encoding differs.
+func (f *File) Convert(v Version) (*File, error) {
+	converted := &File{
+		Version: v,
+		proofs:  append([]*hashedProof(nil), f.proofs...),
+	}
+	if converted.IsUnknownVersion() {
+		return nil, ErrUnknownVersion
+	}
+
+	return converted, nil
+}
+
+// SizeReport encodes the file in both the uncompressed and the compressed
+// encoding and reports the difference.
+func (f *File) SizeReport() (*FileSizeReport, error) {
+	uncompressed, err := f.Convert(V0)
+	if err != nil {
+		return nil, err
+	}
+
+	var uncompressedBuf bytes.Buffer
+	if err := uncompressed.Encode(&uncompressedBuf); err != nil {
+		return nil, err
+	}
+
+	var compressedBuf bytes.Buffer
+	if err := f.encodeHeader(&compressedBuf, V1); err != nil {
+		return nil, err
+	}
+	d, err := f.encodeCompressed(&compressedBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSizeReport{
+		NumProofs:                uint64(len(f.proofs)),
+		UncompressedSize:         uint64(uncompressedBuf.Len()),
+		CompressedSize:           uint64(compressedBuf.Len()),
+		NumBlockHeaders:          d.numHeaderRefs,
+		NumUniqueBlockHeaders:    uint64(len(d.headers)),
+		NumExclusionProofs:       d.numExclusionProofRefs,
+		NumUniqueExclusionProofs: uint64(len(d.exclusionProofs)),
+	}, nil
+}
@@ -0,0 +1,257 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// loadTestProofFile loads the proof file test vector.
+func loadTestProofFile(t *testing.T) *File {
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	var f File
+	require.NoError(t, f.Decode(bytes.NewReader(proofBytes)))
+
+	return &f
+}
+
+// encodeFile encodes the given file and returns its raw bytes.
+func encodeFile(t *testing.T, f *File) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+
+	return buf.Bytes()
+}
+
+// assertSameProofs asserts that the two files contain the same proofs.
+func assertSameProofs(t *testing.T, expected, actual *File) {
+	require.Equal(t, expected.NumProofs(), actual.NumProofs())
+	for idx := range expected.proofs {
+		require.Equal(
+			t, expected.proofs[idx].proofBytes,
+			actual.proofs[idx].proofBytes,
+		)
+		require.Equal(
+			t, expected.proofs[idx].hash, actual.proofs[idx].hash,
+		)
+	}
+}
+
+// TestCompressedFile tests that a proof file can be converted to the
+// compressed version and back without changing its proofs.
+func TestCompressedFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testFile := loadTestProofFile(t)
+
+	// We create a file with a proof that spends the last asset of the test
+	// file twice as additional inputs, which means all block headers and
+	// exclusion proofs of the inputs are duplicates.
+	lastProof, err := testFile.LastProof()
+	require.NoError(t, err)
+	lastProof.AdditionalInputs = []File{*testFile, *testFile}
+
+	inputsFile, err := NewFile(V0, *lastProof)
+	require.NoError(t, err)
+
+	for _, f := range []*File{testFile, inputsFile} {
+		compressedFile, err := f.Convert(V1)
+		require.NoError(t, err)
+		require.Equal(t, V1, compressedFile.Version)
+
+		compressedBytes := encodeFile(t, compressedFile)
+		uncompressedBytes := encodeFile(t, f)
+
+		var decoded File
+		err = decoded.Decode(bytes.NewReader(compressedBytes))
+		require.NoError(t, err)
+		require.Equal(t, V1, decoded.Version)
+		assertSameProofs(t, f, &decoded)
+
+		// Converting the file back results in the exact same
+		// encoding as before.
+		uncompressed, err := decoded.Convert(V0)
+		require.NoError(t, err)
+		require.Equal(t, uncompressedBytes, encodeFile(t, uncompressed))
+
+		report, err := f.SizeReport()
+		require.NoError(t, err)
+		require.EqualValues(t, f.NumProofs(), report.NumProofs)
+		require.EqualValues(
+			t, len(uncompressedBytes), report.UncompressedSize,
+		)
+		require.EqualValues(
+			t, len(compressedBytes), report.CompressedSize,
+		)
+		require.Less(t, report.CompressedSize, report.UncompressedSize)
+	}
+
+	// Both verifiers must accept the compressed file and arrive at the same
+	// result.
+	expectedSnapshot, err := testFile.Verify(
+		ctx, MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup,
+	)
+	require.NoError(t, err)
+
+	compressedFile, err := testFile.Convert(V1)
+	require.NoError(t, err)
+	compressedBytes := encodeFile(t, compressedFile)
+
+	verifiers := []Verifier{&BaseVerifier{}, &StreamVerifier{}}
+	for _, verifier := range verifiers {
+		snapshot, err := verifier.Verify(
+			ctx, bytes.NewReader(compressedBytes),
+			MockHeaderVerifier, MockMerkleVerifier,
+			MockGroupVerifier, MockChainLookup,
+		)
+		require.NoError(t, err)
+		require.Equal(t, expectedSnapshot, snapshot)
+	}
+
+	// All block headers and exclusion proofs of the additional inputs are
+	// only encoded once.
+	report, err := inputsFile.SizeReport()
+	require.NoError(t, err)
+
+	numInputProofs := uint64(testFile.NumProofs())
+	require.EqualValues(t, 2*numInputProofs+1, report.NumBlockHeaders)
+	require.LessOrEqual(t, report.NumUniqueBlockHeaders, numInputProofs+1)
+	require.Greater(t, report.NumExclusionProofs, uint64(0))
+	require.LessOrEqual(
+		t, 2*report.NumUniqueExclusionProofs, report.NumExclusionProofs,
+	)
+
+	// Converting to an unknown version fails.
+	_, err = testFile.Convert(Version(212))
+	require.ErrorIs(t, err, ErrUnknownVersion)
+}
+
+// TestCompressedFileInvalid tests that corrupted compressed proof files are
+// rejected.
+func TestCompressedFileInvalid(t *testing.T) {
+	t.Parallel()
+
+	compressedFile, err := loadTestProofFile(t).Convert(V1)
+	require.NoError(t, err)
+	compressedBytes := encodeFile(t, compressedFile)
+
+	// A file that is cut off must be rejected.
+	var f File
+	truncated := compressedBytes[:len(compressedBytes)-1]
+	err = f.Decode(bytes.NewReader(truncated))
+	require.Error(t, err)
+
+	// A file with a modified checksum must be rejected.
+	invalidChecksum := bytes.Clone(compressedBytes)
+	invalidChecksum[len(invalidChecksum)-1] ^= 1
+	err = f.Decode(bytes.NewReader(invalidChecksum))
+	require.ErrorIs(t, err, ErrInvalidChecksum)
+
+	// A proof that references a block header that isn't part of the
+	// dictionary must be rejected.
+	d := newFileDictionary()
+	compressed, err := compressProof(compressedFile.proofs[0].proofBytes, d)
+	require.NoError(t, err)
+
+	_, err = decompressProof(compressed, newFileDictionary())
+	require.ErrorIs(t, err, ErrProofFileInvalid)
+
+	proofBytes, err := decompressProof(compressed, d)
+	require.NoError(t, err)
+	require.Equal(t, compressedFile.proofs[0].proofBytes, proofBytes)
+}
+
+// TestCompressedFileBomb tests that a small compressed proof file that
+// references a large dictionary entry many times is rejected before it is
+// expanded into memory.
+func TestCompressedFileBomb(t *testing.T) {
+	t.Parallel()
+
+	// We pick a valid exclusion proof from the test vector and inflate it
+	// with an unknown odd record, so it is close to the maximum size of a
+	// dictionary entry.
+	var exclusionProof *TaprootProof
+	for _, hashed := range loadTestProofFile(t).proofs {
+		var p Proof
+		err := p.Decode(bytes.NewReader(hashed.proofBytes))
+		require.NoError(t, err)
+
+		if len(p.ExclusionProofs) > 0 {
+			exclusionProof = &p.ExclusionProofs[0]
+			break
+		}
+	}
+	require.NotNil(t, exclusionProof)
+
+	exclusionProof.UnknownOddTypes = tlv.TypeMap{
+		1001: bytes.Repeat([]byte{0x01}, 60_000),
+	}
+	var entry bytes.Buffer
+	require.NoError(t, exclusionProof.Encode(&entry))
+
+	d := newFileDictionary()
+	d.headers = append(d.headers, wire.BlockHeader{})
+	d.exclusionProofs = append(d.exclusionProofs, entry.Bytes())
+
+	// The compressed proof references the same entry as often as possible,
+	// which would expand to more than a gigabyte.
+	var (
+		refs bytes.Buffer
+		buf  [8]byte
+	)
+	require.NoError(t, tlv.WriteVarInt(&refs, MaxNumTaprootProofs, &buf))
+	for i := uint64(0); i < MaxNumTaprootProofs; i++ {
+		require.NoError(t, tlv.WriteVarInt(&refs, 0, &buf))
+	}
+
+	var (
+		headerIdx  uint64
+		refBytes   = refs.Bytes()
+		compressed bytes.Buffer
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakeBigSizeRecord(BlockHeaderType, &headerIdx),
+		tlv.MakePrimitiveRecord(ExclusionProofsType, &refBytes),
+	)
+	require.NoError(t, err)
+	require.NoError(t, stream.Encode(&compressed))
+
+	bombFile := &File{
+		proofs: []*hashedProof{{}},
+	}
+	var fileBuf bytes.Buffer
+	require.NoError(t, bombFile.encodeHeader(&fileBuf, V1))
+	require.NoError(t, d.encode(&fileBuf))
+
+	compressedBytes := compressed.Bytes()
+	err = asset.InlineVarBytesEncoder(&fileBuf, &compressedBytes, &buf)
+	require.NoError(t, err)
+	_, err = fileBuf.Write(make([]byte, sha256.Size))
+	require.NoError(t, err)
+
+	// The whole file is only a fraction of the size of a single proof.
+	require.Less(t, fileBuf.Len(), FileMaxProofSizeBytes/1000)
+
+	var f File
+	err = f.Decode(bytes.NewReader(fileBuf.Bytes()))
+	require.ErrorIs(t, err, ErrProofFileInvalid)
+	require.ErrorContains(t, err, "decompressed proof too large")
+}
@@ -0,0 +1,801 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+var (
+	// ErrHeaderNotFound is returned if a block header isn't found in the
+	// header chain store.
+	ErrHeaderNotFound = errors.New("block header not found")
+
+	// ErrHeaderConflict is returned if a block header conflicts with the
+	// header of the header chain at the same height.
+	ErrHeaderConflict = errors.New("block header conflicts with header " +
+		"chain")
+
+	// ErrHeaderNotSynced is returned if a block header is above the tip of
+	// the header chain, even after syncing it with the header source.
+	ErrHeaderNotSynced = errors.New("header chain not synced to block " +
+		"height")
+
+	// ErrHeaderNotConnected is returned if a block header doesn't link to
+	// the header of the header chain at the previous height.
+	ErrHeaderNotConnected = errors.New("block header doesn't connect to " +
+		"header chain")
+
+	// ErrInvalidHeader is returned if a block header violates the
+	// consensus rules of the chain.
+	ErrInvalidHeader = errors.New("invalid block header")
+)
+
+const (
+	// DefaultHeaderSyncInterval is the default interval at which the
+	// header chain is synced with its header source.
+	DefaultHeaderSyncInterval = time.Minute
+
+	// minHeaderSyncInterval is the minimum time between two syncs that
+	// are triggered by verifying a header above the tip of the header
+	// chain. This keeps proofs with made up block heights from making us
+	// contact the header source over and over.
+	minHeaderSyncInterval = 10 * time.Second
+
+	// maxHeaderReorgDepth is the maximum number of headers a branch with
+	// more work can replace. Deeper reorgs are refused, so a header source
+	// can't make us load and compare large parts of the header chain.
+	maxHeaderReorgDepth = wire.MaxBlockHeadersPerMsg
+
+	// medianTimeHeaders is the number of headers the median time past of
+	// a header is calculated from.
+	medianTimeHeaders = 11
+
+	// numDenseLocatorHashes is the number of headers below the tip that
+	// are all part of a block locator, before it gets exponentially
+	// sparser.
+	numDenseLocatorHashes = 10
+)
+
+// StoredHeader is a block header of the SPV header chain, along with its
+// height.
+type StoredHeader struct {
+	// Header is the block header.
+	Header wire.BlockHeader
+
+	// Height is the height of the block.
+	Height uint32
+}
+
+// HeaderChainStore is a persistent store of the block headers of the SPV
+// header chain. The store holds a contiguous chain of headers, starting at the
+// genesis block.
+type HeaderChainStore interface {
+	// FetchHeader returns the header at the given height. If there is no
+	// header at that height, ErrHeaderNotFound is returned.
+	FetchHeader(ctx context.Context, height uint32) (*StoredHeader, error)
+
+	// FetchHeaders returns the headers from the start height up to and
+	// including the end height, in ascending order.
+	FetchHeaders(ctx context.Context, startHeight,
+		endHeight uint32) ([]StoredHeader, error)
+
+	// FetchBestHeader returns the header with the greatest height. If the
+	// store is empty, ErrHeaderNotFound is returned.
+	FetchBestHeader(ctx context.Context) (*StoredHeader, error)
+
+	// ConnectHeaders atomically replaces all headers at or above the
+	// height of the first given header with the given headers. The
+	// headers must be in ascending order without gaps, and the first one
+	// must link to the stored header at the height below it, unless it is
+	// the genesis block. Otherwise ErrHeaderNotConnected is returned.
+	ConnectHeaders(ctx context.Context, headers []StoredHeader) error
+}
+
+// HeaderSource is a source of block headers, such as a bitcoin node.
+type HeaderSource interface {
+	// FetchHeaders returns the headers that follow the most recent block
+	// of the given locator the source knows of, in ascending order. An
+	// empty list is returned if the source doesn't know of any headers
+	// beyond the locator.
+	FetchHeaders(ctx context.Context,
+		locator blockchain.BlockLocator) ([]wire.BlockHeader, error)
+}
+
+// HeaderChainConfig is the config of the SPV header chain.
+type HeaderChainConfig struct {
+	// Store is the persistent store of the header chain.
+	Store HeaderChainStore
+
+	// Source is the source new headers are synced from.
+	Source HeaderSource
+
+	// ChainParams are the parameters of the chain the headers belong to.
+	ChainParams *chaincfg.Params
+
+	// Clock is used to reject headers with a timestamp too far in the
+	// future.
+	Clock clock.Clock
+
+	// SyncInterval is the interval at which the header chain is synced
+	// with the header source in the background. If this is zero, the
+	// header chain is only synced on demand.
+	SyncInterval time.Duration
+}
+
+// HeaderChain is a light client header verifier that doesn't require a chain
+// backend. It keeps its own SPV header chain, which is synced from a header
+// source, like the peer to peer network.
+//
+// Just like the header chain of a full node, the chain is contiguous from the
+// genesis block, and every header is validated against the consensus rules in
+// the context of the headers before it: its proof of work, the difficulty
+// retarget rules, its timestamp, its version and the checkpoints of the chain.
+// Headers of a competing branch only replace the known ones if the branch has
+// more work.
+//
+// Verifying the header of a proof never modifies the header chain. The header
+// must match the known header at the same height, which is all a proof's
+// header can prove. Headers are only ever added by syncing with the header
+// source, and only if they connect to the known headers.
+type HeaderChain struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *HeaderChainConfig
+
+	// chainCtx provides the chain parameters to the consensus rules.
+	chainCtx *headerChainCtx
+
+	// syncMtx serializes the header syncs, so new headers are always
+	// connected to the latest tip.
+	syncMtx sync.Mutex
+
+	// lastSync is the time the last sync finished at. It is guarded by
+	// syncMtx.
+	lastSync time.Time
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewHeaderChain creates a new SPV header chain.
+func NewHeaderChain(cfg *HeaderChainConfig) *HeaderChain {
+	return &HeaderChain{
+		cfg: cfg,
+		chainCtx: &headerChainCtx{
+			params: cfg.ChainParams,
+		},
+		ContextGuard: &fn.ContextGuard{
+			Quit: make(chan struct{}),
+		},
+	}
+}
+
+// Start starts syncing the header chain in the background.
+func (c *HeaderChain) Start() error {
+	c.startOnce.Do(func() {
+		if c.cfg.SyncInterval == 0 {
+			log.Infof("SPV header chain only synced on demand")
+			return
+		}
+
+		log.Infof("Starting SPV header chain sync, interval=%v",
+			c.cfg.SyncInterval)
+
+		c.Wg.Add(1)
+		go c.syncLoop()
+	})
+
+	return nil
+}
+
+// Stop stops syncing the header chain.
+func (c *HeaderChain) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Info("Stopping SPV header chain sync")
+
+		close(c.Quit)
+		c.Wg.Wait()
+	})
+
+	return nil
+}
+
+// syncLoop syncs the header chain right away, and then each time the sync
+// interval elapsed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *HeaderChain) syncLoop() {
+	defer c.Wg.Done()
+
+	ticker := time.NewTicker(c.cfg.SyncInterval)
+	defer ticker.Stop()
+
+	syncHeaders := func() {
+		ctx, cancel := c.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		if err := c.Sync(ctx); err != nil {
+			log.Warnf("Unable to sync SPV header chain: %v", err)
+		}
+	}
+
+	syncHeaders()
+	for {
+		select {
+		case <-ticker.C:
+			syncHeaders()
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// HeaderVerifier returns a header verification callback that verifies the
+// given headers against the header chain.
+func (c *HeaderChain) HeaderVerifier(ctx context.Context) HeaderVerifier {
+	return func(header wire.BlockHeader, height uint32) error {
+		return c.VerifyHeader(ctx, header, height)
+	}
+}
+
+// VerifyHeader returns an error if the given header isn't the header of the
+// header chain at the given height. If the height is above the tip of the
+// header chain, the header chain is synced first. The header chain itself is
+// never modified by the given header.
+func (c *HeaderChain) VerifyHeader(ctx context.Context,
+	header wire.BlockHeader, height uint32) error {
+
+	// Without a height, we can't place the header within the chain. Only
+	// very old proofs don't specify the height of their block.
+	if height == 0 {
+		return fmt.Errorf("%w: block height required",
+			ErrInvalidHeader)
+	}
+
+	known, err := c.cfg.Store.FetchHeader(ctx, height)
+	if errors.Is(err, ErrHeaderNotFound) {
+		if err := c.syncToHeight(ctx, height); err != nil {
+			return err
+		}
+
+		known, err = c.cfg.Store.FetchHeader(ctx, height)
+		if errors.Is(err, ErrHeaderNotFound) {
+			return fmt.Errorf("%w: height=%d", ErrHeaderNotSynced,
+				height)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("unable to fetch header: %w", err)
+	}
+
+	hash, knownHash := header.BlockHash(), known.Header.BlockHash()
+	if hash != knownHash {
+		return fmt.Errorf("%w: height=%d, hash=%v, chain_hash=%v",
+			ErrHeaderConflict, height, hash, knownHash)
+	}
+
+	return nil
+}
+
+// syncToHeight syncs the header chain if its tip is below the given height,
+// unless the header chain was synced very recently.
+func (c *HeaderChain) syncToHeight(ctx context.Context, height uint32) error {
+	c.syncMtx.Lock()
+	defer c.syncMtx.Unlock()
+
+	// A concurrent sync might have reached the height already.
+	best, err := c.cfg.Store.FetchBestHeader(ctx)
+	switch {
+	case err == nil && best.Height >= height:
+		return nil
+
+	case err != nil && !errors.Is(err, ErrHeaderNotFound):
+		return fmt.Errorf("unable to fetch best header: %w", err)
+	}
+
+	now := c.cfg.Clock.Now()
+	if !c.lastSync.IsZero() &&
+		now.Sub(c.lastSync) < minHeaderSyncInterval {
+
+		return nil
+	}
+
+	return c.sync(ctx)
+}
+
+// Sync fetches new headers from the header source and connects them to the
+// header chain, until the source doesn't know of any more headers.
+func (c *HeaderChain) Sync(ctx context.Context) error {
+	c.syncMtx.Lock()
+	defer c.syncMtx.Unlock()
+
+	return c.sync(ctx)
+}
+
+// sync fetches new headers from the header source and connects them to the
+// header chain.
+//
+// NOTE: The sync mutex MUST be held when calling this method.
+func (c *HeaderChain) sync(ctx context.Context) error {
+	defer func() {
+		c.lastSync = c.cfg.Clock.Now()
+	}()
+
+	if err := c.connectGenesis(ctx); err != nil {
+		return err
+	}
+
+	for {
+		locator, heights, err := c.blockLocator(ctx)
+		if err != nil {
+			return err
+		}
+
+		headers, err := c.cfg.Source.FetchHeaders(ctx, locator)
+		if err != nil {
+			return fmt.Errorf("unable to fetch headers: %w", err)
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+
+		connected, err := c.connectHeaders(ctx, heights, headers)
+		if err != nil {
+			return err
+		}
+		if !connected {
+			return nil
+		}
+	}
+}
+
+// connectGenesis adds the genesis block of the chain to an empty header
+// chain.
+func (c *HeaderChain) connectGenesis(ctx context.Context) error {
+	genesis, err := c.cfg.Store.FetchHeader(ctx, 0)
+	switch {
+	case err == nil:
+		hash := genesis.Header.BlockHash()
+		if hash != *c.cfg.ChainParams.GenesisHash {
+			return fmt.Errorf("header chain starts with %v "+
+				"instead of genesis block %v", hash,
+				c.cfg.ChainParams.GenesisHash)
+		}
+
+		return nil
+
+	case !errors.Is(err, ErrHeaderNotFound):
+		return fmt.Errorf("unable to fetch genesis header: %w", err)
+	}
+
+	err = c.cfg.Store.ConnectHeaders(ctx, []StoredHeader{{
+		Header: c.cfg.ChainParams.GenesisBlock.Header,
+		Height: 0,
+	}})
+	if err != nil {
+		return fmt.Errorf("unable to store genesis header: %w", err)
+	}
+
+	return nil
+}
+
+// blockLocator returns a block locator for the tip of the header chain, along
+// with the heights of the headers it contains. The locator contains the
+// hashes of the headers right below the tip, followed by exponentially
+// sparser ones down to the genesis block.
+func (c *HeaderChain) blockLocator(ctx context.Context) (
+	blockchain.BlockLocator, map[chainhash.Hash]uint32, error) {
+
+	best, err := c.cfg.Store.FetchBestHeader(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch best header: %w",
+			err)
+	}
+
+	var (
+		locator blockchain.BlockLocator
+		heights = make(map[chainhash.Hash]uint32)
+		header  = best
+		step    = uint32(1)
+	)
+	for {
+		hash := header.Header.BlockHash()
+		locator = append(locator, &hash)
+		heights[hash] = header.Height
+
+		if header.Height == 0 {
+			return locator, heights, nil
+		}
+
+		if len(locator) >= numDenseLocatorHashes {
+			step *= 2
+		}
+
+		height := uint32(0)
+		if header.Height > step {
+			height = header.Height - step
+		}
+
+		header, err = c.cfg.Store.FetchHeader(ctx, height)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to fetch header "+
+				"at height %d: %w", height, err)
+		}
+	}
+}
+
+// connectHeaders validates the given headers, which follow the header with
+// one of the given hashes, and connects them to the header chain. If the
+// headers are part of a branch that forks off below the tip, the headers
+// above the fork point are only replaced if the branch has more work. False
+// is returned if none of the headers were connected.
+func (c *HeaderChain) connectHeaders(ctx context.Context,
+	heights map[chainhash.Hash]uint32,
+	headers []wire.BlockHeader) (bool, error) {
+
+	forkHeight, ok := heights[headers[0].PrevBlock]
+	if !ok {
+		return false, fmt.Errorf("%w: previous block %v of header %v "+
+			"not in block locator", ErrHeaderNotConnected,
+			headers[0].PrevBlock, headers[0].BlockHash())
+	}
+	for i := 1; i < len(headers); i++ {
+		prevHash := headers[i-1].BlockHash()
+		if headers[i].PrevBlock != prevHash {
+			return false, fmt.Errorf("%w: header %v doesn't link "+
+				"to header %v", ErrHeaderNotConnected,
+				headers[i].BlockHash(), prevHash)
+		}
+	}
+
+	best, err := c.cfg.Store.FetchBestHeader(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unable to fetch best header: %w",
+			err)
+	}
+	if best.Height-forkHeight > maxHeaderReorgDepth {
+		return false, fmt.Errorf("%w: branch forks off at height %d, "+
+			"%d blocks below the tip", ErrHeaderConflict,
+			forkHeight, best.Height-forkHeight)
+	}
+
+	// Besides the headers above the fork point, which the new headers
+	// might replace, we need enough headers below it to validate the new
+	// headers against the difficulty and timestamp rules.
+	numContextHeaders := uint32(
+		c.chainCtx.BlocksPerRetarget() + medianTimeHeaders,
+	)
+	startHeight := uint32(0)
+	if forkHeight > numContextHeaders {
+		startHeight = forkHeight - numContextHeaders
+	}
+	stored, err := c.cfg.Store.FetchHeaders(ctx, startHeight, best.Height)
+	if err != nil {
+		return false, fmt.Errorf("unable to fetch headers: %w", err)
+	}
+	if len(stored) != int(best.Height-startHeight+1) {
+		return false, fmt.Errorf("header chain has gaps between "+
+			"height %d and %d", startHeight, best.Height)
+	}
+
+	window := newHeaderWindow()
+	for _, header := range stored[:forkHeight-startHeight+1] {
+		window.add(header)
+	}
+	replaced := stored[forkHeight-startHeight+1:]
+
+	// The source might send us some headers we already know of before
+	// the ones we don't.
+	for len(headers) > 0 && len(replaced) > 0 &&
+		headers[0].BlockHash() == replaced[0].Header.BlockHash() {
+
+		window.add(replaced[0])
+		headers, replaced = headers[1:], replaced[1:]
+		forkHeight++
+	}
+	if len(headers) == 0 {
+		return false, nil
+	}
+
+	var (
+		prev       = window.nodes[forkHeight]
+		newHeaders = make([]StoredHeader, 0, len(headers))
+		newWork    = new(big.Int)
+	)
+	for _, header := range headers {
+		if err := c.checkHeader(&header, prev); err != nil {
+			return false, err
+		}
+
+		newHeader := StoredHeader{
+			Header: header,
+			Height: prev.header.Height + 1,
+		}
+		newHeaders = append(newHeaders, newHeader)
+		newWork.Add(newWork, blockchain.CalcWork(header.Bits))
+
+		prev = window.add(newHeader)
+	}
+
+	// Just like a full node, we only switch to another branch if it has
+	// more work than the one we're on.
+	if len(replaced) > 0 {
+		oldWork := new(big.Int)
+		for _, header := range replaced {
+			work := blockchain.CalcWork(header.Header.Bits)
+			oldWork.Add(oldWork, work)
+		}
+
+		if newWork.Cmp(oldWork) <= 0 {
+			log.Debugf("Ignoring %d headers of branch forking off "+
+				"at height %d without more work",
+				len(newHeaders), forkHeight)
+
+			return false, nil
+		}
+
+		log.Infof("Replacing %d headers above height %d with %d "+
+			"headers of branch with more work", len(replaced),
+			forkHeight, len(newHeaders))
+	}
+
+	if err := c.cfg.Store.ConnectHeaders(ctx, newHeaders); err != nil {
+		return false, fmt.Errorf("unable to store headers: %w", err)
+	}
+
+	log.Debugf("Connected %d headers to SPV header chain, new tip at "+
+		"height %d", len(newHeaders), prev.header.Height)
+
+	return true, nil
+}
+
+// checkHeader checks that the given header follows the consensus rules of the
+// chain, in the context of the header it follows.
+func (c *HeaderChain) checkHeader(header *wire.BlockHeader,
+	prev *headerNode) error {
+
+	hash := header.BlockHash()
+	err := blockchain.CheckBlockHeaderSanity(
+		header, c.cfg.ChainParams.PowLimit,
+		&clockTimeSource{clock: c.cfg.Clock}, blockchain.BFNone,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: header %v: %v", ErrInvalidHeader, hash,
+			err)
+	}
+
+	err = blockchain.CheckBlockHeaderContext(
+		header, prev, blockchain.BFNone, c.chainCtx, false,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: header %v: %v", ErrInvalidHeader, hash,
+			err)
+	}
+
+	return nil
+}
+
+// CurrentHeight returns the height of the tip of the header chain.
+func (c *HeaderChain) CurrentHeight(ctx context.Context) (uint32, error) {
+	best, err := c.cfg.Store.FetchBestHeader(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch best header: %w", err)
+	}
+
+	return best.Height, nil
+}
+
+// GetBlockTimestamp returns the timestamp of the block at the given height.
+// If the header chain isn't synced to that height yet, the timestamp of its
+// tip is returned instead. Since that timestamp is earlier, time based locks
+// are evaluated conservatively. Zero is returned if the header chain is empty.
+func (c *HeaderChain) GetBlockTimestamp(ctx context.Context,
+	height uint32) int64 {
+
+	if height == 0 {
+		return 0
+	}
+
+	header, err := c.cfg.Store.FetchHeader(ctx, height)
+	if errors.Is(err, ErrHeaderNotFound) {
+		header, err = c.cfg.Store.FetchBestHeader(ctx)
+	}
+	if err != nil {
+		return 0
+	}
+
+	return header.Header.Timestamp.Unix()
+}
+
+// headerWindow is a contiguous part of the header chain that is kept in memory
+// while new headers are validated against it.
+type headerWindow struct {
+	// nodes are the headers of the window, by height.
+	nodes map[uint32]*headerNode
+}
+
+// newHeaderWindow creates a new empty header window.
+func newHeaderWindow() *headerWindow {
+	return &headerWindow{
+		nodes: make(map[uint32]*headerNode),
+	}
+}
+
+// add adds the given header to the window and returns its node.
+func (w *headerWindow) add(header StoredHeader) *headerNode {
+	node := &headerNode{
+		header: header,
+		window: w,
+	}
+	w.nodes[header.Height] = node
+
+	return node
+}
+
+// headerNode is a header of a header window.
+type headerNode struct {
+	// header is the header of the node.
+	header StoredHeader
+
+	// window is the window the node is part of.
+	window *headerWindow
+}
+
+// Height returns the height of the header.
+//
+// NOTE: This is part of the blockchain.HeaderCtx interface.
+func (n *headerNode) Height() int32 {
+	return int32(n.header.Height)
+}
+
+// Bits returns the compact target of the header.
+//
+// NOTE: This is part of the blockchain.HeaderCtx interface.
+func (n *headerNode) Bits() uint32 {
+	return n.header.Header.Bits
+}
+
+// Timestamp returns the timestamp of the header.
+//
+// NOTE: This is part of the blockchain.HeaderCtx interface.
+func (n *headerNode) Timestamp() int64 {
+	return n.header.Header.Timestamp.Unix()
+}
+
+// Parent returns the header at the previous height, if it's in the window.
+//
+// NOTE: This is part of the blockchain.HeaderCtx interface.
+func (n *headerNode) Parent() blockchain.HeaderCtx {
+	return n.RelativeAncestorCtx(1)
+}
+
+// RelativeAncestorCtx returns the header the given number of blocks below
+// this one, if it's in the window.
+//
+// NOTE: This is part of the blockchain.HeaderCtx interface.
+func (n *headerNode) RelativeAncestorCtx(
+	distance int32) blockchain.HeaderCtx {
+
+	if distance < 0 || n.Height() < distance {
+		return nil
+	}
+
+	ancestor, ok := n.window.nodes[n.header.Height-uint32(distance)]
+	if !ok {
+		return nil
+	}
+
+	return ancestor
+}
+
+// headerChainCtx provides the parameters of the chain to the consensus rules
+// of btcd.
+type headerChainCtx struct {
+	params *chaincfg.Params
+}
+
+// ChainParams returns the parameters of the chain.
+//
+// NOTE: This is part of the blockchain.ChainCtx interface.
+func (c *headerChainCtx) ChainParams() *chaincfg.Params {
+	return c.params
+}
+
+// BlocksPerRetarget returns the number of blocks between two difficulty
+// retargets.
+//
+// NOTE: This is part of the blockchain.ChainCtx interface.
+func (c *headerChainCtx) BlocksPerRetarget() int32 {
+	return int32(c.params.TargetTimespan / c.params.TargetTimePerBlock)
+}
+
+// MinRetargetTimespan returns the minimum timespan in seconds a difficulty
+// retarget is calculated with.
+//
+// NOTE: This is part of the blockchain.ChainCtx interface.
+func (c *headerChainCtx) MinRetargetTimespan() int64 {
+	targetTimespan := int64(c.params.TargetTimespan / time.Second)
+	return targetTimespan / c.params.RetargetAdjustmentFactor
+}
+
+// MaxRetargetTimespan returns the maximum timespan in seconds a difficulty
+// retarget is calculated with.
+//
+// NOTE: This is part of the blockchain.ChainCtx interface.
+func (c *headerChainCtx) MaxRetargetTimespan() int64 {
+	targetTimespan := int64(c.params.TargetTimespan / time.Second)
+	return targetTimespan * c.params.RetargetAdjustmentFactor
+}
+
+// VerifyCheckpoint returns false if there is a checkpoint at the given height
+// that doesn't match the given hash.
+//
+// NOTE: This is part of the blockchain.ChainCtx interface.
+func (c *headerChainCtx) VerifyCheckpoint(height int32,
+	hash *chainhash.Hash) bool {
+
+	for _, checkpoint := range c.params.Checkpoints {
+		if checkpoint.Height == height {
+			return *checkpoint.Hash == *hash
+		}
+	}
+
+	return true
+}
+
+// FindPreviousCheckpoint returns nil, as forks are limited by the maximum reorg
+// depth instead of the checkpoints.
+//
+// NOTE: This is part of the blockchain.ChainCtx interface.
+func (c *headerChainCtx) FindPreviousCheckpoint() (blockchain.HeaderCtx,
+	error) {
+
+	return nil, nil
+}
+
+// clockTimeSource is a time source for the consensus rules that uses the local
+// clock without any adjustments.
+type clockTimeSource struct {
+	clock clock.Clock
+}
+
+// AdjustedTime returns the current time of the clock.
+//
+// NOTE: This is part of the blockchain.MedianTimeSource interface.
+func (s *clockTimeSource) AdjustedTime() time.Time {
+	return time.Unix(s.clock.Now().Unix(), 0)
+}
+
+// AddTimeSample ignores the given time sample.
+//
+// NOTE: This is part of the blockchain.MedianTimeSource interface.
+func (s *clockTimeSource) AddTimeSample(string, time.Time) {}
+
+// Offset returns zero, as the clock is never adjusted.
+//
+// NOTE: This is part of the blockchain.MedianTimeSource interface.
+func (s *clockTimeSource) Offset() time.Duration {
+	return 0
+}
+
+// A compile-time assertion to ensure the consensus rule contexts meet their
+// interfaces.
+var (
+	_ blockchain.HeaderCtx        = (*headerNode)(nil)
+	_ blockchain.ChainCtx         = (*headerChainCtx)(nil)
+	_ blockchain.MedianTimeSource = (*clockTimeSource)(nil)
+)
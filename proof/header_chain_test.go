@@ -0,0 +1,504 @@
+package proof
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockHeaderChainStore is an in-memory header chain store.
+type mockHeaderChainStore struct {
+	sync.Mutex
+
+	headers []StoredHeader
+}
+
+// newMockHeaderChainStore creates a new empty in-memory header chain store.
+func newMockHeaderChainStore() *mockHeaderChainStore {
+	return &mockHeaderChainStore{}
+}
+
+func (m *mockHeaderChainStore) FetchHeader(_ context.Context,
+	height uint32) (*StoredHeader, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if height >= uint32(len(m.headers)) {
+		return nil, ErrHeaderNotFound
+	}
+
+	header := m.headers[height]
+	return &header, nil
+}
+
+func (m *mockHeaderChainStore) FetchHeaders(_ context.Context, startHeight,
+	endHeight uint32) ([]StoredHeader, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	endHeight = min(endHeight+1, uint32(len(m.headers)))
+	if startHeight >= endHeight {
+		return nil, nil
+	}
+
+	return append([]StoredHeader{}, m.headers[startHeight:endHeight]...),
+		nil
+}
+
+func (m *mockHeaderChainStore) FetchBestHeader(
+	context.Context) (*StoredHeader, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.headers) == 0 {
+		return nil, ErrHeaderNotFound
+	}
+
+	header := m.headers[len(m.headers)-1]
+	return &header, nil
+}
+
+func (m *mockHeaderChainStore) ConnectHeaders(_ context.Context,
+	headers []StoredHeader) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	first := headers[0]
+	switch {
+	case first.Height > uint32(len(m.headers)):
+		return ErrHeaderNotConnected
+
+	case first.Height > 0 && first.Header.PrevBlock !=
+		m.headers[first.Height-1].Header.BlockHash():
+
+		return ErrHeaderNotConnected
+	}
+
+	m.headers = append(m.headers[:first.Height:first.Height], headers...)
+
+	return nil
+}
+
+// bestHeight returns the height of the best stored header.
+func (m *mockHeaderChainStore) bestHeight() uint32 {
+	m.Lock()
+	defer m.Unlock()
+
+	return uint32(len(m.headers) - 1)
+}
+
+var _ HeaderChainStore = (*mockHeaderChainStore)(nil)
+
+// mockHeaderSource is a header source that serves the headers of a single
+// chain, like a bitcoin node would.
+type mockHeaderSource struct {
+	sync.Mutex
+
+	// chain is the chain of headers that is served, starting at the
+	// genesis block.
+	chain []wire.BlockHeader
+
+	// maxHeaders is the maximum number of headers returned at once.
+	maxHeaders int
+
+	// numFetches is the number of times headers were fetched.
+	numFetches int
+}
+
+// setChain replaces the chain of headers that is served.
+func (m *mockHeaderSource) setChain(chain []wire.BlockHeader) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.chain = chain
+}
+
+// fetches returns the number of times headers were fetched.
+func (m *mockHeaderSource) fetches() int {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.numFetches
+}
+
+func (m *mockHeaderSource) FetchHeaders(_ context.Context,
+	locator blockchain.BlockLocator) ([]wire.BlockHeader, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.numFetches++
+
+	return locateHeaders(m.chain, locator, m.maxHeaders), nil
+}
+
+var _ HeaderSource = (*mockHeaderSource)(nil)
+
+// locateHeaders returns at most the given number of headers of the given
+// chain that follow the most recent block of the locator that is part of the
+// chain.
+func locateHeaders(chain []wire.BlockHeader, locator blockchain.BlockLocator,
+	maxHeaders int) []wire.BlockHeader {
+
+	start := 1
+locate:
+	for _, hash := range locator {
+		for height, header := range chain {
+			if header.BlockHash() == *hash {
+				start = height + 1
+				break locate
+			}
+		}
+	}
+
+	end := min(start+maxHeaders, len(chain))
+	if start >= end {
+		return nil
+	}
+
+	return append([]wire.BlockHeader{}, chain[start:end]...)
+}
+
+// testHeaderChainParams returns chain parameters with a trivial proof of work
+// limit and a difficulty period of 10 blocks.
+func testHeaderChainParams() *chaincfg.Params {
+	params := chaincfg.RegressionNetParams
+	params.PoWNoRetargeting = false
+	params.ReduceMinDifficulty = false
+	params.TargetTimePerBlock = time.Minute
+	params.TargetTimespan = 10 * time.Minute
+	params.Checkpoints = nil
+
+	return &params
+}
+
+// mineHeader creates a header with the given previous block and target that
+// either meets or misses the target.
+func mineHeader(prevBlock chainhash.Hash, bits uint32, timestamp time.Time,
+	valid bool) wire.BlockHeader {
+
+	header := wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  prevBlock,
+		MerkleRoot: test.RandHash(),
+		Timestamp:  timestamp,
+		Bits:       bits,
+	}
+
+	target := blockchain.CompactToBig(bits)
+	for {
+		hash := header.BlockHash()
+		meetsTarget := blockchain.HashToBig(&hash).Cmp(target) <= 0
+		if meetsTarget == valid {
+			return header
+		}
+
+		header.Nonce++
+	}
+}
+
+// nextBits returns the target the header following the given chain must have,
+// according to the difficulty retarget rules.
+func nextBits(params *chaincfg.Params, chain []wire.BlockHeader) uint32 {
+	var (
+		blocksPerRetarget = int(
+			params.TargetTimespan / params.TargetTimePerBlock,
+		)
+		factor = time.Duration(params.RetargetAdjustmentFactor)
+		prev   = chain[len(chain)-1]
+	)
+	if len(chain)%blocksPerRetarget != 0 {
+		return prev.Bits
+	}
+
+	first := chain[len(chain)-blocksPerRetarget]
+	timespan := prev.Timestamp.Sub(first.Timestamp)
+	timespan = max(timespan, params.TargetTimespan/factor)
+	timespan = min(timespan, params.TargetTimespan*factor)
+
+	target := blockchain.CompactToBig(prev.Bits)
+	target.Mul(target, big.NewInt(int64(timespan/time.Second)))
+	target.Div(target, big.NewInt(int64(params.TargetTimespan/time.Second)))
+	if target.Cmp(params.PowLimit) > 0 {
+		target.Set(params.PowLimit)
+	}
+
+	return blockchain.BigToCompact(target)
+}
+
+// mineChain extends the given chain by the given number of valid headers that
+// are the given time apart. The chain is copied, so it can be used to create
+// multiple branches.
+func mineChain(params *chaincfg.Params, chain []wire.BlockHeader, num int,
+	spacing time.Duration) []wire.BlockHeader {
+
+	chain = append([]wire.BlockHeader{}, chain...)
+	for i := 0; i < num; i++ {
+		prev := chain[len(chain)-1]
+		chain = append(chain, mineHeader(
+			prev.BlockHash(), nextBits(params, chain),
+			prev.Timestamp.Add(spacing), true,
+		))
+	}
+
+	return chain
+}
+
+// newTestHeaderChain creates a header chain with an in-memory store that is
+// synced from a source serving the given chain.
+func newTestHeaderChain(params *chaincfg.Params, chain []wire.BlockHeader,
+	now time.Time) (*HeaderChain, *mockHeaderChainStore, *mockHeaderSource,
+	*clock.TestClock) {
+
+	store := newMockHeaderChainStore()
+	source := &mockHeaderSource{
+		chain:      chain,
+		maxHeaders: 10,
+	}
+	testClock := clock.NewTestClock(now)
+	headerChain := NewHeaderChain(&HeaderChainConfig{
+		Store:       store,
+		Source:      source,
+		ChainParams: params,
+		Clock:       testClock,
+	})
+
+	return headerChain, store, source, testClock
+}
+
+// TestHeaderChainSync tests that the SPV header chain is synced from its
+// header source and that verifying headers never modifies it.
+func TestHeaderChainSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(time.Now().Unix(), 0)
+	params := testHeaderChainParams()
+
+	// The blocks are mined faster than the target time, so the difficulty
+	// increases at every retarget.
+	genesis := []wire.BlockHeader{params.GenesisBlock.Header}
+	chain := mineChain(params, genesis, 25, 10*time.Second)
+	require.NotEqual(t, chain[9].Bits, chain[10].Bits)
+	require.NotEqual(t, chain[19].Bits, chain[20].Bits)
+
+	headerChain, store, source, testClock := newTestHeaderChain(
+		params, chain, now,
+	)
+
+	require.NoError(t, headerChain.Sync(ctx))
+	require.EqualValues(t, 25, store.bestHeight())
+
+	height, err := headerChain.CurrentHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 25, height)
+
+	for height, header := range chain[1:] {
+		err := headerChain.VerifyHeader(ctx, header, uint32(height+1))
+		require.NoError(t, err)
+	}
+
+	// A header needs a height.
+	err = headerChain.VerifyHeader(ctx, chain[1], 0)
+	require.ErrorIs(t, err, ErrInvalidHeader)
+
+	// A valid header that isn't part of the header chain is rejected,
+	// without being added.
+	header := mineHeader(chain[4].BlockHash(), chain[4].Bits, now, true)
+	err = headerChain.VerifyHeader(ctx, header, 5)
+	require.ErrorIs(t, err, ErrHeaderConflict)
+
+	known, err := store.FetchHeader(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, chain[5], known.Header)
+
+	// A header above the tip makes the header chain sync first, unless it
+	// was synced very recently.
+	chain = mineChain(params, chain, 5, 10*time.Second)
+	source.setChain(chain)
+
+	err = headerChain.VerifyHeader(ctx, chain[28], 28)
+	require.ErrorIs(t, err, ErrHeaderNotSynced)
+	require.EqualValues(t, 25, store.bestHeight())
+
+	testClock.SetTime(now.Add(minHeaderSyncInterval))
+	require.NoError(t, headerChain.VerifyHeader(ctx, chain[28], 28))
+	require.EqualValues(t, 30, store.bestHeight())
+
+	// A header the source doesn't know of either is rejected, and doesn't
+	// change the height of the header chain.
+	header = mineHeader(chain[30].BlockHash(), chain[30].Bits, now, true)
+	numFetches := source.fetches()
+	testClock.SetTime(now.Add(2 * minHeaderSyncInterval))
+
+	err = headerChain.VerifyHeader(ctx, header, 1000)
+	require.ErrorIs(t, err, ErrHeaderNotSynced)
+	require.EqualValues(t, 30, store.bestHeight())
+	require.Greater(t, source.fetches(), numFetches)
+
+	// If the header chain isn't synced to a height, the timestamp of its
+	// tip is used.
+	require.Equal(
+		t, chain[20].Timestamp.Unix(),
+		headerChain.GetBlockTimestamp(ctx, 20),
+	)
+	require.Equal(
+		t, chain[30].Timestamp.Unix(),
+		headerChain.GetBlockTimestamp(ctx, 1000),
+	)
+}
+
+// TestHeaderChainReorg tests that the SPV header chain switches to a branch
+// with more work.
+func TestHeaderChainReorg(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(time.Now().Unix(), 0)
+	params := testHeaderChainParams()
+
+	genesis := []wire.BlockHeader{params.GenesisBlock.Header}
+	chain := mineChain(params, genesis, 25, 10*time.Second)
+
+	headerChain, store, source, _ := newTestHeaderChain(
+		params, chain, now,
+	)
+	require.NoError(t, headerChain.Sync(ctx))
+
+	// A branch with less work than the header chain is ignored.
+	branch := mineChain(params, chain[:21], 3, 10*time.Second)
+	source.setChain(branch)
+
+	require.NoError(t, headerChain.Sync(ctx))
+	require.EqualValues(t, 25, store.bestHeight())
+	require.NoError(t, headerChain.VerifyHeader(ctx, chain[22], 22))
+
+	// Once it has more work, the header chain switches to it.
+	branch = mineChain(params, branch, 7, 10*time.Second)
+	source.setChain(branch)
+
+	require.NoError(t, headerChain.Sync(ctx))
+	require.EqualValues(t, 30, store.bestHeight())
+	require.NoError(t, headerChain.VerifyHeader(ctx, branch[22], 22))
+	require.NoError(t, headerChain.VerifyHeader(ctx, chain[20], 20))
+
+	err := headerChain.VerifyHeader(ctx, chain[22], 22)
+	require.ErrorIs(t, err, ErrHeaderConflict)
+}
+
+// TestHeaderChainInvalidHeaders tests that headers that violate the consensus
+// rules or don't connect to the header chain are never stored.
+func TestHeaderChainInvalidHeaders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(time.Now().Unix(), 0)
+	params := testHeaderChainParams()
+
+	genesis := []wire.BlockHeader{params.GenesisBlock.Header}
+	chain := mineChain(params, genesis, 25, 10*time.Second)
+	tip := chain[25]
+	bits := nextBits(params, chain)
+
+	testCases := []struct {
+		name   string
+		header wire.BlockHeader
+		err    error
+	}{{
+		name: "proof of work",
+		header: mineHeader(
+			tip.BlockHash(), bits, tip.Timestamp.Add(time.Second),
+			false,
+		),
+		err: ErrInvalidHeader,
+	}, {
+		name: "difficulty",
+		header: mineHeader(
+			tip.BlockHash(), params.PowLimitBits,
+			tip.Timestamp.Add(time.Second), true,
+		),
+		err: ErrInvalidHeader,
+	}, {
+		name: "timestamp before median time",
+		header: mineHeader(
+			tip.BlockHash(), bits, chain[15].Timestamp, true,
+		),
+		err: ErrInvalidHeader,
+	}, {
+		name: "timestamp in the future",
+		header: mineHeader(
+			tip.BlockHash(), bits, now.Add(3*time.Hour), true,
+		),
+		err: ErrInvalidHeader,
+	}, {
+		name: "not connected",
+		header: mineHeader(
+			test.RandHash(), bits, tip.Timestamp.Add(time.Second),
+			true,
+		),
+		err: ErrHeaderNotConnected,
+	}}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			headerChain, store, source, _ := newTestHeaderChain(
+				params, chain, now,
+			)
+			require.NoError(t, headerChain.Sync(ctx))
+
+			// The invalid header is followed by a valid one, so
+			// the batch of headers is rejected as a whole.
+			badChain := mineChain(
+				params, append(chain[:26:26], testCase.header),
+				1, time.Second,
+			)
+			source.setChain(badChain)
+
+			err := headerChain.Sync(ctx)
+			require.ErrorIs(t, err, testCase.err)
+			require.EqualValues(t, 25, store.bestHeight())
+
+			err = headerChain.VerifyHeader(
+				ctx, testCase.header, 26,
+			)
+			require.ErrorIs(t, err, ErrHeaderNotSynced)
+		})
+	}
+
+	// A header must match the checkpoint at its height.
+	checkpointHash := test.RandHash()
+	checkpointParams := testHeaderChainParams()
+	checkpointParams.Checkpoints = []chaincfg.Checkpoint{{
+		Height: 20,
+		Hash:   &checkpointHash,
+	}}
+
+	headerChain, store, _, _ := newTestHeaderChain(
+		checkpointParams, chain, now,
+	)
+	err := headerChain.Sync(ctx)
+	require.ErrorIs(t, err, ErrInvalidHeader)
+	require.Less(t, store.bestHeight(), uint32(20))
+
+	// A header chain of another network is refused.
+	headerChain, store, _, _ = newTestHeaderChain(params, chain, now)
+	err = store.ConnectHeaders(ctx, []StoredHeader{{
+		Header: chaincfg.MainNetParams.GenesisBlock.Header,
+	}})
+	require.NoError(t, err)
+	require.ErrorContains(t, headerChain.Sync(ctx), "genesis block")
+}
@@ -0,0 +1,200 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// DefaultPeerTimeout is the default maximum time to wait for a
+	// bitcoin node to complete the handshake and to respond to a request.
+	DefaultPeerTimeout = 30 * time.Second
+)
+
+// PeerHeaderSourceConfig is the config of a header source that fetches block
+// headers from bitcoin nodes over the peer to peer protocol.
+type PeerHeaderSourceConfig struct {
+	// Peers are the addresses of the bitcoin nodes the headers are
+	// fetched from, in the host:port format. They are tried in order,
+	// until one of them responds.
+	Peers []string
+
+	// ChainParams are the parameters of the chain the nodes are on.
+	ChainParams *chaincfg.Params
+
+	// UserAgentName is the user agent name advertised to the nodes.
+	UserAgentName string
+
+	// UserAgentVersion is the user agent version advertised to the nodes.
+	UserAgentVersion string
+
+	// Dial connects to the bitcoin node at the given address. If this is
+	// nil, a plain TCP connection is used.
+	Dial func(ctx context.Context, addr string) (net.Conn, error)
+
+	// Timeout is the maximum time to wait for a bitcoin node to complete
+	// the handshake and to respond to a request. If this is zero,
+	// DefaultPeerTimeout is used.
+	Timeout time.Duration
+}
+
+// PeerHeaderSource is a header source that fetches block headers from bitcoin
+// nodes over the peer to peer protocol. Since the headers are validated by the
+// header chain, the nodes don't need to be trusted. A node can only withhold
+// new headers from us, which is why multiple nodes should be configured.
+type PeerHeaderSource struct {
+	cfg *PeerHeaderSourceConfig
+}
+
+// NewPeerHeaderSource creates a new header source that fetches headers from
+// the configured bitcoin nodes.
+func NewPeerHeaderSource(cfg *PeerHeaderSourceConfig) *PeerHeaderSource {
+	return &PeerHeaderSource{
+		cfg: cfg,
+	}
+}
+
+// FetchHeaders returns the headers that follow the most recent block of the
+// given locator the first responding node knows of.
+//
+// NOTE: This is part of the HeaderSource interface.
+func (s *PeerHeaderSource) FetchHeaders(ctx context.Context,
+	locator blockchain.BlockLocator) ([]wire.BlockHeader, error) {
+
+	if len(s.cfg.Peers) == 0 {
+		return nil, fmt.Errorf("no bitcoin nodes to fetch headers from")
+	}
+
+	var errs []error
+	for _, addr := range s.cfg.Peers {
+		headers, err := s.fetchFromPeer(ctx, addr, locator)
+		if err == nil {
+			return headers, nil
+		}
+
+		log.Debugf("Unable to fetch headers from %v: %v", addr, err)
+		errs = append(errs, fmt.Errorf("%v: %w", addr, err))
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// fetchFromPeer connects to the bitcoin node at the given address and
+// requests the headers that follow the given locator.
+func (s *PeerHeaderSource) fetchFromPeer(ctx context.Context, addr string,
+	locator blockchain.BlockLocator) ([]wire.BlockHeader, error) {
+
+	timeout := s.cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultPeerTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		verAck      = make(chan struct{}, 1)
+		headersMsgs = make(chan *wire.MsgHeaders, 1)
+	)
+	p, err := peer.NewOutboundPeer(&peer.Config{
+		// The address is only used for the version message, so host
+		// names don't need to be resolved.
+		HostToNetAddress: func(host string, port uint16,
+			services wire.ServiceFlag) (*wire.NetAddressV2, error) {
+
+			ip := net.ParseIP(host)
+			if ip == nil {
+				ip = net.IPv4zero
+			}
+
+			return wire.NetAddressV2FromBytes(
+				time.Now(), services, ip, port,
+			), nil
+		},
+		UserAgentName:    s.cfg.UserAgentName,
+		UserAgentVersion: s.cfg.UserAgentVersion,
+		ChainParams:      s.cfg.ChainParams,
+		DisableRelayTx:   true,
+		Listeners: peer.MessageListeners{
+			OnVerAck: func(*peer.Peer, *wire.MsgVerAck) {
+				select {
+				case verAck <- struct{}{}:
+				default:
+				}
+			},
+			OnHeaders: func(_ *peer.Peer, msg *wire.MsgHeaders) {
+				select {
+				case headersMsgs <- msg:
+				default:
+				}
+			},
+		},
+	}, addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	dial := s.cfg.Dial
+	if dial == nil {
+		dial = func(ctx context.Context, addr string) (net.Conn,
+			error) {
+
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "tcp", addr)
+		}
+	}
+	conn, err := dial(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect: %w", err)
+	}
+
+	disconnected := make(chan struct{})
+	p.AssociateConnection(conn)
+	go func() {
+		p.WaitForDisconnect()
+		close(disconnected)
+	}()
+	defer p.Disconnect()
+
+	select {
+	case <-verAck:
+	case <-disconnected:
+		return nil, fmt.Errorf("disconnected during handshake")
+	case <-ctx.Done():
+		return nil, fmt.Errorf("handshake not completed: %w",
+			ctx.Err())
+	}
+
+	err = p.PushGetHeadersMsg(locator, &chainhash.Hash{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to request headers: %w", err)
+	}
+
+	select {
+	case msg := <-headersMsgs:
+		headers := make([]wire.BlockHeader, 0, len(msg.Headers))
+		for _, header := range msg.Headers {
+			headers = append(headers, *header)
+		}
+
+		return headers, nil
+
+	case <-disconnected:
+		return nil, fmt.Errorf("disconnected before sending headers")
+
+	case <-ctx.Done():
+		return nil, fmt.Errorf("no headers received: %w", ctx.Err())
+	}
+}
+
+// A compile-time assertion to ensure PeerHeaderSource meets the HeaderSource
+// interface.
+var _ HeaderSource = (*PeerHeaderSource)(nil)
@@ -0,0 +1,143 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// serveHeaders acts as a bitcoin node on the given connection that serves the
+// headers of the given chain, until the connection is closed.
+func serveHeaders(conn net.Conn, params *chaincfg.Params,
+	chain []wire.BlockHeader, maxHeaders int) {
+
+	defer conn.Close()
+
+	pver := wire.FeeFilterVersion
+	read := func() (wire.Message, error) {
+		msg, _, err := wire.ReadMessage(conn, pver, params.Net)
+		return msg, err
+	}
+	write := func(msg wire.Message) error {
+		return wire.WriteMessage(conn, msg, pver, params.Net)
+	}
+
+	// The connection is synchronous, so the handshake must be done in the
+	// order the peer expects it.
+	if _, err := read(); err != nil {
+		return
+	}
+
+	addr := wire.NewNetAddressIPPort(net.IPv4zero, 0, 0)
+	version := wire.NewMsgVersion(addr, addr, test.RandInt[uint64](), 0)
+	version.ProtocolVersion = int32(pver)
+	if err := write(version); err != nil {
+		return
+	}
+	if _, err := read(); err != nil {
+		return
+	}
+	if err := write(wire.NewMsgVerAck()); err != nil {
+		return
+	}
+
+	for {
+		msg, err := read()
+		switch {
+		case errors.Is(err, wire.ErrUnknownMessage):
+			continue
+
+		case err != nil:
+			return
+		}
+
+		getHeaders, ok := msg.(*wire.MsgGetHeaders)
+		if !ok {
+			continue
+		}
+
+		headersMsg := wire.NewMsgHeaders()
+		headers := locateHeaders(
+			chain, getHeaders.BlockLocatorHashes, maxHeaders,
+		)
+		for i := range headers {
+			_ = headersMsg.AddBlockHeader(&headers[i])
+		}
+		if err := write(headersMsg); err != nil {
+			return
+		}
+	}
+}
+
+// TestPeerHeaderSource tests that the SPV header chain can be synced from
+// bitcoin nodes over the peer to peer protocol.
+func TestPeerHeaderSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(time.Now().Unix(), 0)
+	params := testHeaderChainParams()
+
+	genesis := []wire.BlockHeader{params.GenesisBlock.Header}
+	chain := mineChain(params, genesis, 25, 10*time.Second)
+
+	// The first node is unreachable, the second one silently drops the
+	// connection and the third one serves the chain.
+	source := NewPeerHeaderSource(&PeerHeaderSourceConfig{
+		Peers: []string{
+			"unreachable:8333", "silent:8333", "127.0.0.1:8333",
+		},
+		ChainParams:   params,
+		UserAgentName: "test",
+		Dial: func(_ context.Context, addr string) (net.Conn, error) {
+			switch addr {
+			case "unreachable:8333":
+				return nil, errors.New("unreachable")
+
+			case "silent:8333":
+				conn, remote := net.Pipe()
+				_ = remote.Close()
+
+				return conn, nil
+			}
+
+			conn, remote := net.Pipe()
+			go serveHeaders(remote, params, chain, 10)
+
+			return conn, nil
+		},
+		Timeout: 5 * time.Second,
+	})
+
+	headerChain := NewHeaderChain(&HeaderChainConfig{
+		Store:       newMockHeaderChainStore(),
+		Source:      source,
+		ChainParams: params,
+		Clock:       clock.NewTestClock(now),
+	})
+	require.NoError(t, headerChain.Sync(ctx))
+
+	height, err := headerChain.CurrentHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 25, height)
+	require.NoError(t, headerChain.VerifyHeader(ctx, chain[25], 25))
+
+	// Without any reachable node, syncing fails.
+	source = NewPeerHeaderSource(&PeerHeaderSourceConfig{
+		Peers:       []string{"unreachable:8333"},
+		ChainParams: params,
+		Dial: func(context.Context, string) (net.Conn, error) {
+			return nil, errors.New("unreachable")
+		},
+	})
+	_, err = source.FetchHeaders(ctx, nil)
+	require.ErrorContains(t, err, "unreachable")
+}
@@ -6,9 +6,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -888,6 +890,265 @@ func TestProofFileVerification(t *testing.T) {
 	require.ErrorIs(t, err, ErrUnknownVersion)
 }
 
+// TestStreamVerifier ensures that the stream verifier arrives at the same
+// result as the base verifier and detects invalid proof files.
+func TestStreamVerifier(t *testing.T) {
+	t.Parallel()
+
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	baseVerifier := &BaseVerifier{}
+	expectedSnapshot, err := baseVerifier.Verify(
+		ctx, bytes.NewReader(proofBytes), MockHeaderVerifier,
+		MockMerkleVerifier, MockGroupVerifier, MockChainLookup,
+	)
+	require.NoError(t, err)
+
+	for _, parallelism := range []int{0, 1, 3} {
+		verifier := &StreamVerifier{MaxParallelism: parallelism}
+		snapshot, err := verifier.Verify(
+			ctx, bytes.NewReader(proofBytes), MockHeaderVerifier,
+			MockMerkleVerifier, MockGroupVerifier, MockChainLookup,
+		)
+		require.NoError(t, err)
+		require.Equal(t, expectedSnapshot, snapshot)
+	}
+
+	verifier := &StreamVerifier{}
+
+	// An empty file is valid but doesn't have a final state.
+	var emptyFile bytes.Buffer
+	require.NoError(t, NewEmptyFile(V0).Encode(&emptyFile))
+	snapshot, err := verifier.Verify(
+		ctx, &emptyFile, MockHeaderVerifier, MockMerkleVerifier,
+		MockGroupVerifier, MockChainLookup,
+	)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	// A file that is cut off must be rejected.
+	_, err = verifier.Verify(
+		ctx, bytes.NewReader(proofBytes[:len(proofBytes)-1]),
+		MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup,
+	)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// A file with a modified checksum must be rejected.
+	invalidChecksum := bytes.Clone(proofBytes)
+	invalidChecksum[len(invalidChecksum)-1] ^= 1
+	_, err = verifier.Verify(
+		ctx, bytes.NewReader(invalidChecksum), MockHeaderVerifier,
+		MockMerkleVerifier, MockGroupVerifier, MockChainLookup,
+	)
+	require.ErrorIs(t, err, ErrInvalidChecksum)
+
+	// An invalid block header of any proof must be detected.
+	errHeaderVerifier := fmt.Errorf("invalid block header")
+	_, err = verifier.Verify(
+		ctx, bytes.NewReader(proofBytes),
+		func(_ wire.BlockHeader, height uint32) error {
+			if height == expectedSnapshot.AnchorBlockHeight {
+				return errHeaderVerifier
+			}
+
+			return nil
+		}, MockMerkleVerifier, MockGroupVerifier, MockChainLookup,
+	)
+	require.ErrorIs(t, err, errHeaderVerifier)
+}
+
+// mockVerifiedProofCache is an in-memory verified proof cache that records the
+// proofs inserted into it.
+type mockVerifiedProofCache struct {
+	proofs   map[[32]byte]VerifiedProof
+	inserted [][32]byte
+	err      error
+	mu       sync.Mutex
+}
+
+// newMockVerifiedProofCache creates a new empty mock verified proof cache.
+func newMockVerifiedProofCache() *mockVerifiedProofCache {
+	return &mockVerifiedProofCache{
+		proofs: make(map[[32]byte]VerifiedProof),
+	}
+}
+
+// IsVerified returns true if the proof with the given hash was inserted.
+func (c *mockVerifiedProofCache) IsVerified(_ context.Context,
+	proofHash [32]byte) (bool, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return false, c.err
+	}
+
+	_, ok := c.proofs[proofHash]
+	return ok, nil
+}
+
+// InsertVerified adds the given proofs to the cache.
+func (c *mockVerifiedProofCache) InsertVerified(_ context.Context,
+	proofs ...VerifiedProof) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, p := range proofs {
+		c.proofs[p.ProofHash] = p
+		c.inserted = append(c.inserted, p.ProofHash)
+	}
+
+	return nil
+}
+
+// PurgeBlock removes all proofs of the given block from the cache.
+func (c *mockVerifiedProofCache) PurgeBlock(_ context.Context,
+	blockHash chainhash.Hash) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for hash, p := range c.proofs {
+		if p.BlockHash == blockHash {
+			delete(c.proofs, hash)
+		}
+	}
+
+	return nil
+}
+
+// popInserted returns the hashes of all proofs inserted since the last call.
+func (c *mockVerifiedProofCache) popInserted() [][32]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inserted := c.inserted
+	c.inserted = nil
+
+	return inserted
+}
+
+// TestVerifiedProofCache tests that the verifiers skip the state transitions of
+// proofs that were already verified, and only cache newly verified proofs.
+func TestVerifiedProofCache(t *testing.T) {
+	t.Parallel()
+
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	var proofFile File
+	require.NoError(t, proofFile.Decode(bytes.NewReader(proofBytes)))
+	require.Greater(t, proofFile.NumProofs(), 1)
+
+	lastProof, err := proofFile.LastProof()
+	require.NoError(t, err)
+
+	allHashes := make([][32]byte, 0, proofFile.NumProofs())
+	for _, hashed := range proofFile.proofs {
+		allHashes = append(allHashes, hashed.hash)
+	}
+	lastHash := allHashes[len(allHashes)-1]
+
+	ctx := context.Background()
+	expectedSnapshot, err := proofFile.Verify(
+		ctx, MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup,
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		newVerifier func(VerifiedProofCache) Verifier
+	}{{
+		name: "base verifier",
+		newVerifier: func(cache VerifiedProofCache) Verifier {
+			return &BaseVerifier{Cache: cache}
+		},
+	}, {
+		name: "stream verifier",
+		newVerifier: func(cache VerifiedProofCache) Verifier {
+			return &StreamVerifier{Cache: cache}
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache := newMockVerifiedProofCache()
+			verifier := tc.newVerifier(cache)
+			verify := func() (*AssetSnapshot, error) {
+				return verifier.Verify(
+					ctx, bytes.NewReader(proofBytes),
+					MockHeaderVerifier, MockMerkleVerifier,
+					MockGroupVerifier, MockChainLookup,
+				)
+			}
+
+			// Initially all proofs are verified and cached.
+			snapshot, err := verify()
+			require.NoError(t, err)
+			require.Equal(t, expectedSnapshot, snapshot)
+			require.Equal(t, allHashes, cache.popInserted())
+
+			block := cache.proofs[lastHash]
+			require.Equal(
+				t, lastProof.BlockHeader.BlockHash(),
+				block.BlockHash,
+			)
+			require.Equal(
+				t, lastProof.BlockHeight, block.BlockHeight,
+			)
+
+			// The second time around, only the last proof is
+			// verified in full, and the snapshot is the same.
+			snapshot, err = verify()
+			require.NoError(t, err)
+			require.Equal(t, expectedSnapshot, snapshot)
+			require.Equal(
+				t, [][32]byte{lastHash}, cache.popInserted(),
+			)
+
+			// Once the block of the first proof is re-organized
+			// out, that proof is verified in full again.
+			firstProof, err := proofFile.ProofAt(0)
+			require.NoError(t, err)
+			err = cache.PurgeBlock(
+				ctx, firstProof.BlockHeader.BlockHash(),
+			)
+			require.NoError(t, err)
+
+			_, err = verify()
+			require.NoError(t, err)
+			require.Equal(
+				t, [][32]byte{allHashes[0], lastHash},
+				cache.popInserted(),
+			)
+
+			// A cache that can't be queried doesn't prevent the
+			// verification.
+			cache.err = fmt.Errorf("cache unavailable")
+			snapshot, err = verify()
+			require.NoError(t, err)
+			require.Equal(t, expectedSnapshot, snapshot)
+			require.Equal(t, allHashes, cache.popInserted())
+		})
+	}
+}
+
 // TestProofVerification ensures that the proof encoding and decoding works as
 // expected.
 func TestProofVerification(t *testing.T) {
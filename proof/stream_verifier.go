@@ -0,0 +1,220 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"runtime"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"golang.org/x/sync/errgroup"
+)
+
+// StreamVerifier implements a verifier that decodes a proof file while it is
+// being read and verifies its proofs concurrently. All checks of a proof that
+// don't depend on the previous state of the asset (the block header, merkle,
+// inclusion and exclusion proofs) are run in parallel, while only the asset
+// state transitions are verified one after another.
+type StreamVerifier struct {
+	// MaxParallelism is the maximum number of proofs that are checked in
+	// parallel. If zero, the number of available CPUs is used.
+	MaxParallelism int
+
+	// Cache is an optional cache of proofs that were already verified.
+	// The state transitions of cached proofs aren't verified again, and
+	// all newly verified proofs are added to the cache.
+	Cache VerifiedProofCache
+}
+
+// streamedProof is a single proof of a streamed proof file, along with the
+// result of its stateless checks.
+type streamedProof struct {
+	// hashed is the encoded proof and its checksum.
+	hashed *hashedProof
+
+	// proof is the decoded proof.
+	proof *Proof
+
+	// done is closed once the stateless checks of the proof are complete.
+	done chan struct{}
+
+	// tapCommitment is the verified Taproot Asset commitment of the
+	// proof's anchor output. This is only set if err is nil.
+	tapCommitment *commitment.TapCommitment
+
+	// err is the error of the stateless checks, if any.
+	err error
+}
+
+// Verify takes the passed serialized proof file, and returns a nil
+// error if the proof file is valid. A valid file should return an
+// AssetSnapshot of the final state transition of the file.
+func (s *StreamVerifier) Verify(ctx context.Context, blobReader io.Reader,
+	headerVerifier HeaderVerifier, merkleVerifier MerkleVerifier,
+	groupVerifier GroupVerifier,
+	chainLookupGen ChainLookupGenerator) (*AssetSnapshot, error) {
+
+	version, numProofs, err := decodeFileHeader(blobReader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse proof: %w", err)
+	}
+
+	// Check only for the proof file version and not file emptiness,
+	// since an empty proof file should return a nil error.
+	proofFile := NewEmptyFile(version)
+	if proofFile.IsUnknownVersion() {
+		return nil, ErrUnknownVersion
+	}
+
+	readProof, err := newProofReader(blobReader, version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse proof: %w", err)
+	}
+
+	parallelism := s.MaxParallelism
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+
+	// The stateless checks are run by a limited number of workers. Their
+	// errors are reported in the order of the proofs by the state
+	// transition verification below, so the result doesn't depend on
+	// which worker finishes first.
+	var workers errgroup.Group
+	workers.SetLimit(parallelism)
+	defer func() {
+		_ = workers.Wait()
+	}()
+
+	// The decoded proofs are handed over to the state transition
+	// verification in order. We only buffer a limited number of proofs,
+	// so we don't read ahead too far if the state transitions are slow.
+	proofs := make(chan *streamedProof, parallelism)
+	errGroup, ctx := errgroup.WithContext(ctx)
+
+	errGroup.Go(func() error {
+		defer close(proofs)
+
+		var prevHash [sha256.Size]byte
+		prevOut := fn.None[wire.OutPoint]()
+		for i := uint64(0); i < numProofs; i++ {
+			hashed, err := readProof(prevHash)
+			if err != nil {
+				return fmt.Errorf("unable to parse proof: %w",
+					err)
+			}
+			prevHash = hashed.hash
+
+			p := &Proof{}
+			err = p.Decode(bytes.NewReader(hashed.proofBytes))
+			if err != nil {
+				return fmt.Errorf("error decoding proof: %w",
+					err)
+			}
+
+			sp := &streamedProof{
+				hashed: hashed,
+				proof:  p,
+				done:   make(chan struct{}),
+			}
+			proofPrevOut := prevOut
+			workers.Go(func() error {
+				defer close(sp.done)
+
+				sp.tapCommitment, sp.err = p.verifyStateless(
+					proofPrevOut, headerVerifier,
+					merkleVerifier,
+				)
+
+				return nil
+			})
+
+			select {
+			case proofs <- sp:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			// The outpoint of a proof is known without verifying
+			// it, so the next proof can be checked against it.
+			prevOut = fn.Some(p.OutPoint())
+		}
+
+		return nil
+	})
+
+	var prev *AssetSnapshot
+	errGroup.Go(func() error {
+		// The file only ever contains the proofs up to the one that is
+		// currently verified. The state transition of a proof can only
+		// reference transactions of earlier proofs or of its own
+		// additional inputs, so that's all the context it needs.
+		chainLookup := chainLookupGen.GenFileChainLookup(proofFile)
+
+		var (
+			idx         uint64
+			newVerified []VerifiedProof
+		)
+		for sp := range proofs {
+			select {
+			case <-sp.done:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			if sp.err != nil {
+				return sp.err
+			}
+
+			proofFile.proofs = append(proofFile.proofs, sp.hashed)
+
+			// Just like the base verifier, we always verify the
+			// last proof in full.
+			idx++
+			isLast := idx == numProofs
+			if !isLast && isVerifiedProof(ctx, s.Cache, sp.hashed) {
+				p := sp.proof
+				prev = p.snapshot(
+					sp.tapCommitment,
+					p.Asset.HasSplitCommitmentWitness(),
+				)
+
+				continue
+			}
+
+			result, err := sp.proof.verifyStateful(
+				ctx, prev, sp.tapCommitment, headerVerifier,
+				merkleVerifier, groupVerifier, chainLookup,
+				proofVerificationParams{},
+			)
+			if err != nil {
+				return err
+			}
+			prev = result
+
+			newVerified = append(
+				newVerified, newVerifiedProof(
+					sp.hashed, sp.proof,
+				),
+			)
+		}
+
+		cacheVerifiedProofs(ctx, s.Cache, newVerified)
+
+		return nil
+	})
+
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	return prev, nil
+}
+
+// A compile-time assertion to ensure StreamVerifier meets the Verifier
+// interface.
+var _ Verifier = (*StreamVerifier)(nil)
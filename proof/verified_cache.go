@@ -0,0 +1,89 @@
+package proof
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// VerifiedProof is an entry of the verified proof cache. It identifies a proof
+// within a proof file that was fully verified, including its state transition.
+type VerifiedProof struct {
+	// ProofHash is the hash of the proof within its proof file. This is
+	// the chained hash of the file, which commits to the proof itself and
+	// all proofs that precede it. A cached proof is therefore only ever
+	// skipped if its full history matches the one it was verified with.
+	ProofHash [32]byte
+
+	// BlockHash is the hash of the block the proof's anchor transaction
+	// was confirmed in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the proof's anchor
+	// transaction was confirmed in.
+	BlockHeight uint32
+}
+
+// VerifiedProofCache is a persistent cache of proofs that were already fully
+// verified. Verifiers use it to skip the state transition verification of
+// proofs they've seen before, for example the shared prefix of the proof
+// files of multiple transfers of the same asset.
+type VerifiedProofCache interface {
+	// IsVerified returns true if the proof with the given hash was already
+	// verified.
+	IsVerified(ctx context.Context, proofHash [32]byte) (bool, error)
+
+	// InsertVerified adds the given proofs to the cache.
+	InsertVerified(ctx context.Context, proofs ...VerifiedProof) error
+
+	// PurgeBlock removes all proofs from the cache that are anchored in the
+	// block with the given hash. This must be called if the block is
+	// re-organized out of the chain.
+	PurgeBlock(ctx context.Context, blockHash chainhash.Hash) error
+}
+
+// isVerifiedProof returns true if the given proof of a proof file is found in
+// the given cache. A failed lookup is not fatal, the proof is then just
+// verified again.
+func isVerifiedProof(ctx context.Context, cache VerifiedProofCache,
+	hashed *hashedProof) bool {
+
+	if cache == nil {
+		return false
+	}
+
+	verified, err := cache.IsVerified(ctx, hashed.hash)
+	if err != nil {
+		log.Warnf("Unable to look up verified proof %x: %v",
+			hashed.hash[:], err)
+
+		return false
+	}
+
+	return verified
+}
+
+// cacheVerifiedProofs adds the given newly verified proofs to the given cache.
+// Failing to do so is not fatal, since the proofs were verified successfully.
+func cacheVerifiedProofs(ctx context.Context, cache VerifiedProofCache,
+	proofs []VerifiedProof) {
+
+	if cache == nil || len(proofs) == 0 {
+		return
+	}
+
+	if err := cache.InsertVerified(ctx, proofs...); err != nil {
+		log.Warnf("Unable to cache %d verified proofs: %v",
+			len(proofs), err)
+	}
+}
+
+// newVerifiedProof creates a new cache entry for the given proof of a proof
+// file.
+func newVerifiedProof(hashed *hashedProof, p *Proof) VerifiedProof {
+	return VerifiedProof{
+		ProofHash:   hashed.hash,
+		BlockHash:   p.BlockHeader.BlockHash(),
+		BlockHeight: p.BlockHeight,
+	}
+}
@@ -47,6 +47,10 @@ type Verifier interface {
 // BaseVerifier implements a simple verifier that loads the entire proof file
 // into memory and then verifies it all at once.
 type BaseVerifier struct {
+	// Cache is an optional cache of proofs that were already verified.
+	// The state transitions of cached proofs aren't verified again, and
+	// all newly verified proofs are added to the cache.
+	Cache VerifiedProofCache
 }
 
 // Verify takes the passed serialized proof file, and returns a nil
@@ -63,9 +67,9 @@ func (b *BaseVerifier) Verify(ctx context.Context, blobReader io.Reader,
 		return nil, fmt.Errorf("unable to parse proof: %w", err)
 	}
 
-	return proofFile.Verify(
+	return proofFile.verify(
 		ctx, headerVerifier, merkleVerifier, groupVerifier,
-		chainLookupGen.GenFileChainLookup(&proofFile),
+		chainLookupGen.GenFileChainLookup(&proofFile), b.Cache,
 	)
 }
 
@@ -547,6 +551,34 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		opt(&verificationParams)
 	}
 
+	prevOut := fn.None[wire.OutPoint]()
+	if prev != nil {
+		prevOut = fn.Some(prev.OutPoint)
+	}
+
+	tapCommitment, err := p.verifyStateless(
+		prevOut, headerVerifier, merkleVerifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.verifyStateful(
+		ctx, prev, tapCommitment, headerVerifier, merkleVerifier,
+		groupVerifier, chainLookup, verificationParams,
+	)
+}
+
+// verifyStateless runs all checks of the proof that don't depend on the
+// verified state of the previous proof in a proof file. Only the outpoint of
+// the previous asset output is needed, which can be derived from the previous
+// proof without verifying it. This allows these checks to be run for all proofs
+// of a file in parallel. The verified Taproot Asset commitment of the anchor
+// output is returned.
+func (p *Proof) verifyStateless(prevOut fn.Option[wire.OutPoint],
+	headerVerifier HeaderVerifier,
+	merkleVerifier MerkleVerifier) (*commitment.TapCommitment, error) {
+
 	// 0. Check only for the proof version.
 	if p.IsUnknownVersion() {
 		return nil, ErrUnknownVersion
@@ -560,7 +592,10 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 
 	// 1. A transaction that spends the previous asset output has a valid
 	// merkle proof within a block in the chain.
-	if prev != nil && p.PrevOut != prev.OutPoint {
+	prevOutMismatch := fn.MapOptionZ(prevOut, func(op wire.OutPoint) bool {
+		return p.PrevOut != op
+	})
+	if prevOutMismatch {
 		return nil, fmt.Errorf("%w: prev output mismatch",
 			commitment.ErrInvalidTaprootProof)
 	}
@@ -619,10 +654,23 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		}
 	}
 
+	return tapCommitment, nil
+}
+
+// verifyStateful runs the checks of the proof that depend on the verified state
+// of the previous proof in a proof file, which is the asset state transition
+// itself. It must be called after verifyStateless succeeded for the proof.
+func (p *Proof) verifyStateful(ctx context.Context, prev *AssetSnapshot,
+	tapCommitment *commitment.TapCommitment, headerVerifier HeaderVerifier,
+	merkleVerifier MerkleVerifier, groupVerifier GroupVerifier,
+	chainLookup asset.ChainLookup,
+	verificationParams proofVerificationParams) (*AssetSnapshot, error) {
+
 	// 6. Verify group key and group key reveal for genesis assets. Not all
 	// assets have a group key, and should therefore not have a group key
 	// reveal. The group key reveal must be present for group anchors, and
 	// the group key must be present for any reissuance into an asset group.
+	isGenesisAsset := p.Asset.IsGenesisAsset()
 	hasGroupKeyReveal := p.GroupKeyReveal != nil
 	hasGroupKey := p.Asset.GroupKey != nil
 	switch {
@@ -656,7 +704,10 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	// 8. Either a set of asset inputs with valid witnesses is included that
 	// satisfy the resulting state transition or a challenge witness is
 	// provided as part of an ownership proof.
-	var splitAsset bool
+	var (
+		splitAsset bool
+		err        error
+	)
 	switch {
 	case prev == nil && p.ChallengeWitness != nil:
 		splitAsset, err = p.verifyChallengeWitness(
@@ -673,6 +724,14 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		return nil, err
 	}
 
+	return p.snapshot(tapCommitment, splitAsset), nil
+}
+
+// snapshot creates the snapshot of the asset state that results from the proof.
+// This must only be called for a proof that was verified.
+func (p *Proof) snapshot(tapCommitment *commitment.TapCommitment,
+	splitAsset bool) *AssetSnapshot {
+
 	// 8. At this point we know there is an inclusion proof, which must be
 	// a commitment proof. So we can extract the tapscript preimage directly
 	// from there.
@@ -681,11 +740,8 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	// TODO(roasbeef): need tx index as well
 
 	return &AssetSnapshot{
-		Asset: &p.Asset,
-		OutPoint: wire.OutPoint{
-			Hash:  p.AnchorTx.TxHash(),
-			Index: p.InclusionProof.OutputIndex,
-		},
+		Asset:             &p.Asset,
+		OutPoint:          p.OutPoint(),
 		AnchorBlockHash:   p.BlockHeader.BlockHash(),
 		AnchorBlockHeight: p.BlockHeight,
 		AnchorTx:          &p.AnchorTx,
@@ -695,7 +751,7 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		TapscriptSibling:  tapscriptPreimage,
 		SplitAsset:        splitAsset,
 		MetaReveal:        p.MetaReveal,
-	}, nil
+	}
 }
 
 // VerifyProofs verifies the inclusion and exclusion proofs as well as the split
@@ -756,6 +812,20 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 	merkleVerifier MerkleVerifier, groupVerifier GroupVerifier,
 	chainLookup asset.ChainLookup) (*AssetSnapshot, error) {
 
+	return f.verify(
+		ctx, headerVerifier, merkleVerifier, groupVerifier,
+		chainLookup, nil,
+	)
+}
+
+// verify verifies a full proof file starting from the asset's genesis. If a
+// cache is given, the state transitions of proofs found in it are skipped and
+// all other proofs are added to it once the whole file was verified.
+func (f *File) verify(ctx context.Context, headerVerifier HeaderVerifier,
+	merkleVerifier MerkleVerifier, groupVerifier GroupVerifier,
+	chainLookup asset.ChainLookup,
+	cache VerifiedProofCache) (*AssetSnapshot, error) {
+
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -768,7 +838,10 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 		return nil, ErrUnknownVersion
 	}
 
-	var prev *AssetSnapshot
+	var (
+		prev        *AssetSnapshot
+		newVerified []VerifiedProof
+	)
 	for idx := range f.proofs {
 		select {
 		case <-ctx.Done():
@@ -781,15 +854,51 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 			return nil, err
 		}
 
-		result, err := decodedProof.Verify(
-			ctx, prev, headerVerifier, merkleVerifier,
-			groupVerifier, chainLookup,
+		// The last proof is always verified in full, so we can be sure
+		// the returned snapshot is consistent with the current state
+		// of the verifiers.
+		hashed := f.proofs[idx]
+		isLast := idx == len(f.proofs)-1
+		if isLast || !isVerifiedProof(ctx, cache, hashed) {
+			result, err := decodedProof.Verify(
+				ctx, prev, headerVerifier, merkleVerifier,
+				groupVerifier, chainLookup,
+			)
+			if err != nil {
+				return nil, err
+			}
+			prev = result
+
+			newVerified = append(
+				newVerified, newVerifiedProof(
+					hashed, decodedProof,
+				),
+			)
+
+			continue
+		}
+
+		// The proof's state transition was verified before, so we only
+		// need to run the checks that don't depend on it. This makes
+		// sure the proof is still anchored in the main chain.
+		prevOut := fn.None[wire.OutPoint]()
+		if prev != nil {
+			prevOut = fn.Some(prev.OutPoint)
+		}
+		tapCommitment, err := decodedProof.verifyStateless(
+			prevOut, headerVerifier, merkleVerifier,
 		)
 		if err != nil {
 			return nil, err
 		}
-		prev = result
+
+		prev = decodedProof.snapshot(
+			tapCommitment,
+			decodedProof.Asset.HasSplitCommitmentWitness(),
+		)
 	}
 
+	cacheVerifiedProofs(ctx, cache, newVerified)
+
 	return prev, nil
 }
@@ -0,0 +1,187 @@
+package rfq
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+)
+
+const (
+	// DefaultQuoteAuctionWindow is the default duration during which the
+	// quotes of peers are collected for an order that doesn't specify a
+	// peer.
+	DefaultQuoteAuctionWindow = 5 * time.Second
+)
+
+// QuoteAuction collects the responses of peers to the quote requests that were
+// sent for a single order. An auction is closed once all peers responded, the
+// auction window elapsed or it is closed explicitly.
+type QuoteAuction struct {
+	// isBuy is true if the auction is held for a buy order, and false if
+	// it is held for a sell order.
+	isBuy bool
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// numRequests is the number of quote requests that are still expected
+	// to be answered.
+	numRequests int
+
+	// accepted holds the events of the quotes that peers accepted.
+	accepted []fn.Event
+
+	// rejected holds the events of the quote requests that peers rejected,
+	// or of the quotes that were deemed invalid by our node.
+	rejected []fn.Event
+
+	// done is closed once the auction is closed.
+	done chan struct{}
+
+	// closeOnce ensures that the done channel is only closed once.
+	closeOnce sync.Once
+}
+
+// newQuoteAuction creates a new quote auction that expects a response to the
+// given number of quote requests.
+func newQuoteAuction(isBuy bool, numRequests int) *QuoteAuction {
+	auction := &QuoteAuction{
+		isBuy:       isBuy,
+		numRequests: numRequests,
+		done:        make(chan struct{}),
+	}
+
+	if numRequests == 0 {
+		auction.Close()
+	}
+
+	return auction
+}
+
+// Done returns a channel that is closed once the auction is closed.
+func (a *QuoteAuction) Done() <-chan struct{} {
+	return a.done
+}
+
+// Close closes the auction. Responses that arrive after the auction was closed
+// are ignored.
+func (a *QuoteAuction) Close() {
+	a.closeOnce.Do(func() {
+		close(a.done)
+	})
+}
+
+// isClosed returns true if the auction was closed.
+func (a *QuoteAuction) isClosed() bool {
+	select {
+	case <-a.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordResponse records the response of a peer to one of the quote requests
+// of the auction. The event must either be a peer accepted quote event, an
+// invalid quote response event or an incoming reject quote event.
+func (a *QuoteAuction) recordResponse(event fn.Event) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.isClosed() {
+		return
+	}
+
+	switch event.(type) {
+	case *PeerAcceptedBuyQuoteEvent, *PeerAcceptedSellQuoteEvent:
+		a.accepted = append(a.accepted, event)
+
+	default:
+		a.rejected = append(a.rejected, event)
+	}
+
+	a.numRequests--
+	if a.numRequests <= 0 {
+		a.Close()
+	}
+}
+
+// requestFailed records that one of the quote requests of the auction could
+// not be sent, so no response is expected for it.
+func (a *QuoteAuction) requestFailed() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.numRequests--
+	if a.numRequests <= 0 {
+		a.Close()
+	}
+}
+
+// QuoteAuctionResult is the result of a quote auction.
+type QuoteAuctionResult struct {
+	// Accepted holds the events of the quotes that peers accepted, ordered
+	// from the best to the worst asset rate, so the first one is the
+	// winning quote. The best quote is the one that offers the most asset
+	// units per BTC for a buy order, and the fewest asset units per BTC
+	// for a sell order.
+	Accepted []fn.Event
+
+	// Rejected holds the events of the quote requests that peers rejected,
+	// or of the quotes that were deemed invalid by our node, in the order
+	// in which they were received.
+	Rejected []fn.Event
+}
+
+// Result returns the responses that were collected by the auction so far.
+func (a *QuoteAuction) Result() QuoteAuctionResult {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	accepted := make([]fn.Event, len(a.accepted))
+	copy(accepted, a.accepted)
+
+	rejected := make([]fn.Event, len(a.rejected))
+	copy(rejected, a.rejected)
+
+	// A stable sort keeps quotes with the same rate in the order in which
+	// they were received, so the earliest one wins a tie.
+	sort.SliceStable(accepted, func(i, j int) bool {
+		rateI := acceptedRate(accepted[i])
+		rateJ := acceptedRate(accepted[j])
+		if a.isBuy {
+			return rateGt(rateI, rateJ)
+		}
+
+		return rateGt(rateJ, rateI)
+	})
+
+	return QuoteAuctionResult{
+		Accepted: accepted,
+		Rejected: rejected,
+	}
+}
+
+// acceptedRate returns the asset rate of a peer accepted quote event.
+func acceptedRate(event fn.Event) rfqmath.BigIntFixedPoint {
+	switch e := event.(type) {
+	case *PeerAcceptedBuyQuoteEvent:
+		return e.AssetRate.Rate
+
+	case *PeerAcceptedSellQuoteEvent:
+		return e.AssetRate.Rate
+
+	default:
+		return rfqmath.BigIntFixedPoint{}
+	}
+}
+
+// rateGt returns true if the first asset rate is greater than the second one.
+func rateGt(a, b rfqmath.BigIntFixedPoint) bool {
+	scale := max(a.Scale, b.Scale)
+
+	return a.ScaleTo(scale).Coefficient.Gt(b.ScaleTo(scale).Coefficient)
+}
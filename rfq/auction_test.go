@@ -0,0 +1,179 @@
+package rfq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestNegotiator creates a negotiator without a price oracle, along with the
+// channel its outgoing messages are sent to.
+func newTestNegotiator(t *testing.T) (*Negotiator, chan rfqmsg.OutgoingMsg) {
+	outgoingMsgs := make(chan rfqmsg.OutgoingMsg, 10)
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		OutgoingMessages: outgoingMsgs,
+		ErrChan:          make(chan error, 10),
+	})
+	require.NoError(t, err)
+	require.NoError(t, negotiator.Start())
+
+	t.Cleanup(func() {
+		require.NoError(t, negotiator.Stop())
+	})
+
+	return negotiator, outgoingMsgs
+}
+
+// randPeers returns the given number of random peers.
+func randPeers(t *testing.T, num int) []route.Vertex {
+	peers := make([]route.Vertex, num)
+	for i := range peers {
+		peers[i] = route.NewVertex(test.RandPubKey(t))
+	}
+
+	return peers
+}
+
+// TestQuoteAuctionBuyOrder tests that the quotes that peers respond with to a
+// buy order are collected by its auction, with the quote that offers the most
+// asset units per BTC winning the auction.
+func TestQuoteAuctionBuyOrder(t *testing.T) {
+	t.Parallel()
+
+	negotiator, outgoingMsgs := newTestNegotiator(t)
+
+	peers := randPeers(t, 3)
+	auction := newQuoteAuction(true, len(peers))
+	order := BuyOrder{
+		AssetSpecifier: asset.NewSpecifierFromId(asset.RandID(t)),
+		AssetMaxAmt:    1_000,
+	}
+	err := negotiator.HandleOutgoingBuyOrder(order, peers, auction, 0)
+	require.NoError(t, err)
+
+	// A request is sent to each of the peers.
+	requests := make(map[route.Vertex]*rfqmsg.BuyRequest)
+	for range peers {
+		msg, err := fn.RecvOrTimeout(outgoingMsgs, time.Second)
+		require.NoError(t, err)
+
+		request, ok := (*msg).(*rfqmsg.BuyRequest)
+		require.True(t, ok)
+		requests[request.Peer] = request
+	}
+	require.Len(t, requests, len(peers))
+
+	acceptEvent := func(peer route.Vertex,
+		coefficient uint64) *PeerAcceptedBuyQuoteEvent {
+
+		request := requests[peer]
+		return NewPeerAcceptedBuyQuoteEvent(&rfqmsg.BuyAccept{
+			Peer:    peer,
+			Request: *request,
+			ID:      request.ID,
+			AssetRate: rfqmsg.NewAssetRate(
+				rfqmath.NewBigIntFixedPoint(coefficient, 1),
+				time.Now().Add(time.Hour),
+			),
+		})
+	}
+
+	// The first peer offers 1000 units per BTC, the third one 1000.5
+	// units, and the second one rejects the request. Responses to unknown
+	// requests are ignored.
+	lowEvent := acceptEvent(peers[0], 10_000)
+	highEvent := acceptEvent(peers[2], 10_005)
+	rejectEvent := NewIncomingRejectQuoteEvent(rfqmsg.NewReject(
+		peers[1], requests[peers[1]].ID, rfqmsg.ErrUnknownReject,
+	))
+
+	negotiator.HandleQuoteResponse(lowEvent.ID, lowEvent)
+	negotiator.HandleQuoteResponse(rfqmsg.ID{}, lowEvent)
+	negotiator.HandleQuoteResponse(rejectEvent.ID.Val, rejectEvent)
+
+	select {
+	case <-auction.Done():
+		t.Fatalf("auction closed before all peers responded")
+	default:
+	}
+
+	negotiator.HandleQuoteResponse(highEvent.ID, highEvent)
+
+	_, err = fn.RecvOrTimeout(auction.Done(), time.Second)
+	require.NoError(t, err)
+
+	result := auction.Result()
+	require.Equal(t, []fn.Event{highEvent, lowEvent}, result.Accepted)
+	require.Equal(t, []fn.Event{rejectEvent}, result.Rejected)
+
+	// Once the auction is closed, the responses to its requests are no
+	// longer routed to it.
+	require.Eventually(t, func() bool {
+		_, ok := negotiator.auctions.Load(lowEvent.ID)
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestQuoteAuctionSellOrder tests that the auction of a sell order is closed
+// once its window elapsed, with the quote that asks for the fewest asset units
+// per BTC winning the auction.
+func TestQuoteAuctionSellOrder(t *testing.T) {
+	t.Parallel()
+
+	negotiator, outgoingMsgs := newTestNegotiator(t)
+
+	peers := randPeers(t, 3)
+	auction := newQuoteAuction(false, len(peers))
+	order := SellOrder{
+		AssetSpecifier: asset.NewSpecifierFromId(asset.RandID(t)),
+		PaymentMaxAmt:  1_000_000,
+	}
+	negotiator.HandleOutgoingSellOrder(
+		order, peers, auction, 100*time.Millisecond,
+	)
+
+	var events []fn.Event
+	for i := range peers {
+		msg, err := fn.RecvOrTimeout(outgoingMsgs, time.Second)
+		require.NoError(t, err)
+
+		request, ok := (*msg).(*rfqmsg.SellRequest)
+		require.True(t, ok)
+
+		// Only the first two peers respond, with a rate that has a
+		// different scale each.
+		if i == 2 {
+			continue
+		}
+
+		event := NewPeerAcceptedSellQuoteEvent(&rfqmsg.SellAccept{
+			Peer:    request.Peer,
+			Request: *request,
+			ID:      request.ID,
+			AssetRate: rfqmsg.NewAssetRate(
+				rfqmath.NewBigIntFixedPoint(
+					uint64(1_000+i), uint8(i),
+				),
+				time.Now().Add(time.Hour),
+			),
+		})
+		negotiator.HandleQuoteResponse(request.ID, event)
+		events = append(events, event)
+	}
+
+	// The auction is closed once its window elapsed, even though the
+	// third peer didn't respond.
+	_, err := fn.RecvOrTimeout(auction.Done(), time.Second)
+	require.NoError(t, err)
+
+	result := auction.Result()
+	require.Equal(t, []fn.Event{events[1], events[0]}, result.Accepted)
+	require.Empty(t, result.Rejected)
+}
@@ -2,6 +2,7 @@ package rfq
 
 import (
 	"fmt"
+	"time"
 )
 
 const (
@@ -20,7 +21,15 @@ const (
 //
 // nolint: lll
 type CliConfig struct {
-	PriceOracleAddress string `long:"priceoracleaddress" description:"Price oracle gRPC server address (rfqrpc://<hostname>:<port>). To use the integrated mock, use the following value: use_mock_price_oracle_service_promise_to_not_use_on_mainnet"`
+	PriceOracleAddress string `long:"priceoracleaddress" description:"Price oracle address; either a gRPC server address (rfqrpc://<hostname>:<port>), a local JSON or YAML rate file that is re-read when it changes (file:///<path>) or a formula (formula://peg?rate=<units_per_btc>&spreadbps=<bps>&expiry=<duration>[&assetid=<hex>][&groupkey=<hex>]). To use the integrated mock, use the following value: use_mock_price_oracle_service_promise_to_not_use_on_mainnet"`
+
+	PriceOracleSources []string `long:"priceoraclesource" description:"Price oracle address (see priceoracleaddress) of an upstream source of the composite price oracle; the median asset rate of all sources is used; can be specified multiple times; cannot be combined with priceoracleaddress"`
+
+	PriceOracleMinSources uint32 `long:"priceoracleminsources" description:"The minimum number of price oracle sources that must provide a valid asset rate for a price query to succeed"`
+
+	PriceOracleOutlierPpm uint64 `long:"priceoracleoutlierppm" description:"The maximum deviation in parts per million of the asset rate of a price oracle source from the median rate of all sources; rates that deviate more are dropped as outliers; 0 disables the check"`
+
+	PriceOracleQueryTimeout time.Duration `long:"priceoraclequerytimeout" description:"The timeout for querying a single price oracle source; 0 disables the timeout"`
 
 	AcceptPriceDeviationPpm uint64 `long:"acceptpricedeviationppm" description:"The default price deviation in parts per million that is accepted by the RFQ negotiator"`
 
@@ -30,6 +39,16 @@ type CliConfig struct {
 
 	// TODO(ffranr): Remove in favour of MockOracleAssetsPerBTC.
 	MockOracleSatsPerAsset uint64 `long:"mockoraclesatsperasset" description:"Mock price oracle static satoshis per asset unit rate (for example number of satoshis to pay for one USD cent if one asset unit represents a USD cent); whole numbers only, use either this or mockoracleassetsperbtc depending on required precision"`
+
+	RateHintDeviationPpm uint64 `long:"ratehintdeviationppm" description:"The maximum deviation in parts per million of the asset rate that a peer proposes in a quote request from the rate of our price oracle; requests outside of it are rejected; 0 disables the check"`
+
+	MinQuoteExpiry time.Duration `long:"minquoteexpiry" description:"The minimum remaining lifetime of the asset rate of a quote; quotes that expire sooner are rejected; 0 disables the lower bound"`
+
+	MaxQuoteExpiry time.Duration `long:"maxquoteexpiry" description:"The maximum remaining lifetime of the asset rate of a quote; quotes that expire later are rejected; 0 disables the upper bound"`
+
+	QuoteHistoryRetention time.Duration `long:"quotehistoryretention" description:"The duration for which expired quotes are kept in the database so their history can be queried; 0 keeps them forever"`
+
+	QuoteAuctionWindow time.Duration `long:"quoteauctionwindow" description:"The duration during which quotes are collected from all peers with a matching asset channel for buy and sell orders that don't specify a peer; the best quote is used; 0 waits for all peers to respond"`
 }
 
 // Validate returns an error if the configuration is invalid.
@@ -92,5 +111,49 @@ func (c *CliConfig) Validate() error {
 		}
 	}
 
+	if len(c.PriceOracleSources) > 0 && c.PriceOracleAddress != "" {
+		return fmt.Errorf("priceoraclesource cannot be combined " +
+			"with priceoracleaddress")
+	}
+
+	for _, source := range c.PriceOracleSources {
+		_, err := ParsePriceOracleAddress(source)
+		if err != nil {
+			return fmt.Errorf("invalid price oracle source URI "+
+				"address: %w", err)
+		}
+	}
+
+	if len(c.PriceOracleSources) > 0 &&
+		int(c.PriceOracleMinSources) > len(c.PriceOracleSources) {
+
+		return fmt.Errorf("priceoracleminsources must not exceed "+
+			"the number of price oracle sources (%d)",
+			len(c.PriceOracleSources))
+	}
+
+	if c.PriceOracleQueryTimeout < 0 {
+		return fmt.Errorf("priceoraclequerytimeout must not be " +
+			"negative")
+	}
+
+	if c.MinQuoteExpiry < 0 || c.MaxQuoteExpiry < 0 {
+		return fmt.Errorf("minquoteexpiry and maxquoteexpiry must " +
+			"not be negative")
+	}
+
+	if c.MaxQuoteExpiry > 0 && c.MaxQuoteExpiry < c.MinQuoteExpiry {
+		return fmt.Errorf("maxquoteexpiry must not be less than " +
+			"minquoteexpiry")
+	}
+
+	if c.QuoteHistoryRetention < 0 {
+		return fmt.Errorf("quotehistoryretention must not be negative")
+	}
+
+	if c.QuoteAuctionWindow < 0 {
+		return fmt.Errorf("quoteauctionwindow must not be negative")
+	}
+
 	return nil
 }
@@ -0,0 +1,377 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultOracleMinSources is the default minimum number of price
+	// oracle sources that must provide a valid asset rate.
+	DefaultOracleMinSources = 1
+
+	// DefaultOracleOutlierPpm is the default maximum deviation in parts
+	// per million of the asset rate of a price oracle source from the
+	// median rate of all sources. Rates that deviate more are dropped as
+	// outliers.
+	DefaultOracleOutlierPpm = 50_000
+
+	// DefaultOracleQueryTimeout is the default timeout for querying a
+	// single price oracle source.
+	DefaultOracleQueryTimeout = 5 * time.Second
+)
+
+// OracleSource is an upstream price oracle of a composite price oracle.
+type OracleSource struct {
+	// Name identifies the source in logs and metrics.
+	Name string
+
+	// Oracle is the price oracle that is queried for asset rates.
+	Oracle PriceOracle
+}
+
+// OracleSourceStats holds the statistics of the queries that a composite
+// price oracle sent to one of its sources.
+type OracleSourceStats struct {
+	// Name identifies the source.
+	Name string
+
+	// Queries is the number of queries that were sent to the source.
+	Queries uint64
+
+	// Errors is the number of queries that failed or that the source
+	// responded to with an error.
+	Errors uint64
+
+	// Stale is the number of asset rates of the source that were dropped
+	// because they expired too soon.
+	Stale uint64
+
+	// Outliers is the number of asset rates of the source that were
+	// dropped because they deviated too much from the median rate.
+	Outliers uint64
+
+	// LastLatency is the duration of the last query to the source.
+	LastLatency time.Duration
+
+	// TotalLatency is the total duration of all queries to the source.
+	TotalLatency time.Duration
+}
+
+// CompositePriceOracleCfg is the configuration of a composite price oracle.
+type CompositePriceOracleCfg struct {
+	// Sources are the upstream price oracles that are queried.
+	Sources []OracleSource
+
+	// MinSources is the minimum number of sources that must provide a
+	// valid asset rate for a query to succeed. A value of zero is treated
+	// as one.
+	MinSources uint32
+
+	// OutlierPpm is the maximum deviation in parts per million of the
+	// asset rate of a source from the median rate of all sources. Rates
+	// that deviate more are dropped. A value of zero disables the check.
+	OutlierPpm uint64
+
+	// QueryTimeout is the timeout for querying a single source. A value
+	// of zero only applies the deadline of the caller's context.
+	QueryTimeout time.Duration
+
+	// MinExpiry is the minimum remaining lifetime of the asset rate of a
+	// source. Rates that expire sooner are dropped as stale.
+	MinExpiry time.Duration
+}
+
+// CompositePriceOracle is a price oracle that queries several upstream price
+// oracles in parallel and aggregates their responses. Stale and outlier asset
+// rates are dropped, and the median of the remaining rates is returned along
+// with the earliest expiry among them.
+type CompositePriceOracle struct {
+	cfg CompositePriceOracleCfg
+
+	// statsMtx guards stats.
+	statsMtx sync.Mutex
+
+	// stats holds the query statistics of each source, in the order of
+	// the configured sources.
+	stats []OracleSourceStats
+}
+
+// NewCompositePriceOracle creates a new composite price oracle from the given
+// configuration.
+func NewCompositePriceOracle(
+	cfg CompositePriceOracleCfg) (*CompositePriceOracle, error) {
+
+	if len(cfg.Sources) == 0 {
+		return nil, fmt.Errorf("composite price oracle requires at " +
+			"least one source")
+	}
+
+	if cfg.MinSources == 0 {
+		cfg.MinSources = 1
+	}
+
+	if int(cfg.MinSources) > len(cfg.Sources) {
+		return nil, fmt.Errorf("composite price oracle requires %d "+
+			"sources but only %d are configured", cfg.MinSources,
+			len(cfg.Sources))
+	}
+
+	stats := make([]OracleSourceStats, len(cfg.Sources))
+	for idx, source := range cfg.Sources {
+		if source.Oracle == nil {
+			return nil, fmt.Errorf("price oracle source %s is nil",
+				source.Name)
+		}
+
+		stats[idx].Name = source.Name
+	}
+
+	return &CompositePriceOracle{
+		cfg:   cfg,
+		stats: stats,
+	}, nil
+}
+
+// sourceQuery is a query of a single price oracle source.
+type sourceQuery func(ctx context.Context,
+	oracle PriceOracle) (*OracleResponse, error)
+
+// sourceRate is the asset rate that a price oracle source provided.
+type sourceRate struct {
+	// idx is the index of the source.
+	idx int
+
+	// rate is the asset rate of the source.
+	rate rfqmsg.AssetRate
+}
+
+// query sends the given query to all sources in parallel and aggregates the
+// asset rates that they respond with.
+func (c *CompositePriceOracle) query(ctx context.Context,
+	queryFn sourceQuery) (*OracleResponse, error) {
+
+	var (
+		wg          sync.WaitGroup
+		numSources  = len(c.cfg.Sources)
+		rates       = make([]fn.Option[rfqmsg.AssetRate], numSources)
+		staleBefore = time.Now().Add(c.cfg.MinExpiry)
+	)
+	for idx, source := range c.cfg.Sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			rates[idx] = c.querySource(ctx, idx, source, queryFn)
+		}()
+	}
+	wg.Wait()
+
+	// Drop the rates that expire too soon to be of any use.
+	var valid []sourceRate
+	for idx, rate := range rates {
+		if rate.IsNone() {
+			continue
+		}
+
+		r := rate.UnwrapToPtr()
+		if r.Expiry.Before(staleBefore) {
+			log.Debugf("Dropping stale asset rate of price oracle "+
+				"source %s (asset_rate=%s)",
+				c.cfg.Sources[idx].Name, r.String())
+			c.recordStats(idx, func(s *OracleSourceStats) {
+				s.Stale++
+			})
+
+			continue
+		}
+
+		valid = append(valid, sourceRate{idx: idx, rate: *r})
+	}
+
+	// Drop the rates that deviate too much from the median rate of all
+	// sources, as they are likely to be the result of a faulty source.
+	if c.cfg.OutlierPpm > 0 && len(valid) > 0 {
+		median := medianRate(valid)
+		tolerance := rfqmath.NewBigIntFromUint64(c.cfg.OutlierPpm)
+
+		inliers := make([]sourceRate, 0, len(valid))
+		for _, r := range valid {
+			if r.rate.Rate.WithinTolerance(median, tolerance) {
+				inliers = append(inliers, r)
+				continue
+			}
+
+			log.Debugf("Dropping outlier asset rate of price "+
+				"oracle source %s (asset_rate=%s, median=%s)",
+				c.cfg.Sources[r.idx].Name, r.rate.String(),
+				median.String())
+			c.recordStats(r.idx, func(s *OracleSourceStats) {
+				s.Outliers++
+			})
+		}
+		valid = inliers
+	}
+
+	if len(valid) < int(c.cfg.MinSources) {
+		return nil, fmt.Errorf("only %d of %d price oracle sources "+
+			"provided a valid asset rate, %d required", len(valid),
+			numSources, c.cfg.MinSources)
+	}
+
+	expiry := valid[0].rate.Expiry
+	for _, r := range valid[1:] {
+		if r.rate.Expiry.Before(expiry) {
+			expiry = r.rate.Expiry
+		}
+	}
+
+	return &OracleResponse{
+		AssetRate: rfqmsg.NewAssetRate(medianRate(valid), expiry),
+	}, nil
+}
+
+// querySource sends the given query to a single source and records its
+// statistics. The asset rate of the source is returned if it provided one.
+func (c *CompositePriceOracle) querySource(ctx context.Context, idx int,
+	source OracleSource,
+	queryFn sourceQuery) fn.Option[rfqmsg.AssetRate] {
+
+	if c.cfg.QueryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.QueryTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	resp, err := queryFn(ctx, source.Oracle)
+	latency := time.Since(start)
+
+	switch {
+	case err == nil && resp == nil:
+		err = fmt.Errorf("empty response")
+
+	case err == nil && resp.Err != nil:
+		err = resp.Err
+
+	case err == nil && resp.AssetRate.Rate.Coefficient.ToUint64() == 0:
+		err = fmt.Errorf("no asset rate specified")
+	}
+
+	c.recordStats(idx, func(s *OracleSourceStats) {
+		s.Queries++
+		s.LastLatency = latency
+		s.TotalLatency += latency
+
+		if err != nil {
+			s.Errors++
+		}
+	})
+
+	if err != nil {
+		log.Warnf("Failed to query price oracle source %s: %v",
+			source.Name, err)
+
+		return fn.None[rfqmsg.AssetRate]()
+	}
+
+	return fn.Some(resp.AssetRate)
+}
+
+// recordStats updates the statistics of the source with the given index.
+func (c *CompositePriceOracle) recordStats(idx int,
+	update func(*OracleSourceStats)) {
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+
+	update(&c.stats[idx])
+}
+
+// SourceStats returns the query statistics of each source, in the order of
+// the configured sources.
+func (c *CompositePriceOracle) SourceStats() []OracleSourceStats {
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+
+	stats := make([]OracleSourceStats, len(c.stats))
+	copy(stats, c.stats)
+
+	return stats
+}
+
+// medianRate returns the median of the given asset rates. For an even number
+// of rates, the mean of the two middle rates is returned.
+func medianRate(rates []sourceRate) rfqmath.BigIntFixedPoint {
+	sorted := make([]rfqmath.BigIntFixedPoint, len(rates))
+	for idx, r := range rates {
+		sorted[idx] = r.rate.Rate
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return rateGt(sorted[j], sorted[i])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	low, high := sorted[mid-1], sorted[mid]
+	scale := max(low.Scale, high.Scale)
+	sum := low.ScaleTo(scale).Coefficient.Add(
+		high.ScaleTo(scale).Coefficient,
+	)
+
+	return rfqmath.BigIntFixedPoint{
+		Coefficient: sum.Div(rfqmath.NewBigIntFromUint64(2)),
+		Scale:       scale,
+	}
+}
+
+// QueryAskPrice returns the median ask price of the sources for the given
+// asset amount.
+func (c *CompositePriceOracle) QueryAskPrice(ctx context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	assetRateHint fn.Option[rfqmsg.AssetRate]) (*OracleResponse,
+	error) {
+
+	return c.query(ctx, func(ctx context.Context,
+		oracle PriceOracle) (*OracleResponse, error) {
+
+		return oracle.QueryAskPrice(
+			ctx, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+			assetRateHint,
+		)
+	})
+}
+
+// QueryBidPrice returns the median bid price of the sources for the given
+// asset amount.
+func (c *CompositePriceOracle) QueryBidPrice(ctx context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	assetRateHint fn.Option[rfqmsg.AssetRate]) (*OracleResponse,
+	error) {
+
+	return c.query(ctx, func(ctx context.Context,
+		oracle PriceOracle) (*OracleResponse, error) {
+
+		return oracle.QueryBidPrice(
+			ctx, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+			assetRateHint,
+		)
+	})
+}
+
+// Ensure that CompositePriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*CompositePriceOracle)(nil)
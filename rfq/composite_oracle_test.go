@@ -0,0 +1,215 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// staticOracle is a price oracle that responds to every query with the same
+// response or error.
+type staticOracle struct {
+	resp *OracleResponse
+	err  error
+}
+
+// newStaticOracle creates a static price oracle that responds with the given
+// asset rate coefficient and expiry.
+func newStaticOracle(coefficient uint64, expiry time.Time) *staticOracle {
+	return &staticOracle{
+		resp: &OracleResponse{
+			AssetRate: rfqmsg.NewAssetRate(
+				rfqmath.NewBigIntFixedPoint(coefficient, 0),
+				expiry,
+			),
+		},
+	}
+}
+
+// QueryAskPrice returns the static response of the oracle.
+func (s *staticOracle) QueryAskPrice(context.Context, asset.Specifier,
+	fn.Option[uint64], fn.Option[lnwire.MilliSatoshi],
+	fn.Option[rfqmsg.AssetRate]) (*OracleResponse, error) {
+
+	return s.resp, s.err
+}
+
+// QueryBidPrice returns the static response of the oracle.
+func (s *staticOracle) QueryBidPrice(context.Context, asset.Specifier,
+	fn.Option[uint64], fn.Option[lnwire.MilliSatoshi],
+	fn.Option[rfqmsg.AssetRate]) (*OracleResponse, error) {
+
+	return s.resp, s.err
+}
+
+// TestCompositePriceOracle tests that the composite price oracle drops the
+// failed, stale and outlier responses of its sources and returns the median of
+// the remaining asset rates with the earliest expiry among them.
+func TestCompositePriceOracle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	soon := now.Add(10 * time.Second)
+	later := now.Add(time.Hour)
+	latest := now.Add(2 * time.Hour)
+
+	testCases := []struct {
+		name string
+
+		// sources are the oracles of the composite oracle.
+		sources []PriceOracle
+
+		// minSources is the minimum number of valid rates.
+		minSources uint32
+
+		// expectedRate is the expected median coefficient.
+		expectedRate uint64
+
+		// expectedExpiry is the expected expiry of the rate.
+		expectedExpiry time.Time
+
+		// expectedErr is the expected error, if any.
+		expectedErr string
+
+		// expectedStats are the expected number of errors, stale and
+		// outlier rates of each source.
+		expectedStats [][3]uint64
+	}{
+		{
+			name: "odd number of rates",
+			sources: []PriceOracle{
+				newStaticOracle(100_000, later),
+				newStaticOracle(102_000, latest),
+				newStaticOracle(101_000, latest),
+			},
+			minSources:     3,
+			expectedRate:   101_000,
+			expectedExpiry: later,
+			expectedStats:  [][3]uint64{{}, {}, {}},
+		},
+		{
+			name: "drop failed, stale and outlier rates",
+			sources: []PriceOracle{
+				newStaticOracle(100_000, latest),
+				&staticOracle{err: fmt.Errorf("unavailable")},
+				newStaticOracle(101_000, later),
+				newStaticOracle(100_500, soon),
+				newStaticOracle(200_000, latest),
+				&staticOracle{resp: &OracleResponse{
+					Err: &OracleError{Msg: "unknown asset"},
+				}},
+			},
+			minSources:     2,
+			expectedRate:   100_500,
+			expectedExpiry: later,
+			expectedStats: [][3]uint64{
+				{}, {1, 0, 0}, {}, {0, 1, 0}, {0, 0, 1},
+				{1, 0, 0},
+			},
+		},
+		{
+			name: "not enough valid rates",
+			sources: []PriceOracle{
+				newStaticOracle(100_000, latest),
+				&staticOracle{err: fmt.Errorf("unavailable")},
+				newStaticOracle(100_000, soon),
+			},
+			minSources: 2,
+			expectedErr: "only 1 of 3 price oracle sources " +
+				"provided a valid asset rate, 2 required",
+			expectedStats: [][3]uint64{{}, {1, 0, 0}, {0, 1, 0}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sources := make([]OracleSource, len(tc.sources))
+			for idx, oracle := range tc.sources {
+				sources[idx] = OracleSource{
+					Name:   fmt.Sprintf("source-%d", idx),
+					Oracle: oracle,
+				}
+			}
+
+			oracle, err := NewCompositePriceOracle(
+				CompositePriceOracleCfg{
+					Sources:      sources,
+					MinSources:   tc.minSources,
+					OutlierPpm:   DefaultOracleOutlierPpm,
+					QueryTimeout: time.Second,
+					MinExpiry:    time.Minute,
+				},
+			)
+			require.NoError(t, err)
+
+			resp, err := oracle.QueryAskPrice(
+				context.Background(),
+				asset.NewSpecifierFromId(asset.RandID(t)),
+				fn.Some[uint64](1_000),
+				fn.None[lnwire.MilliSatoshi](),
+				fn.None[rfqmsg.AssetRate](),
+			)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+
+				rate := resp.AssetRate
+				require.Equal(
+					t, tc.expectedRate,
+					rate.Rate.Coefficient.ToUint64(),
+				)
+				require.True(
+					t, tc.expectedExpiry.Equal(rate.Expiry),
+				)
+			}
+
+			stats := oracle.SourceStats()
+			require.Len(t, stats, len(tc.expectedStats))
+			for idx, expected := range tc.expectedStats {
+				s := stats[idx]
+				require.Equal(t, sources[idx].Name, s.Name)
+				require.EqualValues(t, 1, s.Queries)
+				require.Equal(t, expected, [3]uint64{
+					s.Errors, s.Stale, s.Outliers,
+				})
+			}
+		})
+	}
+}
+
+// TestMedianRate tests that the median of an even number of asset rates with
+// different scales is the mean of the two middle rates.
+func TestMedianRate(t *testing.T) {
+	t.Parallel()
+
+	rates := []sourceRate{
+		{rate: rfqmsg.AssetRate{
+			Rate: rfqmath.NewBigIntFixedPoint(40, 0),
+		}},
+		{rate: rfqmsg.AssetRate{
+			Rate: rfqmath.NewBigIntFixedPoint(1_005, 2),
+		}},
+		{rate: rfqmsg.AssetRate{
+			Rate: rfqmath.NewBigIntFixedPoint(10, 0),
+		}},
+		{rate: rfqmsg.AssetRate{
+			Rate: rfqmath.NewBigIntFixedPoint(1, 0),
+		}},
+	}
+
+	// The middle rates are 10.05 and 10, so the median is 10.025, which
+	// is rounded down to 10.02 at the larger scale of the two.
+	median := medianRate(rates)
+	require.True(t, rfqmath.NewBigIntFixedPoint(1_002, 2).Equals(median))
+}
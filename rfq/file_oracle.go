@@ -0,0 +1,325 @@
+package rfq
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"gopkg.in/yaml.v3"
+)
+
+// rateFile is the content of a price oracle rate file.
+type rateFile struct {
+	// Expiry is the default lifetime of the asset rates, as a duration
+	// string such as "5m".
+	Expiry string `json:"expiry" yaml:"expiry"`
+
+	// Assets are the asset rates of the file.
+	Assets []rateFileAsset `json:"assets" yaml:"assets"`
+}
+
+// rateFileAsset is the asset rate of a single asset in a price oracle rate
+// file.
+type rateFileAsset struct {
+	// AssetID is the hex encoded ID of the asset. Either the asset ID or
+	// the group key must be set.
+	AssetID string `json:"asset_id" yaml:"asset_id"`
+
+	// GroupKey is the hex encoded compressed group key of the asset.
+	GroupKey string `json:"group_key" yaml:"group_key"`
+
+	// Rate is the decimal mid-market number of asset units per BTC.
+	Rate json.Number `json:"rate" yaml:"rate"`
+
+	// SpreadBps is the spread in basis points that is added to the rate
+	// for bid prices and subtracted from it for ask prices.
+	SpreadBps uint32 `json:"spread_bps" yaml:"spread_bps"`
+
+	// Expiry optionally overrides the default lifetime of the asset
+	// rate.
+	Expiry string `json:"expiry" yaml:"expiry"`
+}
+
+// fileRates are the parsed asset rates of a price oracle rate file.
+type fileRates struct {
+	// byID holds the asset rates of the assets that are identified by
+	// their asset ID.
+	byID map[asset.ID]*staticRate
+
+	// byGroupKey holds the asset rates of the assets that are identified
+	// by their group key.
+	byGroupKey map[asset.SerializedKey]*staticRate
+}
+
+// parseRateFile parses the content of a price oracle rate file. JSON is
+// expected if the file has a .json extension, and YAML otherwise.
+func parseRateFile(path string, content []byte) (*fileRates, error) {
+	var (
+		file rateFile
+		err  error
+	)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode rate file: %w", err)
+	}
+
+	defaultLifetime := DefaultStaticRateExpiry
+	if file.Expiry != "" {
+		defaultLifetime, err = time.ParseDuration(file.Expiry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry: %w", err)
+		}
+	}
+
+	rates := &fileRates{
+		byID:       make(map[asset.ID]*staticRate),
+		byGroupKey: make(map[asset.SerializedKey]*staticRate),
+	}
+	for idx, entry := range file.Assets {
+		lifetime := defaultLifetime
+		if entry.Expiry != "" {
+			lifetime, err = time.ParseDuration(entry.Expiry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiry of "+
+					"asset %d: %w", idx, err)
+			}
+		}
+
+		rate, err := newStaticRate(
+			entry.Rate.String(), entry.SpreadBps, lifetime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate of asset %d: %w",
+				idx, err)
+		}
+
+		switch {
+		case entry.AssetID != "" && entry.GroupKey != "":
+			return nil, fmt.Errorf("asset %d must specify either "+
+				"an asset ID or a group key, not both", idx)
+
+		case entry.AssetID != "":
+			var id asset.ID
+			idBytes, err := hex.DecodeString(entry.AssetID)
+			if err != nil || len(idBytes) != len(id) {
+				return nil, fmt.Errorf("invalid asset ID %q",
+					entry.AssetID)
+			}
+			copy(id[:], idBytes)
+
+			if _, ok := rates.byID[id]; ok {
+				return nil, fmt.Errorf("duplicate asset ID %q",
+					entry.AssetID)
+			}
+			rates.byID[id] = rate
+
+		case entry.GroupKey != "":
+			groupKey, err := parseGroupKey(entry.GroupKey)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := rates.byGroupKey[groupKey]; ok {
+				return nil, fmt.Errorf("duplicate group key %q",
+					entry.GroupKey)
+			}
+			rates.byGroupKey[groupKey] = rate
+
+		default:
+			return nil, fmt.Errorf("asset %d must specify an "+
+				"asset ID or a group key", idx)
+		}
+	}
+
+	return rates, nil
+}
+
+// FilePriceOracle is a price oracle that provides the asset rates of a local
+// JSON or YAML rate file, without querying any external service. It is
+// selected with a price oracle address of the form:
+//
+//	file:///path/to/rates.yaml
+//
+// The modification time and size of the file are checked on every query, so
+// the rates can be updated without restarting the node. If an updated file
+// can't be parsed, the rates of the last valid version of the file continue
+// to be used, and the file is only read again once it changes once more.
+type FilePriceOracle struct {
+	// path is the path of the rate file.
+	path string
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// modTime and size are the modification time and size of the rate
+	// file when it was last read, which are used to detect changes.
+	modTime time.Time
+	size    int64
+
+	// failedModTime and failedSize are the modification time and size of
+	// the rate file when it last failed to parse, if it did. The file
+	// isn't read again until one of them changes.
+	failedModTime fn.Option[time.Time]
+	failedSize    int64
+
+	// rates are the asset rates of the last valid version of the file.
+	rates *fileRates
+}
+
+// NewFilePriceOracle creates a new file price oracle from the given price
+// oracle address. The rate file must exist and be valid.
+func NewFilePriceOracle(addrStr string) (*FilePriceOracle, error) {
+	addr, err := ParsePriceOracleAddress(addrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if addr.Scheme != FileOracleAddrScheme {
+		return nil, fmt.Errorf("invalid file price oracle scheme: %v",
+			addr.Scheme)
+	}
+
+	if addr.Host != "" && addr.Host != "localhost" {
+		return nil, fmt.Errorf("rate file must be local, got host %q",
+			addr.Host)
+	}
+
+	oracle := &FilePriceOracle{
+		path: addr.Path,
+	}
+	if err := oracle.refresh(); err != nil {
+		return nil, err
+	}
+
+	return oracle, nil
+}
+
+// refresh reads the rate file again if it changed since it was last read.
+//
+// NOTE: The mutex must be held when calling this method.
+func (f *FilePriceOracle) refresh() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("unable to stat rate file: %w", err)
+	}
+
+	if f.rates != nil && info.ModTime().Equal(f.modTime) &&
+		info.Size() == f.size {
+
+		return nil
+	}
+
+	// We already reported that this version of the file is invalid, so
+	// we wait for it to change before we try again.
+	failedBefore := fn.MapOptionZ(
+		f.failedModTime, func(modTime time.Time) bool {
+			return info.ModTime().Equal(modTime) &&
+				info.Size() == f.failedSize
+		},
+	)
+	if failedBefore {
+		return nil
+	}
+
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("unable to read rate file: %w", err)
+	}
+
+	rates, err := parseRateFile(f.path, content)
+	if err != nil {
+		f.failedModTime = fn.Some(info.ModTime())
+		f.failedSize = info.Size()
+
+		return fmt.Errorf("invalid rate file %s: %w", f.path, err)
+	}
+
+	log.Infof("Loaded %d asset rates from price oracle rate file %s",
+		len(rates.byID)+len(rates.byGroupKey), f.path)
+
+	f.rates = rates
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	f.failedModTime = fn.None[time.Time]()
+	f.failedSize = 0
+
+	return nil
+}
+
+// query returns the bid or ask asset rate of the file for the given asset.
+func (f *FilePriceOracle) query(assetSpecifier asset.Specifier,
+	bid bool) (*OracleResponse, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// A broken update of the file is logged, and the rates of the last
+	// valid version of the file continue to be used until it is fixed.
+	if err := f.refresh(); err != nil {
+		log.Errorf("Unable to refresh price oracle rate file: %v", err)
+	}
+
+	var rate *staticRate
+	assetSpecifier.WhenId(func(id asset.ID) {
+		rate = f.rates.byID[id]
+	})
+	if rate == nil {
+		assetSpecifier.WhenGroupPubKey(func(key btcec.PublicKey) {
+			rate = f.rates.byGroupKey[asset.ToSerialized(&key)]
+		})
+	}
+
+	if rate == nil {
+		return &OracleResponse{
+			Err: &OracleError{
+				Msg: fmt.Sprintf("no asset rate for asset %s",
+					assetSpecifier.String()),
+			},
+		}, nil
+	}
+
+	return &OracleResponse{
+		AssetRate: rate.assetRate(bid),
+	}, nil
+}
+
+// QueryAskPrice returns the ask price of the rate file for the given asset.
+func (f *FilePriceOracle) QueryAskPrice(_ context.Context,
+	assetSpecifier asset.Specifier, _ fn.Option[uint64],
+	_ fn.Option[lnwire.MilliSatoshi],
+	_ fn.Option[rfqmsg.AssetRate]) (*OracleResponse, error) {
+
+	return f.query(assetSpecifier, false)
+}
+
+// QueryBidPrice returns the bid price of the rate file for the given asset.
+func (f *FilePriceOracle) QueryBidPrice(_ context.Context,
+	assetSpecifier asset.Specifier, _ fn.Option[uint64],
+	_ fn.Option[lnwire.MilliSatoshi],
+	_ fn.Option[rfqmsg.AssetRate]) (*OracleResponse, error) {
+
+	return f.query(assetSpecifier, true)
+}
+
+// Ensure that FilePriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*FilePriceOracle)(nil)
@@ -0,0 +1,200 @@
+package rfq
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFilePriceOracle tests that the file price oracle provides the rates of
+// its rate file, and that it picks up changes to the file while ignoring
+// broken updates.
+func TestFilePriceOracle(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	groupKeyHex := hex.EncodeToString(groupKey.SerializeCompressed())
+
+	path := filepath.Join(t.TempDir(), "rates.yaml")
+	writeRates := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	writeRates(fmt.Sprintf(`
+expiry: 10m
+assets:
+  - asset_id: %x
+    rate: 42000000000
+    spread_bps: 100
+  - group_key: %s
+    rate: 100000.5
+    expiry: 1m
+`, assetID[:], groupKeyHex))
+
+	oracle, err := NewPriceOracle("file://" + path)
+	require.NoError(t, err)
+	require.IsType(t, &FilePriceOracle{}, oracle)
+
+	ask, bid := queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(assetID),
+	)
+	requireStaticRate(t, ask, "41580000000", 10*time.Minute)
+	requireStaticRate(t, bid, "42420000000", 10*time.Minute)
+
+	ask, bid = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromGroupKey(*groupKey),
+	)
+	requireStaticRate(t, ask, "100000.5", time.Minute)
+	requireStaticRate(t, bid, "100000.5", time.Minute)
+
+	ask, _ = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(asset.RandID(t)),
+	)
+	require.NotNil(t, ask.Err)
+
+	// An updated rate file is picked up on the next query.
+	writeRates(fmt.Sprintf(`
+assets:
+  - asset_id: %x
+    rate: "50000"
+`, assetID[:]))
+
+	ask, _ = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(assetID),
+	)
+	requireStaticRate(t, ask, "50000", DefaultStaticRateExpiry)
+
+	// A broken update is ignored, so the rates of the last valid version
+	// of the file continue to be used.
+	writeRates("assets: [{asset_id: 00, rate: 1}]")
+
+	ask, _ = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(assetID),
+	)
+	requireStaticRate(t, ask, "50000", DefaultStaticRateExpiry)
+
+	// The broken version of the file isn't parsed again until it changes.
+	fileOracle := oracle.(*FilePriceOracle)
+	fileOracle.mu.Lock()
+	require.NoError(t, fileOracle.refresh())
+	fileOracle.mu.Unlock()
+
+	// A missing or invalid rate file is rejected on startup.
+	_, err = NewFilePriceOracle("file://" + path)
+	require.ErrorContains(t, err, "invalid asset ID")
+
+	_, err = NewFilePriceOracle("file://" + path + ".missing")
+	require.ErrorContains(t, err, "unable to stat rate file")
+
+	_, err = NewFilePriceOracle("file://example.com" + path)
+	require.ErrorContains(t, err, "rate file must be local")
+
+	// Once the broken file is fixed, its rates are used again.
+	writeRates(fmt.Sprintf(`
+assets:
+  - asset_id: %x
+    rate: "60000"
+`, assetID[:]))
+
+	ask, _ = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(assetID),
+	)
+	requireStaticRate(t, ask, "60000", DefaultStaticRateExpiry)
+	require.True(t, fileOracle.failedModTime.IsNone())
+}
+
+// TestParseRateFile tests the parsing of JSON and YAML rate files.
+func TestParseRateFile(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	groupKeyHex := hex.EncodeToString(groupKey.SerializeCompressed())
+
+	testCases := []struct {
+		name        string
+		path        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name: "json",
+			path: "rates.json",
+			content: fmt.Sprintf(`{"assets": [
+				{"asset_id": "%x", "rate": 42000.25},
+				{"group_key": "%s", "rate": "1000"}
+			]}`, assetID[:], groupKeyHex),
+		},
+		{
+			name:        "json unknown field",
+			path:        "rates.json",
+			content:     `{"assets": [], "spread": 1}`,
+			expectedErr: "unknown field",
+		},
+		{
+			name:        "yaml unknown field",
+			path:        "rates.yml",
+			content:     "assets: []\nspread: 1",
+			expectedErr: "field spread not found",
+		},
+		{
+			name: "both asset ID and group key",
+			path: "rates.yaml",
+			content: fmt.Sprintf(`assets: [{asset_id: %x, `+
+				`group_key: %s, rate: 1}]`, assetID[:],
+				groupKeyHex),
+			expectedErr: "not both",
+		},
+		{
+			name:        "neither asset ID nor group key",
+			path:        "rates.yaml",
+			content:     "assets: [{rate: 1}]",
+			expectedErr: "must specify an asset ID or a group key",
+		},
+		{
+			name: "duplicate asset ID",
+			path: "rates.yaml",
+			content: fmt.Sprintf(`assets: [{asset_id: %x, `+
+				`rate: 1}, {asset_id: %x, rate: 2}]`,
+				assetID[:], assetID[:]),
+			expectedErr: "duplicate asset ID",
+		},
+		{
+			name: "invalid spread",
+			path: "rates.yaml",
+			content: fmt.Sprintf(`assets: [{asset_id: %x, `+
+				`rate: 1, spread_bps: 20000}]`, assetID[:]),
+			expectedErr: "must be less than",
+		},
+		{
+			name:        "invalid expiry",
+			path:        "rates.yaml",
+			content:     "expiry: soon\nassets: []",
+			expectedErr: "invalid expiry",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates, err := parseRateFile(tc.path, []byte(tc.content))
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, rates.byID, 1)
+			require.Len(t, rates.byGroupKey, 1)
+		})
+	}
+}
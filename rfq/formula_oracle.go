@@ -0,0 +1,286 @@
+package rfq
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultStaticRateExpiry is the default lifetime of the asset rates
+	// that the file and formula price oracles provide.
+	DefaultStaticRateExpiry = 5 * time.Minute
+
+	// PegFormula is the name of the formula that pegs the asset to a fixed
+	// number of asset units per BTC.
+	PegFormula = "peg"
+
+	// maxSpreadBps is the exclusive upper bound of a spread in basis
+	// points. A spread of 100% would result in an ask rate of zero.
+	maxSpreadBps = 10_000
+
+	// maxRateDecimals is the maximum number of decimal places of an asset
+	// rate.
+	maxRateDecimals = 18
+)
+
+// staticRate is a mid-market asset rate with a spread that is applied to it
+// for bid and ask prices.
+type staticRate struct {
+	// rate is the mid-market number of asset units per BTC.
+	rate rfqmath.BigIntFixedPoint
+
+	// spreadBps is the spread in basis points that is added to the rate
+	// for bid prices and subtracted from it for ask prices.
+	spreadBps uint32
+
+	// lifetime is the lifetime of the asset rates that are provided.
+	lifetime time.Duration
+}
+
+// newStaticRate creates a new static rate from the given decimal asset units
+// per BTC rate, spread in basis points and lifetime.
+func newStaticRate(rateStr string, spreadBps uint32,
+	lifetime time.Duration) (*staticRate, error) {
+
+	rate, err := parseDecimalRate(rateStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if spreadBps >= maxSpreadBps {
+		return nil, fmt.Errorf("spread of %d bps must be less than "+
+			"%d bps", spreadBps, maxSpreadBps)
+	}
+
+	if lifetime <= 0 {
+		return nil, fmt.Errorf("rate expiry must be positive")
+	}
+
+	return &staticRate{
+		rate:      rate,
+		spreadBps: spreadBps,
+		lifetime:  lifetime,
+	}, nil
+}
+
+// assetRate returns the asset rate for a bid or an ask price. The bid rate
+// offers more asset units per BTC than the mid-market rate, and the ask rate
+// fewer, so the spread is earned either way.
+func (s *staticRate) assetRate(bid bool) rfqmsg.AssetRate {
+	factor := uint64(maxSpreadBps - s.spreadBps)
+	if bid {
+		factor = uint64(maxSpreadBps + s.spreadBps)
+	}
+
+	// Multiplying the coefficient with the factor and raising the scale
+	// by four divides it by 10,000 without any loss of precision.
+	rate := rfqmath.BigIntFixedPoint{
+		Coefficient: s.rate.Coefficient.Mul(
+			rfqmath.NewBigIntFromUint64(factor),
+		),
+		Scale: s.rate.Scale + 4,
+	}
+
+	return rfqmsg.NewAssetRate(rate, time.Now().Add(s.lifetime).UTC())
+}
+
+// parseDecimalRate parses a positive decimal number of asset units per BTC,
+// such as "42000" or "42000.125", into a fixed point rate.
+func parseDecimalRate(rateStr string) (rfqmath.BigIntFixedPoint, error) {
+	rateStr = strings.TrimSpace(rateStr)
+	integer, fraction, _ := strings.Cut(rateStr, ".")
+
+	if len(fraction) > maxRateDecimals {
+		return rfqmath.BigIntFixedPoint{}, fmt.Errorf("asset rate %q "+
+			"has more than %d decimal places", rateStr,
+			maxRateDecimals)
+	}
+
+	coefficient, ok := new(big.Int).SetString(integer+fraction, 10)
+	if !ok || strings.ContainsAny(rateStr, "+-") {
+		return rfqmath.BigIntFixedPoint{}, fmt.Errorf("invalid asset "+
+			"rate %q", rateStr)
+	}
+
+	if coefficient.Sign() == 0 {
+		return rfqmath.BigIntFixedPoint{}, fmt.Errorf("asset rate " +
+			"must be positive")
+	}
+
+	return rfqmath.BigIntFixedPoint{
+		Coefficient: rfqmath.NewBigInt(coefficient),
+		Scale:       uint8(len(fraction)),
+	}, nil
+}
+
+// parseGroupKey parses a hex encoded compressed group public key.
+func parseGroupKey(keyStr string) (asset.SerializedKey, error) {
+	keyBytes, err := hex.DecodeString(keyStr)
+	if err != nil {
+		return asset.SerializedKey{}, fmt.Errorf("invalid group key "+
+			"%q: %w", keyStr, err)
+	}
+
+	groupKey, err := btcec.ParsePubKey(keyBytes)
+	if err != nil {
+		return asset.SerializedKey{}, fmt.Errorf("invalid group key "+
+			"%q: %w", keyStr, err)
+	}
+
+	return asset.ToSerialized(groupKey), nil
+}
+
+// FormulaPriceOracle is a price oracle that computes asset rates from a
+// formula that is configured in its address, without querying any external
+// service. It is selected with a price oracle address of the form:
+//
+//	formula://peg?rate=<units_per_btc>&spreadbps=<bps>&expiry=<duration>
+//
+// The peg formula provides a fixed number of asset units per BTC with the
+// given spread in basis points applied to it. The rates are provided for the
+// assets given by any number of assetid and groupkey parameters, or for all
+// assets if none are given.
+type FormulaPriceOracle struct {
+	// rate is the rate that the formula computes.
+	rate *staticRate
+
+	// assetIDs is the set of asset IDs that rates are provided for.
+	assetIDs fn.Set[asset.ID]
+
+	// groupKeys is the set of group keys that rates are provided for.
+	groupKeys fn.Set[asset.SerializedKey]
+}
+
+// NewFormulaPriceOracle creates a new formula price oracle from the given
+// price oracle address.
+func NewFormulaPriceOracle(addrStr string) (*FormulaPriceOracle, error) {
+	addr, err := ParsePriceOracleAddress(addrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if addr.Scheme != FormulaOracleAddrScheme {
+		return nil, fmt.Errorf("invalid formula price oracle "+
+			"scheme: %v", addr.Scheme)
+	}
+
+	if addr.Host != PegFormula {
+		return nil, fmt.Errorf("unknown price oracle formula: %q",
+			addr.Host)
+	}
+
+	params := addr.Query()
+
+	var spreadBps uint64
+	if spreadStr := params.Get("spreadbps"); spreadStr != "" {
+		spreadBps, err = strconv.ParseUint(spreadStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spreadbps: %w", err)
+		}
+	}
+
+	lifetime := DefaultStaticRateExpiry
+	if expiryStr := params.Get("expiry"); expiryStr != "" {
+		lifetime, err = time.ParseDuration(expiryStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry: %w", err)
+		}
+	}
+
+	rate, err := newStaticRate(
+		params.Get("rate"), uint32(spreadBps), lifetime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid peg formula: %w", err)
+	}
+
+	assetIDs := fn.NewSet[asset.ID]()
+	for _, idStr := range params["assetid"] {
+		var id asset.ID
+		idBytes, err := hex.DecodeString(idStr)
+		if err != nil || len(idBytes) != len(id) {
+			return nil, fmt.Errorf("invalid asset ID %q", idStr)
+		}
+		copy(id[:], idBytes)
+
+		assetIDs.Add(id)
+	}
+
+	groupKeys := fn.NewSet[asset.SerializedKey]()
+	for _, keyStr := range params["groupkey"] {
+		groupKey, err := parseGroupKey(keyStr)
+		if err != nil {
+			return nil, err
+		}
+
+		groupKeys.Add(groupKey)
+	}
+
+	return &FormulaPriceOracle{
+		rate:      rate,
+		assetIDs:  assetIDs,
+		groupKeys: groupKeys,
+	}, nil
+}
+
+// query returns the bid or ask asset rate of the formula for the given asset.
+func (f *FormulaPriceOracle) query(assetSpecifier asset.Specifier,
+	bid bool) (*OracleResponse, error) {
+
+	// Without any configured assets, the formula applies to all assets.
+	matches := len(f.assetIDs) == 0 && len(f.groupKeys) == 0
+
+	assetSpecifier.WhenId(func(id asset.ID) {
+		matches = matches || f.assetIDs.Contains(id)
+	})
+	assetSpecifier.WhenGroupPubKey(func(groupKey btcec.PublicKey) {
+		matches = matches ||
+			f.groupKeys.Contains(asset.ToSerialized(&groupKey))
+	})
+
+	if !matches {
+		return &OracleResponse{
+			Err: &OracleError{
+				Msg: fmt.Sprintf("no asset rate for asset %s",
+					assetSpecifier.String()),
+			},
+		}, nil
+	}
+
+	return &OracleResponse{
+		AssetRate: f.rate.assetRate(bid),
+	}, nil
+}
+
+// QueryAskPrice returns the ask price of the formula for the given asset.
+func (f *FormulaPriceOracle) QueryAskPrice(_ context.Context,
+	assetSpecifier asset.Specifier, _ fn.Option[uint64],
+	_ fn.Option[lnwire.MilliSatoshi],
+	_ fn.Option[rfqmsg.AssetRate]) (*OracleResponse, error) {
+
+	return f.query(assetSpecifier, false)
+}
+
+// QueryBidPrice returns the bid price of the formula for the given asset.
+func (f *FormulaPriceOracle) QueryBidPrice(_ context.Context,
+	assetSpecifier asset.Specifier, _ fn.Option[uint64],
+	_ fn.Option[lnwire.MilliSatoshi],
+	_ fn.Option[rfqmsg.AssetRate]) (*OracleResponse, error) {
+
+	return f.query(assetSpecifier, true)
+}
+
+// Ensure that FormulaPriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*FormulaPriceOracle)(nil)
@@ -0,0 +1,171 @@
+package rfq
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// queryStaticOracle queries the ask and bid price of the given asset from a
+// price oracle.
+func queryStaticOracle(t *testing.T, oracle PriceOracle,
+	specifier asset.Specifier) (*OracleResponse, *OracleResponse) {
+
+	ctx := context.Background()
+	ask, err := oracle.QueryAskPrice(
+		ctx, specifier, fn.None[uint64](),
+		fn.None[lnwire.MilliSatoshi](), fn.None[rfqmsg.AssetRate](),
+	)
+	require.NoError(t, err)
+
+	bid, err := oracle.QueryBidPrice(
+		ctx, specifier, fn.None[uint64](),
+		fn.None[lnwire.MilliSatoshi](), fn.None[rfqmsg.AssetRate](),
+	)
+	require.NoError(t, err)
+
+	return ask, bid
+}
+
+// requireStaticRate asserts that the response of a price oracle holds the
+// given decimal asset rate, which expires after the given lifetime.
+func requireStaticRate(t *testing.T, resp *OracleResponse, expected string,
+	lifetime time.Duration) {
+
+	require.Nil(t, resp.Err)
+
+	expectedRate, err := parseDecimalRate(expected)
+	require.NoError(t, err)
+
+	rate := resp.AssetRate.Rate
+	require.True(
+		t, expectedRate.ScaleTo(rate.Scale).Equals(rate),
+		"expected %v, got %v", expectedRate, rate,
+	)
+
+	expiry := time.Now().Add(lifetime)
+	require.WithinDuration(t, expiry, resp.AssetRate.Expiry, time.Second)
+}
+
+// TestParseDecimalRate tests the parsing of decimal asset rates.
+func TestParseDecimalRate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		rate        string
+		expected    rfqmath.BigIntFixedPoint
+		expectedErr string
+	}{
+		{
+			rate:     "42000",
+			expected: rfqmath.NewBigIntFixedPoint(42_000, 0),
+		},
+		{
+			rate:     " 42000.125 ",
+			expected: rfqmath.NewBigIntFixedPoint(42_000_125, 3),
+		},
+		{
+			rate:     ".5",
+			expected: rfqmath.NewBigIntFixedPoint(5, 1),
+		},
+		{
+			rate:        "",
+			expectedErr: "invalid asset rate",
+		},
+		{
+			rate:        "-1",
+			expectedErr: "invalid asset rate",
+		},
+		{
+			rate:        "1e5",
+			expectedErr: "invalid asset rate",
+		},
+		{
+			rate:        "0.000",
+			expectedErr: "must be positive",
+		},
+		{
+			rate:        "1.0000000000000000001",
+			expectedErr: "more than 18 decimal places",
+		},
+	}
+
+	for _, tc := range testCases {
+		rate, err := parseDecimalRate(tc.rate)
+		if tc.expectedErr != "" {
+			require.ErrorContains(t, err, tc.expectedErr, tc.rate)
+			continue
+		}
+
+		require.NoError(t, err, tc.rate)
+		require.True(t, tc.expected.Equals(rate), tc.rate)
+	}
+}
+
+// TestFormulaPriceOracle tests that the peg formula price oracle applies its
+// spread to the pegged rate, and only provides rates for the configured
+// assets.
+func TestFormulaPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	groupKeyHex := hex.EncodeToString(groupKey.SerializeCompressed())
+
+	// Without any configured assets, the formula applies to all assets.
+	oracle, err := NewPriceOracle(
+		"formula://peg?rate=100000&spreadbps=150&expiry=10m",
+	)
+	require.NoError(t, err)
+	require.IsType(t, &FormulaPriceOracle{}, oracle)
+
+	ask, bid := queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(assetID),
+	)
+	requireStaticRate(t, ask, "98500", 10*time.Minute)
+	requireStaticRate(t, bid, "101500", 10*time.Minute)
+
+	// With configured assets, only those are priced.
+	oracle, err = NewPriceOracle(fmt.Sprintf(
+		"formula://peg?rate=42000.5&groupkey=%s", groupKeyHex,
+	))
+	require.NoError(t, err)
+
+	ask, bid = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromGroupKey(*groupKey),
+	)
+	requireStaticRate(t, ask, "42000.5", DefaultStaticRateExpiry)
+	requireStaticRate(t, bid, "42000.5", DefaultStaticRateExpiry)
+
+	ask, _ = queryStaticOracle(
+		t, oracle, asset.NewSpecifierFromId(assetID),
+	)
+	require.NotNil(t, ask.Err)
+
+	// Invalid formulas are rejected.
+	invalidAddrs := map[string]string{
+		"formula://peg":                        "invalid asset rate",
+		"formula://curve?rate=1":               "unknown price oracle",
+		"formula://peg?rate=1&spreadbps=10000": "must be less than",
+		"formula://peg?rate=1&expiry=0s":       "must be positive",
+		"formula://peg?rate=1&assetid=00":      "invalid asset ID",
+		"formula://peg?rate=1&groupkey=00":     "invalid group key",
+		"formula://peg?rate=1&spreadbps=abc":   "invalid spreadbps",
+		"formula://peg?rate=1&expiry=tomorrow": "invalid expiry",
+		"formula://peg?rate=1&spreadbps=-1":    "invalid spreadbps",
+	}
+	for addr, expectedErr := range invalidAddrs {
+		_, err := NewFormulaPriceOracle(addr)
+		require.ErrorContains(t, err, expectedErr, addr)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
 	lfn "github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/lnutils"
@@ -99,6 +100,34 @@ type ManagerCfg struct {
 	// messages (this means that the price oracle will not be queried).
 	SkipAcceptQuotePriceCheck bool
 
+	// RateHintDeviationPpm is the maximum deviation in parts per million
+	// of the asset rate hint in an incoming quote request from the asset
+	// rate of our price oracle. A value of zero disables the check.
+	RateHintDeviationPpm uint64
+
+	// MinQuoteExpiry is the minimum remaining lifetime of the asset rate
+	// of a quote. A value of zero disables the lower bound.
+	MinQuoteExpiry time.Duration
+
+	// MaxQuoteExpiry is the maximum remaining lifetime of the asset rate
+	// of a quote. A value of zero disables the upper bound.
+	MaxQuoteExpiry time.Duration
+
+	// QuoteStore is the store in which accepted quotes, their SCID aliases
+	// and the HTLCs accepted under their policies are persisted, so they
+	// survive a restart. This field is optional.
+	QuoteStore QuoteStore
+
+	// QuoteHistoryRetention is the duration for which expired quotes are
+	// kept in the quote store before they are pruned. A value of zero
+	// keeps them forever.
+	QuoteHistoryRetention time.Duration
+
+	// QuoteAuctionWindow is the duration during which the quotes of peers
+	// are collected for an order that doesn't specify a peer. A value of
+	// zero waits for all peers to respond.
+	QuoteAuctionWindow time.Duration
+
 	// ErrChan is the main error channel which will be used to report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -213,12 +242,19 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 		HtlcInterceptor:  m.cfg.HtlcInterceptor,
 		HtlcSubscriber:   m.cfg.HtlcSubscriber,
 		AcceptHtlcEvents: m.acceptHtlcEvents,
+		QuoteStore:       m.cfg.QuoteStore,
 	})
 	if err != nil {
 		return fmt.Errorf("error initializing RFQ order handler: %w",
 			err)
 	}
 
+	// Restore the quotes that were accepted before a restart, before the
+	// order handler starts intercepting HTLCs that may depend on them.
+	if err := m.restoreQuotes(ctx); err != nil {
+		return fmt.Errorf("unable to restore RFQ quotes: %w", err)
+	}
+
 	if err := m.orderHandler.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ order handler: %w", err)
 	}
@@ -248,6 +284,10 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 			OutgoingMessages:          m.outgoingMessages,
 			AcceptPriceDeviationPpm:   m.cfg.AcceptPriceDeviationPpm,
 			SkipAcceptQuotePriceCheck: m.cfg.SkipAcceptQuotePriceCheck,
+			RateHintDeviationPpm:      m.cfg.RateHintDeviationPpm,
+			MinQuoteExpiry:            m.cfg.MinQuoteExpiry,
+			MaxQuoteExpiry:            m.cfg.MaxQuoteExpiry,
+			PublishEvent:              m.publishSubscriberEvent,
 			ErrChan:                   m.subsystemErrChan,
 		},
 	)
@@ -372,11 +412,15 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		finaliseCallback := func(msg rfqmsg.BuyAccept,
 			invalidQuoteEvent fn.Option[InvalidQuoteRespEvent]) {
 
-			// If the quote is invalid, notify subscribers of the
-			// invalid quote event and return.
+			// If the quote is invalid, notify subscribers and the
+			// auction of the order of the invalid quote event and
+			// return.
 			invalidQuoteEvent.WhenSome(
 				func(event InvalidQuoteRespEvent) {
 					m.publishSubscriberEvent(&event)
+					m.negotiator.HandleQuoteResponse(
+						msg.ID, &event,
+					)
 				},
 			)
 
@@ -394,7 +438,7 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 			// need to make sure we can identify the incoming asset
 			// payment by the SCID alias through which it comes in
 			// and compare it to the one in the invoice.
-			err := m.addScidAlias(
+			baseScid, err := m.addScidAlias(
 				uint64(msg.ShortChannelId()),
 				msg.Request.AssetSpecifier, msg.Peer,
 			)
@@ -406,10 +450,23 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 				return
 			}
 
-			// Notify subscribers of the incoming peer accepted
-			// asset buy quote.
+			// Persist the quote, as the invoice that is about to
+			// be created for it may be paid after a restart.
+			err = m.storeQuote(NewBuyQuoteRecord(
+				msg, false, fn.Some(baseScid),
+			))
+			if err != nil {
+				m.handleError(
+					fmt.Errorf("error storing quote: %w",
+						err),
+				)
+			}
+
+			// Notify subscribers and the auction of the order of
+			// the incoming peer accepted asset buy quote.
 			event := NewPeerAcceptedBuyQuoteEvent(&msg)
 			m.publishSubscriberEvent(event)
+			m.negotiator.HandleQuoteResponse(msg.ID, event)
 		}
 
 		m.negotiator.HandleIncomingBuyAccept(*msg, finaliseCallback)
@@ -428,11 +485,15 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		finaliseCallback := func(msg rfqmsg.SellAccept,
 			invalidQuoteEvent fn.Option[InvalidQuoteRespEvent]) {
 
-			// If the quote is invalid, notify subscribers of the
-			// invalid quote event and return.
+			// If the quote is invalid, notify subscribers and the
+			// auction of the order of the invalid quote event and
+			// return.
 			invalidQuoteEvent.WhenSome(
 				func(event InvalidQuoteRespEvent) {
 					m.publishSubscriberEvent(&event)
+					m.negotiator.HandleQuoteResponse(
+						msg.ID, &event,
+					)
 				},
 			)
 
@@ -446,19 +507,31 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 			scid := msg.ShortChannelId()
 			m.peerAcceptedSellQuotes.Store(scid, msg)
 
-			// Notify subscribers of the incoming peer accepted
-			// asset sell quote.
+			err := m.storeQuote(NewSellQuoteRecord(
+				msg, false, fn.None[lnwire.ShortChannelID](),
+			))
+			if err != nil {
+				m.handleError(
+					fmt.Errorf("error storing quote: %w",
+						err),
+				)
+			}
+
+			// Notify subscribers and the auction of the order of
+			// the incoming peer accepted asset sell quote.
 			event := NewPeerAcceptedSellQuoteEvent(&msg)
 			m.publishSubscriberEvent(event)
+			m.negotiator.HandleQuoteResponse(msg.ID, event)
 		}
 
 		m.negotiator.HandleIncomingSellAccept(*msg, finaliseCallback)
 
 	case *rfqmsg.Reject:
-		// The quote request has been rejected. Notify subscribers of
-		// the rejection.
+		// The quote request has been rejected. Notify subscribers and
+		// the auction of the order of the rejection.
 		event := NewIncomingRejectQuoteEvent(msg)
 		m.publishSubscriberEvent(event)
+		m.negotiator.HandleQuoteResponse(msg.ID.Val, event)
 
 	default:
 		return fmt.Errorf("unhandled incoming message type: %T", msg)
@@ -487,7 +560,7 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		// Since our peer is going to buy assets from us, we need to
 		// make sure we can identify the forwarded asset payment by the
 		// outgoing SCID alias within the onion packet.
-		err := m.addScidAlias(
+		baseScid, err := m.addScidAlias(
 			uint64(msg.ShortChannelId()),
 			msg.Request.AssetSpecifier, msg.Peer,
 		)
@@ -495,6 +568,15 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 			return fmt.Errorf("error adding local alias: %w", err)
 		}
 
+		// Persist the quote before our peer learns about it, so we
+		// don't forget about it if we restart before it is used.
+		err = m.storeQuote(NewBuyQuoteRecord(
+			*msg, true, fn.Some(baseScid),
+		))
+		if err != nil {
+			return fmt.Errorf("error storing quote: %w", err)
+		}
+
 	case *rfqmsg.SellAccept:
 		// A peer sent us an asset sell quote request in an attempt to
 		// sell an asset to us. Having accepted the request, but before
@@ -506,6 +588,13 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		// We want to store that we accepted the sell quote, in case we
 		// need to look it up for a direct peer payment.
 		m.localAcceptedSellQuotes.Store(msg.ShortChannelId(), *msg)
+
+		err := m.storeQuote(NewSellQuoteRecord(
+			*msg, true, fn.None[lnwire.ShortChannelID](),
+		))
+		if err != nil {
+			return fmt.Errorf("error storing quote: %w", err)
+		}
 	}
 
 	// Send the outgoing message to the peer.
@@ -518,9 +607,12 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 	return nil
 }
 
-// addScidAlias adds a SCID alias to the alias manager.
+// addScidAlias adds a SCID alias to the alias manager. The base SCID of the
+// channel the alias was added for is returned.
 func (m *Manager) addScidAlias(scidAlias uint64, assetSpecifier asset.Specifier,
-	peer route.Vertex) error {
+	peer route.Vertex) (lnwire.ShortChannelID, error) {
+
+	var noScid lnwire.ShortChannelID
 
 	// Retrieve all local channels.
 	ctxb := context.Background()
@@ -528,7 +620,7 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetSpecifier asset.Specifier,
 	if err != nil {
 		// Not being able to call lnd to add the alias is a critical
 		// error, which warrants shutting down, as something is wrong.
-		return fn.NewCriticalError(
+		return noScid, fn.NewCriticalError(
 			fmt.Errorf("add alias: error listing local channels: "+
 				"%w", err),
 		)
@@ -543,33 +635,14 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetSpecifier asset.Specifier,
 	// by inspecting the asset data in the custom channel data.
 	assetID, err := assetSpecifier.UnwrapIdOrErr()
 	if err != nil {
-		return fmt.Errorf("asset ID must be specified when adding "+
-			"alias: %w", err)
+		return noScid, fmt.Errorf("asset ID must be specified when "+
+			"adding alias: %w", err)
 	}
 
-	var (
-		assetIDStr = assetID.String()
-		baseSCID   uint64
-	)
+	var baseSCID uint64
 	for _, localChan := range peerChannels {
-		if len(localChan.CustomChannelData) == 0 {
-			continue
-		}
-
-		var assetData rfqmsg.JsonAssetChannel
-		err = json.Unmarshal(localChan.CustomChannelData, &assetData)
-		if err != nil {
-			log.Warnf("Unable to unmarshal channel asset data: %v",
-				err)
-			continue
-		}
-
-		for _, channelAsset := range assetData.Assets {
-			gen := channelAsset.AssetInfo.AssetGenesis
-			if gen.AssetID == assetIDStr {
-				baseSCID = localChan.ChannelID
-				break
-			}
+		if channelHasAsset(localChan, assetID) {
+			baseSCID = localChan.ChannelID
 		}
 	}
 
@@ -583,30 +656,210 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetSpecifier asset.Specifier,
 	// At this point, if the base SCID is still not found, we return an
 	// error. We can't map the SCID alias to a base SCID.
 	if baseSCID == 0 {
-		return fmt.Errorf("add alias: base SCID not found for asset: "+
-			"%v", assetID)
+		return noScid, fmt.Errorf("add alias: base SCID not found "+
+			"for asset: %v", assetID)
 	}
 
 	log.Debugf("Adding SCID alias %d for base SCID %d", scidAlias, baseSCID)
 
+	baseScid := lnwire.NewShortChanIDFromInt(baseSCID)
 	err = m.cfg.AliasManager.AddLocalAlias(
-		ctxb, lnwire.NewShortChanIDFromInt(scidAlias),
-		lnwire.NewShortChanIDFromInt(baseSCID),
+		ctxb, lnwire.NewShortChanIDFromInt(scidAlias), baseScid,
 	)
 	if err != nil {
 		// Not being able to call lnd to add the alias is a critical
 		// error, which warrants shutting down, as something is wrong.
-		return fn.NewCriticalError(
+		return noScid, fn.NewCriticalError(
 			fmt.Errorf("add alias: error adding SCID alias to "+
 				"lnd alias manager: %w", err),
 		)
 	}
 
+	return baseScid, nil
+}
+
+// channelHasAsset returns true if the custom channel data of the given channel
+// shows that the channel carries the given asset.
+func channelHasAsset(localChan lndclient.ChannelInfo, assetID asset.ID) bool {
+	if len(localChan.CustomChannelData) == 0 {
+		return false
+	}
+
+	var assetData rfqmsg.JsonAssetChannel
+	err := json.Unmarshal(localChan.CustomChannelData, &assetData)
+	if err != nil {
+		log.Warnf("Unable to unmarshal channel asset data: %v", err)
+		return false
+	}
+
+	assetIDStr := assetID.String()
+	for _, channelAsset := range assetData.Assets {
+		gen := channelAsset.AssetInfo.AssetGenesis
+		if gen.AssetID == assetIDStr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// storeQuote persists an accepted quote in the quote store, if one is
+// configured.
+func (m *Manager) storeQuote(quote *QuoteRecord) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	return m.cfg.QuoteStore.StoreQuote(ctx, quote)
+}
+
+// restoreQuotes loads the quotes that have not expired yet from the quote
+// store, if one is configured, and registers the policies of the quotes that
+// were accepted by our node with the order handler.
+func (m *Manager) restoreQuotes(ctx context.Context) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	quotes, err := m.cfg.QuoteStore.FetchActiveQuotes(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to fetch active quotes: %w", err)
+	}
+
+	for _, quote := range quotes {
+		scid := quote.Scid()
+		switch {
+		case quote.BuyAccept != nil && quote.LocalAccept:
+			m.localAcceptedBuyQuotes.Store(scid, *quote.BuyAccept)
+
+		case quote.BuyAccept != nil:
+			m.peerAcceptedBuyQuotes.Store(scid, *quote.BuyAccept)
+
+		case quote.SellAccept != nil && quote.LocalAccept:
+			m.localAcceptedSellQuotes.Store(scid, *quote.SellAccept)
+
+		case quote.SellAccept != nil:
+			m.peerAcceptedSellQuotes.Store(scid, *quote.SellAccept)
+		}
+	}
+
+	m.orderHandler.RestorePolicies(quotes)
+
+	log.Infof("Restored %d active RFQ quotes", len(quotes))
+
 	return nil
 }
 
+// cleanupExpiredQuotes removes the expired quotes from the local caches and
+// removes their SCID aliases. Expired quotes are pruned from the quote store
+// once the history retention period has passed.
+func (m *Manager) cleanupExpiredQuotes() {
+	now := time.Now()
+
+	m.peerAcceptedBuyQuotes.ForEach(
+		func(scid SerialisedScid, accept rfqmsg.BuyAccept) error {
+			if now.After(accept.AssetRate.Expiry) {
+				m.peerAcceptedBuyQuotes.Delete(scid)
+			}
+
+			return nil
+		},
+	)
+	m.peerAcceptedSellQuotes.ForEach(
+		func(scid SerialisedScid, accept rfqmsg.SellAccept) error {
+			if now.After(accept.AssetRate.Expiry) {
+				m.peerAcceptedSellQuotes.Delete(scid)
+			}
+
+			return nil
+		},
+	)
+	m.localAcceptedBuyQuotes.ForEach(
+		func(scid SerialisedScid, accept rfqmsg.BuyAccept) error {
+			if now.After(accept.AssetRate.Expiry) {
+				m.localAcceptedBuyQuotes.Delete(scid)
+			}
+
+			return nil
+		},
+	)
+	m.localAcceptedSellQuotes.ForEach(
+		func(scid SerialisedScid, accept rfqmsg.SellAccept) error {
+			if now.After(accept.AssetRate.Expiry) {
+				m.localAcceptedSellQuotes.Delete(scid)
+			}
+
+			return nil
+		},
+	)
+
+	if m.cfg.QuoteStore == nil {
+		return
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	aliases, err := m.cfg.QuoteStore.FetchExpiredAliases(ctx, now)
+	if err != nil {
+		log.Errorf("Unable to fetch SCID aliases of expired quotes: %v",
+			err)
+		return
+	}
+
+	for scid, baseScid := range aliases {
+		// If lnd is unable to remove the alias, there is no point in
+		// trying again, so we only log the error and forget about the
+		// alias either way.
+		alias := lnwire.NewShortChanIDFromInt(uint64(scid))
+		err := m.cfg.AliasManager.DeleteLocalAlias(ctx, alias, baseScid)
+		if err != nil {
+			log.Warnf("Unable to delete SCID alias %d of expired "+
+				"quote: %v", scid, err)
+		}
+
+		if err := m.cfg.QuoteStore.RemoveAlias(ctx, scid); err != nil {
+			log.Errorf("Unable to remove SCID alias %d of expired "+
+				"quote: %v", scid, err)
+		}
+	}
+
+	if m.cfg.QuoteHistoryRetention == 0 {
+		return
+	}
+
+	numPruned, err := m.cfg.QuoteStore.PruneQuotes(
+		ctx, now.Add(-m.cfg.QuoteHistoryRetention),
+	)
+	if err != nil {
+		log.Errorf("Unable to prune expired quotes: %v", err)
+		return
+	}
+
+	if numPruned > 0 {
+		log.Debugf("Pruned %d expired quotes", numPruned)
+	}
+}
+
+// QueryQuoteHistory returns the persisted quotes that match the given query.
+func (m *Manager) QueryQuoteHistory(ctx context.Context,
+	query QuoteQuery) ([]*QuoteRecord, error) {
+
+	if m.cfg.QuoteStore == nil {
+		return nil, fmt.Errorf("no quote store configured")
+	}
+
+	return m.cfg.QuoteStore.QueryQuotes(ctx, query)
+}
+
 // mainEventLoop is the main event loop of the RFQ manager.
 func (m *Manager) mainEventLoop() {
+	cleanupTicker := time.NewTicker(CacheCleanupInterval)
+	defer cleanupTicker.Stop()
+
 	for {
 		select {
 		// Handle incoming message.
@@ -639,6 +892,10 @@ func (m *Manager) mainEventLoop() {
 			// Handle a HTLC accept event. Notify any subscribers.
 			m.publishSubscriberEvent(acceptHtlcEvent)
 
+		// Periodically clean up expired quotes.
+		case <-cleanupTicker.C:
+			m.cleanupExpiredQuotes()
+
 		// Handle subsystem errors.
 		case err := <-m.subsystemErrChan:
 			// Report the subsystem error to the main server, in
@@ -724,30 +981,92 @@ type BuyOrder struct {
 	Expiry time.Time
 
 	// Peer is the peer that the buy order is intended for. This field is
-	// optional.
-	//
-	// TODO(ffranr): Currently, this field must be specified. In the future,
-	//  the negotiator should be able to determine the optimal peer.
+	// optional. If it isn't specified, the order is sent to all peers that
+	// we have an asset channel with for the asset, and the quotes they
+	// respond with are auctioned off.
 	Peer fn.Option[route.Vertex]
 }
 
-// UpsertAssetBuyOrder upserts an asset buy order for management.
-func (m *Manager) UpsertAssetBuyOrder(order BuyOrder) error {
-	// For now, a peer must be specified.
-	//
-	// TODO(ffranr): Add support for peerless buy orders. The negotiator
-	//  should be able to determine the optimal peer.
-	if order.Peer.IsNone() {
-		return fmt.Errorf("buy order peer must be specified")
+// UpsertAssetBuyOrder upserts an asset buy order for management. The returned
+// auction collects the responses of the peers the order was sent to. If the
+// order doesn't specify a peer, the auction is closed once the quote auction
+// window elapsed. Otherwise, it is closed once the peer responded. The caller
+// should close the auction once it is no longer interested in it.
+func (m *Manager) UpsertAssetBuyOrder(order BuyOrder) (*QuoteAuction, error) {
+	peers, auctionWindow, err := m.orderPeers(
+		order.Peer, order.AssetSpecifier,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	// Request a quote from a peer via the negotiator.
-	err := m.negotiator.HandleOutgoingBuyOrder(order)
+	// Request a quote from the peers via the negotiator.
+	auction := newQuoteAuction(true, len(peers))
+	err = m.negotiator.HandleOutgoingBuyOrder(
+		order, peers, auction, auctionWindow,
+	)
 	if err != nil {
-		return fmt.Errorf("error registering asset buy order: %w", err)
+		auction.Close()
+		return nil, fmt.Errorf("error registering asset buy order: %w",
+			err)
 	}
 
-	return nil
+	return auction, nil
+}
+
+// orderPeers returns the peers that an order should be sent to, along with the
+// window during which their quotes are collected. If the order specifies a
+// peer, only that peer is returned and no window applies. Otherwise, all peers
+// that we have an asset channel with for the asset are returned.
+func (m *Manager) orderPeers(orderPeer fn.Option[route.Vertex],
+	assetSpecifier asset.Specifier) ([]route.Vertex, time.Duration, error) {
+
+	if orderPeer.IsSome() {
+		peer := orderPeer.UnwrapOr(route.Vertex{})
+		return []route.Vertex{peer}, 0, nil
+	}
+
+	assetID, err := assetSpecifier.UnwrapIdOrErr()
+	if err != nil {
+		return nil, 0, fmt.Errorf("asset ID must be specified for "+
+			"orders without a peer: %w", err)
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	localChans, err := m.cfg.ChannelLister.ListChannels(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing local channels: %w",
+			err)
+	}
+
+	var (
+		peers     []route.Vertex
+		seenPeers = make(map[route.Vertex]struct{})
+	)
+	for _, localChan := range localChans {
+		if _, ok := seenPeers[localChan.PubKeyBytes]; ok {
+			continue
+		}
+
+		if !channelHasAsset(localChan, assetID) {
+			continue
+		}
+
+		seenPeers[localChan.PubKeyBytes] = struct{}{}
+		peers = append(peers, localChan.PubKeyBytes)
+	}
+
+	if len(peers) == 0 {
+		return nil, 0, fmt.Errorf("no peer with an asset channel "+
+			"found for asset %v", assetID)
+	}
+
+	log.Debugf("Auctioning order for asset %v among %d peers", assetID,
+		len(peers))
+
+	return peers, m.cfg.QuoteAuctionWindow, nil
 }
 
 // SellOrder instructs the RFQ (Request For Quote) system to request a quote
@@ -778,26 +1097,36 @@ type SellOrder struct {
 	// Expiry is the time at which the order expires.
 	Expiry time.Time
 
-	// Peer is the peer that the buy order is intended for. This field is
-	// optional.
+	// Peer is the peer that the sell order is intended for. This field is
+	// optional. If it isn't specified, the order is sent to all peers that
+	// we have an asset channel with for the asset, and the quotes they
+	// respond with are auctioned off.
 	Peer fn.Option[route.Vertex]
 }
 
-// UpsertAssetSellOrder upserts an asset sell order for management.
-func (m *Manager) UpsertAssetSellOrder(order SellOrder) error {
-	// For now, a peer must be specified.
-	//
-	// TODO(ffranr): Add support for peerless sell orders. The negotiator
-	//  should be able to determine the optimal peer.
-	if order.Peer.IsNone() {
-		return fmt.Errorf("sell order peer must be specified")
+// UpsertAssetSellOrder upserts an asset sell order for management. The returned
+// auction collects the responses of the peers the order was sent to. If the
+// order doesn't specify a peer, the auction is closed once the quote auction
+// window elapsed. Otherwise, it is closed once the peer responded. The caller
+// should close the auction once it is no longer interested in it.
+func (m *Manager) UpsertAssetSellOrder(order SellOrder) (*QuoteAuction,
+	error) {
+
+	peers, auctionWindow, err := m.orderPeers(
+		order.Peer, order.AssetSpecifier,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	// Pass the asset sell order to the negotiator which will generate sell
 	// request messages to send to peers.
-	m.negotiator.HandleOutgoingSellOrder(order)
+	auction := newQuoteAuction(false, len(peers))
+	m.negotiator.HandleOutgoingSellOrder(
+		order, peers, auction, auctionWindow,
+	)
 
-	return nil
+	return auction, nil
 }
 
 // PeerAcceptedBuyQuotes returns buy quotes that were requested by our node and
@@ -1068,6 +1397,68 @@ func (q *IncomingRejectQuoteEvent) Timestamp() time.Time {
 // interface.
 var _ fn.Event = (*IncomingRejectQuoteEvent)(nil)
 
+// QuoteDeviationEvent is an event that is broadcast when the asset rate that a
+// peer quoted or proposed is compared to the asset rate of our price oracle.
+type QuoteDeviationEvent struct {
+	// timestamp is the event creation UTC timestamp.
+	timestamp time.Time
+
+	// Peer is the peer that quoted or proposed the asset rate.
+	Peer route.Vertex
+
+	// ID is the ID of the quote request.
+	ID rfqmsg.ID
+
+	// RateHint is true if the peer rate is the asset rate hint of an
+	// incoming quote request, and false if it is the asset rate of a quote
+	// that the peer accepted.
+	RateHint bool
+
+	// PeerRate is the asset rate that the peer quoted or proposed.
+	PeerRate rfqmath.BigIntFixedPoint
+
+	// OracleRate is the asset rate of our price oracle.
+	OracleRate rfqmath.BigIntFixedPoint
+
+	// DeviationPpm is the deviation in parts per million of the peer rate
+	// from the oracle rate.
+	DeviationPpm uint64
+
+	// TolerancePpm is the maximum deviation in parts per million that is
+	// accepted.
+	TolerancePpm uint64
+
+	// WithinTolerance is true if the deviation is within the tolerance,
+	// meaning that the peer rate was accepted.
+	WithinTolerance bool
+}
+
+// NewQuoteDeviationEvent creates a new QuoteDeviationEvent.
+func NewQuoteDeviationEvent(peer route.Vertex, id rfqmsg.ID, rateHint bool,
+	peerRate, oracleRate rfqmath.BigIntFixedPoint, tolerancePpm uint64,
+	withinTolerance bool) *QuoteDeviationEvent {
+
+	return &QuoteDeviationEvent{
+		timestamp:       time.Now().UTC(),
+		Peer:            peer,
+		ID:              id,
+		RateHint:        rateHint,
+		PeerRate:        peerRate,
+		OracleRate:      oracleRate,
+		DeviationPpm:    peerRate.DeviationPpm(oracleRate).ToUint64(),
+		TolerancePpm:    tolerancePpm,
+		WithinTolerance: withinTolerance,
+	}
+}
+
+// Timestamp returns the event creation UTC timestamp.
+func (q *QuoteDeviationEvent) Timestamp() time.Time {
+	return q.timestamp.UTC()
+}
+
+// Ensure that the QuoteDeviationEvent struct implements the Event interface.
+var _ fn.Event = (*QuoteDeviationEvent)(nil)
+
 // AcceptHtlcEvent is an event that is sent to the accept HTLCs channel when
 // an HTLC is accepted.
 type AcceptHtlcEvent struct {
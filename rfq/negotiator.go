@@ -12,18 +12,23 @@ import (
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 const (
-	// minAssetRatesExpiryLifetime is the minimum asset rates expiry
-	// lifetime in seconds.
-	minAssetRatesExpiryLifetime = 60
-
 	// DefaultAcceptPriceDeviationPpm is the default price deviation in
 	// parts per million that is accepted by the RFQ negotiator.
 	//
 	// NOTE: This value is set to 5% (50,000 ppm).
 	DefaultAcceptPriceDeviationPpm = 50_000
+
+	// DefaultMinQuoteExpiry is the default minimum remaining lifetime of
+	// the asset rate of a quote.
+	DefaultMinQuoteExpiry = time.Minute
+
+	// DefaultMaxQuoteExpiry is the default maximum remaining lifetime of
+	// the asset rate of a quote.
+	DefaultMaxQuoteExpiry = 24 * time.Hour
 )
 
 // NegotiatorCfg holds the configuration for the negotiator.
@@ -48,6 +53,25 @@ type NegotiatorCfg struct {
 	// useful for testing purposes.
 	SkipAcceptQuotePriceCheck bool
 
+	// RateHintDeviationPpm specifies the maximum allowable deviation in
+	// parts per million (PPM) of the asset rate hint in an incoming quote
+	// request from the asset rate of our price oracle. Requests with a
+	// rate hint outside of this tolerance are rejected. A value of zero
+	// disables the check.
+	RateHintDeviationPpm uint64
+
+	// MinQuoteExpiry is the minimum remaining lifetime of the asset rate
+	// of a quote. A value of zero disables the lower bound.
+	MinQuoteExpiry time.Duration
+
+	// MaxQuoteExpiry is the maximum remaining lifetime of the asset rate
+	// of a quote. A value of zero disables the upper bound.
+	MaxQuoteExpiry time.Duration
+
+	// PublishEvent is an optional function that is used to notify
+	// subscribers of the events that this subsystem produces.
+	PublishEvent func(fn.Event)
+
 	// ErrChan is a channel that is populated with errors by this subsystem.
 	ErrChan chan<- error
 }
@@ -77,6 +101,10 @@ type Negotiator struct {
 	// asset buy offers.
 	assetGroupBuyOffers lnutils.SyncMap[asset.SerializedKey, BuyOffer]
 
+	// auctions is a map (keyed on quote request ID) that holds the
+	// auctions that collect the responses to our outgoing quote requests.
+	auctions lnutils.SyncMap[rfqmsg.ID, *QuoteAuction]
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -95,6 +123,8 @@ func NewNegotiator(cfg NegotiatorCfg) (*Negotiator, error) {
 		assetGroupBuyOffers: lnutils.SyncMap[
 			asset.SerializedKey, BuyOffer]{},
 
+		auctions: lnutils.SyncMap[rfqmsg.ID, *QuoteAuction]{},
+
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -147,15 +177,20 @@ func (n *Negotiator) queryBidFromPriceOracle(assetSpecifier asset.Specifier,
 	}
 
 	// TODO(ffranr): Check that the bid price is reasonable.
-	// TODO(ffranr): Ensure that the expiry time is valid and sufficient.
 
 	return &oracleResponse.AssetRate, nil
 }
 
 // HandleOutgoingBuyOrder handles an outgoing buy order by constructing buy
-// requests and passing them to the outgoing messages channel. These requests
-// are sent to peers.
-func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder) error {
+// requests for the given peers and passing them to the outgoing messages
+// channel. These requests are sent to peers. The responses of the peers are
+// collected by the given auction, which is closed once the auction window
+// elapsed. A zero auction window keeps the auction open until all peers
+// responded or it is closed by the caller.
+func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder,
+	peers []route.Vertex, auction *QuoteAuction,
+	auctionWindow time.Duration) error {
+
 	// Query the price oracle for a reasonable bid price. We perform this
 	// query and response handling in a separate goroutine in case it is a
 	// remote service and takes a long time to respond.
@@ -163,15 +198,6 @@ func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder) error {
 	go func() {
 		defer n.Wg.Done()
 
-		// Unwrap the peer from the buy order. For now, we can assume
-		// that the peer is always specified.
-		peer, err := buyOrder.Peer.UnwrapOrErr(
-			fmt.Errorf("buy order peer must be specified"),
-		)
-		if err != nil {
-			n.cfg.ErrChan <- err
-		}
-
 		// We calculate a proposed bid price for our peer's
 		// consideration. If a price oracle is not specified we will
 		// skip this step.
@@ -204,30 +230,41 @@ func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder) error {
 			)
 		}
 
-		// Construct a new buy request to send to the peer.
-		request, err := rfqmsg.NewBuyRequest(
-			peer, buyOrder.AssetSpecifier,
-			buyOrder.AssetMaxAmt, assetRateHint,
-		)
-		if err != nil {
-			err := fmt.Errorf("unable to create buy request "+
-				"message: %w", err)
-			n.cfg.ErrChan <- err
-			return
-		}
+		requestIDs := make([]rfqmsg.ID, 0, len(peers))
+		for _, peer := range peers {
+			// Construct a new buy request to send to the peer.
+			request, err := rfqmsg.NewBuyRequest(
+				peer, buyOrder.AssetSpecifier,
+				buyOrder.AssetMaxAmt, assetRateHint,
+			)
+			if err != nil {
+				auction.requestFailed()
+				n.cfg.ErrChan <- fmt.Errorf("unable to "+
+					"create buy request message: %w", err)
+				continue
+			}
 
-		// Send the response message to the outgoing messages channel.
-		var msg rfqmsg.OutgoingMsg = request
-		sendSuccess := fn.SendOrQuit(
-			n.cfg.OutgoingMessages, msg, n.Quit,
-		)
-		if !sendSuccess {
-			err := fmt.Errorf("negotiator failed to add quote " +
-				"request message to the outgoing messages " +
-				"channel")
-			n.cfg.ErrChan <- err
-			return
+			// Register the request with the auction before it is
+			// sent, so we can't miss the response.
+			n.auctions.Store(request.ID, auction)
+			requestIDs = append(requestIDs, request.ID)
+
+			// Send the response message to the outgoing messages
+			// channel.
+			var msg rfqmsg.OutgoingMsg = request
+			sendSuccess := fn.SendOrQuit(
+				n.cfg.OutgoingMessages, msg, n.Quit,
+			)
+			if !sendSuccess {
+				auction.requestFailed()
+				n.cfg.ErrChan <- fmt.Errorf("negotiator " +
+					"failed to add quote request message " +
+					"to the outgoing messages channel")
+				break
+			}
 		}
+
+		n.awaitAuction(auction, requestIDs, auctionWindow)
 	}()
 
 	return nil
@@ -274,7 +311,6 @@ func (n *Negotiator) queryAskFromPriceOracle(assetSpecifier asset.Specifier,
 	}
 
 	// TODO(ffranr): Check that the asking price is reasonable.
-	// TODO(ffranr): Ensure that the expiry time is valid and sufficient.
 
 	return &oracleResponse.AssetRate, nil
 }
@@ -360,6 +396,21 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 			return
 		}
 
+		// Reject the request if the asset rate of our price oracle
+		// isn't within our sanity bounds.
+		rejectErr := n.checkQuoteBounds(
+			request.Peer, request.ID, request.AssetRateHint,
+			*assetRate,
+		)
+		if rejectErr.IsSome() {
+			msg := rfqmsg.NewReject(
+				request.Peer, request.ID,
+				rejectErr.UnwrapOr(rfqmsg.ErrUnknownReject),
+			)
+			sendOutgoingMsg(msg)
+			return
+		}
+
 		// Construct and send a buy accept message.
 		msg := rfqmsg.NewBuyAcceptFromRequest(request, *assetRate)
 		sendOutgoingMsg(msg)
@@ -455,6 +506,21 @@ func (n *Negotiator) HandleIncomingSellRequest(
 			return
 		}
 
+		// Reject the request if the asset rate of our price oracle
+		// isn't within our sanity bounds.
+		rejectErr := n.checkQuoteBounds(
+			request.Peer, request.ID, request.AssetRateHint,
+			*assetRate,
+		)
+		if rejectErr.IsSome() {
+			msg := rfqmsg.NewReject(
+				request.Peer, request.ID,
+				rejectErr.UnwrapOr(rfqmsg.ErrUnknownReject),
+			)
+			sendOutgoingMsg(msg)
+			return
+		}
+
 		// Construct and send a sell accept message.
 		msg := rfqmsg.NewSellAcceptFromRequest(request, *assetRate)
 		sendOutgoingMsg(msg)
@@ -464,9 +530,15 @@ func (n *Negotiator) HandleIncomingSellRequest(
 }
 
 // HandleOutgoingSellOrder handles an outgoing sell order by constructing sell
-// requests and passing them to the outgoing messages channel. These requests
-// are sent to peers.
-func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder) {
+// requests for the given peers and passing them to the outgoing messages
+// channel. These requests are sent to peers. The responses of the peers are
+// collected by the given auction, which is closed once the auction window
+// elapsed. A zero auction window keeps the auction open until all peers
+// responded or it is closed by the caller.
+func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder,
+	peers []route.Vertex, auction *QuoteAuction,
+	auctionWindow time.Duration) {
+
 	// Query the price oracle for a reasonable ask price. We perform this
 	// query and response handling in a separate goroutine in case it is a
 	// remote service and takes a long time to respond.
@@ -474,15 +546,6 @@ func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder) {
 	go func() {
 		defer n.Wg.Done()
 
-		// Unwrap the peer from the order. For now, we can assume that
-		// the peer is always specified.
-		peer, err := order.Peer.UnwrapOrErr(
-			fmt.Errorf("buy order peer must be specified"),
-		)
-		if err != nil {
-			n.cfg.ErrChan <- err
-		}
-
 		// We calculate a proposed ask price for our peer's
 		// consideration. If a price oracle is not specified we will
 		// skip this step.
@@ -499,6 +562,7 @@ func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder) {
 				fn.None[rfqmsg.AssetRate](),
 			)
 			if err != nil {
+				auction.Close()
 				err := fmt.Errorf("negotiator failed to "+
 					"handle price oracle response: %w", err)
 				n.cfg.ErrChan <- err
@@ -508,38 +572,155 @@ func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder) {
 			assetRateHint = fn.MaybeSome(assetRate)
 		}
 
-		request, err := rfqmsg.NewSellRequest(
-			peer, order.AssetSpecifier, order.PaymentMaxAmt,
-			assetRateHint,
-		)
-		if err != nil {
-			err := fmt.Errorf("unable to create sell request "+
-				"message: %w", err)
-			n.cfg.ErrChan <- err
-			return
-		}
+		requestIDs := make([]rfqmsg.ID, 0, len(peers))
+		for _, peer := range peers {
+			request, err := rfqmsg.NewSellRequest(
+				peer, order.AssetSpecifier, order.PaymentMaxAmt,
+				assetRateHint,
+			)
+			if err != nil {
+				auction.requestFailed()
+				n.cfg.ErrChan <- fmt.Errorf("unable to "+
+					"create sell request message: %w", err)
+				continue
+			}
 
-		// Send the response message to the outgoing messages channel.
-		var msg rfqmsg.OutgoingMsg = request
-		sendSuccess := fn.SendOrQuit(
-			n.cfg.OutgoingMessages, msg, n.Quit,
-		)
-		if !sendSuccess {
-			err := fmt.Errorf("negotiator failed to add sell " +
-				"request message to the outgoing messages " +
-				"channel")
-			n.cfg.ErrChan <- err
-			return
+			// Register the request with the auction before it is
+			// sent, so we can't miss the response.
+			n.auctions.Store(request.ID, auction)
+			requestIDs = append(requestIDs, request.ID)
+
+			// Send the response message to the outgoing messages
+			// channel.
+			var msg rfqmsg.OutgoingMsg = request
+			sendSuccess := fn.SendOrQuit(
+				n.cfg.OutgoingMessages, msg, n.Quit,
+			)
+			if !sendSuccess {
+				auction.requestFailed()
+				n.cfg.ErrChan <- fmt.Errorf("negotiator " +
+					"failed to add sell request message " +
+					"to the outgoing messages channel")
+				break
+			}
 		}
+
+		n.awaitAuction(auction, requestIDs, auctionWindow)
 	}()
 }
 
-// expiryWithinBounds checks if a quote expiry unix timestamp (in seconds) is
-// within acceptable bounds. This check ensures that the expiry timestamp is far
-// enough in the future for the quote to be useful.
-func expiryWithinBounds(expiry time.Time, minExpiryLifetime uint64) bool {
-	diff := expiry.Unix() - time.Now().Unix()
-	return diff >= int64(minExpiryLifetime)
+// awaitAuction waits until the given auction is closed, closing it once the
+// auction window elapsed, and then stops routing the responses to the given
+// requests to the auction.
+func (n *Negotiator) awaitAuction(auction *QuoteAuction,
+	requestIDs []rfqmsg.ID, auctionWindow time.Duration) {
+
+	var windowElapsed <-chan time.Time
+	if auctionWindow > 0 {
+		timer := time.NewTimer(auctionWindow)
+		defer timer.Stop()
+
+		windowElapsed = timer.C
+	}
+
+	select {
+	case <-auction.Done():
+	case <-windowElapsed:
+	case <-n.Quit:
+	}
+
+	auction.Close()
+
+	for _, id := range requestIDs {
+		n.auctions.Delete(id)
+	}
+}
+
+// HandleQuoteResponse routes the response of a peer to one of our quote
+// requests to the auction that the request belongs to. Responses to requests
+// that don't belong to an open auction are ignored.
+func (n *Negotiator) HandleQuoteResponse(id rfqmsg.ID, event fn.Event) {
+	auction, ok := n.auctions.Load(id)
+	if !ok {
+		return
+	}
+
+	auction.recordResponse(event)
+}
+
+// expiryWithinBounds checks if a quote expiry timestamp is within acceptable
+// bounds. This check ensures that the expiry timestamp is far enough in the
+// future for the quote to be useful, but not so far that its asset rate is
+// likely to become stale.
+func (n *Negotiator) expiryWithinBounds(expiry time.Time) bool {
+	lifetime := time.Until(expiry)
+
+	if lifetime < n.cfg.MinQuoteExpiry {
+		return false
+	}
+
+	if n.cfg.MaxQuoteExpiry > 0 && lifetime > n.cfg.MaxQuoteExpiry {
+		return false
+	}
+
+	return true
+}
+
+// checkQuoteBounds checks that the asset rate that our price oracle provided
+// for an incoming quote request is within our sanity bounds. If it isn't, the
+// error that the request should be rejected with is returned.
+func (n *Negotiator) checkQuoteBounds(peer route.Vertex, id rfqmsg.ID,
+	rateHint fn.Option[rfqmsg.AssetRate],
+	oracleRate rfqmsg.AssetRate) fn.Option[rfqmsg.RejectErr] {
+
+	if !n.expiryWithinBounds(oracleRate.Expiry) {
+		log.Warnf("Rejecting quote request %x: price oracle asset "+
+			"rate expiry not within acceptable bounds "+
+			"(asset_rate=%s)", id[:], oracleRate.String())
+
+		return fn.Some(rfqmsg.ErrExpiryOutOfBounds)
+	}
+
+	if n.cfg.RateHintDeviationPpm == 0 || rateHint.IsNone() {
+		return fn.None[rfqmsg.RejectErr]()
+	}
+
+	hint := rateHint.UnwrapToPtr()
+	withinTolerance := n.checkRateDeviation(
+		peer, id, true, hint.Rate, oracleRate.Rate,
+		n.cfg.RateHintDeviationPpm,
+	)
+	if !withinTolerance {
+		log.Debugf("Rejecting quote request %x: asset rate hint not "+
+			"within acceptable bounds (rate_hint=%s, "+
+			"oracle_asset_rate=%s)", id[:], hint.String(),
+			oracleRate.String())
+
+		return fn.Some(rfqmsg.ErrAssetRateOutOfBounds)
+	}
+
+	return fn.None[rfqmsg.RejectErr]()
+}
+
+// checkRateDeviation checks whether the asset rate of a peer is within the
+// given tolerance of the asset rate of our price oracle. Subscribers are
+// notified of the deviation in either case.
+func (n *Negotiator) checkRateDeviation(peer route.Vertex, id rfqmsg.ID,
+	rateHint bool, peerRate, oracleRate rfqmath.BigIntFixedPoint,
+	tolerancePpm uint64) bool {
+
+	withinTolerance := peerRate.WithinTolerance(
+		oracleRate, rfqmath.NewBigIntFromUint64(tolerancePpm),
+	)
+
+	if n.cfg.PublishEvent != nil {
+		n.cfg.PublishEvent(NewQuoteDeviationEvent(
+			peer, id, rateHint, peerRate, oracleRate, tolerancePpm,
+			withinTolerance,
+		))
+	}
+
+	return withinTolerance
 }
 
 // HandleIncomingBuyAccept handles an incoming buy accept message. This method
@@ -555,9 +736,7 @@ func (n *Negotiator) HandleIncomingBuyAccept(msg rfqmsg.BuyAccept,
 	//  timestamp given the expiry timestamp in our outgoing buy request.
 	//  The expiry timestamp in the outgoing request relates to the lifetime
 	//  of the lightning invoice.
-	if !expiryWithinBounds(
-		msg.AssetRate.Expiry, minAssetRatesExpiryLifetime,
-	) {
+	if !n.expiryWithinBounds(msg.AssetRate.Expiry) {
 		// The expiry time is not within the acceptable bounds.
 		log.Debugf("Buy accept quote expiry time is not within "+
 			"acceptable bounds (asset_rate=%s)",
@@ -638,11 +817,9 @@ func (n *Negotiator) HandleIncomingBuyAccept(msg rfqmsg.BuyAccept,
 
 		// Ensure that the peer provided price is reasonable given the
 		// price provided by the price oracle service.
-		tolerance := rfqmath.NewBigIntFromUint64(
-			n.cfg.AcceptPriceDeviationPpm,
-		)
-		acceptablePrice := msg.AssetRate.Rate.WithinTolerance(
-			assetRate.Rate, tolerance,
+		acceptablePrice := n.checkRateDeviation(
+			msg.Peer, msg.ID, false, msg.AssetRate.Rate,
+			assetRate.Rate, n.cfg.AcceptPriceDeviationPpm,
 		)
 		if !acceptablePrice {
 			// The price is not within the acceptable tolerance.
@@ -683,9 +860,7 @@ func (n *Negotiator) HandleIncomingSellAccept(msg rfqmsg.SellAccept,
 	//
 	// TODO(ffranr): Sanity check the quote expiry timestamp given
 	//  the expiry timestamp provided by the price oracle.
-	if !expiryWithinBounds(
-		msg.AssetRate.Expiry, minAssetRatesExpiryLifetime,
-	) {
+	if !n.expiryWithinBounds(msg.AssetRate.Expiry) {
 		// The expiry time is not within the acceptable bounds.
 		log.Debugf("Sell accept quote expiry time is not within "+
 			"acceptable bounds (asset_rate=%s)",
@@ -766,11 +941,9 @@ func (n *Negotiator) HandleIncomingSellAccept(msg rfqmsg.SellAccept,
 
 		// Ensure that the peer provided price is reasonable given the
 		// price provided by the price oracle service.
-		tolerance := rfqmath.NewBigIntFromUint64(
-			n.cfg.AcceptPriceDeviationPpm,
-		)
-		acceptablePrice := msg.AssetRate.Rate.WithinTolerance(
-			assetRate.Rate, tolerance,
+		acceptablePrice := n.checkRateDeviation(
+			msg.Peer, msg.ID, false, msg.AssetRate.Rate,
+			assetRate.Rate, n.cfg.AcceptPriceDeviationPpm,
 		)
 		if !acceptablePrice {
 			// The price is not within the acceptable bounds.
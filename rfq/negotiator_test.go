@@ -0,0 +1,171 @@
+package rfq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIncomingBuyRequestBounds tests that an incoming buy request is rejected
+// if the asset rate of our price oracle or the rate hint of the peer is not
+// within the sanity bounds of the negotiator, and that the deviation of the
+// rate hint is published to subscribers.
+func TestIncomingBuyRequestBounds(t *testing.T) {
+	t.Parallel()
+
+	// The price oracle always provides a rate of 100,000 asset units per
+	// BTC.
+	const oracleRate = 100_000
+
+	testCases := []struct {
+		name string
+
+		// oracleExpiry is the lifetime of the asset rate that the price
+		// oracle provides.
+		oracleExpiry time.Duration
+
+		// rateHint is the asset rate hint of the request, if any.
+		rateHint fn.Option[uint64]
+
+		// expectedReject is the error that the request is expected to
+		// be rejected with, if any.
+		expectedReject fn.Option[rfqmsg.RejectErr]
+
+		// expectedDeviation is the deviation event that is expected to
+		// be published, if any.
+		expectedDeviation fn.Option[bool]
+	}{
+		{
+			name:              "no rate hint",
+			oracleExpiry:      time.Hour,
+			rateHint:          fn.None[uint64](),
+			expectedReject:    fn.None[rfqmsg.RejectErr](),
+			expectedDeviation: fn.None[bool](),
+		},
+		{
+			name:              "rate hint within tolerance",
+			oracleExpiry:      time.Hour,
+			rateHint:          fn.Some[uint64](101_000),
+			expectedReject:    fn.None[rfqmsg.RejectErr](),
+			expectedDeviation: fn.Some(true),
+		},
+		{
+			name:         "rate hint out of tolerance",
+			oracleExpiry: time.Hour,
+			rateHint:     fn.Some[uint64](110_000),
+			expectedReject: fn.Some(
+				rfqmsg.ErrAssetRateOutOfBounds,
+			),
+			expectedDeviation: fn.Some(false),
+		},
+		{
+			name:         "expiry too soon",
+			oracleExpiry: 30 * time.Second,
+			rateHint:     fn.None[uint64](),
+			expectedReject: fn.Some(
+				rfqmsg.ErrExpiryOutOfBounds,
+			),
+			expectedDeviation: fn.None[bool](),
+		},
+		{
+			name:         "expiry too late",
+			oracleExpiry: 48 * time.Hour,
+			rateHint:     fn.Some[uint64](oracleRate),
+			expectedReject: fn.Some(
+				rfqmsg.ErrExpiryOutOfBounds,
+			),
+			expectedDeviation: fn.None[bool](),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			outgoingMsgs := make(chan rfqmsg.OutgoingMsg, 10)
+			events := make(chan fn.Event, 10)
+			negotiator, err := NewNegotiator(NegotiatorCfg{
+				PriceOracle: NewMockPriceOracle(
+					uint64(tc.oracleExpiry.Seconds()),
+					oracleRate,
+				),
+				OutgoingMessages:     outgoingMsgs,
+				RateHintDeviationPpm: 50_000,
+				MinQuoteExpiry:       DefaultMinQuoteExpiry,
+				MaxQuoteExpiry:       DefaultMaxQuoteExpiry,
+				PublishEvent: func(event fn.Event) {
+					events <- event
+				},
+				ErrChan: make(chan error, 10),
+			})
+			require.NoError(t, err)
+			require.NoError(t, negotiator.Start())
+			t.Cleanup(func() {
+				require.NoError(t, negotiator.Stop())
+			})
+
+			rateHint := fn.MapOption(
+				func(coefficient uint64) rfqmsg.AssetRate {
+					return rfqmsg.NewAssetRate(
+						rfqmath.NewBigIntFixedPoint(
+							coefficient, 0,
+						),
+						time.Now().Add(time.Hour),
+					)
+				},
+			)(tc.rateHint)
+
+			peer := route.NewVertex(test.RandPubKey(t))
+			request, err := rfqmsg.NewBuyRequest(
+				peer, asset.NewSpecifierFromId(asset.RandID(t)),
+				1_000, rateHint,
+			)
+			require.NoError(t, err)
+
+			err = negotiator.HandleIncomingBuyRequest(*request)
+			require.NoError(t, err)
+
+			msg, err := fn.RecvOrTimeout(outgoingMsgs, time.Second)
+			require.NoError(t, err)
+
+			tc.expectedReject.WhenSome(func(e rfqmsg.RejectErr) {
+				reject, ok := (*msg).(*rfqmsg.Reject)
+				require.True(t, ok)
+				require.Equal(t, request.ID, reject.ID.Val)
+				require.Equal(t, e, reject.Err.Val)
+			})
+			if tc.expectedReject.IsNone() {
+				accept, ok := (*msg).(*rfqmsg.BuyAccept)
+				require.True(t, ok)
+				require.Equal(t, request.ID, accept.ID)
+			}
+
+			tc.expectedDeviation.WhenSome(func(within bool) {
+				event, err := fn.RecvOrTimeout(
+					events, time.Second,
+				)
+				require.NoError(t, err)
+
+				deviation, ok := (*event).(*QuoteDeviationEvent)
+				require.True(t, ok)
+				require.Equal(t, peer, deviation.Peer)
+				require.Equal(t, request.ID, deviation.ID)
+				require.True(t, deviation.RateHint)
+				require.Equal(
+					t, within, deviation.WithinTolerance,
+				)
+				require.EqualValues(
+					t, 50_000, deviation.TolerancePpm,
+				)
+			})
+			require.Empty(t, events)
+		})
+	}
+}
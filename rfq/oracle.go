@@ -61,6 +61,14 @@ const (
 	// RfqRpcOracleAddrScheme is the URL address scheme used by an RPC price
 	// oracle service.
 	RfqRpcOracleAddrScheme string = "rfqrpc"
+
+	// FileOracleAddrScheme is the URL address scheme used by a price
+	// oracle that reads asset rates from a local rate file.
+	FileOracleAddrScheme string = "file"
+
+	// FormulaOracleAddrScheme is the URL address scheme used by a price
+	// oracle that computes asset rates from a formula.
+	FormulaOracleAddrScheme string = "formula"
 )
 
 // ParsePriceOracleAddress parses a price oracle service address string and
@@ -80,7 +88,11 @@ func ParsePriceOracleAddress(addrStr string) (*OracleAddr, error) {
 	}
 
 	// Ensure that the price oracle address scheme is valid.
-	if addr.Scheme != RfqRpcOracleAddrScheme {
+	switch addr.Scheme {
+	case RfqRpcOracleAddrScheme, FileOracleAddrScheme,
+		FormulaOracleAddrScheme:
+
+	default:
 		return nil, fmt.Errorf("unknown price oracle protocol "+
 			"(consider updating tapd): %v", addr.Scheme)
 	}
@@ -88,6 +100,26 @@ func ParsePriceOracleAddress(addrStr string) (*OracleAddr, error) {
 	return addr, nil
 }
 
+// NewPriceOracle creates a new price oracle for the given price oracle
+// address, based on its scheme.
+func NewPriceOracle(addrStr string) (PriceOracle, error) {
+	addr, err := ParsePriceOracleAddress(addrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch addr.Scheme {
+	case FileOracleAddrScheme:
+		return NewFilePriceOracle(addrStr)
+
+	case FormulaOracleAddrScheme:
+		return NewFormulaPriceOracle(addrStr)
+
+	default:
+		return NewRpcPriceOracle(addrStr, false)
+	}
+}
+
 // PriceOracle is an interface that provides exchange rate information for
 // assets.
 type PriceOracle interface {
@@ -642,6 +642,11 @@ type OrderHandlerCfg struct {
 	// HtlcSubscriber is a subscriber that is used to retrieve live HTLC
 	// event updates.
 	HtlcSubscriber HtlcSubscriber
+
+	// QuoteStore is the store in which the HTLCs that are accepted under
+	// the policies are tracked, so they survive a restart. This field is
+	// optional.
+	QuoteStore QuoteStore
 }
 
 // OrderHandler orchestrates management of accepted quote bundles. It monitors
@@ -684,7 +689,7 @@ func NewOrderHandler(cfg OrderHandlerCfg) (*OrderHandler, error) {
 //
 // NOTE: This function must be thread safe. It is used by an external
 // interceptor service.
-func (h *OrderHandler) handleIncomingHtlc(_ context.Context,
+func (h *OrderHandler) handleIncomingHtlc(ctx context.Context,
 	htlc lndclient.InterceptedHtlc) (*lndclient.InterceptedHtlcResponse,
 	error) {
 
@@ -731,6 +736,7 @@ func (h *OrderHandler) handleIncomingHtlc(_ context.Context,
 	// The HTLC passed the compliance checks, so now we keep track of the
 	// accepted HTLC.
 	policy.TrackAcceptedHtlc(htlc.IncomingCircuitKey, htlc.AmountOutMsat)
+	h.storeHtlc(ctx, policy, htlc.IncomingCircuitKey, htlc.AmountOutMsat)
 
 	log.Debug("HTLC complies with policy. Broadcasting accept event.")
 	h.cfg.AcceptHtlcEvents <- NewAcceptHtlcEvent(htlc, policy)
@@ -824,6 +830,7 @@ func (h *OrderHandler) subscribeHtlcs(ctx context.Context) error {
 
 				// Stop tracking this HTLC as it failed.
 				policy.UntrackHtlc(circuitKey)
+				h.removeHtlc(ctx, circuitKey)
 			}
 
 		case err := <-chErr:
@@ -899,6 +906,123 @@ func (h *OrderHandler) RegisterAssetPurchasePolicy(
 	h.policies.Store(policy.scid, policy)
 }
 
+// RestorePolicies registers the policies of the given persisted quotes with
+// the order handler, along with the HTLCs that were accepted under them. Only
+// quotes that were accepted by our node have a policy.
+func (h *OrderHandler) RestorePolicies(quotes []*QuoteRecord) {
+	circuitPolicies := make(map[models.CircuitKey][]Policy)
+	for _, quote := range quotes {
+		if !quote.LocalAccept {
+			continue
+		}
+
+		var policy Policy
+		switch {
+		case quote.BuyAccept != nil:
+			salePolicy := NewAssetSalePolicy(*quote.BuyAccept)
+			h.policies.Store(
+				salePolicy.AcceptedQuoteId.Scid(), salePolicy,
+			)
+			policy = salePolicy
+
+		case quote.SellAccept != nil:
+			purchasePolicy := NewAssetPurchasePolicy(
+				*quote.SellAccept,
+			)
+			h.policies.Store(purchasePolicy.scid, purchasePolicy)
+			policy = purchasePolicy
+
+		default:
+			continue
+		}
+
+		for circuitKey, amt := range quote.Htlcs {
+			policy.TrackAcceptedHtlc(circuitKey, amt)
+			circuitPolicies[circuitKey] = append(
+				circuitPolicies[circuitKey], policy,
+			)
+		}
+	}
+
+	// An HTLC that was accepted under both a purchase and a sale policy
+	// was an asset-to-asset forward, which was tracked by a forward policy
+	// that combines the two.
+	for circuitKey, policies := range circuitPolicies {
+		policy := policies[0]
+		if len(policies) == 2 {
+			forwardPolicy, err := NewAssetForwardPolicy(
+				policies[0], policies[1],
+			)
+			if err != nil {
+				forwardPolicy, err = NewAssetForwardPolicy(
+					policies[1], policies[0],
+				)
+			}
+			if err != nil {
+				log.Warnf("Unable to restore forward policy "+
+					"of HTLC %v: %v", circuitKey, err)
+			} else {
+				policy = forwardPolicy
+			}
+		}
+
+		h.htlcToPolicy.Store(circuitKey, policy)
+	}
+}
+
+// storeHtlc tracks an HTLC that was accepted under the given policy in the
+// quote store, if one is configured.
+func (h *OrderHandler) storeHtlc(ctx context.Context, policy Policy,
+	circuitKey models.CircuitKey, amt lnwire.MilliSatoshi) {
+
+	if h.cfg.QuoteStore == nil {
+		return
+	}
+
+	for _, quoteID := range policyQuoteIDs(policy) {
+		err := h.cfg.QuoteStore.TrackHtlc(ctx, quoteID, circuitKey, amt)
+		if err != nil {
+			log.Errorf("Unable to store HTLC %v of quote %x: %v",
+				circuitKey, quoteID[:], err)
+		}
+	}
+}
+
+// removeHtlc stops tracking an HTLC in the quote store, if one is configured.
+func (h *OrderHandler) removeHtlc(ctx context.Context,
+	circuitKey models.CircuitKey) {
+
+	if h.cfg.QuoteStore == nil {
+		return
+	}
+
+	err := h.cfg.QuoteStore.UntrackHtlc(ctx, circuitKey)
+	if err != nil {
+		log.Errorf("Unable to remove HTLC %v: %v", circuitKey, err)
+	}
+}
+
+// policyQuoteIDs returns the IDs of the quotes the given policy was created
+// from.
+func policyQuoteIDs(policy Policy) []rfqmsg.ID {
+	switch p := policy.(type) {
+	case *AssetSalePolicy:
+		return []rfqmsg.ID{p.AcceptedQuoteId}
+
+	case *AssetPurchasePolicy:
+		return []rfqmsg.ID{p.AcceptedQuoteId}
+
+	case *AssetForwardPolicy:
+		return []rfqmsg.ID{
+			p.incomingPolicy.AcceptedQuoteId,
+			p.outgoingPolicy.AcceptedQuoteId,
+		}
+
+	default:
+		return nil
+	}
+}
+
 // fetchPolicy fetches a policy which is relevant to a given HTLC. If a policy
 // is not found, false is returned. Expired policies are not returned and are
 // removed from the cache.
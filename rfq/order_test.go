@@ -0,0 +1,112 @@
+package rfq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRestorePolicies tests that the policies of persisted quotes are restored
+// along with the HTLCs that were accepted under them.
+func TestRestorePolicies(t *testing.T) {
+	t.Parallel()
+
+	handler, err := NewOrderHandler(OrderHandlerCfg{})
+	require.NoError(t, err)
+
+	specifier := asset.NewSpecifierFromId(asset.RandID(t))
+	assetRate := rfqmsg.NewAssetRate(
+		rfqmath.NewBigIntFixedPoint(100_000, 0),
+		time.Now().Add(time.Hour),
+	)
+	noScid := fn.None[lnwire.ShortChannelID]()
+
+	buyID, err := rfqmsg.NewID()
+	require.NoError(t, err)
+	buyQuote := NewBuyQuoteRecord(rfqmsg.BuyAccept{
+		Request: rfqmsg.BuyRequest{
+			ID:             buyID,
+			AssetSpecifier: specifier,
+			AssetMaxAmt:    1_000,
+		},
+		ID:        buyID,
+		AssetRate: assetRate,
+	}, true, noScid)
+
+	sellID, err := rfqmsg.NewID()
+	require.NoError(t, err)
+	sellQuote := NewSellQuoteRecord(rfqmsg.SellAccept{
+		Request: rfqmsg.SellRequest{
+			ID:             sellID,
+			AssetSpecifier: specifier,
+			PaymentMaxAmt:  1_000_000,
+		},
+		ID:        sellID,
+		AssetRate: assetRate,
+	}, true, noScid)
+
+	peerID, err := rfqmsg.NewID()
+	require.NoError(t, err)
+	peerQuote := NewBuyQuoteRecord(rfqmsg.BuyAccept{
+		Request: rfqmsg.BuyRequest{
+			ID:             peerID,
+			AssetSpecifier: specifier,
+		},
+		ID:        peerID,
+		AssetRate: assetRate,
+	}, false, noScid)
+
+	// One HTLC was only accepted under the sale policy, the other one was
+	// forwarded from the purchase to the sale policy.
+	saleKey := models.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(1),
+		HtlcID: 1,
+	}
+	forwardKey := models.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(2),
+		HtlcID: 2,
+	}
+	buyQuote.Htlcs[saleKey] = 1_000
+	buyQuote.Htlcs[forwardKey] = 2_000
+	sellQuote.Htlcs[forwardKey] = 2_000
+
+	handler.RestorePolicies(
+		[]*QuoteRecord{buyQuote, sellQuote, peerQuote},
+	)
+
+	// Only the quotes that we accepted have a policy.
+	_, ok := handler.policies.Load(peerQuote.Scid())
+	require.False(t, ok)
+
+	policy, ok := handler.policies.Load(buyQuote.Scid())
+	require.True(t, ok)
+	salePolicy, ok := policy.(*AssetSalePolicy)
+	require.True(t, ok)
+	require.EqualValues(t, 3_000, salePolicy.CurrentAmountMsat)
+
+	policy, ok = handler.policies.Load(sellQuote.Scid())
+	require.True(t, ok)
+	purchasePolicy, ok := policy.(*AssetPurchasePolicy)
+	require.True(t, ok)
+	require.EqualValues(t, 2_000, purchasePolicy.CurrentAmountMsat)
+
+	// Untracking the forwarded HTLC removes it from both policies.
+	policy, ok = handler.htlcToPolicy.Load(saleKey)
+	require.True(t, ok)
+	require.Equal(t, salePolicy, policy)
+
+	policy, ok = handler.htlcToPolicy.Load(forwardKey)
+	require.True(t, ok)
+	require.IsType(t, &AssetForwardPolicy{}, policy)
+
+	policy.UntrackHtlc(forwardKey)
+	require.EqualValues(t, 1_000, salePolicy.CurrentAmountMsat)
+	require.Zero(t, purchasePolicy.CurrentAmountMsat)
+}
@@ -0,0 +1,181 @@
+package rfq
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultQuoteHistoryRetention is the default duration for which
+	// expired quotes are kept in the quote store, so their history can be
+	// queried.
+	DefaultQuoteHistoryRetention = 30 * 24 * time.Hour
+)
+
+// QuoteRecord is an accepted quote as it is persisted in the quote store,
+// along with the state that is needed to honour the quote after a restart.
+type QuoteRecord struct {
+	// BuyAccept is the accepted buy quote. Exactly one of BuyAccept and
+	// SellAccept is set.
+	BuyAccept *rfqmsg.BuyAccept
+
+	// SellAccept is the accepted sell quote. Exactly one of BuyAccept and
+	// SellAccept is set.
+	SellAccept *rfqmsg.SellAccept
+
+	// LocalAccept is true if the quote was accepted by our node, and false
+	// if it was accepted by our peer.
+	LocalAccept bool
+
+	// BaseScid is the SCID of the channel that the SCID alias of the quote
+	// maps to. This is None if no alias was added for the quote, or if the
+	// alias was already removed.
+	BaseScid fn.Option[lnwire.ShortChannelID]
+
+	// Htlcs maps the HTLCs that were accepted under the policy of the
+	// quote to the amount they carry.
+	Htlcs map[models.CircuitKey]lnwire.MilliSatoshi
+
+	// CreatedAt is the time at which the quote was accepted.
+	CreatedAt time.Time
+}
+
+// NewBuyQuoteRecord creates a new quote record for an accepted buy quote.
+func NewBuyQuoteRecord(accept rfqmsg.BuyAccept, localAccept bool,
+	baseScid fn.Option[lnwire.ShortChannelID]) *QuoteRecord {
+
+	return &QuoteRecord{
+		BuyAccept:   &accept,
+		LocalAccept: localAccept,
+		BaseScid:    baseScid,
+		Htlcs:       make(map[models.CircuitKey]lnwire.MilliSatoshi),
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+// NewSellQuoteRecord creates a new quote record for an accepted sell quote.
+func NewSellQuoteRecord(accept rfqmsg.SellAccept, localAccept bool,
+	baseScid fn.Option[lnwire.ShortChannelID]) *QuoteRecord {
+
+	return &QuoteRecord{
+		SellAccept:  &accept,
+		LocalAccept: localAccept,
+		BaseScid:    baseScid,
+		Htlcs:       make(map[models.CircuitKey]lnwire.MilliSatoshi),
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+// ID returns the ID of the quote request the quote was accepted for.
+func (q *QuoteRecord) ID() rfqmsg.ID {
+	if q.BuyAccept != nil {
+		return q.BuyAccept.ID
+	}
+
+	return q.SellAccept.ID
+}
+
+// Peer returns the counterparty peer of the quote.
+func (q *QuoteRecord) Peer() route.Vertex {
+	if q.BuyAccept != nil {
+		return q.BuyAccept.Peer
+	}
+
+	return q.SellAccept.Peer
+}
+
+// Scid returns the SCID alias that is derived from the quote ID.
+func (q *QuoteRecord) Scid() SerialisedScid {
+	if q.BuyAccept != nil {
+		return q.BuyAccept.ShortChannelId()
+	}
+
+	return q.SellAccept.ShortChannelId()
+}
+
+// AssetRate returns the accepted asset to BTC rate of the quote, along with
+// its expiry.
+func (q *QuoteRecord) AssetRate() rfqmsg.AssetRate {
+	if q.BuyAccept != nil {
+		return q.BuyAccept.AssetRate
+	}
+
+	return q.SellAccept.AssetRate
+}
+
+// HtlcAmount returns the total amount of the HTLCs that were accepted under
+// the policy of the quote.
+func (q *QuoteRecord) HtlcAmount() lnwire.MilliSatoshi {
+	var total lnwire.MilliSatoshi
+	for _, amt := range q.Htlcs {
+		total += amt
+	}
+
+	return total
+}
+
+// QuoteQuery is a query for the history of the accepted quotes.
+type QuoteQuery struct {
+	// Peer, if set, restricts the query to the quotes of the given peer.
+	Peer fn.Option[route.Vertex]
+
+	// StartTime is the earliest time at which a returned quote was
+	// accepted.
+	StartTime time.Time
+
+	// EndTime is the latest time at which a returned quote was accepted.
+	EndTime time.Time
+
+	// Offset is the number of quotes to skip.
+	Offset int32
+
+	// Limit is the maximum number of quotes to return.
+	Limit int32
+}
+
+// QuoteStore is a persistent store of accepted quotes, their SCID aliases and
+// the HTLCs that were accepted under their policies.
+type QuoteStore interface {
+	// StoreQuote persists an accepted quote, unless a quote with the same
+	// ID is already stored.
+	StoreQuote(ctx context.Context, quote *QuoteRecord) error
+
+	// FetchActiveQuotes returns all quotes that have not expired at the
+	// given time, along with the HTLCs accepted under their policies.
+	FetchActiveQuotes(ctx context.Context,
+		now time.Time) ([]*QuoteRecord, error)
+
+	// QueryQuotes returns the stored quotes that match the given query,
+	// most recently accepted first.
+	QueryQuotes(ctx context.Context,
+		query QuoteQuery) ([]*QuoteRecord, error)
+
+	// TrackHtlc records that the given HTLC was accepted under the policy
+	// of the quote with the given ID.
+	TrackHtlc(ctx context.Context, id rfqmsg.ID,
+		circuitKey models.CircuitKey, amt lnwire.MilliSatoshi) error
+
+	// UntrackHtlc removes the given HTLC from the policies of all quotes
+	// it was accepted under.
+	UntrackHtlc(ctx context.Context, circuitKey models.CircuitKey) error
+
+	// FetchExpiredAliases returns the SCID aliases of the quotes that have
+	// expired at the given time, mapped to the base SCID of their channel.
+	FetchExpiredAliases(ctx context.Context,
+		now time.Time) (map[SerialisedScid]lnwire.ShortChannelID, error)
+
+	// RemoveAlias records that the SCID alias of the quote was removed.
+	RemoveAlias(ctx context.Context, scid SerialisedScid) error
+
+	// PruneQuotes deletes the quotes that expired before the given time
+	// and have no SCID alias left. The number of deleted quotes is
+	// returned.
+	PruneQuotes(ctx context.Context, expiredBefore time.Time) (int64,
+		error)
+}
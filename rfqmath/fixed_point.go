@@ -123,6 +123,41 @@ func (f FixedPoint[T]) Equals(other FixedPoint[T]) bool {
 func (f FixedPoint[T]) WithinTolerance(
 	other FixedPoint[T], tolerancePpm T) bool {
 
+	delta, maxCoefficient := f.absDelta(other)
+
+	// Calculate the tolerance in absolute terms based on the largest
+	// coefficient.
+	//
+	// tolerancePpm is parts per million, therefore we multiply the delta by
+	// 1,000,000 instead of dividing the tolerance.
+	scaledDelta := delta.Mul(NewInt[T]().FromUint64(1_000_000))
+
+	// Compare the scaled delta to the product of the maximum coefficient
+	// and the tolerance.
+	toleranceCoefficient := maxCoefficient.Mul(tolerancePpm)
+	return toleranceCoefficient.Gte(scaledDelta)
+}
+
+// DeviationPpm returns the absolute difference between the two FixedPoint
+// values in parts per million (PPM) of the larger value, rounded down. This is
+// the deviation that WithinTolerance compares to the tolerance. If both values
+// are zero, the deviation is zero.
+func (f FixedPoint[T]) DeviationPpm(other FixedPoint[T]) T {
+	delta, maxCoefficient := f.absDelta(other)
+
+	zero := NewInt[T]().FromUint64(0)
+	if maxCoefficient.Equals(zero) {
+		return zero
+	}
+
+	scaledDelta := delta.Mul(NewInt[T]().FromUint64(1_000_000))
+	return scaledDelta.Div(maxCoefficient)
+}
+
+// absDelta returns the absolute difference between the coefficients of the two
+// FixedPoint values, along with the larger coefficient. Both values are scaled
+// to the larger of their scales first.
+func (f FixedPoint[T]) absDelta(other FixedPoint[T]) (T, T) {
 	// Determine the larger scale between the two fixed-point numbers.
 	// Both values will be scaled to this larger scale to ensure a
 	// consistent comparison.
@@ -136,33 +171,13 @@ func (f FixedPoint[T]) WithinTolerance(
 	subjectFp := f.ScaleTo(largerScale)
 	otherFp := other.ScaleTo(largerScale)
 
-	var (
-		// delta will be the absolute difference between the two
-		// coefficients.
-		delta T
-
-		// maxCoefficient is the larger of the two coefficients.
-		maxCoefficient T
-	)
 	if subjectFp.Coefficient.Gt(otherFp.Coefficient) {
-		delta = subjectFp.Coefficient.Sub(otherFp.Coefficient)
-		maxCoefficient = subjectFp.Coefficient
-	} else {
-		delta = otherFp.Coefficient.Sub(subjectFp.Coefficient)
-		maxCoefficient = otherFp.Coefficient
+		delta := subjectFp.Coefficient.Sub(otherFp.Coefficient)
+		return delta, subjectFp.Coefficient
 	}
 
-	// Calculate the tolerance in absolute terms based on the largest
-	// coefficient.
-	//
-	// tolerancePpm is parts per million, therefore we multiply the delta by
-	// 1,000,000 instead of dividing the tolerance.
-	scaledDelta := delta.Mul(NewInt[T]().FromUint64(1_000_000))
-
-	// Compare the scaled delta to the product of the maximum coefficient
-	// and the tolerance.
-	toleranceCoefficient := maxCoefficient.Mul(tolerancePpm)
-	return toleranceCoefficient.Gte(scaledDelta)
+	delta := otherFp.Coefficient.Sub(subjectFp.Coefficient)
+	return delta, otherFp.Coefficient
 }
 
 // FixedPointFromUint64 creates a new FixedPoint from the given integer and
@@ -496,6 +496,36 @@ func testWithinToleranceFloatReproduce(t *rapid.T) {
 	}
 }
 
+// testDeviationPpmTolerance is a property-based test which ensures that the
+// deviation returned by DeviationPpm is the smallest tolerance for which
+// WithinTolerance holds, give or take the rounding of the deviation.
+func testDeviationPpmTolerance(t *rapid.T) {
+	coefficient1 := rapid.Int64Min(1).Draw(t, "coefficient_1")
+	scale1 := rapid.Uint8Range(0, 18).Draw(t, "scale_1")
+	f1 := FixedPoint[BigInt]{
+		Coefficient: NewBigInt(big.NewInt(coefficient1)),
+		Scale:       scale1,
+	}
+
+	coefficient2 := rapid.Int64Min(1).Draw(t, "coefficient_2")
+	scale2 := rapid.Uint8Range(0, 18).Draw(t, "scale_2")
+	f2 := FixedPoint[BigInt]{
+		Coefficient: NewBigInt(big.NewInt(coefficient2)),
+		Scale:       scale2,
+	}
+
+	deviation := f1.DeviationPpm(f2)
+	require.True(t, deviation.Equals(f2.DeviationPpm(f1)))
+	require.LessOrEqual(t, deviation.ToUint64(), uint64(1_000_000))
+
+	one := NewBigIntFromUint64(1)
+	require.True(t, f1.WithinTolerance(f2, deviation.Add(one)))
+
+	if deviation.ToUint64() > 0 {
+		require.False(t, f1.WithinTolerance(f2, deviation.Sub(one)))
+	}
+}
+
 // testWithinTolerance runs a series of tests to ensure the WithinTolerance
 // method behaves as expected.
 func testWithinTolerance(t *testing.T) {
@@ -527,6 +557,11 @@ func testWithinTolerance(t *testing.T) {
 		"within_tolerance_float_reproduce",
 		rapid.MakeCheck(testWithinToleranceFloatReproduce),
 	)
+
+	t.Run(
+		"deviation_ppm_tolerance",
+		rapid.MakeCheck(testDeviationPpmTolerance),
+	)
 }
 
 // TestFixedPoint runs a series of property-based tests on the FixedPoint type
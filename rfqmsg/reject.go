@@ -90,6 +90,22 @@ var (
 		Code: 1,
 		Msg:  "price oracle unavailable",
 	}
+
+	// ErrAssetRateOutOfBounds is the error code for when the asset rate
+	// that the requesting peer proposed deviates too much from the asset
+	// rate of our price oracle.
+	ErrAssetRateOutOfBounds = RejectErr{
+		Code: 2,
+		Msg:  "asset rate out of bounds",
+	}
+
+	// ErrExpiryOutOfBounds is the error code for when the expiry of the
+	// asset rate that our price oracle provided for the quote is not
+	// within our acceptable bounds.
+	ErrExpiryOutOfBounds = RejectErr{
+		Code: 3,
+		Msg:  "quote expiry out of bounds",
+	}
 )
 
 const (
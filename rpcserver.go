@@ -1,6 +1,7 @@
 package taprootassets
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/sha256"
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -70,6 +72,10 @@ import (
 	"golang.org/x/exp/maps"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -101,6 +107,22 @@ const (
 	// proofTypeReceive is an alias for the proof type used for receiving
 	// assets.
 	proofTypeReceive = tapdevrpc.ProofTransferType_PROOF_TRANSFER_TYPE_RECEIVE
+
+	// authorizationHeader is the metadata key of the header a client uses
+	// to present the preimage of a paid proof insertion invoice.
+	authorizationHeader = "authorization"
+
+	// wwwAuthenticateHeader is the metadata key of the header that carries
+	// the invoice a client needs to pay before inserting a proof.
+	wwwAuthenticateHeader = "www-authenticate"
+
+	// forwardedForHeader is the metadata key of the header the REST proxy
+	// uses to forward the address of the REST client.
+	forwardedForHeader = "x-forwarded-for"
+
+	// defaultQuoteHistoryLimit is the default maximum number of quotes
+	// returned by QueryQuoteHistory.
+	defaultQuoteHistoryLimit = 100
 )
 
 type (
@@ -1967,6 +1989,57 @@ func (r *rpcServer) ExportProof(ctx context.Context,
 	}, nil
 }
 
+// ConvertProofFile converts a proof file to the given proof file version and
+// reports the size of the file in the uncompressed and compressed encoding.
+func (r *rpcServer) ConvertProofFile(_ context.Context,
+	req *taprpc.ConvertProofFileRequest) (*taprpc.ConvertProofFileResponse,
+	error) {
+
+	if !proof.IsProofFile(req.RawProofFile) {
+		return nil, fmt.Errorf("invalid raw proof, expect file, not " +
+			"single encoded mint or transition proof")
+	}
+
+	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
+
+	proofFile, err := proof.DecodeFile(req.RawProofFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	converted, err := proofFile.Convert(proof.Version(req.Version))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert proof file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := converted.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("unable to encode proof file: %w", err)
+	}
+
+	report, err := proofFile.SizeReport()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create size report: %w", err)
+	}
+
+	rpcReport := &taprpc.ProofFileSizeReport{
+		NumProofs:                report.NumProofs,
+		UncompressedSize:         report.UncompressedSize,
+		CompressedSize:           report.CompressedSize,
+		NumBlockHeaders:          report.NumBlockHeaders,
+		NumUniqueBlockHeaders:    report.NumUniqueBlockHeaders,
+		NumExclusionProofs:       report.NumExclusionProofs,
+		NumUniqueExclusionProofs: report.NumUniqueExclusionProofs,
+	}
+
+	return &taprpc.ConvertProofFileResponse{
+		RawProofFile: buf.Bytes(),
+		SizeReport:   rpcReport,
+	}, nil
+}
+
 // ImportProof attempts to import a proof file into the daemon. If successful, a
 // new asset will be inserted on disk, spendable using the specified target
 // script key, and internal key.
@@ -2103,6 +2176,14 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 			err)
 	}
 
+	selectStrategy, err := unmarshalCoinSelectStrategy(
+		req.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing coin select strategy: "+
+			"%w", err)
+	}
+
 	var fundedVPkt *tapfreighter.FundedVPacket
 	switch {
 	case req.GetPsbt() != nil:
@@ -2125,6 +2206,7 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 		}
 
 		desc.CoinSelectType = coinSelectType
+		desc.SelectStrategy = selectStrategy
 		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(ctx, desc, vPkt)
 		if err != nil {
 			return nil, fmt.Errorf("error funding packet: %w", err)
@@ -2157,7 +2239,7 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 		}
 
 		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(
-			ctx, coinSelectType, addr,
+			ctx, coinSelectType, selectStrategy, addr,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding address send: "+
@@ -2222,6 +2304,29 @@ func unmarshalCoinSelectType(
 	}
 }
 
+// unmarshalCoinSelectStrategy converts an RPC select strategy into a native
+// one.
+func unmarshalCoinSelectStrategy(strategy taprpc.CoinSelectStrategy) (
+	tapsend.MultiCommitmentSelectStrategy, error) {
+
+	switch strategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT,
+		taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_PREFER_MAX_AMOUNT:
+
+		return tapsend.PreferMaxAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_PREFER_MIN_AMOUNT:
+		return tapsend.PreferMinAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_EXACT_MATCH:
+		return tapsend.ExactMatch, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin select strategy: %d",
+			strategy)
+	}
+}
+
 // SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
 // commitments of the inputs and outputs.
 func (r *rpcServer) SignVirtualPsbt(ctx context.Context,
@@ -3262,8 +3367,17 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 		return nil, err
 	}
 
+	selectStrategy, err := unmarshalCoinSelectStrategy(
+		req.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(feeRate, tapAddrs...),
+		tapfreighter.NewAddressParcel(
+			feeRate, selectStrategy, tapAddrs...,
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -3280,6 +3394,103 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 	}, nil
 }
 
+// SendAssetBatch sends to an arbitrary set of Taproot Asset addresses, which
+// may be for different assets. The addresses are split into batches that are
+// each sent within their own anchor transaction. A failure to send one batch
+// does not affect the other batches.
+func (r *rpcServer) SendAssetBatch(ctx context.Context,
+	req *taprpc.SendAssetBatchRequest) (*taprpc.SendAssetBatchResponse,
+	error) {
+
+	if len(req.TapAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
+	}
+
+	var (
+		tapAddrs = make([]*address.Tap, len(req.TapAddrs))
+		err      error
+	)
+	for idx := range req.TapAddrs {
+		if req.TapAddrs[idx] == "" {
+			return nil, fmt.Errorf("addr %d must be specified", idx)
+		}
+
+		tapAddrs[idx], err = address.DecodeAddress(
+			req.TapAddrs[idx], &r.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode addr %d: %w",
+				idx, err)
+		}
+	}
+
+	maxWeight := tapfreighter.DefaultMaxBatchAnchorTxWeight
+	if req.MaxAnchorTxWeight != 0 {
+		maxWeight = lntypes.WeightUnit(req.MaxAnchorTxWeight)
+	}
+
+	batches, err := tapfreighter.ChunkAddresses(tapAddrs, maxWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := checkFeeRateSanity(
+		ctx, chainfee.SatPerKWeight(req.FeeRate), r.cfg.Lnd.WalletKit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	selectStrategy, err := unmarshalCoinSelectStrategy(
+		req.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &taprpc.SendAssetBatchResponse{
+		Batches: make([]*taprpc.SendAssetBatchResult, len(batches)),
+	}
+	for idx, batch := range batches {
+		result := &taprpc.SendAssetBatchResult{
+			TapAddrs:        make([]string, len(batch.Addrs)),
+			EstimatedWeight: uint32(batch.EstimatedWeight),
+		}
+		resp.Batches[idx] = result
+
+		for addrIdx, addr := range batch.Addrs {
+			result.TapAddrs[addrIdx], err = addr.EncodeAddress()
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode "+
+					"addr: %w", err)
+			}
+		}
+
+		// A failed batch is reported in its result, we continue with
+		// the remaining batches.
+		parcel, err := r.cfg.ChainPorter.RequestShipment(
+			tapfreighter.NewAddressParcel(
+				feeRate, selectStrategy, batch.Addrs...,
+			),
+		)
+		if err != nil {
+			rpcsLog.Errorf("Unable to send batch %d of %d: %v",
+				idx+1, len(batches), err)
+
+			result.Error = err.Error()
+			continue
+		}
+
+		result.Transfer, err = marshalOutboundParcel(parcel)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling outbound "+
+				"parcel: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
 // BurnAsset burns the given number of units of a given asset by sending them
 // to a provably un-spendable script key. Burning means irrevocably destroying
 // a certain number of assets, reducing the total supply of the asset. Because
@@ -3403,6 +3614,81 @@ func (r *rpcServer) BurnAsset(ctx context.Context,
 	}, nil
 }
 
+// RecoverAssets attempts to recover all assets owned by keys derived from the
+// seed of the backing lnd node by scanning the local universe or the universe
+// federation for leaves with matching script keys.
+func (r *rpcServer) RecoverAssets(ctx context.Context,
+	req *taprpc.RecoverAssetsRequest) (*taprpc.RecoverAssetsResponse,
+	error) {
+
+	// Parse the proof courier address if one was provided, otherwise use
+	// the default specified in the config.
+	courierAddr := r.cfg.DefaultProofCourierAddr
+	if req.ProofCourierAddr != "" {
+		var err error
+		courierAddr, err = proof.ParseCourierAddress(
+			req.ProofCourierAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier "+
+				"address: %w", err)
+		}
+	}
+	if courierAddr == nil {
+		return nil, fmt.Errorf("no proof courier address provided")
+	}
+
+	sources := []universe.DiffEngine{r.cfg.UniverseArchive}
+	if req.ScanFederation {
+		uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch federation "+
+				"servers: %w", err)
+		}
+		if len(uniServers) == 0 {
+			return nil, universe.ErrNoUniverseServers
+		}
+
+		sources = make([]universe.DiffEngine, 0, len(uniServers))
+		for _, uniServer := range uniServers {
+			diffEngine, err := NewRpcUniverseDiff(uniServer)
+			if err != nil {
+				return nil, fmt.Errorf("unable to connect to "+
+					"universe server %v: %w",
+					uniServer.HostStr(), err)
+			}
+			defer diffEngine.Close()
+
+			sources = append(sources, diffEngine)
+		}
+	}
+
+	result, err := r.cfg.AssetCustodian.RecoverAssets(
+		ctx, &tapgarden.RecoveryRequest{
+			GapLimit:    req.GapLimit,
+			Sources:     sources,
+			CourierAddr: courierAddr,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to recover assets: %w", err)
+	}
+
+	lastUsedKeyIndex := int64(-1)
+	result.LastUsedKeyIndex.WhenSome(func(index uint32) {
+		lastUsedKeyIndex = int64(index)
+	})
+
+	return &taprpc.RecoverAssetsResponse{
+		NumKeysScanned:     result.NumKeysScanned,
+		LastUsedKeyIndex:   lastUsedKeyIndex,
+		NumAssetsRecovered: uint32(result.NumAssetsRecovered),
+		NumAssetsKnown:     uint32(result.NumAssetsKnown),
+		NumAssetsSpent:     uint32(result.NumAssetsSpent),
+		NumAssetsSkipped:   uint32(result.NumAssetsSkipped),
+	}, nil
+}
+
 // ListBurns returns a list of burnt assets. Some filters may be defined in the
 // request to return more specific results.
 func (r *rpcServer) ListBurns(ctx context.Context,
@@ -4125,9 +4411,38 @@ func marshalSendEvent(event fn.Event) (*taprpc.SendEvent, error) {
 		}
 	}
 
+	result.ProofDeliveries = fn.Map(
+		e.ProofDeliveries, marshalProofDelivery,
+	)
+
 	return result, nil
 }
 
+// marshalProofDelivery marshals the proof delivery state of a single transfer
+// output into the RPC counterpart.
+func marshalProofDelivery(d tapfreighter.ProofDelivery) *taprpc.ProofDelivery {
+	status := taprpc.ProofDeliveryStatusPending
+	if d.Delivered {
+		status = taprpc.ProofDeliveryStatusComplete
+	}
+
+	out := d.Output
+	rpcDelivery := &taprpc.ProofDelivery{
+		ScriptKey:        out.ScriptKey.PubKey.SerializeCompressed(),
+		AnchorOutpoint:   out.Anchor.OutPoint.String(),
+		OutputPosition:   out.Position,
+		Amount:           out.Amount,
+		ProofCourierAddr: string(out.ProofCourierAddr),
+		Status:           status,
+	}
+
+	if d.Error != nil {
+		rpcDelivery.Error = d.Error.Error()
+	}
+
+	return rpcDelivery
+}
+
 // marshalVerboseBatch marshals a minting batch into the RPC counterpart.
 func marshalVerboseBatch(params chaincfg.Params, batch *tapgarden.VerboseBatch,
 	verbose bool, skipSeedlings bool) (*mintrpc.VerboseBatch, error) {
@@ -4489,6 +4804,47 @@ func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
 	}
 }
 
+// ExportBackup exports an encrypted backup of all assets owned by the daemon,
+// their proofs and key derivation information, the address book and all
+// pending transfers.
+func (r *rpcServer) ExportBackup(ctx context.Context,
+	_ *taprpc.ExportBackupRequest) (*taprpc.ExportBackupResponse, error) {
+
+	backup, err := r.cfg.BackupManager.ExportBackup(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export backup: %w", err)
+	}
+
+	return &taprpc.ExportBackupResponse{
+		Backup: backup,
+	}, nil
+}
+
+// RestoreBackup restores an encrypted backup that was created with
+// ExportBackup. Every proof in the backup is verified before it is imported.
+func (r *rpcServer) RestoreBackup(ctx context.Context,
+	req *taprpc.RestoreBackupRequest) (*taprpc.RestoreBackupResponse,
+	error) {
+
+	if len(req.Backup) == 0 {
+		return nil, fmt.Errorf("backup must be set")
+	}
+
+	result, err := r.cfg.BackupManager.RestoreBackup(ctx, req.Backup)
+	if err != nil {
+		return nil, fmt.Errorf("unable to restore backup: %w", err)
+	}
+
+	return &taprpc.RestoreBackupResponse{
+		NumAssetsRestored:    uint32(result.NumAssetsRestored),
+		NumAssetsSkipped:     uint32(result.NumAssetsSkipped),
+		NumAddrsRestored:     uint32(result.NumAddrsRestored),
+		NumAddrsSkipped:      uint32(result.NumAddrsSkipped),
+		NumTransfersRestored: uint32(result.NumTransfersRestored),
+		NumTransfersSkipped:  uint32(result.NumTransfersSkipped),
+	}, nil
+}
+
 func (r *rpcServer) FetchAssetMeta(ctx context.Context,
 	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
 
@@ -5104,6 +5460,11 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 		return nil, fmt.Errorf("invalid request limit: %d", req.Limit)
 	}
 
+	if len(req.KeyPrefix) > sha256.Size {
+		return nil, fmt.Errorf("invalid key prefix length: %d",
+			len(req.KeyPrefix))
+	}
+
 	// Check the rate limiter to see if we need to wait at all. If not then
 	// this'll be a noop.
 	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
@@ -5116,6 +5477,7 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 			SortDirection: universe.SortDirection(req.Direction),
 			Offset:        req.Offset,
 			Limit:         req.Limit,
+			KeyPrefix:     req.KeyPrefix,
 		},
 	)
 	if err != nil {
@@ -5133,6 +5495,56 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 	return resp, nil
 }
 
+// BranchNodes queries for the nodes of the Universe MS-SMT of a given asset_id
+// or group_key that are a number of levels below the branch identified by a
+// key prefix.
+func (r *rpcServer) BranchNodes(ctx context.Context,
+	req *unirpc.BranchNodesRequest) (*unirpc.BranchNodesResponse, error) {
+
+	if req == nil {
+		return nil, fmt.Errorf("request must be set")
+	}
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
+
+	query := universe.BranchNodesQuery{
+		Id:        universeID,
+		KeyPrefix: req.KeyPrefix,
+		Depth:     int(req.Depth),
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	nodes, err := r.cfg.UniverseArchive.BranchNodes(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.BranchNodesResponse{
+		Nodes: make([]*unirpc.MerkleSumNode, len(nodes)),
+	}
+
+	for i, node := range nodes {
+		resp.Nodes[i] = marshalMssmtNode(node)
+	}
+
+	return resp, nil
+}
+
 func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
 	assetLeaf *universe.Leaf,
 	decDisplay fn.Option[uint32]) (*unirpc.AssetLeaf, error) {
@@ -5352,36 +5764,140 @@ func (r *rpcServer) QueryProof(ctx context.Context,
 		return nil, err
 	}
 
-	return r.marshalUniverseProofLeaf(ctx, req, firstProof)
-}
+	resp, err := r.marshalUniverseProofLeaf(ctx, req, firstProof)
+	if err != nil {
+		return nil, err
+	}
 
-// queryProof attempts to query for an issuance or transfer proof for a given
-// asset based on its UniverseKey. A UniverseKey is composed of the Universe ID
-// (asset_id/group_key) and also a leaf key (outpoint || script_key).
-func (r *rpcServer) queryProof(ctx context.Context, uniID universe.Identifier,
-	leafKey universe.LeafKey) (*universe.Proof, error) {
+	// If the universe root of the proof is included in the latest chain
+	// commitment to our multiverse roots, we also return the commitment,
+	// so the caller can verify we don't equivocate.
+	committedProof, err := r.queryCommittedProof(
+		ctx, universeID, firstProof,
+	)
+	switch {
+	case errors.Is(err, universe.ErrNoCommitment):
+		return resp, nil
 
-	rpcsLog.Tracef("[QueryProof]: fetching proof at (uniID=%v, "+
-		"leafKey=%x)", uniID.StringForLog(), leafKey.UniverseKey())
+	case err != nil:
+		return nil, err
+	}
 
-	// Retrieve proof export config for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	resp.ChainCommitment, err = marshalUniverseCommitment(
+		committedProof.ChainProof,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	var candidateIDs []universe.Identifier
+	resp.CommittedMultiverseInclusionProof, err = marshalMssmtProof(
+		committedProof.MultiverseInclusionProof,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// queryCommittedProof returns the given universe proof along with the latest
+// chain commitment that includes its universe root. If the proof type of the
+// universe ID is unspecified, both the issuance and the transfer universe are
+// tried.
+func (r *rpcServer) queryCommittedProof(ctx context.Context,
+	uniID universe.Identifier,
+	leafProof *universe.Proof) (*universe.CommittedIssuanceProof, error) {
 
+	candidateIDs := []universe.Identifier{uniID}
 	if uniID.ProofType == universe.ProofTypeUnspecified {
-		// If the proof type is unspecified, then we'll attempt to
-		// retrieve both the issuance and transfer proofs. We gather the
-		// corresponding universe IDs into a candidate set.
-		uniID.ProofType = universe.ProofTypeIssuance
-		if syncConfigs.IsSyncExportEnabled(uniID) {
-			candidateIDs = append(candidateIDs, uniID)
-		}
+		issuanceID, transferID := uniID, uniID
+		issuanceID.ProofType = universe.ProofTypeIssuance
+		transferID.ProofType = universe.ProofTypeTransfer
 
-		uniID.ProofType = universe.ProofTypeTransfer
+		candidateIDs = []universe.Identifier{issuanceID, transferID}
+	}
+
+	for _, candidateID := range candidateIDs {
+		committedProof, err := r.cfg.UniverseCommitments.CommittedProof(
+			ctx, candidateID, leafProof,
+		)
+		if errors.Is(err, universe.ErrNoCommitment) {
+			continue
+		}
+
+		return committedProof, err
+	}
+
+	return nil, universe.ErrNoCommitment
+}
+
+// marshalUniverseCommitment marshals a chain commitment to the multiverse
+// roots into the RPC form.
+func marshalUniverseCommitment(
+	c *universe.Commitment) (*unirpc.UniverseChainCommitment, error) {
+
+	var anchorTx bytes.Buffer
+	if err := c.AnchorTx.Serialize(&anchorTx); err != nil {
+		return nil, err
+	}
+
+	var blockHeader bytes.Buffer
+	if err := c.BlockHeader.Serialize(&blockHeader); err != nil {
+		return nil, err
+	}
+
+	var merkleProof bytes.Buffer
+	if err := c.MerkleProof.Encode(&merkleProof); err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseChainCommitment{
+		AnchorTx:    anchorTx.Bytes(),
+		OutputIndex: c.OutputIndex,
+		InternalKey: c.InternalKey.PubKey.SerializeCompressed(),
+		IssuanceMultiverseRoot: marshalMssmtNode(
+			c.IssuanceRoot,
+		),
+		TransferMultiverseRoot: marshalMssmtNode(
+			c.TransferRoot,
+		),
+		BlockHeight: c.BlockHeight,
+		BlockHeader: blockHeader.Bytes(),
+		MerkleProof: merkleProof.Bytes(),
+		GenesisOutpoint: &taprpc.OutPoint{
+			Txid:        c.GenesisOutPoint.Hash[:],
+			OutputIndex: c.GenesisOutPoint.Index,
+		},
+	}, nil
+}
+
+// queryProof attempts to query for an issuance or transfer proof for a given
+// asset based on its UniverseKey. A UniverseKey is composed of the Universe ID
+// (asset_id/group_key) and also a leaf key (outpoint || script_key).
+func (r *rpcServer) queryProof(ctx context.Context, uniID universe.Identifier,
+	leafKey universe.LeafKey) (*universe.Proof, error) {
+
+	rpcsLog.Tracef("[QueryProof]: fetching proof at (uniID=%v, "+
+		"leafKey=%x)", uniID.StringForLog(), leafKey.UniverseKey())
+
+	// Retrieve proof export config for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidateIDs []universe.Identifier
+
+	if uniID.ProofType == universe.ProofTypeUnspecified {
+		// If the proof type is unspecified, then we'll attempt to
+		// retrieve both the issuance and transfer proofs. We gather the
+		// corresponding universe IDs into a candidate set.
+		uniID.ProofType = universe.ProofTypeIssuance
+		if syncConfigs.IsSyncExportEnabled(uniID) {
+			candidateIDs = append(candidateIDs, uniID)
+		}
+
+		uniID.ProofType = universe.ProofTypeTransfer
 		if syncConfigs.IsSyncExportEnabled(uniID) {
 			candidateIDs = append(candidateIDs, uniID)
 		}
@@ -5450,6 +5966,188 @@ func (r *rpcServer) queryProof(ctx context.Context, uniID universe.Identifier,
 	return firstProof, nil
 }
 
+// SubscribeUniverseLeaves subscribes to the issuance and transfer leaves that
+// are inserted into the local Universe. Leaves that were inserted after the
+// cursor of the request are sent first, followed by any new leaves as soon as
+// they are inserted.
+func (r *rpcServer) SubscribeUniverseLeaves(
+	req *unirpc.SubscribeUniverseLeavesRequest,
+	stream unirpc.Universe_SubscribeUniverseLeavesServer) error {
+
+	ctx := stream.Context()
+
+	query := universe.LeafEventsQuery{
+		AfterCursor: req.AfterCursor,
+		Limit:       universe.RequestPageSize,
+	}
+
+	if len(req.AssetId) > 0 {
+		if len(req.AssetId) != sha256.Size {
+			return fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.AssetId)
+		query.AssetID = &assetID
+	}
+
+	if len(req.GroupKey) > 0 {
+		groupKey, err := parseUserKey(req.GroupKey)
+		if err != nil {
+			return fmt.Errorf("unable to parse group key: %w", err)
+		}
+
+		query.GroupKey = groupKey
+	}
+
+	proofType, err := UnmarshalUniProofType(req.ProofType)
+	if err != nil {
+		return err
+	}
+	query.ProofType = proofType
+
+	// We register for new leaves before determining the cursor to start
+	// after, so no leaves that are inserted in the meantime are missed.
+	leafSubscriber := fn.NewEventReceiver[universe.Identifier](
+		fn.DefaultQueueSize,
+	)
+	r.cfg.UniverseArchive.RegisterLeafSubscriber(leafSubscriber)
+	defer func() {
+		err := r.cfg.UniverseArchive.RemoveLeafSubscriber(
+			leafSubscriber,
+		)
+		if err != nil {
+			rpcsLog.Errorf("Error unsubscribing leaf subscriber: %v",
+				err)
+		}
+	}()
+
+	if query.AfterCursor == 0 {
+		query.AfterCursor, err = r.cfg.UniverseArchive.LatestLeafCursor(
+			ctx,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch latest leaf cursor: "+
+				"%w", err)
+		}
+	}
+
+	// The first message lets the client know which cursor to resume from,
+	// even if no leaf is sent before it disconnects.
+	err = stream.Send(&unirpc.UniverseLeafEvent{
+		Cursor: query.AfterCursor,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		// Send all leaves that were inserted after the cursor, one page
+		// at a time.
+		for {
+			numLeaves, err := r.sendUniverseLeafEvents(
+				ctx, stream, &query,
+			)
+			if err != nil {
+				return err
+			}
+
+			if numLeaves < int(query.Limit) {
+				break
+			}
+		}
+
+		// Once we've caught up, we wait for new leaves to be inserted.
+		select {
+		case <-leafSubscriber.NewItemCreated.ChanOut():
+
+		case <-ctx.Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+
+			return ctx.Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// sendUniverseLeafEvents sends the next page of leaves that were inserted after
+// the cursor of the given query to the stream, and advances the cursor. The
+// number of leaves fetched is returned, including those of universes that
+// don't allow proof export and therefore aren't sent.
+func (r *rpcServer) sendUniverseLeafEvents(ctx context.Context,
+	stream unirpc.Universe_SubscribeUniverseLeavesServer,
+	query *universe.LeafEventsQuery) (int, error) {
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return 0, err
+	}
+
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := r.cfg.UniverseArchive.FetchLeafEvents(ctx, *query)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch leaf events: %w", err)
+	}
+
+	for _, event := range events {
+		query.AfterCursor = event.Cursor
+
+		if !syncConfigs.IsSyncExportEnabled(event.ID) {
+			continue
+		}
+
+		proofs, err := r.cfg.UniverseArchive.FetchProofLeaf(
+			ctx, event.ID, event.Key,
+		)
+		switch {
+		// The leaf may have been deleted in the meantime, in which
+		// case there's nothing to send.
+		case errors.Is(err, universe.ErrNoUniverseProofFound):
+			continue
+
+		case err != nil:
+			return 0, fmt.Errorf("unable to fetch proof leaf: %w",
+				err)
+		}
+
+		rpcID, err := MarshalUniID(event.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		rpcLeaf, err := r.marshalUniverseProofLeaf(
+			ctx, &unirpc.UniverseKey{
+				Id:      rpcID,
+				LeafKey: marshalLeafKey(event.Key),
+			}, proofs[0],
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		err = stream.Send(&unirpc.UniverseLeafEvent{
+			Cursor: event.Cursor,
+			Leaf:   rpcLeaf,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(events), nil
+}
+
 // unmarshalUniverseKey unmarshals a universe key from the RPC form.
 func unmarshalUniverseKey(key *unirpc.UniverseKey) (universe.Identifier,
 	universe.LeafKey, error) {
@@ -5548,6 +6246,13 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 			"given universe")
 	}
 
+	// Before we verify and insert the proof, we make sure it's admitted
+	// by the spam protection policy of this universe server.
+	err = r.admitProofInsert(ctx, req, universeID, assetLeaf)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check the rate limiter to see if we need to wait at all. If not then
 	// this'll be a noop.
 	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
@@ -5572,6 +6277,135 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 	return r.marshalUniverseProofLeaf(ctx, req.Key, newUniverseState)
 }
 
+// admitProofInsert returns an error if the proof insertion isn't admitted by
+// the admission policy of the universe server. If the client needs to pay for
+// the insertion, an L402 style challenge with the invoice is sent along with
+// the error.
+func (r *rpcServer) admitProofInsert(ctx context.Context,
+	req *unirpc.AssetProof, universeID universe.Identifier,
+	assetLeaf *universe.Leaf) error {
+
+	preimage, err := insertPaymentPreimage(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prevOuts := make([]*wire.TxOut, 0, len(req.AnchorPrevOuts))
+	for _, prevOut := range req.AnchorPrevOuts {
+		prevOuts = append(prevOuts, &wire.TxOut{
+			Value:    prevOut.Value,
+			PkScript: prevOut.PkScript,
+		})
+	}
+
+	// The macaroon of the request was only verified by the interceptor if
+	// the insertion isn't whitelisted for public access. Otherwise, a
+	// client could send a different random macaroon with every request to
+	// get a fresh token bucket each time.
+	macaroonVerified := !r.cfg.RPCConfig.NoMacaroons &&
+		!r.cfg.UniversePublicAccess.IsWriteAccessGranted()
+
+	err = r.cfg.UniverseAdmitter.AdmitInsert(ctx, &universe.InsertRequest{
+		ClientID:       insertClientID(ctx, macaroonVerified),
+		ID:             universeID,
+		Leaf:           assetLeaf,
+		AnchorPrevOuts: prevOuts,
+		Preimage:       preimage,
+	})
+
+	var (
+		rejectedErr *universe.InsertRejectedError
+		paymentErr  *universe.PaymentRequiredError
+	)
+	switch {
+	case err == nil:
+		return nil
+
+	case errors.As(err, &rejectedErr):
+		code := codes.FailedPrecondition
+		if rejectedErr.Reason == universe.RejectRateLimited {
+			code = codes.ResourceExhausted
+		}
+
+		return status.Error(code, err.Error())
+
+	case errors.As(err, &paymentErr):
+		challenge := fmt.Sprintf("L402 invoice=%q", paymentErr.Invoice)
+		err := grpc.SetHeader(ctx, metadata.Pairs(
+			wwwAuthenticateHeader, challenge,
+		))
+		if err != nil {
+			rpcsLog.Warnf("Unable to send payment challenge: %v",
+				err)
+		}
+
+		return status.Error(codes.Unauthenticated, paymentErr.Error())
+
+	default:
+		return err
+	}
+}
+
+// insertClientID returns the ID of the client that inserts a proof, which
+// its proof insertions are rate limited by. Clients are identified by their
+// macaroon if it was verified, and by their IP address otherwise.
+func insertClientID(ctx context.Context, macaroonVerified bool) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	macaroons := md.Get("macaroon")
+	if macaroonVerified && len(macaroons) > 0 {
+		macHash := sha256.Sum256([]byte(macaroons[0]))
+		return fmt.Sprintf("macaroon:%x", macHash[:8])
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	// Requests of the REST proxy are forwarded to the gRPC server over a
+	// local connection, so the address of the REST client is taken from
+	// the header the proxy adds. We only trust that header from local
+	// peers.
+	ip := net.ParseIP(host)
+	forwardedFor := md.Get(forwardedForHeader)
+	if ip != nil && ip.IsLoopback() && len(forwardedFor) > 0 {
+		clients := strings.Split(forwardedFor[0], ",")
+		return strings.TrimSpace(clients[0])
+	}
+
+	return host
+}
+
+// insertPaymentPreimage parses the preimage of a paid proof insertion invoice
+// from the L402 style authorization header of the request, if present.
+func insertPaymentPreimage(
+	ctx context.Context) (fn.Option[lntypes.Preimage], error) {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	authHeaders := md.Get(authorizationHeader)
+	if len(authHeaders) == 0 {
+		return fn.None[lntypes.Preimage](), nil
+	}
+
+	scheme, token, ok := strings.Cut(authHeaders[0], " ")
+	if !ok || !strings.EqualFold(scheme, "L402") {
+		return fn.None[lntypes.Preimage](), nil
+	}
+
+	preimage, err := lntypes.MakePreimageFromStr(strings.TrimSpace(token))
+	if err != nil {
+		return fn.None[lntypes.Preimage](), fmt.Errorf("invalid "+
+			"payment preimage: %w", err)
+	}
+
+	return fn.Some(preimage), nil
+}
+
 // PushProof attempts to query the local universe for a proof specified by a
 // UniverseKey. If found, a connection is made to a remote Universe server to
 // attempt to upload the asset leaf.
@@ -5593,7 +6427,11 @@ func (r *rpcServer) PushProof(ctx context.Context,
 			"and id")
 	}
 
-	remoteUniAddr := unmarshalUniverseServer(req.Server)
+	remoteUniAddr, err := unmarshalUniverseServer(req.Server)
+	if err != nil {
+		return nil, err
+	}
+
 	universeID, leafKey, err := unmarshalUniverseKey(req.Key)
 	if err != nil {
 		return nil, err
@@ -5610,7 +6448,7 @@ func (r *rpcServer) PushProof(ctx context.Context,
 
 	// Make sure that we aren't trying to push the proof to ourself, and
 	// then attempt to push the proof.
-	err = CheckFederationServer(
+	_, err = CheckFederationServer(
 		r.cfg.RuntimeID, universe.DefaultTimeout, remoteUniAddr,
 	)
 	if err != nil {
@@ -5644,11 +6482,129 @@ func (r *rpcServer) PushProof(ctx context.Context,
 
 // Info returns a set of information about the current state of the Universe.
 func (r *rpcServer) Info(ctx context.Context,
-	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
+	req *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
 
-	return &unirpc.InfoResponse{
-		RuntimeId: r.cfg.RuntimeID,
-	}, nil
+	resp := &unirpc.InfoResponse{
+		RuntimeId:  r.cfg.RuntimeID,
+		NodePubkey: r.cfg.Lnd.NodePubkey[:],
+	}
+
+	// Clients need the genesis outpoint of the commitment chain to check
+	// that the commitments they're served are its tip.
+	latest, err := r.cfg.UniverseCommitments.LatestCommitment(ctx)
+	switch {
+	case errors.Is(err, universe.ErrNoCommitment):
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch latest commitment: %w",
+			err)
+
+	default:
+		resp.CommitmentGenesisOutpoint = &taprpc.OutPoint{
+			Txid:        latest.GenesisOutPoint.Hash[:],
+			OutputIndex: latest.GenesisOutPoint.Index,
+		}
+	}
+
+	if len(req.Challenge) == 0 {
+		return resp, nil
+	}
+
+	if len(req.Challenge) != universe.ChallengeSize {
+		return nil, fmt.Errorf("challenge must be %d bytes",
+			universe.ChallengeSize)
+	}
+
+	// The challenge is signed by lnd, so we rate limit the requests like
+	// the other public universe queries.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	var challenge [universe.ChallengeSize]byte
+	copy(challenge[:], req.Challenge)
+
+	// The signature commits to the TLS session the challenge was received
+	// over, so it can't be relayed by a man-in-the-middle.
+	clientPeer, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("unable to determine client connection")
+	}
+	binding, err := peerChannelBinding(clientPeer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign challenge: %w", err)
+	}
+
+	// We prove our identity by signing the challenge with our node key.
+	sig, err := r.cfg.Lnd.Signer.SignMessage(
+		ctx, universe.ChallengeMsg(challenge, binding),
+		keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign challenge: %w", err)
+	}
+	resp.ChallengeSig = sig
+
+	return resp, nil
+}
+
+// SignedMultiverseRoots returns the multiverse root snapshot signed by this
+// Universe server for the current epoch, along with all signed snapshots of
+// other federation members it has collected since the given epoch.
+func (r *rpcServer) SignedMultiverseRoots(ctx context.Context,
+	req *unirpc.SignedMultiverseRootsRequest) (
+	*unirpc.SignedMultiverseRootsResponse, error) {
+
+	// Our own root may need to be signed by lnd, so we rate limit the
+	// requests like the other public universe queries.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	roots, err := r.cfg.UniverseGossiper.SignedRoots(ctx, req.MinEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch signed roots: %w", err)
+	}
+
+	resp := &unirpc.SignedMultiverseRootsResponse{
+		Roots: make([]*unirpc.SignedMultiverseRoot, len(roots)),
+	}
+	for idx := range roots {
+		resp.Roots[idx] = marshalSignedMultiverseRoot(&roots[idx])
+	}
+
+	return resp, nil
+}
+
+// marshalSignedMultiverseRoot marshals a signed multiverse root into its RPC
+// counterpart.
+func marshalSignedMultiverseRoot(
+	root *universe.SignedMultiverseRoot) *unirpc.SignedMultiverseRoot {
+
+	return &unirpc.SignedMultiverseRoot{
+		ServerKey:    root.ServerKey.SerializeCompressed(),
+		Epoch:        root.Epoch,
+		IssuanceRoot: marshalMssmtNode(root.IssuanceRoot),
+		TransferRoot: marshalMssmtNode(root.TransferRoot),
+		Signature:    root.Signature,
+	}
+}
+
+// marshalUniverseEquivocation marshals the evidence of an equivocation into
+// its RPC counterpart.
+func marshalUniverseEquivocation(
+	e *universe.Equivocation) *unirpc.UniverseEquivocation {
+
+	return &unirpc.UniverseEquivocation{
+		ServerKey:  e.First.ServerKey.SerializeCompressed(),
+		Epoch:      e.First.Epoch,
+		FirstRoot:  marshalSignedMultiverseRoot(&e.First),
+		SecondRoot: marshalSignedMultiverseRoot(&e.Second),
+		Reporter:   e.Reporter,
+		DetectedAt: e.DetectedAt.Unix(),
+	}
 }
 
 // unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
@@ -5788,10 +6744,36 @@ func (r *rpcServer) SyncUniverse(ctx context.Context,
 func marshalUniverseServer(
 	server universe.ServerAddr) *unirpc.UniverseFederationServer {
 
-	return &unirpc.UniverseFederationServer{
+	rpcServer := &unirpc.UniverseFederationServer{
 		Host: server.HostStr(),
 		Id:   int32(server.ID),
 	}
+	if server.IdentityKey != nil {
+		rpcServer.IdentityKey = server.IdentityKey.SerializeCompressed()
+	}
+
+	return rpcServer
+}
+
+// marshalServerReputation marshals the reputation of a universe server into
+// its RPC counterpart.
+func marshalServerReputation(reputation universe.ServerReputation,
+	banThreshold int32) *unirpc.UniverseFederationServer {
+
+	rpcServer := marshalUniverseServer(reputation.Addr)
+	rpcServer.ReputationScore = reputation.Score
+	rpcServer.Banned = universe.IsBanned(reputation.Score, banThreshold)
+	rpcServer.Failures = fn.Map(
+		reputation.Failures,
+		func(f universe.ServerFailure) *unirpc.FederationServerFailure {
+			return &unirpc.FederationServerFailure{
+				Timestamp: f.Time.Unix(),
+				Reason:    f.Reason,
+			}
+		},
+	)
+
+	return rpcServer
 }
 
 // ListFederationServers lists the set of servers that make up the federation
@@ -5801,20 +6783,40 @@ func (r *rpcServer) ListFederationServers(ctx context.Context,
 	_ *unirpc.ListFederationServersRequest) (
 	*unirpc.ListFederationServersResponse, error) {
 
-	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	reputations, err := r.cfg.FederationDB.QueryServerReputations(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	servers := make(
+		[]*unirpc.UniverseFederationServer, 0, len(reputations),
+	)
+	for _, reputation := range reputations {
+		servers = append(servers, marshalServerReputation(
+			reputation, r.cfg.UniverseBanThreshold,
+		))
+	}
+
 	return &unirpc.ListFederationServersResponse{
-		Servers: fn.Map(uniServers, marshalUniverseServer),
+		Servers: servers,
 	}, nil
 }
 
 func unmarshalUniverseServer(
-	server *unirpc.UniverseFederationServer) universe.ServerAddr {
+	server *unirpc.UniverseFederationServer) (universe.ServerAddr, error) {
+
+	addr := universe.NewServerAddr(int64(server.Id), server.Host)
+
+	if len(server.IdentityKey) != 0 {
+		identityKey, err := btcec.ParsePubKey(server.IdentityKey)
+		if err != nil {
+			return addr, fmt.Errorf("invalid identity key: %w", err)
+		}
 
-	return universe.NewServerAddr(int64(server.Id), server.Host)
+		addr.IdentityKey = identityKey
+	}
+
+	return addr, nil
 }
 
 // AddFederationServer adds a new server to the federation of the local
@@ -5824,23 +6826,26 @@ func (r *rpcServer) AddFederationServer(ctx context.Context,
 	req *unirpc.AddFederationServerRequest,
 ) (*unirpc.AddFederationServerResponse, error) {
 
-	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
+	serversToAdd, err := fn.MapErr(req.Servers, unmarshalUniverseServer)
+	if err != nil {
+		return nil, err
+	}
 
 	for idx := range serversToAdd {
-		server := serversToAdd[idx]
-
 		// Before we add the server as a federation member, we check
 		// that we can actually connect to it and that it isn't
-		// ourselves.
-		err := CheckFederationServer(
-			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+		// ourselves. This also pins the identity key of the server if
+		// none was given.
+		serversToAdd[idx], err = CheckFederationServer(
+			r.cfg.RuntimeID, universe.DefaultTimeout,
+			serversToAdd[idx],
 		)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
+	err = r.cfg.UniverseFederation.AddServer(serversToAdd...)
 	if err != nil {
 		return nil, err
 	}
@@ -5854,12 +6859,15 @@ func (r *rpcServer) DeleteFederationServer(ctx context.Context,
 	req *unirpc.DeleteFederationServerRequest,
 ) (*unirpc.DeleteFederationServerResponse, error) {
 
-	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
+	serversToDel, err := fn.MapErr(req.Servers, unmarshalUniverseServer)
+	if err != nil {
+		return nil, err
+	}
 
 	// Remove the servers from the proofs sync log. This is necessary before
 	// we can remove the servers from the database because of a foreign
 	// key constraint.
-	err := r.cfg.FederationDB.DeleteProofsSyncLogEntries(
+	err = r.cfg.FederationDB.DeleteProofsSyncLogEntries(
 		ctx, serversToDel...,
 	)
 	if err != nil {
@@ -5981,6 +6989,128 @@ func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
 	}, nil
 }
 
+// snapshotPieceSize is the maximum size of a piece of a universe snapshot that
+// is sent in a single RPC message.
+const snapshotPieceSize = 1024 * 1024
+
+// snapshotStreamWriter is an io.Writer that sends everything written to it as
+// pieces of a universe snapshot over an RPC stream.
+type snapshotStreamWriter struct {
+	stream unirpc.Universe_ExportSnapshotServer
+}
+
+// Write sends the given data in pieces of at most snapshotPieceSize bytes.
+func (w *snapshotStreamWriter) Write(p []byte) (int, error) {
+	for start := 0; start < len(p); start += snapshotPieceSize {
+		end := min(start+snapshotPieceSize, len(p))
+		err := w.stream.Send(&unirpc.ExportSnapshotResponse{
+			Data: fn.CopySlice(p[start:end]),
+		})
+		if err != nil {
+			return start, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// snapshotStreamReader is an io.Reader that reads the pieces of a universe
+// snapshot received over an RPC stream.
+type snapshotStreamReader struct {
+	stream unirpc.Universe_ImportSnapshotServer
+
+	// pending is the part of the last received piece that wasn't read yet.
+	pending []byte
+}
+
+// Read reads the next bytes of the snapshot, receiving the next piece from the
+// stream if needed. Once the client closed the stream, io.EOF is returned.
+func (r *snapshotStreamReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.pending = req.Data
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
+
+// marshalSnapshotSummary marshals the summary of a universe snapshot into the
+// RPC counterpart.
+func marshalSnapshotSummary(
+	s *universe.SnapshotSummary) *unirpc.SnapshotSummary {
+
+	return &unirpc.SnapshotSummary{
+		CreationTimeUnix: s.CreatedAt.Unix(),
+		IssuanceRoot:     marshalMssmtNode(s.IssuanceRoot),
+		TransferRoot:     marshalMssmtNode(s.TransferRoot),
+		NumUniverses:     s.NumUniverses,
+		NumLeaves:        s.NumLeaves,
+	}
+}
+
+// ExportSnapshot writes every leaf of every known universe, along with the
+// universe and multiverse roots, to a snapshot that is streamed back in pieces.
+func (r *rpcServer) ExportSnapshot(req *unirpc.ExportSnapshotRequest,
+	stream unirpc.Universe_ExportSnapshotServer) error {
+
+	if req.ChunkSize > universe.MaxPageSize {
+		return fmt.Errorf("chunk size must be at most %d",
+			universe.MaxPageSize)
+	}
+
+	// We buffer the writes, so the many small records of the snapshot
+	// don't each result in a separate message.
+	w := bufio.NewWriterSize(
+		&snapshotStreamWriter{stream: stream}, snapshotPieceSize,
+	)
+	summary, err := universe.ExportSnapshot(
+		stream.Context(), r.cfg.UniverseArchive, w, time.Now(),
+		int(req.ChunkSize),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export snapshot: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("unable to send snapshot: %w", err)
+	}
+
+	rpcsLog.Infof("Exported universe snapshot with %d universes and %d "+
+		"leaves", summary.NumUniverses, summary.NumLeaves)
+
+	return stream.Send(&unirpc.ExportSnapshotResponse{
+		Summary: marshalSnapshotSummary(summary),
+	})
+}
+
+// ImportSnapshot reads a snapshot created by ExportSnapshot that is streamed to
+// the server in pieces, and inserts the leaves of its universes once they match
+// the universe roots stored in the snapshot.
+func (r *rpcServer) ImportSnapshot(
+	stream unirpc.Universe_ImportSnapshotServer) error {
+
+	summary, err := universe.ImportSnapshot(
+		stream.Context(), &snapshotStreamReader{stream: stream},
+		r.cfg.UniverseArchive,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import snapshot: %w", err)
+	}
+
+	rpcsLog.Infof("Imported universe snapshot with %d universes and %d "+
+		"leaves", summary.NumUniverses, summary.NumLeaves)
+
+	return stream.SendAndClose(&unirpc.ImportSnapshotResponse{
+		Summary: marshalSnapshotSummary(summary),
+	})
+}
+
 // ProveAssetOwnership creates an ownership proof embedded in an asset
 // transition proof. That ownership proof is a signed virtual transaction
 // spending the asset with a valid witness to prove the prover owns the keys
@@ -6249,6 +7379,80 @@ func (r *rpcServer) QueryAssetStats(ctx context.Context,
 	return resp, nil
 }
 
+// SearchAssets searches the assets of the issuance universes by their name
+// and the keys and values of their revealed JSON meta data.
+func (r *rpcServer) SearchAssets(ctx context.Context,
+	req *unirpc.SearchAssetsRequest) (*unirpc.SearchAssetsResponse, error) {
+
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit must not be negative")
+	}
+
+	query := universe.AssetSearchQuery{
+		Text:          req.Query,
+		SortBy:        universe.AssetSearchSort(req.SortBy),
+		SortDirection: universe.SortDirection(req.Direction),
+		Offset:        int(req.Offset),
+		Limit:         int(req.Limit),
+	}
+
+	switch req.AssetTypeFilter {
+	case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
+		query.AssetTypeFilter = fn.Ptr(asset.Normal)
+
+	case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
+		query.AssetTypeFilter = fn.Ptr(asset.Collectible)
+	}
+
+	if len(req.GroupKeyFilter) > 0 {
+		groupKey, err := btcec.ParsePubKey(req.GroupKeyFilter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key filter: %w",
+				err)
+		}
+
+		query.GroupKeyFilter = groupKey
+	}
+
+	if req.DecimalDisplayFilter != nil {
+		query.DecimalDisplayFilter = fn.Ptr(
+			req.DecimalDisplayFilter.DecimalDisplay,
+		)
+	}
+
+	results, err := r.cfg.UniverseSearcher.SearchAssets(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to search assets: %w", err)
+	}
+
+	resp := &unirpc.SearchAssetsResponse{
+		Assets: make([]*unirpc.AssetSearchResult, len(results)),
+	}
+	for idx, result := range results {
+		rpcResult := &unirpc.AssetSearchResult{
+			AssetId:       fn.ByteSlice(result.AssetID),
+			AssetName:     result.AssetName,
+			AssetType:     taprpc.AssetType(result.AssetType),
+			MetaJson:      result.MetaJSON,
+			GenesisHeight: int32(result.GenesisHeight),
+			Rank:          result.Rank,
+		}
+		if result.GroupKey != nil {
+			groupKey := result.GroupKey.SerializeCompressed()
+			rpcResult.GroupKey = groupKey
+		}
+		result.DecimalDisplay.WhenSome(func(d uint32) {
+			rpcResult.DecimalDisplay = &taprpc.DecimalDisplay{
+				DecimalDisplay: d,
+			}
+		})
+
+		resp.Assets[idx] = rpcResult
+	}
+
+	return resp, nil
+}
+
 // QueryEvents returns the number of sync and proof events for a given time
 // period, grouped by day.
 func (r *rpcServer) QueryEvents(ctx context.Context,
@@ -6291,6 +7495,24 @@ func (r *rpcServer) QueryEvents(ctx context.Context,
 		}
 	}
 
+	// Any equivocation of a federation member detected in the time period
+	// is returned as well, as evidence of the fraud.
+	equivocations, err := r.cfg.UniverseGossiper.QueryEquivocations(
+		ctx, startTime, endTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error querying equivocations: %w", err)
+	}
+
+	rpcStats.Equivocations = make(
+		[]*unirpc.UniverseEquivocation, len(equivocations),
+	)
+	for idx := range equivocations {
+		rpcStats.Equivocations[idx] = marshalUniverseEquivocation(
+			&equivocations[idx],
+		)
+	}
+
 	return rpcStats, nil
 }
 
@@ -6486,67 +7708,128 @@ func (r *rpcServer) AddAssetBuyOrder(ctx context.Context,
 		return nil, fmt.Errorf("error unmarshalling buy order: %w", err)
 	}
 
-	// Currently, we require the peer to be specified in the buy order.
-	peer, err := buyOrder.Peer.UnwrapOrErr(
-		fmt.Errorf("buy order peer must be specified"),
+	// Make sure we have a channel with the peer, if one is specified.
+	err = r.checkOrderPeer(
+		ctx, buyOrder.Peer, buyOrder.AssetSpecifier,
+		req.SkipAssetChannelCheck,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if we have a channel with the peer.
-	err = r.checkPeerChannel(
-		ctx, peer, buyOrder.AssetSpecifier, req.SkipAssetChannelCheck,
+	destPeer := orderPeerString(buyOrder.Peer)
+	rpcsLog.Debugf("[AddAssetBuyOrder]: upserting buy order "+
+		"(dest_peer=%s)", destPeer)
+
+	// Upsert the buy order into the RFQ manager. The returned auction
+	// collects the responses of all peers the order was sent to.
+	auction, err := r.cfg.RfqManager.UpsertAssetBuyOrder(*buyOrder)
+	if err != nil {
+		return nil, fmt.Errorf("error upserting buy order into RFQ "+
+			"manager: %w", err)
+	}
+	defer auction.Close()
+
+	result, event, err := r.awaitQuoteAuction(
+		auction, req.TimeoutSeconds, destPeer,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error checking peer channel: %w", err)
+		return nil, err
 	}
 
-	rpcsLog.Debugf("[AddAssetBuyOrder]: upserting buy order "+
-		"(dest_peer=%s)", peer.String())
+	resp, err := taprpc.NewAddAssetBuyOrderResponse(event)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling buy order response: "+
+			"%w", err)
+	}
 
-	// Register an event listener before actually inserting the order, so we
-	// definitely don't miss any responses.
-	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
-	defer eventSubscriber.Stop()
+	for _, acceptedEvent := range result.Accepted {
+		e, ok := acceptedEvent.(*rfq.PeerAcceptedBuyQuoteEvent)
+		if !ok {
+			continue
+		}
 
-	err = r.cfg.RfqManager.RegisterSubscriber(eventSubscriber, false, 0)
-	if err != nil {
-		return nil, fmt.Errorf("failed to register event listener: %w",
-			err)
+		quote, err := taprpc.MarshalAcceptedBuyQuoteEvent(e)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.CompetingQuotes = append(resp.CompetingQuotes, quote)
 	}
 
-	defer func() {
-		_ = r.cfg.RfqManager.RemoveSubscriber(eventSubscriber)
-	}()
+	return resp, nil
+}
+
+// checkOrderPeer checks if there is a channel with the peer of an order, if the
+// order specifies a peer. Orders without a peer are sent to all peers that we
+// have an asset channel with, so the asset channel check can't be skipped for
+// them.
+func (r *rpcServer) checkOrderPeer(ctx context.Context,
+	orderPeer fn.Option[route.Vertex], specifier asset.Specifier,
+	skipAssetChannelCheck bool) error {
 
-	// Upsert the buy order into the RFQ manager.
-	err = r.cfg.RfqManager.UpsertAssetBuyOrder(*buyOrder)
+	if orderPeer.IsNone() {
+		if skipAssetChannelCheck {
+			return fmt.Errorf("asset channel check can only be " +
+				"skipped for orders with a peer")
+		}
+
+		return nil
+	}
+
+	peer := orderPeer.UnwrapOr(route.Vertex{})
+	err := r.checkPeerChannel(ctx, peer, specifier, skipAssetChannelCheck)
 	if err != nil {
-		return nil, fmt.Errorf("error upserting buy order into RFQ "+
-			"manager: %w", err)
+		return fmt.Errorf("error checking peer channel: %w", err)
+	}
+
+	return nil
+}
+
+// orderPeerString returns the peer an order is sent to as a string, or "all" if
+// the order is sent to all peers that we have an asset channel with.
+func orderPeerString(orderPeer fn.Option[route.Vertex]) string {
+	if orderPeer.IsNone() {
+		return "all"
 	}
 
-	timeout := time.After(time.Second * time.Duration(req.TimeoutSeconds))
+	return orderPeer.UnwrapOr(route.Vertex{}).String()
+}
 
-	for {
-		select {
-		case event := <-eventSubscriber.NewItemCreated.ChanOut():
-			resp, err := taprpc.NewAddAssetBuyOrderResponse(event)
-			if err != nil {
-				return nil, fmt.Errorf("error marshalling "+
-					"buy order response: %w", err)
-			}
+// awaitQuoteAuction waits until the given quote auction is closed or the
+// timeout elapsed. The auction result is returned, along with the event that
+// the order should be answered with: the best accepted quote, or the first
+// rejection if no quote was accepted.
+func (r *rpcServer) awaitQuoteAuction(auction *rfq.QuoteAuction,
+	timeoutSeconds uint32, destPeer string) (*rfq.QuoteAuctionResult,
+	fn.Event, error) {
 
-			return resp, nil
+	timeout := time.After(time.Second * time.Duration(timeoutSeconds))
 
-		case <-r.quit:
-			return nil, fmt.Errorf("server shutting down")
+	var timedOut bool
+	select {
+	case <-auction.Done():
+	case <-r.quit:
+		return nil, nil, fmt.Errorf("server shutting down")
+	case <-timeout:
+		timedOut = true
+	}
 
-		case <-timeout:
-			return nil, fmt.Errorf("timeout waiting for response "+
-				"(peer=%s)", peer.String())
-		}
+	result := auction.Result()
+	switch {
+	case len(result.Accepted) > 0:
+		return &result, result.Accepted[0], nil
+
+	case len(result.Rejected) > 0:
+		return &result, result.Rejected[0], nil
+
+	case timedOut:
+		return nil, nil, fmt.Errorf("timeout waiting for response "+
+			"(peer=%s)", destPeer)
+
+	default:
+		return nil, nil, fmt.Errorf("no response received for order "+
+			"(peer=%s)", destPeer)
 	}
 }
 
@@ -6665,68 +7948,54 @@ func (r *rpcServer) AddAssetSellOrder(ctx context.Context,
 			err)
 	}
 
-	// Currently, we require the peer to be specified in the buy order.
-	peer, err := sellOrder.Peer.UnwrapOrErr(
-		fmt.Errorf("sell order peer must be specified"),
+	// Make sure we have a channel with the peer, if one is specified.
+	err = r.checkOrderPeer(
+		ctx, sellOrder.Peer, sellOrder.AssetSpecifier,
+		req.SkipAssetChannelCheck,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if we have a channel with the peer.
-	err = r.checkPeerChannel(
-		ctx, peer, sellOrder.AssetSpecifier, req.SkipAssetChannelCheck,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error checking peer channel: %w", err)
-	}
-
+	destPeer := orderPeerString(sellOrder.Peer)
 	rpcsLog.Debugf("[AddAssetSellOrder]: upserting sell order "+
-		"(dest_peer=%s)", peer.String())
-
-	// Register an event listener before actually inserting the order, so we
-	// definitely don't miss any responses.
-	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
-	defer eventSubscriber.Stop()
-
-	err = r.cfg.RfqManager.RegisterSubscriber(eventSubscriber, false, 0)
-	if err != nil {
-		return nil, fmt.Errorf("failed to register event listener: %w",
-			err)
-	}
-
-	defer func() {
-		_ = r.cfg.RfqManager.RemoveSubscriber(eventSubscriber)
-	}()
+		"(dest_peer=%s)", destPeer)
 
-	// Upsert the order into the RFQ manager.
-	err = r.cfg.RfqManager.UpsertAssetSellOrder(*sellOrder)
+	// Upsert the order into the RFQ manager. The returned auction collects
+	// the responses of all peers the order was sent to.
+	auction, err := r.cfg.RfqManager.UpsertAssetSellOrder(*sellOrder)
 	if err != nil {
 		return nil, fmt.Errorf("error upserting sell order into RFQ "+
 			"manager: %w", err)
 	}
+	defer auction.Close()
 
-	timeout := time.After(time.Second * time.Duration(req.TimeoutSeconds))
-
-	for {
-		select {
-		case event := <-eventSubscriber.NewItemCreated.ChanOut():
-			resp, err := taprpc.NewAddAssetSellOrderResponse(event)
-			if err != nil {
-				return nil, fmt.Errorf("error marshalling "+
-					"sell order response: %w", err)
-			}
-
-			return resp, nil
+	result, event, err := r.awaitQuoteAuction(
+		auction, req.TimeoutSeconds, destPeer,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		case <-r.quit:
-			return nil, fmt.Errorf("server shutting down")
+	resp, err := taprpc.NewAddAssetSellOrderResponse(event)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling sell order response: "+
+			"%w", err)
+	}
 
-		case <-timeout:
-			return nil, fmt.Errorf("timeout waiting for response "+
-				"from peer %s", peer.String())
+	for _, acceptedEvent := range result.Accepted {
+		e, ok := acceptedEvent.(*rfq.PeerAcceptedSellQuoteEvent)
+		if !ok {
+			continue
 		}
+
+		resp.CompetingQuotes = append(
+			resp.CompetingQuotes,
+			taprpc.MarshalAcceptedSellQuoteEvent(e),
+		)
 	}
+
+	return resp, nil
 }
 
 // AddAssetSellOffer upserts a new sell offer for the given asset into the
@@ -6893,6 +8162,94 @@ func (r *rpcServer) QueryPeerAcceptedQuotes(_ context.Context,
 	}, nil
 }
 
+// QueryQuoteHistory is used to query the history of the quotes that were
+// accepted by our node or by our peers, including the expired ones that were
+// not pruned yet.
+func (r *rpcServer) QueryQuoteHistory(ctx context.Context,
+	req *rfqrpc.QueryQuoteHistoryRequest) (*rfqrpc.QueryQuoteHistoryResponse,
+	error) {
+
+	if req.Limit > universe.MaxPageSize || req.Limit < 0 {
+		return nil, fmt.Errorf("invalid request limit: %d", req.Limit)
+	}
+
+	if req.Offset < 0 {
+		return nil, fmt.Errorf("invalid request offset: %d", req.Offset)
+	}
+
+	query := rfq.QuoteQuery{
+		StartTime: time.Unix(req.StartTimestamp, 0),
+		EndTime:   time.Now(),
+		Offset:    req.Offset,
+		Limit:     req.Limit,
+	}
+	if req.EndTimestamp != 0 {
+		query.EndTime = time.Unix(req.EndTimestamp, 0)
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultQuoteHistoryLimit
+	}
+
+	if len(req.Peer) > 0 {
+		peer, err := route.NewVertexFromBytes(req.Peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer: %w", err)
+		}
+
+		query.Peer = fn.Some(peer)
+	}
+
+	quotes, err := r.cfg.RfqManager.QueryQuoteHistory(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error querying quote history: %w", err)
+	}
+
+	return &rfqrpc.QueryQuoteHistoryResponse{
+		Quotes: fn.Map(quotes, marshalHistoricalQuote),
+	}, nil
+}
+
+// marshalHistoricalQuote marshals a persisted quote into the RPC form.
+func marshalHistoricalQuote(quote *rfq.QuoteRecord) *rfqrpc.HistoricalQuote {
+	id := quote.ID()
+	assetRate := quote.AssetRate()
+	rpcQuote := &rfqrpc.HistoricalQuote{
+		LocalAccept: quote.LocalAccept,
+		Peer:        quote.Peer().String(),
+		Id:          id[:],
+		Scid:        uint64(quote.Scid()),
+		AssetRate: &rfqrpc.FixedPoint{
+			Coefficient: assetRate.Rate.Coefficient.String(),
+			Scale:       uint32(assetRate.Rate.Scale),
+		},
+		Expiry:      uint64(assetRate.Expiry.Unix()),
+		AcceptedAt:  quote.CreatedAt.Unix(),
+		HtlcAmtMsat: uint64(quote.HtlcAmount()),
+	}
+
+	var specifier asset.Specifier
+	switch {
+	case quote.BuyAccept != nil:
+		rpcQuote.Type = rfqrpc.QuoteType_QUOTE_TYPE_BUY
+		rpcQuote.AssetMaxAmount = quote.BuyAccept.Request.AssetMaxAmt
+		specifier = quote.BuyAccept.Request.AssetSpecifier
+
+	case quote.SellAccept != nil:
+		rpcQuote.Type = rfqrpc.QuoteType_QUOTE_TYPE_SELL
+		rpcQuote.PaymentMaxAmtMsat = uint64(
+			quote.SellAccept.Request.PaymentMaxAmt,
+		)
+		specifier = quote.SellAccept.Request.AssetSpecifier
+	}
+
+	rpcQuote.AssetId, rpcQuote.GroupKey = specifier.AsBytes()
+	quote.BaseScid.WhenSome(func(baseScid lnwire.ShortChannelID) {
+		rpcQuote.BaseScid = baseScid.ToUint64()
+	})
+
+	return rpcQuote
+}
+
 // marshallRfqEvent marshals an RFQ event into the RPC form.
 func marshallRfqEvent(eventInterface fn.Event) (*rfqrpc.RfqEvent, error) {
 	timestamp := eventInterface.Timestamp().UTC().UnixMicro()
@@ -6940,6 +8297,32 @@ func marshallRfqEvent(eventInterface fn.Event) (*rfqrpc.RfqEvent, error) {
 			Event: eventRpc,
 		}, nil
 
+	case *rfq.QuoteDeviationEvent:
+		eventRpc := &rfqrpc.RfqEvent_QuoteDeviation{
+			QuoteDeviation: &rfqrpc.QuoteDeviationEvent{
+				Timestamp: uint64(timestamp),
+				Peer:      event.Peer.String(),
+				Id:        event.ID[:],
+				RateHint:  event.RateHint,
+				PeerRate: &rfqrpc.FixedPoint{
+					Coefficient: event.PeerRate.Coefficient.
+						String(),
+					Scale: uint32(event.PeerRate.Scale),
+				},
+				OracleRate: &rfqrpc.FixedPoint{
+					Coefficient: event.OracleRate.
+						Coefficient.String(),
+					Scale: uint32(event.OracleRate.Scale),
+				},
+				DeviationPpm:    event.DeviationPpm,
+				TolerancePpm:    event.TolerancePpm,
+				WithinTolerance: event.WithinTolerance,
+			},
+		}
+		return &rfqrpc.RfqEvent{
+			Event: eventRpc,
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown RFQ event type: %T",
 			eventInterface)
@@ -6951,8 +8334,19 @@ func (r *rpcServer) SubscribeRfqEventNtfns(
 	_ *rfqrpc.SubscribeRfqEventNtfnsRequest,
 	ntfnStream rfqrpc.Rfq_SubscribeRfqEventNtfnsServer) error {
 
+	// Only the events that can be marshalled are forwarded, as any other
+	// event would otherwise terminate the subscription.
 	filter := func(event fn.Event) (bool, error) {
-		return true, nil
+		switch event.(type) {
+		case *rfq.PeerAcceptedBuyQuoteEvent,
+			*rfq.PeerAcceptedSellQuoteEvent, *rfq.AcceptHtlcEvent,
+			*rfq.QuoteDeviationEvent:
+
+			return true, nil
+
+		default:
+			return false, nil
+		}
 	}
 
 	return handleEvents[uint64, *rfqrpc.RfqEvent](
@@ -7730,6 +9124,70 @@ func (r *rpcServer) DeclareScriptKey(ctx context.Context,
 	}, nil
 }
 
+// ConsolidateAssetUtxos merges all anchor outputs that carry the same asset ID
+// or asset group into a single anchor output, for every asset that is spread
+// over at least the configured threshold of anchor outputs. If dry_run is set,
+// only the planned consolidations are returned.
+func (r *rpcServer) ConsolidateAssetUtxos(ctx context.Context,
+	req *wrpc.ConsolidateAssetUtxosRequest) (
+	*wrpc.ConsolidateAssetUtxosResponse, error) {
+
+	var (
+		plan    *tapfreighter.ConsolidationPlan
+		parcels []*tapfreighter.OutboundParcel
+		err     error
+	)
+	if req.DryRun {
+		plan, err = r.cfg.Consolidator.PlanConsolidations(ctx)
+	} else {
+		plan, parcels, err = r.cfg.Consolidator.Consolidate(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to consolidate asset UTXOs: %w",
+			err)
+	}
+
+	resp := &wrpc.ConsolidateAssetUtxosResponse{
+		Consolidations: make(
+			[]*wrpc.PlannedConsolidation, len(plan.Consolidations),
+		),
+		FeeRateSatKw:         uint32(plan.FeeRate),
+		MaxFeeRateSatKw:      uint32(plan.MaxFeeRate),
+		FeeRateTooHigh:       plan.FeeRateTooHigh,
+		TotalEstimatedFeeSat: int64(plan.TotalEstimatedFee()),
+		Transfers: make(
+			[]*taprpc.AssetTransfer, len(parcels),
+		),
+	}
+	for idx, consolidation := range plan.Consolidations {
+		assetID, groupKey := consolidation.AssetSpecifier.AsBytes()
+		anchorPoints := fn.Map(
+			consolidation.AnchorPoints,
+			func(o wire.OutPoint) string {
+				return o.String()
+			},
+		)
+
+		resp.Consolidations[idx] = &wrpc.PlannedConsolidation{
+			AssetId:         assetID,
+			GroupKey:        groupKey,
+			AnchorOutpoints: anchorPoints,
+			TotalAmount:     consolidation.TotalAmount,
+			EstimatedFeeSat: int64(consolidation.EstimatedFee),
+		}
+	}
+
+	for idx := range parcels {
+		resp.Transfers[idx], err = marshalOutboundParcel(parcels[idx])
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal parcel: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
 // serialize is a helper function that serializes a serializable object into a
 // byte slice.
 func serialize(s interface{ Serialize(io.Writer) error }) ([]byte, error) {
@@ -8060,3 +9518,77 @@ func (r *rpcServer) DecodeAssetPayReq(ctx context.Context,
 
 	return &resp, nil
 }
+
+// ExportChannelBackup creates an encrypted backup of the asset state of the
+// given Taproot Asset channels, or of all channels if none are specified.
+func (r *rpcServer) ExportChannelBackup(ctx context.Context,
+	req *tchrpc.ExportChannelBackupRequest) (
+	*tchrpc.ExportChannelBackupResponse, error) {
+
+	// If we're not running inside litd, we cannot offer this functionality.
+	if !r.cfg.EnableChannelFeatures {
+		return nil, fmt.Errorf("the Taproot Asset channel " +
+			"functionality is only available when running inside " +
+			"Lightning Terminal daemon (litd), with lnd and tapd " +
+			"both running in 'integrated' mode")
+	}
+
+	chanPoints := make([]wire.OutPoint, 0, len(req.ChanPoints))
+	for _, chanPointStr := range req.ChanPoints {
+		chanPoint, err := wire.NewOutPointFromString(chanPointStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel point %v: %w",
+				chanPointStr, err)
+		}
+
+		chanPoints = append(chanPoints, *chanPoint)
+	}
+
+	backup, err := r.cfg.ChannelBackupManager.ExportChannelBackups(
+		ctx, chanPoints...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export channel backup: %w",
+			err)
+	}
+
+	return &tchrpc.ExportChannelBackupResponse{
+		Backup: backup,
+	}, nil
+}
+
+// RestoreChannelBackup restores an encrypted asset channel backup that was
+// created with ExportChannelBackup.
+func (r *rpcServer) RestoreChannelBackup(ctx context.Context,
+	req *tchrpc.RestoreChannelBackupRequest) (
+	*tchrpc.RestoreChannelBackupResponse, error) {
+
+	// If we're not running inside litd, we cannot offer this functionality.
+	if !r.cfg.EnableChannelFeatures {
+		return nil, fmt.Errorf("the Taproot Asset channel " +
+			"functionality is only available when running inside " +
+			"Lightning Terminal daemon (litd), with lnd and tapd " +
+			"both running in 'integrated' mode")
+	}
+
+	if len(req.Backup) == 0 {
+		return nil, fmt.Errorf("backup must be specified")
+	}
+
+	chanPoints, err := r.cfg.ChannelBackupManager.RestoreChannelBackups(
+		ctx, req.Backup,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to restore channel backup: %w",
+			err)
+	}
+
+	resp := &tchrpc.RestoreChannelBackupResponse{
+		ChanPoints: make([]string, 0, len(chanPoints)),
+	}
+	for _, chanPoint := range chanPoints {
+		resp.ChanPoints = append(resp.ChanPoints, chanPoint.String())
+	}
+
+	return resp, nil
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/perms"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/rpcperms"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	cmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
@@ -195,11 +197,48 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %w", err)
 	}
 
+	if err := s.cfg.Consolidator.Start(); err != nil {
+		return fmt.Errorf("unable to start consolidator: %w", err)
+	}
+
+	// Before the universe server starts serving requests, we warm up the
+	// multiverse caches with the state that was persisted on the last
+	// shutdown. Failing to do so only means we start with empty caches.
+	err = s.cfg.Multiverse.LoadCacheState(context.Background())
+	if err != nil {
+		srvrLog.Warnf("Unable to load multiverse cache state: %v", err)
+	}
+
+	// The SPV header chain is synced before any universe proofs are
+	// received, if it's enabled.
+	var headerChainErr error
+	s.cfg.SpvHeaderChain.WhenSome(func(headerChain *proof.HeaderChain) {
+		headerChainErr = headerChain.Start()
+	})
+	if headerChainErr != nil {
+		return fmt.Errorf("unable to start SPV header chain: %w",
+			headerChainErr)
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %w", err)
 	}
 
+	if err := s.cfg.UniverseCommitter.Start(); err != nil {
+		return fmt.Errorf("unable to start universe committer: %w",
+			err)
+	}
+
+	if err := s.cfg.UniverseGossiper.Start(); err != nil {
+		return fmt.Errorf("unable to start universe gossiper: %w",
+			err)
+	}
+
+	if err := s.cfg.UniversePruner.Start(); err != nil {
+		return fmt.Errorf("unable to start universe pruner: %w", err)
+	}
+
 	// Start the request for quote (RFQ) manager.
 	if err := s.cfg.RfqManager.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ manager: %w", err)
@@ -219,6 +258,9 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 	if err := s.cfg.AuxInvoiceManager.Start(); err != nil {
 		return fmt.Errorf("unable to start aux invoice mgr: %w", err)
 	}
+	if err := s.cfg.ChannelBackupManager.Start(); err != nil {
+		return fmt.Errorf("unable to start channel backup mgr: %w", err)
+	}
 	if err := s.cfg.AuxSweeper.Start(); err != nil {
 		return fmt.Errorf("unable to start aux sweeper mgr: %w", err)
 	}
@@ -398,6 +440,21 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		// Provide Prometheus collectors with access to Universe stats.
 		s.cfg.Prometheus.UniverseStats = s.cfg.UniverseStats
 
+		// Provide Prometheus collectors with access to the universe
+		// admission stats.
+		s.cfg.Prometheus.UniverseAdmitter = s.cfg.UniverseAdmitter
+
+		// Provide Prometheus collectors with access to the multiverse
+		// cache warm start stats.
+		s.cfg.Prometheus.Multiverse = s.cfg.Multiverse
+
+		// Provide Prometheus collectors with access to the query stats
+		// of the composite price oracle, if it is used.
+		oracle := s.cfg.PriceOracle
+		if composite, ok := oracle.(*rfq.CompositePriceOracle); ok {
+			s.cfg.Prometheus.PriceOracle = composite
+		}
+
 		// Provide Prometheus collectors with access to the asset store.
 		s.cfg.Prometheus.AssetStore = s.cfg.AssetStore
 
@@ -652,6 +709,10 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.Consolidator.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.ChainPorter.Stop(); err != nil {
 		return err
 	}
@@ -660,6 +721,34 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.UniverseCommitter.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.UniverseGossiper.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.UniversePruner.Stop(); err != nil {
+		return err
+	}
+
+	var headerChainErr error
+	s.cfg.SpvHeaderChain.WhenSome(func(headerChain *proof.HeaderChain) {
+		headerChainErr = headerChain.Stop()
+	})
+	if headerChainErr != nil {
+		return headerChainErr
+	}
+
+	// Now that the universe subsystems are stopped, we persist the state
+	// of the multiverse caches so they're warm on the next startup.
+	err := s.cfg.Multiverse.StoreCacheState(context.Background())
+	if err != nil {
+		srvrLog.Errorf("Unable to store multiverse cache state: %v",
+			err)
+	}
+
 	if err := s.cfg.RfqManager.Stop(); err != nil {
 		return err
 	}
@@ -708,6 +797,17 @@ var _ chancloser.AuxChanCloser = (*Server)(nil)
 var _ lnwl.AuxContractResolver = (*Server)(nil)
 var _ sweep.AuxSweeper = (*Server)(nil)
 
+// isReady returns true if the server is ready to serve requests, without
+// blocking.
+func (s *Server) isReady() bool {
+	select {
+	case <-s.ready:
+		return true
+	default:
+		return false
+	}
+}
+
 // waitForReady blocks until the server is ready to serve requests. If the
 // server is shutting down before we ever become ready, an error is returned.
 func (s *Server) waitForReady() error {
@@ -767,6 +867,19 @@ func (s *Server) FetchLeavesFromCommit(chanState lnwl.AuxChanState,
 		"theirBalance=%v, numHtlcs=%d", com.LocalBalance,
 		com.RemoteBalance, len(com.Htlcs))
 
+	// We keep track of the latest remote commitment of each channel, so
+	// the asset state of the channel can be backed up. We only do this
+	// once the server is ready, as we don't want to block on it.
+	if whoseCommit.IsRemote() && s.isReady() {
+		err := s.cfg.ChannelBackupManager.TrackRemoteCommit(
+			chanState, com, keys,
+		)
+		if err != nil {
+			srvrLog.Warnf("Unable to track remote commitment for "+
+				"channel backup: %v", err)
+		}
+	}
+
 	// The aux leaf creator is fully stateless, and we don't need to wait
 	// for the server to be started before being able to use it.
 	return tapchannel.FetchLeavesFromCommit(
@@ -0,0 +1,52 @@
+package taprootassets
+
+import (
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+)
+
+// SpvChainLookupGenerator is an implementation of the
+// proof.ChainLookupGenerator interface that uses the SPV header chain instead
+// of a chain backend to look up block information.
+type SpvChainLookupGenerator struct {
+	headerChain *proof.HeaderChain
+
+	assetStore *tapdb.AssetStore
+}
+
+// NewSpvChainLookupGenerator creates a new chain lookup generator that is
+// backed by the given SPV header chain.
+func NewSpvChainLookupGenerator(headerChain *proof.HeaderChain,
+	assetStore *tapdb.AssetStore) *SpvChainLookupGenerator {
+
+	return &SpvChainLookupGenerator{
+		headerChain: headerChain,
+		assetStore:  assetStore,
+	}
+}
+
+// GenFileChainLookup generates a chain lookup interface for the given
+// proof file that can be used to validate proofs.
+func (s *SpvChainLookupGenerator) GenFileChainLookup(
+	f *proof.File) asset.ChainLookup {
+
+	return NewProofChainLookup(s.headerChain, s.assetStore, f)
+}
+
+// GenProofChainLookup generates a chain lookup interface for the given
+// single proof that can be used to validate proofs.
+func (s *SpvChainLookupGenerator) GenProofChainLookup(
+	p *proof.Proof) (asset.ChainLookup, error) {
+
+	f, err := proof.NewFile(proof.V0, *p)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewProofChainLookup(s.headerChain, s.assetStore, f), nil
+}
+
+// A compile time assertion to ensure SpvChainLookupGenerator meets the
+// proof.ChainLookupGenerator interface.
+var _ proof.ChainLookupGenerator = (*SpvChainLookupGenerator)(nil)
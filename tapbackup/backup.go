@@ -0,0 +1,283 @@
+package tapbackup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/tlvenc"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnencrypt"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// Version is the version of the backup format.
+type Version uint8
+
+const (
+	// V0 is the first version of the backup format.
+	V0 Version = 0
+
+	// LatestVersion is the latest version of the backup format that is
+	// created when exporting a backup.
+	LatestVersion = V0
+)
+
+var (
+	// MagicBytes are the magic bytes every encrypted backup starts with.
+	MagicBytes = [4]byte{0x74, 0x61, 0x70, 0x62}
+
+	// ErrInvalidMagicBytes is returned when a blob doesn't start with the
+	// backup magic bytes.
+	ErrInvalidMagicBytes = errors.New("backup: invalid magic bytes")
+
+	// ErrUnknownVersion is returned when a backup has a version that is
+	// not known to this daemon.
+	ErrUnknownVersion = errors.New("backup: unknown version")
+)
+
+// KeyRing is the key ring the backup encryption key is derived from.
+type KeyRing = tapgarden.KeyRing
+
+// AssetBackup is the backup of a single asset owned by the daemon.
+type AssetBackup struct {
+	// ProofFile is the full proof file of the asset. The asset itself
+	// along with its anchor output is the final state of this file.
+	ProofFile proof.Blob
+
+	// ScriptKey is the wallet specific derivation information of the
+	// asset's script key. This is nil if the script key isn't known to the
+	// wallet.
+	ScriptKey *asset.TweakedScriptKey
+
+	// AnchorInternalKey is the key descriptor of the internal key of the
+	// asset's anchor output. The locator is empty if the key wasn't
+	// derived by the wallet.
+	AnchorInternalKey keychain.KeyDescriptor
+}
+
+// Backup is the full backup of the state of a daemon that can't be recovered
+// from the seed of the backing lnd node alone.
+type Backup struct {
+	// Version is the version of the backup format.
+	Version Version
+
+	// CreationTime is the time the backup was created.
+	CreationTime time.Time
+
+	// Assets is the list of assets owned by the daemon, including the
+	// inputs of pending transfers.
+	Assets []*AssetBackup
+
+	// Addrs is the list of all address book entries.
+	Addrs []*address.AddrWithKeyInfo
+
+	// PendingTransfers is the list of outbound transfers that were
+	// broadcast but haven't been fully completed yet.
+	PendingTransfers []*tapfreighter.OutboundParcel
+}
+
+// Encode encodes the backup into the given writer. The version is not part of
+// the encoding, as it is prepended in plain text when the backup is encrypted.
+func (b *Backup) Encode(w io.Writer) error {
+	creationTime := uint64(b.CreationTime.Unix())
+
+	assets, err := tlvenc.EncodeList(b.Assets, encodeAsset)
+	if err != nil {
+		return fmt.Errorf("unable to encode assets: %w", err)
+	}
+
+	addrs, err := tlvenc.EncodeList(b.Addrs, encodeAddr)
+	if err != nil {
+		return fmt.Errorf("unable to encode addresses: %w", err)
+	}
+
+	transfers, err := tlvenc.EncodeList(b.PendingTransfers, encodeParcel)
+	if err != nil {
+		return fmt.Errorf("unable to encode pending transfers: %w", err)
+	}
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(backupCreationTimeType, &creationTime),
+		tlv.MakePrimitiveRecord(backupAssetsType, &assets),
+		tlv.MakePrimitiveRecord(backupAddrsType, &addrs),
+		tlv.MakePrimitiveRecord(backupPendingTransfersType, &transfers),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Decode decodes a backup from the given reader. Addresses are only accepted
+// if they were created for the given network.
+func (b *Backup) Decode(r io.Reader, chainParams *address.ChainParams) error {
+	var (
+		creationTime uint64
+		assets       []byte
+		addrs        []byte
+		transfers    []byte
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(backupCreationTimeType, &creationTime),
+		tlv.MakePrimitiveRecord(backupAssetsType, &assets),
+		tlv.MakePrimitiveRecord(backupAddrsType, &addrs),
+		tlv.MakePrimitiveRecord(backupPendingTransfersType, &transfers),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Decode(r); err != nil {
+		return err
+	}
+
+	b.CreationTime = time.Unix(int64(creationTime), 0)
+
+	b.Assets, err = tlvenc.DecodeList(assets, decodeAsset)
+	if err != nil {
+		return fmt.Errorf("unable to decode assets: %w", err)
+	}
+
+	b.Addrs, err = tlvenc.DecodeList(
+		addrs, func(addrBytes []byte) (*address.AddrWithKeyInfo,
+			error) {
+
+			return decodeAddr(addrBytes, chainParams)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode addresses: %w", err)
+	}
+
+	b.PendingTransfers, err = tlvenc.DecodeList(transfers, decodeParcel)
+	if err != nil {
+		return fmt.Errorf("unable to decode pending transfers: %w", err)
+	}
+
+	return nil
+}
+
+// encryptionKeyRing adapts the context aware key ring of the daemon to the key
+// ring interface lnd's encryption helpers expect.
+type encryptionKeyRing struct {
+	ctx     context.Context
+	keyRing KeyRing
+}
+
+// DeriveNextKey attempts to derive the *next* key within the key family
+// (account in BIP-0043) specified.
+func (e *encryptionKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return e.keyRing.DeriveNextKey(e.ctx, keyFam)
+}
+
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator.
+func (e *encryptionKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return e.keyRing.DeriveKey(e.ctx, keyLoc)
+}
+
+// A compile-time assertion to ensure encryptionKeyRing meets the
+// keychain.KeyRing interface.
+var _ keychain.KeyRing = (*encryptionKeyRing)(nil)
+
+// NewEncrypter creates a new encrypter with a key derived from the given key
+// ring. This is the same key lnd uses to encrypt its static channel backups,
+// so a backup can be decrypted by any daemon connected to an lnd node that was
+// restored from the same seed.
+func NewEncrypter(ctx context.Context,
+	keyRing KeyRing) (*lnencrypt.Encrypter, error) {
+
+	encrypter, err := lnencrypt.KeyRingEncrypter(&encryptionKeyRing{
+		ctx:     ctx,
+		keyRing: keyRing,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive encryption key: %w",
+			err)
+	}
+
+	return encrypter, nil
+}
+
+// EncryptBackup encodes and encrypts the given backup. The resulting blob
+// consists of the magic bytes and the version in plain text, followed by the
+// encrypted backup.
+func EncryptBackup(ctx context.Context, b *Backup,
+	keyRing KeyRing) ([]byte, error) {
+
+	if b.Version != V0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, b.Version)
+	}
+
+	var payload bytes.Buffer
+	if err := b.Encode(&payload); err != nil {
+		return nil, err
+	}
+
+	encrypter, err := NewEncrypter(ctx, keyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	var blob bytes.Buffer
+	blob.Write(MagicBytes[:])
+	blob.WriteByte(byte(b.Version))
+
+	err = encrypter.EncryptPayloadToWriter(payload.Bytes(), &blob)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt backup: %w", err)
+	}
+
+	return blob.Bytes(), nil
+}
+
+// DecryptBackup decrypts and decodes a backup that was created with
+// EncryptBackup.
+func DecryptBackup(ctx context.Context, blob []byte, keyRing KeyRing,
+	chainParams *address.ChainParams) (*Backup, error) {
+
+	headerLen := len(MagicBytes) + 1
+	if len(blob) < headerLen || !bytes.Equal(blob[:4], MagicBytes[:]) {
+		return nil, ErrInvalidMagicBytes
+	}
+
+	version := Version(blob[len(MagicBytes)])
+	if version != V0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	encrypter, err := NewEncrypter(ctx, keyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := encrypter.DecryptPayloadFromReader(
+		bytes.NewReader(blob[headerLen:]),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt backup: %w", err)
+	}
+
+	b := &Backup{
+		Version: version,
+	}
+	if err := b.Decode(bytes.NewReader(payload), chainParams); err != nil {
+		return nil, fmt.Errorf("unable to decode backup: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,317 @@
+package tapbackup
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainParams = &address.RegressionNetTap
+
+// testKeyRing is a key ring that always derives the same key.
+type testKeyRing struct {
+	privKey *btcec.PrivateKey
+}
+
+func (k *testKeyRing) DeriveNextKey(context.Context,
+	keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{PubKey: k.privKey.PubKey()}, nil
+}
+
+func (k *testKeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     k.privKey.PubKey(),
+	}, nil
+}
+
+func (k *testKeyRing) IsLocalKey(context.Context, keychain.KeyDescriptor) bool {
+	return true
+}
+
+// randAnchor returns a random transfer anchor.
+func randAnchor(t *testing.T) tapfreighter.Anchor {
+	internalKey, _ := test.RandKeyDesc(t)
+	return tapfreighter.Anchor{
+		OutPoint:         test.RandOp(t),
+		Value:            1_000,
+		InternalKey:      internalKey,
+		TaprootAssetRoot: test.RandBytes(32),
+		CommitmentVersion: fn.Ptr(
+			uint8(test.RandIntn(2)),
+		),
+		MerkleRoot:       test.RandBytes(32),
+		TapscriptSibling: test.RandBytes(34),
+		NumPassiveAssets: 1,
+	}
+}
+
+// randParcel returns a random pending parcel.
+func randParcel(t *testing.T) *tapfreighter.OutboundParcel {
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+		SignatureScript:  []byte{},
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: test.RandBytes(34),
+	})
+
+	scriptKeyDesc, _ := test.RandKeyDesc(t)
+	changeKeyDesc, _ := test.RandKeyDesc(t)
+	scriptKey := asset.NewScriptKeyBip86(scriptKeyDesc)
+	changeScriptKey := asset.NewScriptKeyBip86(changeKeyDesc)
+	passiveScriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  1,
+		},
+	})
+	changeScriptKey.TweakedScriptKey.Tweak = test.RandBytes(32)
+
+	return &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 123,
+		TransferTime:       time.Unix(time.Now().Unix(), 0),
+		ChainFees:          456,
+		PassiveAssets: []*tappsbt.VPacket{{
+			Inputs: []*tappsbt.VInput{{
+				PrevID: asset.PrevID{
+					OutPoint:  test.RandOp(t),
+					ID:        asset.RandID(t),
+					ScriptKey: asset.RandSerializedKey(t),
+				},
+			}},
+			Outputs: []*tappsbt.VOutput{{
+				Amount:                  10,
+				Type:                    tappsbt.TypeSimple,
+				Interactive:             true,
+				AnchorOutputInternalKey: test.RandPubKey(t),
+				ScriptKey:               passiveScriptKey,
+			}},
+			ChainParams: testChainParams,
+			Version:     tappsbt.V1,
+		}},
+		PassiveAssetsAnchor: fn.Ptr(randAnchor(t)),
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint:  test.RandOp(t),
+				ID:        asset.RandID(t),
+				ScriptKey: asset.RandSerializedKey(t),
+			},
+			Amount: 100,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor:           randAnchor(t),
+			Type:             tappsbt.TypeSimple,
+			ScriptKey:        asset.NewScriptKey(test.RandPubKey(t)),
+			Amount:           60,
+			LockTime:         1,
+			RelativeLockTime: 2,
+			AssetVersion:     asset.V1,
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: test.RandTxWitnesses(t),
+			}},
+			ProofSuffix:           test.RandBytes(100),
+			ProofCourierAddr:      []byte("universerpc://localhost"),
+			ProofDeliveryComplete: fn.Some(false),
+			Position:              0,
+		}, {
+			Anchor:         randAnchor(t),
+			Type:           tappsbt.TypeSplitRoot,
+			ScriptKey:      scriptKey,
+			ScriptKeyLocal: true,
+			Amount:         40,
+			SplitCommitmentRoot: mssmt.NewComputedNode(
+				mssmt.NodeHash(test.RandHash()), 100,
+			),
+			ProofSuffix: test.RandBytes(100),
+			Position:    1,
+		}, {
+			Anchor:         randAnchor(t),
+			Type:           tappsbt.TypeSimple,
+			ScriptKey:      changeScriptKey,
+			ScriptKeyLocal: true,
+			ProofSuffix:    test.RandBytes(100),
+			Position:       2,
+		}},
+	}
+}
+
+// randBackup returns a random backup.
+func randBackup(t *testing.T) *Backup {
+	internalKey, _ := test.RandKeyDesc(t)
+	scriptKeyDesc, _ := test.RandKeyDesc(t)
+	scriptKey := asset.NewScriptKeyBip86(scriptKeyDesc)
+	scriptKey.TweakedScriptKey.DeclaredKnown = true
+
+	courierAddr, err := url.Parse("universerpc://localhost:10029")
+	require.NoError(t, err)
+
+	addr, _, _ := address.RandAddr(t, testChainParams, *courierAddr)
+	addr.CreationTime = time.Unix(time.Now().Unix(), 0)
+
+	return &Backup{
+		Version:      V0,
+		CreationTime: time.Unix(time.Now().Unix(), 0),
+		Assets: []*AssetBackup{{
+			ProofFile:         test.RandBytes(200),
+			ScriptKey:         scriptKey.TweakedScriptKey,
+			AnchorInternalKey: internalKey,
+		}, {
+			ProofFile: test.RandBytes(200),
+			AnchorInternalKey: keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+			},
+		}},
+		Addrs:            []*address.AddrWithKeyInfo{addr},
+		PendingTransfers: []*tapfreighter.OutboundParcel{randParcel(t)},
+	}
+}
+
+// assertBackupEqual asserts that the two backups are equal. The passive asset
+// packets are compared by their serialization, as decoding doesn't restore
+// all in-memory fields of a packet. The genesis of an address isn't part of
+// its encoding either, so it is only attached when an address is imported.
+func assertBackupEqual(t *testing.T, expected, actual *Backup) {
+	t.Helper()
+
+	require.Len(t, actual.Addrs, len(expected.Addrs))
+	for idx := range expected.Addrs {
+		expectedTap := *expected.Addrs[idx].Tap
+		expectedTap.AttachGenesis(asset.Genesis{})
+
+		expectedAddr := *expected.Addrs[idx]
+		expectedAddr.Tap = &expectedTap
+		require.Equal(t, &expectedAddr, actual.Addrs[idx])
+	}
+
+	require.Len(t, actual.PendingTransfers, len(expected.PendingTransfers))
+	for idx := range expected.PendingTransfers {
+		expectedParcel := *expected.PendingTransfers[idx]
+		actualParcel := *actual.PendingTransfers[idx]
+
+		require.Len(
+			t, actualParcel.PassiveAssets,
+			len(expectedParcel.PassiveAssets),
+		)
+		for i := range expectedParcel.PassiveAssets {
+			expectedBytes, err := encodeVPacket(
+				expectedParcel.PassiveAssets[i],
+			)
+			require.NoError(t, err)
+			actualBytes, err := encodeVPacket(
+				actualParcel.PassiveAssets[i],
+			)
+			require.NoError(t, err)
+			require.Equal(t, expectedBytes, actualBytes)
+		}
+
+		expectedParcel.PassiveAssets = nil
+		actualParcel.PassiveAssets = nil
+		require.Equal(t, expectedParcel, actualParcel)
+	}
+
+	expectedCopy, actualCopy := *expected, *actual
+	expectedCopy.PendingTransfers = nil
+	actualCopy.PendingTransfers = nil
+	expectedCopy.Addrs = nil
+	actualCopy.Addrs = nil
+	require.Equal(t, expectedCopy, actualCopy)
+}
+
+// TestBackupEncoding tests that a backup can be encoded and decoded again.
+func TestBackupEncoding(t *testing.T) {
+	t.Parallel()
+
+	backup := randBackup(t)
+
+	var b bytes.Buffer
+	require.NoError(t, backup.Encode(&b))
+
+	decoded := &Backup{
+		Version: V0,
+	}
+	err := decoded.Decode(bytes.NewReader(b.Bytes()), testChainParams)
+	require.NoError(t, err)
+	assertBackupEqual(t, backup, decoded)
+
+	// Addresses of a different network must be rejected.
+	mainNetParams := address.ParamsForChain(chaincfg.MainNetParams.Name)
+	err = decoded.Decode(bytes.NewReader(b.Bytes()), &mainNetParams)
+	require.ErrorContains(t, err, "unable to decode address")
+
+	// An empty backup is valid too.
+	empty := &Backup{
+		Version:      V0,
+		CreationTime: time.Unix(time.Now().Unix(), 0),
+	}
+	b.Reset()
+	require.NoError(t, empty.Encode(&b))
+
+	decoded = &Backup{
+		Version: V0,
+	}
+	err = decoded.Decode(bytes.NewReader(b.Bytes()), testChainParams)
+	require.NoError(t, err)
+	require.Equal(t, empty.CreationTime, decoded.CreationTime)
+	require.Empty(t, decoded.Assets)
+	require.Empty(t, decoded.Addrs)
+	require.Empty(t, decoded.PendingTransfers)
+}
+
+// TestBackupEncryption tests that a backup can only be decrypted with the key
+// ring it was encrypted with.
+func TestBackupEncryption(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyRing := &testKeyRing{privKey: test.RandPrivKey()}
+
+	backup := randBackup(t)
+	blob, err := EncryptBackup(ctx, backup, keyRing)
+	require.NoError(t, err)
+	require.Equal(t, MagicBytes[:], blob[:len(MagicBytes)])
+
+	decrypted, err := DecryptBackup(ctx, blob, keyRing, testChainParams)
+	require.NoError(t, err)
+	assertBackupEqual(t, backup, decrypted)
+
+	// A different key ring can't decrypt the backup.
+	otherKeyRing := &testKeyRing{privKey: test.RandPrivKey()}
+	_, err = DecryptBackup(ctx, blob, otherKeyRing, testChainParams)
+	require.ErrorContains(t, err, "unable to decrypt backup")
+
+	// Neither can a backup with invalid magic bytes or an unknown version
+	// be decrypted.
+	invalidMagic := bytes.Clone(blob)
+	invalidMagic[0] ^= 0xff
+	_, err = DecryptBackup(ctx, invalidMagic, keyRing, testChainParams)
+	require.ErrorIs(t, err, ErrInvalidMagicBytes)
+
+	unknownVersion := bytes.Clone(blob)
+	unknownVersion[len(MagicBytes)] = 1
+	_, err = DecryptBackup(ctx, unknownVersion, keyRing, testChainParams)
+	require.ErrorIs(t, err, ErrUnknownVersion)
+}
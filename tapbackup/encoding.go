@@ -0,0 +1,825 @@
+package tapbackup
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/tlvenc"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	backupCreationTimeType     tlv.Type = 0
+	backupAssetsType           tlv.Type = 2
+	backupAddrsType            tlv.Type = 4
+	backupPendingTransfersType tlv.Type = 6
+
+	assetProofFileType         tlv.Type = 0
+	assetScriptKeyType         tlv.Type = 2
+	assetAnchorInternalKeyType tlv.Type = 4
+
+	addrEncodedType      tlv.Type = 0
+	addrScriptKeyType    tlv.Type = 2
+	addrInternalKeyType  tlv.Type = 4
+	addrCreationTimeType tlv.Type = 6
+	addrOutputKeyType    tlv.Type = 8
+
+	keyDescFamilyType tlv.Type = 0
+	keyDescIndexType  tlv.Type = 2
+	keyDescPubKeyType tlv.Type = 4
+
+	scriptKeyRawKeyType        tlv.Type = 0
+	scriptKeyTweakType         tlv.Type = 2
+	scriptKeyDeclaredKnownType tlv.Type = 4
+
+	parcelAnchorTxType           tlv.Type = 0
+	parcelHeightHintType         tlv.Type = 2
+	parcelBlockHashType          tlv.Type = 4
+	parcelTransferTimeType       tlv.Type = 6
+	parcelChainFeesType          tlv.Type = 8
+	parcelPassiveAssetsType      tlv.Type = 10
+	parcelPassiveAssetAnchorType tlv.Type = 12
+	parcelInputsType             tlv.Type = 14
+	parcelOutputsType            tlv.Type = 16
+
+	inputPrevIDType tlv.Type = 0
+	inputAmountType tlv.Type = 2
+
+	anchorOutPointType          tlv.Type = 0
+	anchorValueType             tlv.Type = 2
+	anchorInternalKeyType       tlv.Type = 4
+	anchorTaprootAssetRootType  tlv.Type = 6
+	anchorCommitmentVersionType tlv.Type = 8
+	anchorMerkleRootType        tlv.Type = 10
+	anchorTapscriptSiblingType  tlv.Type = 12
+	anchorNumPassiveAssetsType  tlv.Type = 14
+
+	outputAnchorType                tlv.Type = 0
+	outputTypeType                  tlv.Type = 2
+	outputScriptKeyType             tlv.Type = 4
+	outputScriptKeyTweakType        tlv.Type = 6
+	outputScriptKeyLocalType        tlv.Type = 8
+	outputAmountType                tlv.Type = 10
+	outputLockTimeType              tlv.Type = 12
+	outputRelativeLockTimeType      tlv.Type = 14
+	outputAssetVersionType          tlv.Type = 16
+	outputWitnessDataType           tlv.Type = 18
+	outputSplitCommitmentRootType   tlv.Type = 20
+	outputProofSuffixType           tlv.Type = 22
+	outputProofCourierAddrType      tlv.Type = 24
+	outputProofDeliveryCompleteType tlv.Type = 26
+	outputPositionType              tlv.Type = 28
+)
+
+// boolToUint8 maps a boolean to its single byte encoding.
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// encodeKeyDesc encodes a key descriptor. The public key is optional, as a key
+// can be fully described by its locator.
+func encodeKeyDesc(desc keychain.KeyDescriptor) ([]byte, error) {
+	family := uint32(desc.Family)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(keyDescFamilyType, &family),
+		tlv.MakePrimitiveRecord(keyDescIndexType, &desc.Index),
+	}
+	if desc.PubKey != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			keyDescPubKeyType, &desc.PubKey,
+		))
+	}
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeKeyDesc decodes a key descriptor that was encoded with encodeKeyDesc.
+func decodeKeyDesc(b []byte) (keychain.KeyDescriptor, error) {
+	var (
+		family uint32
+		desc   keychain.KeyDescriptor
+		pubKey *btcec.PublicKey
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakePrimitiveRecord(keyDescFamilyType, &family),
+		tlv.MakePrimitiveRecord(keyDescIndexType, &desc.Index),
+		tlv.MakePrimitiveRecord(keyDescPubKeyType, &pubKey),
+	)
+	if err != nil {
+		return desc, fmt.Errorf("unable to decode key descriptor: %w",
+			err)
+	}
+
+	desc.Family = keychain.KeyFamily(family)
+	if _, ok := parsedTypes[keyDescPubKeyType]; ok {
+		desc.PubKey = pubKey
+	}
+
+	return desc, nil
+}
+
+// encodeTweakedScriptKey encodes the wallet specific information of a script
+// key.
+func encodeTweakedScriptKey(key *asset.TweakedScriptKey) ([]byte, error) {
+	rawKey, err := encodeKeyDesc(key.RawKey)
+	if err != nil {
+		return nil, err
+	}
+
+	declaredKnown := boolToUint8(key.DeclaredKnown)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(scriptKeyRawKeyType, &rawKey),
+	}
+	if len(key.Tweak) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			scriptKeyTweakType, &key.Tweak,
+		))
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		scriptKeyDeclaredKnownType, &declaredKnown,
+	))
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeTweakedScriptKey decodes the wallet specific information of a script
+// key that was encoded with encodeTweakedScriptKey.
+func decodeTweakedScriptKey(b []byte) (*asset.TweakedScriptKey, error) {
+	var (
+		rawKey        []byte
+		tweak         []byte
+		declaredKnown uint8
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakePrimitiveRecord(scriptKeyRawKeyType, &rawKey),
+		tlv.MakePrimitiveRecord(scriptKeyTweakType, &tweak),
+		tlv.MakePrimitiveRecord(
+			scriptKeyDeclaredKnownType, &declaredKnown,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode script key: %w", err)
+	}
+
+	rawKeyDesc, err := decodeKeyDesc(rawKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &asset.TweakedScriptKey{
+		RawKey:        rawKeyDesc,
+		DeclaredKnown: declaredKnown == 1,
+	}
+	if _, ok := parsedTypes[scriptKeyTweakType]; ok {
+		key.Tweak = tweak
+	}
+
+	return key, nil
+}
+
+// encodeAsset encodes a single asset backup entry.
+func encodeAsset(a *AssetBackup) ([]byte, error) {
+	proofFile := []byte(a.ProofFile)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(assetProofFileType, &proofFile),
+	}
+
+	if a.ScriptKey != nil {
+		scriptKey, err := encodeTweakedScriptKey(a.ScriptKey)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			assetScriptKeyType, &scriptKey,
+		))
+	}
+
+	internalKey, err := encodeKeyDesc(a.AnchorInternalKey)
+	if err != nil {
+		return nil, err
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		assetAnchorInternalKeyType, &internalKey,
+	))
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeAsset decodes a single asset backup entry.
+func decodeAsset(b []byte) (*AssetBackup, error) {
+	var (
+		proofFile   []byte
+		scriptKey   []byte
+		internalKey []byte
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakePrimitiveRecord(assetProofFileType, &proofFile),
+		tlv.MakePrimitiveRecord(assetScriptKeyType, &scriptKey),
+		tlv.MakePrimitiveRecord(
+			assetAnchorInternalKeyType, &internalKey,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode asset: %w", err)
+	}
+
+	a := &AssetBackup{
+		ProofFile: proof.Blob(proofFile),
+	}
+
+	if _, ok := parsedTypes[assetScriptKeyType]; ok {
+		a.ScriptKey, err = decodeTweakedScriptKey(scriptKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	a.AnchorInternalKey, err = decodeKeyDesc(internalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// encodeAddr encodes an address book entry. The address itself is stored in
+// its bech32m encoding, which includes the network it was created for. The
+// Taproot output key is stored as well, as it can only be derived again once
+// the asset genesis is known.
+func encodeAddr(addr *address.AddrWithKeyInfo) ([]byte, error) {
+	encodedAddr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode address: %w", err)
+	}
+	encodedBytes := []byte(encodedAddr)
+
+	scriptKey, err := encodeTweakedScriptKey(&addr.ScriptKeyTweak)
+	if err != nil {
+		return nil, err
+	}
+
+	internalKey, err := encodeKeyDesc(addr.InternalKeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		creationTime = uint64(addr.CreationTime.Unix())
+		outputKey    = &addr.TaprootOutputKey
+	)
+
+	return tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(addrEncodedType, &encodedBytes),
+		tlv.MakePrimitiveRecord(addrScriptKeyType, &scriptKey),
+		tlv.MakePrimitiveRecord(addrInternalKeyType, &internalKey),
+		tlv.MakePrimitiveRecord(addrCreationTimeType, &creationTime),
+		tlv.MakePrimitiveRecord(addrOutputKeyType, &outputKey),
+	)
+}
+
+// decodeAddr decodes an address book entry. Decoding fails if the address
+// was created for a different network than the one given.
+func decodeAddr(b []byte,
+	chainParams *address.ChainParams) (*address.AddrWithKeyInfo, error) {
+
+	var (
+		encodedBytes []byte
+		scriptKey    []byte
+		internalKey  []byte
+		creationTime uint64
+		outputKey    *btcec.PublicKey
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakePrimitiveRecord(addrEncodedType, &encodedBytes),
+		tlv.MakePrimitiveRecord(addrScriptKeyType, &scriptKey),
+		tlv.MakePrimitiveRecord(addrInternalKeyType, &internalKey),
+		tlv.MakePrimitiveRecord(addrCreationTimeType, &creationTime),
+		tlv.MakePrimitiveRecord(addrOutputKeyType, &outputKey),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode address: %w", err)
+	}
+
+	if _, ok := parsedTypes[addrOutputKeyType]; !ok {
+		return nil, fmt.Errorf("address is missing Taproot output key")
+	}
+
+	tapAddr, err := address.DecodeAddress(
+		string(encodedBytes), chainParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode address: %w", err)
+	}
+
+	scriptKeyTweak, err := decodeTweakedScriptKey(scriptKey)
+	if err != nil {
+		return nil, err
+	}
+
+	internalKeyDesc, err := decodeKeyDesc(internalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &address.AddrWithKeyInfo{
+		Tap:              tapAddr,
+		ScriptKeyTweak:   *scriptKeyTweak,
+		InternalKeyDesc:  internalKeyDesc,
+		TaprootOutputKey: *outputKey,
+		CreationTime:     time.Unix(int64(creationTime), 0),
+	}, nil
+}
+
+// encodeAnchor encodes the anchor output information of a transfer.
+func encodeAnchor(anchor *tapfreighter.Anchor) ([]byte, error) {
+	internalKey, err := encodeKeyDesc(anchor.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	value := uint64(anchor.Value)
+	records := []tlv.Record{
+		tlv.MakeStaticRecord(
+			anchorOutPointType, &anchor.OutPoint, 36,
+			asset.OutPointEncoder, asset.OutPointDecoder,
+		),
+		tlv.MakePrimitiveRecord(anchorValueType, &value),
+		tlv.MakePrimitiveRecord(anchorInternalKeyType, &internalKey),
+		tlv.MakePrimitiveRecord(
+			anchorTaprootAssetRootType, &anchor.TaprootAssetRoot,
+		),
+	}
+	if anchor.CommitmentVersion != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			anchorCommitmentVersionType, anchor.CommitmentVersion,
+		))
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		anchorMerkleRootType, &anchor.MerkleRoot,
+	))
+	if len(anchor.TapscriptSibling) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			anchorTapscriptSiblingType, &anchor.TapscriptSibling,
+		))
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		anchorNumPassiveAssetsType, &anchor.NumPassiveAssets,
+	))
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeAnchor decodes the anchor output information of a transfer.
+func decodeAnchor(b []byte) (*tapfreighter.Anchor, error) {
+	var (
+		anchor            tapfreighter.Anchor
+		value             uint64
+		internalKey       []byte
+		commitmentVersion uint8
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakeStaticRecord(
+			anchorOutPointType, &anchor.OutPoint, 36,
+			asset.OutPointEncoder, asset.OutPointDecoder,
+		),
+		tlv.MakePrimitiveRecord(anchorValueType, &value),
+		tlv.MakePrimitiveRecord(anchorInternalKeyType, &internalKey),
+		tlv.MakePrimitiveRecord(
+			anchorTaprootAssetRootType, &anchor.TaprootAssetRoot,
+		),
+		tlv.MakePrimitiveRecord(
+			anchorCommitmentVersionType, &commitmentVersion,
+		),
+		tlv.MakePrimitiveRecord(
+			anchorMerkleRootType, &anchor.MerkleRoot,
+		),
+		tlv.MakePrimitiveRecord(
+			anchorTapscriptSiblingType, &anchor.TapscriptSibling,
+		),
+		tlv.MakePrimitiveRecord(
+			anchorNumPassiveAssetsType, &anchor.NumPassiveAssets,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor: %w", err)
+	}
+
+	anchor.Value = btcutil.Amount(value)
+	anchor.InternalKey, err = decodeKeyDesc(internalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[anchorCommitmentVersionType]; ok {
+		anchor.CommitmentVersion = &commitmentVersion
+	}
+
+	return &anchor, nil
+}
+
+// encodeInput encodes a single input of a transfer.
+func encodeInput(in tapfreighter.TransferInput) ([]byte, error) {
+	prevID := &in.PrevID
+	return tlvenc.EncodeStream(
+		tlv.MakeStaticRecord(
+			inputPrevIDType, &prevID, 36+32+33,
+			asset.PrevIDEncoder, asset.PrevIDDecoder,
+		),
+		tlv.MakePrimitiveRecord(inputAmountType, &in.Amount),
+	)
+}
+
+// decodeInput decodes a single input of a transfer.
+func decodeInput(b []byte) (tapfreighter.TransferInput, error) {
+	var (
+		in     tapfreighter.TransferInput
+		prevID *asset.PrevID
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakeStaticRecord(
+			inputPrevIDType, &prevID, 36+32+33,
+			asset.PrevIDEncoder, asset.PrevIDDecoder,
+		),
+		tlv.MakePrimitiveRecord(inputAmountType, &in.Amount),
+	)
+	if err != nil {
+		return in, fmt.Errorf("unable to decode input: %w", err)
+	}
+
+	if _, ok := parsedTypes[inputPrevIDType]; !ok {
+		return in, fmt.Errorf("input is missing previous ID")
+	}
+	in.PrevID = *prevID
+
+	return in, nil
+}
+
+// encodeOutput encodes a single output of a transfer.
+func encodeOutput(out tapfreighter.TransferOutput) ([]byte, error) {
+	anchor, err := encodeAnchor(&out.Anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		outType        = uint8(out.Type)
+		scriptKeyLocal = boolToUint8(out.ScriptKeyLocal)
+		assetVersion   = uint8(out.AssetVersion)
+		buf            [8]byte
+	)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(outputAnchorType, &anchor),
+		tlv.MakePrimitiveRecord(outputTypeType, &outType),
+		tlv.MakePrimitiveRecord(
+			outputScriptKeyType, &out.ScriptKey.PubKey,
+		),
+	}
+	if out.ScriptKey.TweakedScriptKey != nil {
+		scriptKeyTweak, err := encodeTweakedScriptKey(
+			out.ScriptKey.TweakedScriptKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			outputScriptKeyTweakType, &scriptKeyTweak,
+		))
+	}
+	records = append(
+		records,
+		tlv.MakePrimitiveRecord(
+			outputScriptKeyLocalType, &scriptKeyLocal,
+		),
+		tlv.MakePrimitiveRecord(outputAmountType, &out.Amount),
+		tlv.MakePrimitiveRecord(outputLockTimeType, &out.LockTime),
+		tlv.MakePrimitiveRecord(
+			outputRelativeLockTimeType, &out.RelativeLockTime,
+		),
+		tlv.MakePrimitiveRecord(outputAssetVersionType, &assetVersion),
+	)
+
+	if len(out.WitnessData) > 0 {
+		var witnessBuf bytes.Buffer
+		err := asset.WitnessEncoder(&witnessBuf, &out.WitnessData, &buf)
+		if err != nil {
+			return nil, err
+		}
+		witnessData := witnessBuf.Bytes()
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			outputWitnessDataType, &witnessData,
+		))
+	}
+
+	if out.SplitCommitmentRoot != nil {
+		records = append(records, tlv.MakeStaticRecord(
+			outputSplitCommitmentRootType, &out.SplitCommitmentRoot,
+			40, asset.SplitCommitmentRootEncoder,
+			asset.SplitCommitmentRootDecoder,
+		))
+	}
+
+	records = append(records, tlv.MakePrimitiveRecord(
+		outputProofSuffixType, &out.ProofSuffix,
+	))
+
+	if len(out.ProofCourierAddr) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			outputProofCourierAddrType, &out.ProofCourierAddr,
+		))
+	}
+
+	if out.ProofDeliveryComplete.IsSome() {
+		deliveryComplete := boolToUint8(
+			out.ProofDeliveryComplete.UnwrapOr(false),
+		)
+		records = append(records, tlv.MakePrimitiveRecord(
+			outputProofDeliveryCompleteType, &deliveryComplete,
+		))
+	}
+
+	records = append(records, tlv.MakePrimitiveRecord(
+		outputPositionType, &out.Position,
+	))
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeOutput decodes a single output of a transfer.
+func decodeOutput(b []byte) (tapfreighter.TransferOutput, error) {
+	var (
+		out              tapfreighter.TransferOutput
+		anchor           []byte
+		outType          uint8
+		scriptKey        *btcec.PublicKey
+		scriptKeyTweak   []byte
+		scriptKeyLocal   uint8
+		assetVersion     uint8
+		witnessData      []byte
+		splitRoot        mssmt.Node
+		deliveryComplete uint8
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakePrimitiveRecord(outputAnchorType, &anchor),
+		tlv.MakePrimitiveRecord(outputTypeType, &outType),
+		tlv.MakePrimitiveRecord(outputScriptKeyType, &scriptKey),
+		tlv.MakePrimitiveRecord(
+			outputScriptKeyTweakType, &scriptKeyTweak,
+		),
+		tlv.MakePrimitiveRecord(
+			outputScriptKeyLocalType, &scriptKeyLocal,
+		),
+		tlv.MakePrimitiveRecord(outputAmountType, &out.Amount),
+		tlv.MakePrimitiveRecord(outputLockTimeType, &out.LockTime),
+		tlv.MakePrimitiveRecord(
+			outputRelativeLockTimeType, &out.RelativeLockTime,
+		),
+		tlv.MakePrimitiveRecord(outputAssetVersionType, &assetVersion),
+		tlv.MakePrimitiveRecord(outputWitnessDataType, &witnessData),
+		tlv.MakeStaticRecord(
+			outputSplitCommitmentRootType, &splitRoot, 40,
+			asset.SplitCommitmentRootEncoder,
+			asset.SplitCommitmentRootDecoder,
+		),
+		tlv.MakePrimitiveRecord(
+			outputProofSuffixType, &out.ProofSuffix,
+		),
+		tlv.MakePrimitiveRecord(
+			outputProofCourierAddrType, &out.ProofCourierAddr,
+		),
+		tlv.MakePrimitiveRecord(
+			outputProofDeliveryCompleteType, &deliveryComplete,
+		),
+		tlv.MakePrimitiveRecord(outputPositionType, &out.Position),
+	)
+	if err != nil {
+		return out, fmt.Errorf("unable to decode output: %w", err)
+	}
+
+	decodedAnchor, err := decodeAnchor(anchor)
+	if err != nil {
+		return out, err
+	}
+	out.Anchor = *decodedAnchor
+
+	out.Type = tappsbt.VOutputType(outType)
+	out.ScriptKey.PubKey = scriptKey
+	if _, ok := parsedTypes[outputScriptKeyTweakType]; ok {
+		out.ScriptKey.TweakedScriptKey, err = decodeTweakedScriptKey(
+			scriptKeyTweak,
+		)
+		if err != nil {
+			return out, err
+		}
+	}
+	out.ScriptKeyLocal = scriptKeyLocal == 1
+	out.AssetVersion = asset.Version(assetVersion)
+
+	if _, ok := parsedTypes[outputWitnessDataType]; ok {
+		var buf [8]byte
+		err := asset.WitnessDecoder(
+			bytes.NewReader(witnessData), &out.WitnessData, &buf,
+			uint64(len(witnessData)),
+		)
+		if err != nil {
+			return out, fmt.Errorf("unable to decode witness "+
+				"data: %w", err)
+		}
+	}
+
+	if _, ok := parsedTypes[outputSplitCommitmentRootType]; ok {
+		out.SplitCommitmentRoot = splitRoot
+	}
+
+	if _, ok := parsedTypes[outputProofDeliveryCompleteType]; ok {
+		out.ProofDeliveryComplete = fn.Some(deliveryComplete == 1)
+	}
+
+	return out, nil
+}
+
+// encodeVPacket encodes a virtual packet.
+func encodeVPacket(packet *tappsbt.VPacket) ([]byte, error) {
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeVPacket decodes a virtual packet.
+func decodeVPacket(b []byte) (*tappsbt.VPacket, error) {
+	return tappsbt.NewFromRawBytes(bytes.NewReader(b), false)
+}
+
+// encodeParcel encodes a pending outbound parcel.
+func encodeParcel(parcel *tapfreighter.OutboundParcel) ([]byte, error) {
+	var anchorTxBuf bytes.Buffer
+	if err := parcel.AnchorTx.Serialize(&anchorTxBuf); err != nil {
+		return nil, err
+	}
+	anchorTx := anchorTxBuf.Bytes()
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(parcelAnchorTxType, &anchorTx),
+		tlv.MakePrimitiveRecord(
+			parcelHeightHintType, &parcel.AnchorTxHeightHint,
+		),
+	}
+
+	parcel.AnchorTxBlockHash.WhenSome(func(hash chainhash.Hash) {
+		blockHash := [32]byte(hash)
+		records = append(records, tlv.MakePrimitiveRecord(
+			parcelBlockHashType, &blockHash,
+		))
+	})
+
+	var (
+		transferTime = uint64(parcel.TransferTime.Unix())
+		chainFees    = uint64(parcel.ChainFees)
+	)
+	records = append(
+		records,
+		tlv.MakePrimitiveRecord(parcelTransferTimeType, &transferTime),
+		tlv.MakePrimitiveRecord(parcelChainFeesType, &chainFees),
+	)
+
+	if len(parcel.PassiveAssets) > 0 {
+		passiveAssets, err := tlvenc.EncodeList(
+			parcel.PassiveAssets, encodeVPacket,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			parcelPassiveAssetsType, &passiveAssets,
+		))
+	}
+
+	if parcel.PassiveAssetsAnchor != nil {
+		passiveAnchor, err := encodeAnchor(parcel.PassiveAssetsAnchor)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			parcelPassiveAssetAnchorType, &passiveAnchor,
+		))
+	}
+
+	inputs, err := tlvenc.EncodeList(parcel.Inputs, encodeInput)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := tlvenc.EncodeList(parcel.Outputs, encodeOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	records = append(
+		records,
+		tlv.MakePrimitiveRecord(parcelInputsType, &inputs),
+		tlv.MakePrimitiveRecord(parcelOutputsType, &outputs),
+	)
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeParcel decodes a pending outbound parcel.
+func decodeParcel(b []byte) (*tapfreighter.OutboundParcel, error) {
+	var (
+		parcel        tapfreighter.OutboundParcel
+		anchorTx      []byte
+		blockHash     [32]byte
+		transferTime  uint64
+		chainFees     uint64
+		passiveAssets []byte
+		passiveAnchor []byte
+		inputs        []byte
+		outputs       []byte
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b, tlv.MakePrimitiveRecord(parcelAnchorTxType, &anchorTx),
+		tlv.MakePrimitiveRecord(
+			parcelHeightHintType, &parcel.AnchorTxHeightHint,
+		),
+		tlv.MakePrimitiveRecord(parcelBlockHashType, &blockHash),
+		tlv.MakePrimitiveRecord(parcelTransferTimeType, &transferTime),
+		tlv.MakePrimitiveRecord(parcelChainFeesType, &chainFees),
+		tlv.MakePrimitiveRecord(
+			parcelPassiveAssetsType, &passiveAssets,
+		),
+		tlv.MakePrimitiveRecord(
+			parcelPassiveAssetAnchorType, &passiveAnchor,
+		),
+		tlv.MakePrimitiveRecord(parcelInputsType, &inputs),
+		tlv.MakePrimitiveRecord(parcelOutputsType, &outputs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode transfer: %w", err)
+	}
+
+	parcel.AnchorTx = wire.NewMsgTx(2)
+	err = parcel.AnchorTx.Deserialize(bytes.NewReader(anchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	if _, ok := parsedTypes[parcelBlockHashType]; ok {
+		parcel.AnchorTxBlockHash = fn.Some(chainhash.Hash(blockHash))
+	}
+
+	parcel.TransferTime = time.Unix(int64(transferTime), 0)
+	parcel.ChainFees = int64(chainFees)
+
+	if _, ok := parsedTypes[parcelPassiveAssetsType]; ok {
+		parcel.PassiveAssets, err = tlvenc.DecodeList(
+			passiveAssets, decodeVPacket,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode passive "+
+				"assets: %w", err)
+		}
+	}
+
+	if _, ok := parsedTypes[parcelPassiveAssetAnchorType]; ok {
+		parcel.PassiveAssetsAnchor, err = decodeAnchor(passiveAnchor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parcel.Inputs, err = tlvenc.DecodeList(inputs, decodeInput)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel.Outputs, err = tlvenc.DecodeList(outputs, decodeOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parcel, nil
+}
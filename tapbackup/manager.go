@@ -0,0 +1,549 @@
+package tapbackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// AssetStore is the store that holds the assets owned by the daemon.
+type AssetStore interface {
+	// FetchAllAssets fetches the set of confirmed assets stored on disk.
+	FetchAllAssets(ctx context.Context, includeSpent, includeLeased bool,
+		query *tapdb.AssetQueryFilters) ([]*asset.ChainAsset, error)
+}
+
+// ProofArchive is the archive the proof files of the owned assets are fetched
+// from and imported into.
+type ProofArchive interface {
+	proof.Archiver
+
+	// HasProof returns true if the proof for the given locator exists.
+	HasProof(ctx context.Context, id proof.Locator) (bool, error)
+}
+
+// KeyStore is the store that holds the derivation information of the script
+// and internal keys known to the daemon.
+type KeyStore interface {
+	// FetchScriptKey attempts to fetch the full tweaked script key struct
+	// (including the key descriptor) for the given tweaked script key.
+	FetchScriptKey(ctx context.Context,
+		tweakedScriptKey *btcec.PublicKey) (*asset.TweakedScriptKey,
+		error)
+
+	// FetchInternalKeyLocator attempts to fetch the key locator
+	// information for the given raw internal key.
+	FetchInternalKeyLocator(ctx context.Context,
+		rawKey *btcec.PublicKey) (keychain.KeyLocator, error)
+
+	// InsertInternalKey inserts an internal key into the database to make
+	// sure it is identified as a local key later on when importing proofs.
+	InsertInternalKey(ctx context.Context,
+		keyDesc keychain.KeyDescriptor) error
+
+	// InsertScriptKey inserts an address related script key into the
+	// database.
+	InsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
+		declaredKnown bool) error
+}
+
+// AddrBook is the address book whose entries are backed up and restored.
+type AddrBook interface {
+	// QueryAssetInfo attempts to locate asset genesis information by
+	// querying geneses already known to this node, falling back to the
+	// universes in our federation.
+	QueryAssetInfo(ctx context.Context,
+		id asset.ID) (*asset.AssetGroup, error)
+
+	// ListAddrs lists a set of addresses based on the expressed query
+	// params.
+	ListAddrs(ctx context.Context,
+		params address.QueryParams) ([]address.AddrWithKeyInfo, error)
+
+	// AddrByTaprootOutput returns a single address based on its Taproot
+	// output key or address.ErrNoAddr if no such address exists.
+	AddrByTaprootOutput(ctx context.Context,
+		key *btcec.PublicKey) (*address.AddrWithKeyInfo, error)
+
+	// ImportAddress imports an address that was created before into the
+	// address book.
+	ImportAddress(ctx context.Context, addr *address.AddrWithKeyInfo) error
+}
+
+// Porter is used to resume pending transfers after they were restored.
+type Porter interface {
+	// ImportPendingParcel logs the given parcel as a pending parcel and
+	// resumes its delivery.
+	ImportPendingParcel(ctx context.Context,
+		parcel *tapfreighter.OutboundParcel) error
+}
+
+// WalletAnchor is the lnd wallet the anchor outputs of restored assets are
+// imported into.
+type WalletAnchor interface {
+	// ImportTaprootOutput imports a new public key into the wallet, as a
+	// P2TR output.
+	ImportTaprootOutput(context.Context, *btcec.PublicKey) (btcutil.Address,
+		error)
+}
+
+// ManagerConfig is the main config for the backup manager.
+type ManagerConfig struct {
+	// AssetStore is the store that holds the assets owned by the daemon.
+	AssetStore AssetStore
+
+	// ProofArchive is the archive the proof files are fetched from when
+	// exporting and imported into when restoring a backup. All imported
+	// proofs are fully verified by the archive.
+	ProofArchive ProofArchive
+
+	// KeyStore holds the derivation information of the script and
+	// internal keys known to the daemon.
+	KeyStore KeyStore
+
+	// AddrBook is the address book of the daemon.
+	AddrBook AddrBook
+
+	// ExportLog is used to fetch pending transfers.
+	ExportLog tapfreighter.ExportLog
+
+	// Porter is used to resume restored pending transfers.
+	Porter Porter
+
+	// WalletAnchor is the lnd wallet restored anchor outputs are imported
+	// into.
+	WalletAnchor WalletAnchor
+
+	// KeyRing is used to derive the backup encryption key and to find out
+	// whether a restored key belongs to the lnd wallet.
+	KeyRing KeyRing
+
+	// ChainParams are the parameters of the network the daemon runs on.
+	ChainParams *address.ChainParams
+
+	// HeaderVerifier is used to verify the validity of the block headers
+	// of restored proofs.
+	HeaderVerifier proof.HeaderVerifier
+
+	// MerkleVerifier is used to verify the validity of the transaction
+	// merkle proofs of restored proofs.
+	MerkleVerifier proof.MerkleVerifier
+
+	// GroupVerifier is used to verify the validity of the group keys of
+	// restored proofs.
+	GroupVerifier proof.GroupVerifier
+
+	// ChainLookupGen is used to create chain lookups for the verification
+	// of restored proofs.
+	ChainLookupGen proof.ChainLookupGenerator
+}
+
+// RestoreResult summarizes what was restored from a backup. Entries that
+// already existed in the local database are skipped.
+type RestoreResult struct {
+	// NumAssetsRestored is the number of assets whose proofs were
+	// imported.
+	NumAssetsRestored int
+
+	// NumAssetsSkipped is the number of assets that were already known.
+	NumAssetsSkipped int
+
+	// NumAddrsRestored is the number of addresses that were imported.
+	NumAddrsRestored int
+
+	// NumAddrsSkipped is the number of addresses that were already known.
+	NumAddrsSkipped int
+
+	// NumTransfersRestored is the number of pending transfers that were
+	// imported and resumed.
+	NumTransfersRestored int
+
+	// NumTransfersSkipped is the number of pending transfers that were
+	// already known.
+	NumTransfersSkipped int
+}
+
+// Manager creates and restores encrypted backups of all the state of the
+// daemon that can't be re-derived from the lnd seed: the owned assets and
+// their proofs, the derivation information of their keys, the address book
+// and pending transfers.
+type Manager struct {
+	cfg *ManagerConfig
+}
+
+// NewManager creates a new backup manager.
+func NewManager(cfg *ManagerConfig) *Manager {
+	return &Manager{
+		cfg: cfg,
+	}
+}
+
+// ExportBackup creates an encrypted backup of the current state of the
+// daemon.
+func (m *Manager) ExportBackup(ctx context.Context) ([]byte, error) {
+	backup, err := m.collectBackup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Exporting backup with %d assets, %d addresses and %d "+
+		"pending transfers", len(backup.Assets), len(backup.Addrs),
+		len(backup.PendingTransfers))
+
+	return EncryptBackup(ctx, backup, m.cfg.KeyRing)
+}
+
+// collectBackup collects the state of the daemon into an unencrypted backup.
+func (m *Manager) collectBackup(ctx context.Context) (*Backup, error) {
+	backup := &Backup{
+		Version:      LatestVersion,
+		CreationTime: time.Now(),
+	}
+
+	// We keep track of the proofs we've added, as the same asset may be
+	// referenced more than once.
+	knownProofs := make(map[[32]byte]struct{})
+	addAsset := func(loc proof.Locator) error {
+		locHash, err := loc.Hash()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := knownProofs[locHash]; ok {
+			return nil
+		}
+
+		assetBackup, err := m.backupAsset(ctx, loc)
+		if err != nil {
+			return err
+		}
+
+		knownProofs[locHash] = struct{}{}
+		backup.Assets = append(backup.Assets, assetBackup)
+
+		return nil
+	}
+
+	assets, err := m.cfg.AssetStore.FetchAllAssets(ctx, false, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch assets: %w", err)
+	}
+
+	for _, a := range assets {
+		err := addAsset(proof.Locator{
+			AssetID:   fn.Ptr(a.ID()),
+			ScriptKey: *a.ScriptKey.PubKey,
+			OutPoint:  fn.Ptr(a.AnchorOutpoint),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The inputs of pending transfers are already marked as spent, so we
+	// need to add them explicitly. Otherwise, we couldn't log the
+	// transfers again when restoring.
+	backup.PendingTransfers, err = m.cfg.ExportLog.PendingParcels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending transfers: %w",
+			err)
+	}
+
+	for _, parcel := range backup.PendingTransfers {
+		for _, in := range parcel.Inputs {
+			scriptKey, err := in.ScriptKey.ToPubKey()
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse input "+
+					"script key: %w", err)
+			}
+
+			err = addAsset(proof.Locator{
+				AssetID:   fn.Ptr(in.ID),
+				ScriptKey: *scriptKey,
+				OutPoint:  fn.Ptr(in.OutPoint),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	addrs, err := m.cfg.AddrBook.ListAddrs(ctx, address.QueryParams{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch addresses: %w", err)
+	}
+	for idx := range addrs {
+		backup.Addrs = append(backup.Addrs, &addrs[idx])
+	}
+
+	return backup, nil
+}
+
+// backupAsset creates the backup entry of the asset with the given locator.
+func (m *Manager) backupAsset(ctx context.Context,
+	loc proof.Locator) (*AssetBackup, error) {
+
+	proofFile, err := m.cfg.ProofArchive.FetchProof(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof for asset %v: %w",
+			loc.AssetID, err)
+	}
+
+	lastProof, err := proofFile.AsSingleProof()
+	if err != nil {
+		return nil, err
+	}
+
+	internalKey := lastProof.InclusionProof.InternalKey
+	assetBackup := &AssetBackup{
+		ProofFile: proofFile,
+		AnchorInternalKey: keychain.KeyDescriptor{
+			PubKey: internalKey,
+		},
+	}
+
+	scriptKey, err := m.cfg.KeyStore.FetchScriptKey(ctx, &loc.ScriptKey)
+	switch {
+	// Assets with a script key we don't know the derivation of can still
+	// be ours, for example if the key was declared known.
+	case errors.Is(err, address.ErrScriptKeyNotFound):
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch script key: %w", err)
+
+	default:
+		assetBackup.ScriptKey = scriptKey
+	}
+
+	keyLoc, err := m.cfg.KeyStore.FetchInternalKeyLocator(ctx, internalKey)
+	switch {
+	case errors.Is(err, address.ErrInternalKeyNotFound):
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch internal key: %w", err)
+
+	default:
+		assetBackup.AnchorInternalKey.KeyLocator = keyLoc
+	}
+
+	return assetBackup, nil
+}
+
+// RestoreBackup decrypts the given backup and restores all assets, addresses
+// and pending transfers it contains. Every proof is fully verified before it
+// is imported and the anchor outputs of the assets are imported into the lnd
+// wallet. Entries that already exist are skipped, so a backup can safely be
+// restored more than once.
+func (m *Manager) RestoreBackup(ctx context.Context,
+	blob []byte) (*RestoreResult, error) {
+
+	backup, err := DecryptBackup(
+		ctx, blob, m.cfg.KeyRing, m.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Restoring backup created at %v with %d assets, %d "+
+		"addresses and %d pending transfers", backup.CreationTime,
+		len(backup.Assets), len(backup.Addrs),
+		len(backup.PendingTransfers))
+
+	var result RestoreResult
+
+	// We restore the assets first, as both the addresses and the pending
+	// transfers depend on the asset geneses and the transfer inputs being
+	// known.
+	for _, assetBackup := range backup.Assets {
+		restored, err := m.restoreAsset(ctx, assetBackup)
+		if err != nil {
+			return nil, err
+		}
+
+		if restored {
+			result.NumAssetsRestored++
+		} else {
+			result.NumAssetsSkipped++
+		}
+	}
+
+	for _, addr := range backup.Addrs {
+		_, err := m.cfg.AddrBook.AddrByTaprootOutput(
+			ctx, &addr.TaprootOutputKey,
+		)
+		switch {
+		case err == nil:
+			result.NumAddrsSkipped++
+			continue
+
+		case !errors.Is(err, address.ErrNoAddr):
+			return nil, fmt.Errorf("unable to query address: %w",
+				err)
+		}
+
+		if err := m.cfg.AddrBook.ImportAddress(ctx, addr); err != nil {
+			return nil, fmt.Errorf("unable to import address: %w",
+				err)
+		}
+
+		result.NumAddrsRestored++
+	}
+
+	for _, parcel := range backup.PendingTransfers {
+		anchorTxHash := parcel.AnchorTx.TxHash()
+		parcels, err := m.cfg.ExportLog.QueryParcels(
+			ctx, &anchorTxHash, false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query transfers: %w",
+				err)
+		}
+
+		if len(parcels) > 0 {
+			result.NumTransfersSkipped++
+			continue
+		}
+
+		err = m.cfg.Porter.ImportPendingParcel(ctx, parcel)
+		if err != nil {
+			return nil, fmt.Errorf("unable to import pending "+
+				"transfer %v: %w", anchorTxHash, err)
+		}
+
+		result.NumTransfersRestored++
+	}
+
+	return &result, nil
+}
+
+// restoreAsset restores a single asset from its backup entry. It returns
+// false if the asset was already known.
+func (m *Manager) restoreAsset(ctx context.Context,
+	assetBackup *AssetBackup) (bool, error) {
+
+	lastProof, err := assetBackup.ProofFile.AsSingleProof()
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		lastAsset        = lastProof.Asset
+		internalKey      = assetBackup.AnchorInternalKey
+		proofInternalKey = lastProof.InclusionProof.InternalKey
+	)
+	if internalKey.PubKey == nil ||
+		!internalKey.PubKey.IsEqual(proofInternalKey) {
+
+		return false, fmt.Errorf("anchor internal key of asset %v "+
+			"doesn't match proof", lastAsset.ID())
+	}
+
+	// We insert the keys first, so they are identified as local keys when
+	// the proof is imported.
+	err = m.cfg.KeyStore.InsertInternalKey(ctx, internalKey)
+	if err != nil {
+		return false, fmt.Errorf("unable to insert internal key: %w",
+			err)
+	}
+
+	if assetBackup.ScriptKey != nil {
+		scriptKey := asset.ScriptKey{
+			PubKey:           lastAsset.ScriptKey.PubKey,
+			TweakedScriptKey: assetBackup.ScriptKey,
+		}
+		err := m.cfg.KeyStore.InsertScriptKey(
+			ctx, scriptKey, assetBackup.ScriptKey.DeclaredKnown,
+		)
+		if err != nil {
+			return false, fmt.Errorf("unable to insert script "+
+				"key: %w", err)
+		}
+	}
+
+	loc := proof.Locator{
+		AssetID:   fn.Ptr(lastAsset.ID()),
+		ScriptKey: *lastAsset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(lastProof.OutPoint()),
+	}
+	haveProof, err := m.cfg.ProofArchive.HasProof(ctx, loc)
+	if err != nil {
+		return false, fmt.Errorf("unable to query proof: %w", err)
+	}
+	if haveProof {
+		return false, nil
+	}
+
+	// The group of a grouped asset needs to be known for the proof to be
+	// verified. If we don't know it yet, we'll fetch it from the universe.
+	if lastAsset.GroupKey != nil {
+		_, err := m.cfg.AddrBook.QueryAssetInfo(ctx, lastAsset.ID())
+		if err != nil {
+			return false, fmt.Errorf("unable to fetch asset group "+
+				"for asset %v: %w", lastAsset.ID(), err)
+		}
+	}
+
+	err = m.cfg.ProofArchive.ImportProofs(
+		ctx, m.cfg.HeaderVerifier, m.cfg.MerkleVerifier,
+		m.cfg.GroupVerifier, m.cfg.ChainLookupGen, false,
+		&proof.AnnotatedProof{
+			Locator: loc,
+			Blob:    assetBackup.ProofFile,
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("unable to import proof for asset "+
+			"%v: %w", lastAsset.ID(), err)
+	}
+
+	// The anchor output only needs to be imported into the lnd wallet if
+	// the wallet controls its internal key.
+	if !m.cfg.KeyRing.IsLocalKey(ctx, internalKey) {
+		return true, nil
+	}
+
+	outputIndex := lastProof.InclusionProof.OutputIndex
+	if int(outputIndex) >= len(lastProof.AnchorTx.TxOut) {
+		return false, fmt.Errorf("invalid anchor output index %d",
+			outputIndex)
+	}
+
+	pkScript := lastProof.AnchorTx.TxOut[outputIndex].PkScript
+	_, witProgram, err := txscript.ExtractWitnessProgramInfo(pkScript)
+	if err != nil {
+		return false, err
+	}
+	anchorOutputKey, err := schnorr.ParsePubKey(witProgram)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = m.cfg.WalletAnchor.ImportTaprootOutput(ctx, anchorOutputKey)
+	switch {
+	case err == nil:
+
+	// The output might already be known to the wallet, for example if the
+	// backup is restored more than once.
+	case errors.Is(err, tapgarden.ErrOutputAlreadyImported):
+
+	default:
+		return false, fmt.Errorf("unable to import anchor output: %w",
+			err)
+	}
+
+	return true, nil
+}
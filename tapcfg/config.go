@@ -3,6 +3,7 @@ package tapcfg
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/hex"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btclog"
@@ -21,16 +23,21 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/net/http2"
@@ -128,6 +135,11 @@ const (
 	// of 10 queries.
 	defaultUniverseQueriesBurst = 10
 
+	// defaultUniverseClientBurst is the default number of proof insertions
+	// a single client is allowed to make at once, if the insertions per
+	// client are rate limited.
+	defaultUniverseClientBurst = 10
+
 	// defaultProofRetrievalDelay is the default time duration the custodian
 	// waits having identified an asset transfer on-chain and before
 	// retrieving the corresponding proof via the proof courier service.
@@ -136,6 +148,18 @@ const (
 	// defaultLndRPCTimeout is the default timeout we'll use for RPC
 	// requests to lnd.
 	defaultLndRPCTimeout = 1 * time.Minute
+
+	// defaultConsolidationThreshold is the default number of anchor
+	// outputs an asset needs to be spread over before it is consolidated.
+	defaultConsolidationThreshold = 10
+
+	// defaultConsolidationMaxFeeRate is the default fee rate ceiling in
+	// sat/vByte for automatic asset UTXO consolidations.
+	defaultConsolidationMaxFeeRate = 10
+
+	// defaultConsolidationInterval is the default interval at which we'll
+	// check the asset UTXOs for consolidation candidates.
+	defaultConsolidationInterval = time.Hour
 )
 
 var (
@@ -161,6 +185,20 @@ var (
 
 	defaultSqliteDatabaseFileName = "tapd.db"
 
+	// defaultChannelBackupsFileName is the name of the file restored asset
+	// channel backups are stored in, within the network directory.
+	defaultChannelBackupsFileName = "channel-assets.backup"
+
+	// defaultChannelStatesFileName is the name of the file the latest
+	// known asset state of each channel is stored in, within the network
+	// directory.
+	defaultChannelStatesFileName = "channel-assets.state"
+
+	// defaultMultiverseCacheFileName is the name of the file the state of
+	// the multiverse caches is persisted to on shutdown, within the
+	// network directory.
+	defaultMultiverseCacheFileName = "multiverse-cache.state"
+
 	// defaultLndMacaroon is the default macaroon file we use if the old,
 	// deprecated --lnd.macaroondir config option is used.
 	defaultLndMacaroon = "admin.macaroon"
@@ -280,6 +318,10 @@ type UniverseConfig struct {
 
 	SyncAllAssets bool `long:"sync-all-assets" description:"If set, the federation syncer will default to syncing all assets."`
 
+	LiveSync bool `long:"live-sync" description:"If set, the federation syncer subscribes to the leaves that are inserted into the universes of the federation servers, so new issuance and transfer proofs are synced within seconds instead of only with the next periodic sync."`
+
+	FederationBanThreshold int32 `long:"federation-ban-threshold" description:"The reputation score below which a federation server is banned from syncing. Each server starts with a score of 100, which is lowered by 20 each time the server serves invalid proofs and raised by 1 with each successful sync. Banned servers need to be removed and re-added to the federation to be synced with again. Set to 0 to never ban servers."`
+
 	PublicAccess string `long:"public-access" description:"The public access mode for the universe server, controlling whether remote parties can read from and/or write to this universe server over RPC if exposed to a public network interface. This can be unset, 'r', 'w', or 'rw'. If unset, public access is not enabled for the universe server. If 'r' is included, public access is allowed for read-only endpoints. If 'w' is included, public access is allowed for write endpoints."`
 
 	StatsCacheDuration time.Duration `long:"stats-cache-duration" description:"The amount of time to cache stats for before refreshing them. Valid time units are {s, m, h}."`
@@ -288,7 +330,147 @@ type UniverseConfig struct {
 
 	UniverseQueriesBurst int `long:"req-burst-budget" description:"The burst budget for the universe query rate limiting."`
 
+	SpvHeaders bool `long:"spv-headers" description:"If set, the block headers of proofs inserted into the universe are verified against an SPV header chain instead of against the chain backend of the connected lnd node. The header chain is synced from the bitcoin nodes set with spv-peer and every header is validated against the consensus rules. The universe retention policy then also uses the height of the SPV header chain. This doesn't make lnd optional: running tapd or a universe server without a connected lnd node is not supported, as the wallet, the universe root signer, admission invoices and all other subsystems still use it."`
+
+	SpvPeers []string `long:"spv-peer" description:"The address of a bitcoin node the SPV header chain is synced from if spv-headers is set. If no port is given, the default port of the network is used. The nodes don't need to be trusted, but each of them can withhold new headers, so configuring multiple nodes is recommended. Can be specified multiple times."`
+
+	CommitInterval time.Duration `long:"commit-interval" description:"The interval at which the issuance and transfer multiverse roots are committed to in the chain, as the taproot tweak of a small output, if they changed since the last commitment. Each commitment spends the output of the previous one, so they form a single chain starting at a genesis outpoint. This lets universe clients verify that this universe server doesn't serve different roots to different users. Each commitment costs an on-chain transaction. Set to 0 to disable commitments. Valid time units are {s, m, h}."`
+
 	MultiverseCaches *tapdb.MultiverseCacheConfig `group:"multiverse-caches" namespace:"multiverse-caches"`
+
+	Retention *UniverseRetentionConfig `group:"retention" namespace:"retention"`
+
+	Admission *UniverseAdmissionConfig `group:"admission" namespace:"admission"`
+}
+
+// UniverseAdmissionConfig is the config that houses the spam protection
+// policy for proofs inserted into the local universes by remote clients.
+type UniverseAdmissionConfig struct {
+	ClientMaxQps float64 `long:"client-max-qps" description:"The maximum number of proof insertions per second a single client is allowed to make. Authenticated clients are identified by their macaroon, all others by their IP address. Set to 0 to not limit the insertions per client."`
+
+	ClientBurst int `long:"client-burst" description:"The number of proof insertions a single client is allowed to make at once, if client-max-qps is set."`
+
+	MinAnchorFeeRate uint64 `long:"min-anchor-fee-rate" description:"The minimum fee rate in sat/vByte of the anchor transaction of an inserted proof. If set, clients need to provide the outputs spent by the anchor transaction along with the proof. Set to 0 to accept any fee rate."`
+
+	MinTransferAmount uint64 `long:"min-transfer-amount" description:"The minimum amount of a transferred normal asset for its proof to be inserted. Collectibles are never rejected because of their amount."`
+
+	MinIssuanceAmount uint64 `long:"min-issuance-amount" description:"The minimum amount of an issued normal asset for its proof to be inserted. Collectibles are never rejected because of their amount."`
+
+	InsertFeeMsat uint64 `long:"insert-fee-msat" description:"The amount in milli-satoshis a client needs to pay over Lightning for each proof insertion. The client receives an invoice in an L402 style challenge and needs to retry the insertion with the preimage of the paid invoice. Set to 0 to not require payments."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c *UniverseAdmissionConfig) Validate() error {
+	if c.ClientMaxQps < 0 {
+		return fmt.Errorf("client max qps must not be negative")
+	}
+
+	if c.ClientMaxQps > 0 && c.ClientBurst < 1 {
+		return fmt.Errorf("client burst must be at least 1")
+	}
+
+	return nil
+}
+
+// Policy returns the universe admission policy described by the config.
+func (c *UniverseAdmissionConfig) Policy() universe.AdmissionPolicy {
+	minFeeRate := chainfee.SatPerKVByte(c.MinAnchorFeeRate * 1000)
+
+	return universe.AdmissionPolicy{
+		ClientRate:        rate.Limit(c.ClientMaxQps),
+		ClientBurst:       c.ClientBurst,
+		MinAnchorFeeRate:  minFeeRate.FeePerKWeight(),
+		MinTransferAmount: c.MinTransferAmount,
+		MinIssuanceAmount: c.MinIssuanceAmount,
+		InsertFee:         lnwire.MilliSatoshi(c.InsertFeeMsat),
+	}
+}
+
+// UniverseRetentionConfig is the config that houses the retention policy of
+// the local universe trees.
+type UniverseRetentionConfig struct {
+	PruneInterval time.Duration `long:"prune-interval" description:"The interval at which the retention policy is applied to the local transfer universes. Issuance universes are never pruned. Set to 0 to disable pruning. Valid time units are {s, m, h}."`
+
+	TransferProofMaxAge uint32 `long:"transfer-proof-max-age" description:"The number of blocks after which a transfer proof is pruned, counted from the block its anchor transaction confirmed in. Set to 0 to never prune transfer proofs because of their age."`
+
+	MaxLeavesPerAsset uint64 `long:"max-leaves-per-asset" description:"The maximum number of transfer proofs that are kept per asset ID or asset group. If a transfer universe has more leaves, the oldest ones are pruned. Set to 0 to not cap the number of leaves."`
+
+	AllowAssets []string `long:"allow-asset" description:"The hex encoded asset ID (32 bytes) or compressed group key (33 bytes) of an asset whose transfer proofs are never pruned. Can be specified multiple times."`
+
+	SpamAssets []string `long:"spam-asset" description:"The hex encoded asset ID (32 bytes) or compressed group key (33 bytes) of an asset of which only the issuance proofs are kept. Its transfer universe is removed entirely. Can be specified multiple times."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c *UniverseRetentionConfig) Validate() error {
+	if c.PruneInterval < 0 {
+		return fmt.Errorf("prune interval must not be negative")
+	}
+
+	_, err := c.Policy()
+	return err
+}
+
+// Policy returns the universe retention policy described by the config.
+func (c *UniverseRetentionConfig) Policy() (universe.RetentionPolicy, error) {
+	policy := universe.RetentionPolicy{
+		TransferProofMaxAge: c.TransferProofMaxAge,
+		MaxLeavesPerAsset:   c.MaxLeavesPerAsset,
+	}
+
+	for _, allowAsset := range c.AllowAssets {
+		desc, err := parseMultiverseLeafDesc(allowAsset)
+		if err != nil {
+			return policy, fmt.Errorf("invalid allowed asset: %w",
+				err)
+		}
+
+		policy.AllowedAssets = append(policy.AllowedAssets, desc)
+	}
+
+	for _, spamAsset := range c.SpamAssets {
+		desc, err := parseMultiverseLeafDesc(spamAsset)
+		if err != nil {
+			return policy, fmt.Errorf("invalid spam asset: %w", err)
+		}
+
+		policy.SpamAssets = append(policy.SpamAssets, desc)
+	}
+
+	return policy, nil
+}
+
+// parseMultiverseLeafDesc parses a hex encoded asset ID or compressed group
+// key into a multiverse leaf descriptor.
+func parseMultiverseLeafDesc(str string) (universe.MultiverseLeafDesc,
+	error) {
+
+	var desc universe.MultiverseLeafDesc
+
+	keyBytes, err := hex.DecodeString(str)
+	if err != nil {
+		return desc, fmt.Errorf("unable to decode %v: %w", str, err)
+	}
+
+	switch len(keyBytes) {
+	case sha256.Size:
+		var assetID asset.ID
+		copy(assetID[:], keyBytes)
+
+		return fn.NewLeft[asset.ID, btcec.PublicKey](assetID), nil
+
+	case btcec.PubKeyBytesLenCompressed:
+		groupKey, err := btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			return desc, fmt.Errorf("unable to parse group key "+
+				"%v: %w", str, err)
+		}
+
+		return fn.NewRight[asset.ID](*groupKey), nil
+
+	default:
+		return desc, fmt.Errorf("%v is neither an asset ID nor a "+
+			"compressed group key", str)
+	}
 }
 
 // AddrBookConfig is the config that houses any address Book related config
@@ -297,6 +479,32 @@ type AddrBookConfig struct {
 	DisableSyncer bool `long:"disable-syncer" description:"If true, tapd will not try to sync issuance proofs for unknown assets when creating an address."`
 }
 
+// ConsolidationConfig is the config that houses any asset UTXO consolidation
+// related config values.
+type ConsolidationConfig struct {
+	Enable bool `long:"enable" description:"If set, asset UTXOs will automatically be consolidated into a single anchor output per asset ID or asset group once their number passes the threshold and the on-chain fee rate is below the configured ceiling."`
+
+	Threshold uint32 `long:"threshold" description:"The number of anchor outputs an asset ID or asset group needs to be spread over before it is consolidated."`
+
+	MaxFeeRate uint64 `long:"maxfeerate" description:"The fee rate ceiling in sat/vByte. Consolidations are only performed while the estimated on-chain fee rate is at or below this value."`
+
+	Interval time.Duration `long:"interval" description:"The interval at which the asset UTXOs are checked for consolidation candidates. Valid time units are {s, m, h}."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c *ConsolidationConfig) Validate() error {
+	if c.Threshold < tapfreighter.MinConsolidationThreshold {
+		return fmt.Errorf("consolidation threshold must be at least %d",
+			tapfreighter.MinConsolidationThreshold)
+	}
+
+	if c.Enable && c.Interval <= 0 {
+		return fmt.Errorf("consolidation interval must be positive")
+	}
+
+	return nil
+}
+
 // ExperimentalConfig houses experimental tapd cli configuration options.
 type ExperimentalConfig struct {
 	Rfq rfq.CliConfig `group:"rfq" namespace:"rfq"`
@@ -346,6 +554,8 @@ type Config struct {
 
 	AddrBook *AddrBookConfig `group:"address" namespace:"address"`
 
+	Consolidation *ConsolidationConfig `group:"consolidation" namespace:"consolidation"`
+
 	Prometheus monitoring.PrometheusConfig `group:"prometheus" namespace:"prometheus"`
 
 	Experimental *ExperimentalConfig `group:"experimental" namespace:"experimental"`
@@ -429,7 +639,8 @@ func DefaultConfig() Config {
 		},
 		CustodianProofRetrievalDelay: defaultProofRetrievalDelay,
 		Universe: &UniverseConfig{
-			SyncInterval: defaultUniverseSyncInterval,
+			SyncInterval:           defaultUniverseSyncInterval,
+			FederationBanThreshold: universe.DefaultBanThreshold,
 			UniverseQueriesPerSecond: rate.Limit(
 				defaultUniverseMaxQps,
 			),
@@ -437,13 +648,29 @@ func DefaultConfig() Config {
 			MultiverseCaches: fn.Ptr(
 				tapdb.DefaultMultiverseCacheConfig(),
 			),
+			Retention: &UniverseRetentionConfig{},
+			Admission: &UniverseAdmissionConfig{
+				ClientBurst: defaultUniverseClientBurst,
+			},
 		},
 		AddrBook: &AddrBookConfig{
 			DisableSyncer: false,
 		},
+		Consolidation: &ConsolidationConfig{
+			Threshold:  defaultConsolidationThreshold,
+			MaxFeeRate: defaultConsolidationMaxFeeRate,
+			Interval:   defaultConsolidationInterval,
+		},
 		Experimental: &ExperimentalConfig{
 			Rfq: rfq.CliConfig{
 				AcceptPriceDeviationPpm: rfq.DefaultAcceptPriceDeviationPpm,
+				PriceOracleMinSources:   rfq.DefaultOracleMinSources,
+				PriceOracleOutlierPpm:   rfq.DefaultOracleOutlierPpm,
+				PriceOracleQueryTimeout: rfq.DefaultOracleQueryTimeout,
+				MinQuoteExpiry:          rfq.DefaultMinQuoteExpiry,
+				MaxQuoteExpiry:          rfq.DefaultMaxQuoteExpiry,
+				QuoteHistoryRetention:   rfq.DefaultQuoteHistoryRetention,
+				QuoteAuctionWindow:      rfq.DefaultQuoteAuctionWindow,
 			},
 		},
 	}
@@ -862,6 +1089,45 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 			"config: %w", err)
 	}
 
+	// Validate the asset UTXO consolidation config.
+	err = cfg.Consolidation.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("error in consolidation config: %w",
+			err)
+	}
+
+	// Validate the universe retention config.
+	err = cfg.Universe.Retention.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("error in universe retention config: "+
+			"%w", err)
+	}
+
+	// Validate the universe admission config.
+	err = cfg.Universe.Admission.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("error in universe admission config: "+
+			"%w", err)
+	}
+
+	// The SPV header chain needs bitcoin nodes to sync its headers from.
+	if cfg.Universe.SpvHeaders {
+		if len(cfg.Universe.SpvPeers) == 0 {
+			return nil, fmt.Errorf("universe.spv-peer must be " +
+				"set if universe.spv-headers is set")
+		}
+
+		for i, addr := range cfg.Universe.SpvPeers {
+			if _, _, err := net.SplitHostPort(addr); err == nil {
+				continue
+			}
+
+			cfg.Universe.SpvPeers[i] = net.JoinHostPort(
+				addr, cfg.ActiveNetParams.DefaultPort,
+			)
+		}
+	}
+
 	// Use a way higher re-org safe depth value for testnet (if the user
 	// didn't specify a custom value).
 	if cfg.ActiveNetParams.Net == chaincfg.TestNet3Params.Net &&
@@ -6,15 +6,20 @@ import (
 	"database/sql"
 	"encoding/binary"
 	"fmt"
+	"path/filepath"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btclog"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/tapbackup"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
@@ -24,6 +29,11 @@ import (
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/signal"
 )
 
@@ -131,11 +141,15 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	cfgLogger.Debugf("multiverse_cache=%v",
 		spew.Sdump(cfg.Universe.MultiverseCaches))
 
-	multiverse := tapdb.NewMultiverseStore(
-		multiverseDB, &tapdb.MultiverseStoreConfig{
-			Caches: *cfg.Universe.MultiverseCaches,
-		},
-	)
+	multiverseCfg := &tapdb.MultiverseStoreConfig{
+		Caches: *cfg.Universe.MultiverseCaches,
+	}
+	if cfg.Universe.MultiverseCaches.WarmStart {
+		multiverseCfg.WarmStartFile = filepath.Join(
+			cfg.networkDir, defaultMultiverseCacheFileName,
+		)
+	}
+	multiverse := tapdb.NewMultiverseStore(multiverseDB, multiverseCfg)
 
 	uniStatsDB := tapdb.NewTransactionExecutor(
 		db, func(tx *sql.Tx) tapdb.UniverseStatsStore {
@@ -161,18 +175,86 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	groupVerifier := tapgarden.GenGroupVerifier(
 		context.Background(), assetMintingStore,
 	)
+
+	// The universe can optionally verify the block headers of proofs
+	// against its own SPV header chain, so proof verification and the
+	// retention policy don't depend on the chain backend of lnd. This only
+	// covers universe proofs. A universe without a connected lnd node is
+	// not supported, since all other subsystems, including the wallet and
+	// the signer of the universe, still require it.
+	var (
+		uniHeaderVerifier                            = headerVerifier
+		uniChainLookupGen proof.ChainLookupGenerator = chainBridge
+		uniCurrentHeight  func(context.Context) (uint32, error)
+		spvHeaderChain    fn.Option[*proof.HeaderChain]
+	)
+	uniCurrentHeight = chainBridge.CurrentHeight
+	if cfg.Universe.SpvHeaders {
+		spvHeaderStore := tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.SpvHeaderStore {
+				return db.WithTx(tx)
+			},
+		)
+		headerSource := proof.NewPeerHeaderSource(
+			&proof.PeerHeaderSourceConfig{
+				Peers:            cfg.Universe.SpvPeers,
+				ChainParams:      &cfg.ActiveNetParams,
+				UserAgentName:    "tapd",
+				UserAgentVersion: tap.Version(),
+				Timeout:          proof.DefaultPeerTimeout,
+			},
+		)
+
+		headerChain := proof.NewHeaderChain(&proof.HeaderChainConfig{
+			Store:        tapdb.NewSpvHeaderDB(spvHeaderStore),
+			Source:       headerSource,
+			ChainParams:  &cfg.ActiveNetParams,
+			Clock:        defaultClock,
+			SyncInterval: proof.DefaultHeaderSyncInterval,
+		})
+
+		cfgLogger.Infof("Verifying universe proofs against SPV header "+
+			"chain synced from %v, lnd is still required for all "+
+			"other subsystems", cfg.Universe.SpvPeers)
+
+		uniHeaderVerifier = headerChain.HeaderVerifier(
+			context.Background(),
+		)
+		uniChainLookupGen = tap.NewSpvChainLookupGenerator(
+			headerChain, assetStore,
+		)
+		uniCurrentHeight = headerChain.CurrentHeight
+		spvHeaderChain = fn.Some(headerChain)
+	}
+
+	// The pruner is also used by the archive and the syncer to skip the
+	// leaves that it would prune right away.
+	retentionPolicy, err := cfg.Universe.Retention.Policy()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe retention "+
+			"policy: %w", err)
+	}
+	universePruner := universe.NewPruner(&universe.PrunerConfig{
+		Policy:        retentionPolicy,
+		Multiverse:    multiverse,
+		LeafPruner:    multiverse,
+		CurrentHeight: uniCurrentHeight,
+		PruneInterval: cfg.Universe.Retention.PruneInterval,
+	})
+
 	uniCfg := universe.ArchiveConfig{
 		NewBaseTree: func(id universe.Identifier) universe.BaseBackend {
 			return tapdb.NewBaseUniverseTree(
 				uniDB, id,
 			)
 		},
-		HeaderVerifier:       headerVerifier,
+		HeaderVerifier:       uniHeaderVerifier,
 		MerkleVerifier:       proof.DefaultMerkleVerifier,
 		GroupVerifier:        groupVerifier,
-		ChainLookupGenerator: chainBridge,
+		ChainLookupGenerator: uniChainLookupGen,
 		Multiverse:           multiverse,
 		UniverseStats:        universeStats,
+		Retention:            universePruner,
 	}
 
 	federationStore := tapdb.NewTransactionExecutor(db,
@@ -188,9 +270,19 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	if err != nil {
 		return nil, fmt.Errorf("unable to open disk archive: %w", err)
 	}
+	verifiedProofDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.VerifiedProofStore {
+			return db.WithTx(tx)
+		},
+	)
+	verifiedProofCache := tapdb.NewVerifiedProofDB(
+		verifiedProofDB, defaultClock,
+	)
+
 	proofArchive := proof.NewMultiArchiver(
-		&proof.BaseVerifier{}, tapdb.DefaultStoreTimeout,
-		assetStore, proofFileStore,
+		&proof.StreamVerifier{
+			Cache: verifiedProofCache,
+		}, tapdb.DefaultStoreTimeout, assetStore, proofFileStore,
 	)
 
 	federationMembers := cfg.Universe.FederationServers
@@ -277,7 +369,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		GroupVerifier: tapgarden.GenGroupVerifier(
 			context.Background(), assetMintingStore,
 		),
-		ProofArchive: proofArchive,
+		ProofArchive:       proofArchive,
+		VerifiedProofCache: verifiedProofCache,
 		NonBuriedAssetFetcher: func(ctx context.Context,
 			minHeight int32) ([]*asset.ChainAsset, error) {
 
@@ -293,11 +386,17 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	baseUni := universe.NewArchive(uniCfg)
 
+	// Federation servers that serve invalid proofs too often are banned
+	// from syncing, both by the syncer and the federation envoy.
+	banThreshold := cfg.Universe.FederationBanThreshold
 	universeSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
 		LocalDiffEngine:     baseUni,
 		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
 		LocalRegistrar:      baseUni,
 		SyncBatchSize:       defaultUniverseSyncBatchSize,
+		ReputationLog:       federationDB,
+		BanThreshold:        banThreshold,
+		Retention:           universePruner,
 	})
 
 	var runtimeIDBytes [8]byte
@@ -315,13 +414,18 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			SyncInterval:            cfg.Universe.SyncInterval,
 			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
 			StaticFederationMembers: federationMembers,
-			ServerChecker: func(addr universe.ServerAddr) error {
+			ServerChecker: func(addr universe.ServerAddr) (
+				universe.ServerAddr, error) {
+
 				return tap.CheckFederationServer(
 					runtimeID, universe.DefaultTimeout,
 					addr,
 				)
 			},
-			ErrChan: mainErrChan,
+			ErrChan:                 mainErrChan,
+			LiveSync:                cfg.Universe.LiveSync,
+			NewRemoteLeafSubscriber: tap.NewRpcUniverseLeafSubscriber,
+			BanThreshold:            banThreshold,
 		},
 	)
 
@@ -368,8 +472,39 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	var priceOracle rfq.PriceOracle
 
 	rfqCfg := cfg.Experimental.Rfq
-	switch rfqCfg.PriceOracleAddress {
-	case rfq.MockPriceOracleServiceAddress:
+	switch {
+	case len(rfqCfg.PriceOracleSources) > 0:
+		sources := make(
+			[]rfq.OracleSource, 0, len(rfqCfg.PriceOracleSources),
+		)
+		for _, addr := range rfqCfg.PriceOracleSources {
+			oracle, err := rfq.NewPriceOracle(addr)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create price "+
+					"oracle source %s: %w", addr, err)
+			}
+
+			sources = append(sources, rfq.OracleSource{
+				Name:   addr,
+				Oracle: oracle,
+			})
+		}
+
+		priceOracle, err = rfq.NewCompositePriceOracle(
+			rfq.CompositePriceOracleCfg{
+				Sources:      sources,
+				MinSources:   rfqCfg.PriceOracleMinSources,
+				OutlierPpm:   rfqCfg.PriceOracleOutlierPpm,
+				QueryTimeout: rfqCfg.PriceOracleQueryTimeout,
+				MinExpiry:    rfqCfg.MinQuoteExpiry,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create composite "+
+				"price oracle: %w", err)
+		}
+
+	case rfqCfg.PriceOracleAddress == rfq.MockPriceOracleServiceAddress:
 		switch {
 		case rfqCfg.MockOracleAssetsPerBTC > 0:
 			priceOracle = rfq.NewMockPriceOracle(
@@ -382,21 +517,26 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			)
 		}
 
-	case "":
+	case rfqCfg.PriceOracleAddress == "":
 		// Leave the price oracle as nil, which will cause the RFQ
 		// manager to reject all incoming RFQ requests. It will also
 		// skip setting suggested prices for outgoing quote requests.
 
 	default:
-		priceOracle, err = rfq.NewRpcPriceOracle(
-			rfqCfg.PriceOracleAddress, false,
-		)
+		priceOracle, err = rfq.NewPriceOracle(rfqCfg.PriceOracleAddress)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create price "+
 				"oracle: %w", err)
 		}
 	}
 
+	rfqQuoteDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.RfqQuoteStore {
+			return db.WithTx(tx)
+		},
+	)
+	rfqQuoteStore := tapdb.NewRfqQuoteDB(rfqQuoteDB)
+
 	// Construct the RFQ manager.
 	rfqManager, err := rfq.NewManager(
 		rfq.ManagerCfg{
@@ -410,6 +550,12 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			AcceptPriceDeviationPpm: rfqCfg.AcceptPriceDeviationPpm,
 			// nolint: lll
 			SkipAcceptQuotePriceCheck: rfqCfg.SkipAcceptQuotePriceCheck,
+			RateHintDeviationPpm:      rfqCfg.RateHintDeviationPpm,
+			MinQuoteExpiry:            rfqCfg.MinQuoteExpiry,
+			MaxQuoteExpiry:            rfqCfg.MaxQuoteExpiry,
+			QuoteStore:                rfqQuoteStore,
+			QuoteHistoryRetention:     rfqCfg.QuoteHistoryRetention,
+			QuoteAuctionWindow:        rfqCfg.QuoteAuctionWindow,
 			ErrChan:                   mainErrChan,
 		},
 	)
@@ -439,10 +585,123 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			ProofWriter:            proofFileStore,
 			ProofCourierDispatcher: proofCourierDispatcher,
 			ProofWatcher:           reOrgWatcher,
+			VerifiedProofCache:     verifiedProofCache,
 			ErrChan:                mainErrChan,
 		},
 	)
 
+	// The consolidator only runs its background check if automatic
+	// consolidation is enabled. Manual consolidations through the RPC
+	// interface are always possible.
+	var consolidationInterval time.Duration
+	if cfg.Consolidation.Enable {
+		consolidationInterval = cfg.Consolidation.Interval
+	}
+	consolidator := tapfreighter.NewConsolidator(
+		&tapfreighter.ConsolidatorConfig{
+			CoinSelector: coinSelect,
+			AssetWallet:  assetWallet,
+			KeyRing:      keyRing,
+			ChainBridge:  chainBridge,
+			Porter:       chainPorter,
+			ChainParams:  &tapChainParams,
+			Threshold:    cfg.Consolidation.Threshold,
+			MaxFeeRate: chainfee.SatPerKVByte(
+				cfg.Consolidation.MaxFeeRate * 1000,
+			).FeePerKWeight(),
+			CheckInterval: consolidationInterval,
+		},
+	)
+
+	uniCommitmentDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.UniCommitmentStore {
+			return db.WithTx(tx)
+		},
+	)
+	uniCommitmentLog := tapdb.NewUniverseCommitmentDB(
+		uniCommitmentDB, defaultClock,
+	)
+	universeCommitter := tapgarden.NewUniverseCommitter(
+		&tapgarden.UniverseCommitterConfig{
+			Multiverse:     multiverse,
+			CommitmentLog:  uniCommitmentLog,
+			Wallet:         walletAnchor,
+			KeyRing:        keyRing,
+			ChainBridge:    chainBridge,
+			ChainParams:    &tapChainParams,
+			CommitInterval: cfg.Universe.CommitInterval,
+		},
+	)
+
+	nodeKey, err := btcec.ParsePubKey(lndServices.NodePubkey[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse node key: %w", err)
+	}
+	nodeKeyLoc := keychain.KeyLocator{
+		Family: keychain.KeyFamilyNodeKey,
+	}
+	rootGossipDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.RootGossipStore {
+			return db.WithTx(tx)
+		},
+	)
+	universeGossiper := universe.NewRootGossiper(
+		&universe.RootGossiperConfig{
+			Multiverse: multiverse,
+			GossipLog: tapdb.NewRootGossipDB(
+				rootGossipDB, defaultClock,
+			),
+			FederationDB: federationDB,
+			NodeKey:      nodeKey,
+			SignMessage: func(ctx context.Context,
+				msg []byte) ([]byte, error) {
+
+				return lndServices.Signer.SignMessage(
+					ctx, msg, nodeKeyLoc,
+				)
+			},
+			NewSignedRootFetcher: tap.NewRpcSignedRootFetcher,
+			GossipInterval:       cfg.Universe.SyncInterval,
+			Clock:                defaultClock,
+		},
+	)
+
+	universeAdmitter := universe.NewInsertAdmitter(
+		&universe.AdmissionConfig{
+			Policy: cfg.Universe.Admission.Policy(),
+			AddInvoice: func(ctx context.Context,
+				amt lnwire.MilliSatoshi, memo string,
+				expiry time.Duration) (lntypes.Hash, string,
+				error) {
+
+				return lndServices.Client.AddInvoice(
+					ctx, &invoicesrpc.AddInvoiceData{
+						Memo:   memo,
+						Value:  amt,
+						Expiry: int64(expiry.Seconds()),
+					},
+				)
+			},
+			Clock: defaultClock,
+		},
+	)
+
+	backupManager := tapbackup.NewManager(&tapbackup.ManagerConfig{
+		AssetStore:     assetStore,
+		ProofArchive:   proofArchive,
+		KeyStore:       tapdbAddrBook,
+		AddrBook:       addrBook,
+		ExportLog:      assetStore,
+		Porter:         chainPorter,
+		WalletAnchor:   walletAnchor,
+		KeyRing:        keyRing,
+		ChainParams:    &tapChainParams,
+		HeaderVerifier: headerVerifier,
+		MerkleVerifier: proof.DefaultMerkleVerifier,
+		GroupVerifier:  groupVerifier,
+		ChainLookupGen: chainBridge,
+	})
+
 	auxLeafSigner := tapchannel.NewAuxLeafSigner(
 		&tapchannel.LeafSignerConfig{
 			ChainParams: &tapChainParams,
@@ -503,6 +762,18 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			ChainBridge: chainBridge,
 		},
 	)
+	channelBackupManager := tapchannel.NewChannelBackupManager(
+		&tapchannel.ChannelBackupCfg{
+			KeyRing:  keyRing,
+			AddrBook: addrBook,
+			RestoredBackupsFile: filepath.Join(
+				cfg.networkDir, defaultChannelBackupsFileName,
+			),
+			LatestBackupsFile: filepath.Join(
+				cfg.networkDir, defaultChannelStatesFileName,
+			),
+		},
+	)
 	auxSweeper := tapchannel.NewAuxSweeper(
 		&tapchannel.AuxSweeperCfg{
 			AddrBook:           addrBook,
@@ -516,7 +787,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			GroupVerifier: tapgarden.GenGroupVerifier(
 				context.Background(), assetMintingStore,
 			),
-			ChainBridge: chainBridge,
+			ChainBridge:    chainBridge,
+			ChannelBackups: channelBackupManager,
 		},
 	)
 
@@ -561,6 +833,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ChainParams:  &tapChainParams,
 				WalletAnchor: walletAnchor,
 				ChainBridge:  chainBridge,
+				KeyRing:      keyRing,
 				GroupVerifier: tapgarden.GenGroupVerifier(
 					context.Background(), assetMintingStore,
 				),
@@ -580,14 +853,24 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		AssetWallet:              assetWallet,
 		CoinSelect:               coinSelect,
 		ChainPorter:              chainPorter,
+		Consolidator:             consolidator,
+		BackupManager:            backupManager,
 		UniverseArchive:          baseUni,
 		UniverseSyncer:           universeSyncer,
 		UniverseFederation:       universeFederation,
+		UniverseCommitter:        universeCommitter,
+		UniverseCommitments:      uniCommitmentLog,
+		UniverseGossiper:         universeGossiper,
+		UniverseAdmitter:         universeAdmitter,
+		UniversePruner:           universePruner,
+		SpvHeaderChain:           spvHeaderChain,
 		UniFedSyncAllAssets:      cfg.Universe.SyncAllAssets,
 		UniverseStats:            universeStats,
+		UniverseSearcher:         universeStats,
 		UniversePublicAccess:     universePublicAccess,
 		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
 		UniverseQueriesBurst:     cfg.Universe.UniverseQueriesBurst,
+		UniverseBanThreshold:     cfg.Universe.FederationBanThreshold,
 		RfqManager:               rfqManager,
 		PriceOracle:              priceOracle,
 		AuxLeafSigner:            auxLeafSigner,
@@ -596,6 +879,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		AuxTrafficShaper:         auxTrafficShaper,
 		AuxInvoiceManager:        auxInvoiceManager,
 		AuxSweeper:               auxSweeper,
+		ChannelBackupManager:     channelBackupManager,
 		LogWriter:                cfg.LogWriter,
 		DatabaseConfig: &tap.DatabaseConfig{
 			RootKeyStore: tapdb.NewRootKeyStore(rksDB),
@@ -129,6 +129,20 @@ type AuxSweeperCfg struct {
 
 	// ChainBridge is used to fetch blocks from the main chain.
 	ChainBridge tapgarden.ChainBridge
+
+	// ChannelBackups is used to look up restored channel backups, which
+	// allow us to sweep our assets from the remote party's commitment if
+	// we lost our own channel state.
+	ChannelBackups ChannelBackupSource
+}
+
+// ChannelBackupSource is used to look up the asset channel backups that were
+// restored after the channel state was lost.
+type ChannelBackupSource interface {
+	// FetchRestoredBackup returns the restored backup of the given
+	// channel, if there is one.
+	FetchRestoredBackup(
+		chanPoint wire.OutPoint) lfn.Option[*cmsg.ChannelBackup]
 }
 
 // AuxSweeper is used to sweep funds from a commitment transaction that has
@@ -1640,6 +1654,112 @@ func (a *AuxSweeper) importCommitTx(req lnwallet.ResolutionReq,
 	)
 }
 
+// reqFromChannelBackup attempts to fill in the commit and funding blob of the
+// given resolution request from a restored channel backup. A backup only
+// contains the state of the remote party's commitment, so we can only resolve
+// our output on the remote commitment with it. The returned boolean is false
+// if the request can't be resolved with a backup.
+func (a *AuxSweeper) reqFromChannelBackup(
+	req lnwallet.ResolutionReq) (lnwallet.ResolutionReq, bool) {
+
+	switch {
+	case a.cfg.ChannelBackups == nil, req.KeyRing == nil:
+		return req, false
+
+	case req.Type != input.TaprootRemoteCommitSpend:
+		return req, false
+	}
+
+	backup, err := a.cfg.ChannelBackups.FetchRestoredBackup(
+		req.ChanPoint,
+	).UnwrapOrErr(ErrChannelBackupNotFound)
+	if err != nil {
+		return req, false
+	}
+
+	// Our output on the remote commitment always uses the same script key,
+	// so we can make sure the backup actually belongs to this channel.
+	scriptKey, err := remoteCommitScriptKey(
+		req.KeyRing.ToRemoteKey,
+	).Unpack()
+	if err != nil {
+		log.Warnf("Unable to create remote script key for channel "+
+			"backup of %v: %v", req.ChanPoint, err)
+		return req, false
+	}
+	backupScriptKey := backup.ToRemoteScriptKey()
+	if !scriptKey.PubKey.IsEqual(backupScriptKey.PubKey) {
+		log.Warnf("Script key of channel backup of %v doesn't match "+
+			"the remote commitment, ignoring backup", req.ChanPoint)
+		return req, false
+	}
+
+	// The script key is the same for every commitment though, so we also
+	// need to make sure the backup is for the commitment that was actually
+	// confirmed. Otherwise the commit blob describes asset outputs that
+	// don't exist on-chain.
+	err = checkBackupCommitment(req, backup)
+	if err != nil {
+		log.Warnf("Channel backup of %v at commit_height=%d doesn't "+
+			"match the confirmed remote commitment, ignoring "+
+			"backup: %v", req.ChanPoint, backup.CommitHeight.Val,
+			err)
+		return req, false
+	}
+
+	log.Infof("Using restored channel backup for chan_point=%v at "+
+		"commit_height=%d", req.ChanPoint, backup.CommitHeight.Val)
+
+	req.CommitBlob = lfn.Some[tlv.Blob](backup.CommitBlob.Val)
+	req.FundingBlob = lfn.Some[tlv.Blob](backup.FundingBlob.Val)
+
+	return req, true
+}
+
+// checkBackupCommitment makes sure the remote commitment of the given channel
+// backup is the one that was confirmed on-chain. Our to_remote output commits
+// to our asset outputs through its auxiliary leaf, so we re-create the output
+// script from the backup and compare it to the output being resolved.
+func checkBackupCommitment(req lnwallet.ResolutionReq,
+	backup *cmsg.ChannelBackup) error {
+
+	commitTx := req.CommitTx
+	contractPoint := req.ContractPoint
+	switch {
+	case commitTx == nil:
+		return fmt.Errorf("no commitment transaction")
+
+	case contractPoint.Hash != commitTx.TxHash():
+		return fmt.Errorf("contract point %v doesn't spend from "+
+			"commitment transaction %v", contractPoint,
+			commitTx.TxHash())
+
+	case int(contractPoint.Index) >= len(commitTx.TxOut):
+		return fmt.Errorf("contract point %v doesn't exist",
+			contractPoint)
+	}
+
+	commitment, err := backup.RemoteCommitment()
+	if err != nil {
+		return fmt.Errorf("unable to decode remote commitment: %w", err)
+	}
+
+	scriptTree, err := input.NewRemoteCommitScriptTree(
+		req.KeyRing.ToRemoteKey, commitment.Leaves().RemoteAuxLeaf,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create to_remote script: %w", err)
+	}
+
+	pkScript := commitTx.TxOut[contractPoint.Index].PkScript
+	if !bytes.Equal(scriptTree.PkScript(), pkScript) {
+		return fmt.Errorf("to_remote output %v doesn't commit to the "+
+			"asset outputs of the backup", contractPoint)
+	}
+
+	return nil
+}
+
 // errNoPayHash is an error returned when no payment hash is provided.
 var errNoPayHash = fmt.Errorf("no payment hash provided")
 
@@ -1651,9 +1771,16 @@ func (a *AuxSweeper) resolveContract(
 
 	type returnType = tlv.Blob
 
-	// If there's no commit blob, then there's nothing to resolve.
+	// If there's no commit blob, we might have lost our channel state and
+	// restored the channel from a static channel backup. In that case, we
+	// can still resolve the contract if we have an asset channel backup.
+	// Otherwise, there's nothing to resolve.
 	if req.CommitBlob.IsNone() {
-		return lfn.Err[tlv.Blob](nil)
+		var ok bool
+		req, ok = a.reqFromChannelBackup(req)
+		if !ok {
+			return lfn.Err[tlv.Blob](nil)
+		}
 	}
 
 	log.Infof("Generating resolution_blob for contract_type=%v, "+
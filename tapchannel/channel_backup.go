@@ -0,0 +1,420 @@
+package tapchannel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tapbackup"
+	cmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/channeldb"
+	lfn "github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// ChannelBackupVersion is the version of the encrypted channel backup
+	// format.
+	ChannelBackupVersion uint8 = 0
+)
+
+var (
+	// ChannelBackupMagicBytes are the magic bytes every encrypted channel
+	// backup starts with.
+	ChannelBackupMagicBytes = [4]byte{0x74, 0x61, 0x70, 0x63}
+
+	// ErrChannelBackupNotFound is returned when no backup is known for a
+	// requested channel.
+	ErrChannelBackupNotFound = errors.New("channel backup not found")
+
+	// ErrInvalidChannelBackup is returned when an encrypted channel backup
+	// doesn't start with the magic bytes or has an unknown version.
+	ErrInvalidChannelBackup = errors.New("invalid channel backup")
+)
+
+// ChannelBackupCfg holds the configuration for the ChannelBackupManager.
+type ChannelBackupCfg struct {
+	// KeyRing is used to derive the key that channel backups are encrypted
+	// with. This is the same key lnd encrypts its static channel backups
+	// with.
+	KeyRing tapgarden.KeyRing
+
+	// AddrBook is used to import the script keys of restored channels, so
+	// our assets on the remote party's commitment are detected as ours.
+	AddrBook *address.Book
+
+	// RestoredBackupsFile is the path of the file that restored channel
+	// backups are persisted in, so they survive a restart until the
+	// channels are closed.
+	RestoredBackupsFile string
+
+	// LatestBackupsFile is the path of the file that the latest known
+	// remote commitment state of each channel is persisted in, so backups
+	// can still be exported after a restart.
+	LatestBackupsFile string
+}
+
+// ChannelBackupManager keeps track of the latest asset state of the remote
+// commitment of each asset channel. That state can be exported as an encrypted
+// backup, which is the asset equivalent of lnd's static channel backups. If the
+// channel state is lost, the backup can be restored so that the AuxSweeper can
+// claim our assets from the remote party's commitment once the remote party
+// force closes the channel (which they are asked to do by lnd's data loss
+// protection).
+//
+// Unlike lnd's static channel backups, an asset channel backup is only valid
+// for the remote commitment that was current when it was exported. The asset
+// outputs of a newer remote commitment can't be swept with a stale backup, so
+// a backup needs to be exported again after each channel update to cover all
+// of the channel's assets.
+type ChannelBackupManager struct {
+	started atomic.Bool
+
+	cfg *ChannelBackupCfg
+
+	// latest is the latest known remote commitment state of each channel.
+	latest map[wire.OutPoint]*cmsg.ChannelBackup
+
+	// restored are the backups that were restored from an exported
+	// channel backup.
+	restored map[wire.OutPoint]*cmsg.ChannelBackup
+
+	mu sync.RWMutex
+}
+
+// NewChannelBackupManager creates a new channel backup manager from the given
+// config.
+func NewChannelBackupManager(cfg *ChannelBackupCfg) *ChannelBackupManager {
+	return &ChannelBackupManager{
+		cfg:      cfg,
+		latest:   make(map[wire.OutPoint]*cmsg.ChannelBackup),
+		restored: make(map[wire.OutPoint]*cmsg.ChannelBackup),
+	}
+}
+
+// Start loads the previously restored channel backups and the latest known
+// remote commitment states from disk.
+func (m *ChannelBackupManager) Start() error {
+	if !m.started.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Starting channel backup manager")
+
+	restored, err := readBackupsFile(m.cfg.RestoredBackupsFile)
+	if err != nil {
+		return fmt.Errorf("unable to load restored channel backups: "+
+			"%w", err)
+	}
+
+	latest, err := readBackupsFile(m.cfg.LatestBackupsFile)
+	if err != nil {
+		return fmt.Errorf("unable to load latest channel states: %w",
+			err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, backup := range restored {
+		m.restored[backup.ChanPoint.Val.ChanPoint] = backup
+	}
+
+	// A commitment that was tracked before the state on disk was loaded
+	// is never replaced by an older one.
+	for _, backup := range latest {
+		chanPoint := backup.ChanPoint.Val.ChanPoint
+		existing, ok := m.latest[chanPoint]
+		if ok && existing.CommitHeight.Val >= backup.CommitHeight.Val {
+			continue
+		}
+
+		m.latest[chanPoint] = backup
+	}
+
+	log.Infof("Loaded %d restored channel backups and the latest state "+
+		"of %d channels", len(restored), len(latest))
+
+	return nil
+}
+
+// TrackRemoteCommit updates the backup of the given channel with the state of
+// the given remote commitment, if it is more recent than the one we already
+// know about. The updated state is persisted, so it survives a restart.
+// Commitments of channels without any assets are ignored.
+func (m *ChannelBackupManager) TrackRemoteCommit(
+	chanState lnwallet.AuxChanState, commit channeldb.ChannelCommitment,
+	keys lnwallet.CommitmentKeyRing) error {
+
+	if chanState.CustomBlob.IsNone() || commit.CustomBlob.IsNone() {
+		return nil
+	}
+
+	// The to remote output on the remote party's commitment is ours, so
+	// the to remote key is a key of our wallet.
+	toRemoteScriptKey, err := remoteCommitScriptKey(
+		keys.ToRemoteKey,
+	).Unpack()
+	if err != nil {
+		return fmt.Errorf("unable to create remote script key: %w", err)
+	}
+
+	chanPoint := chanState.FundingOutpoint
+	backup, err := cmsg.NewChannelBackup(
+		chanPoint, chanState.CustomBlob.UnwrapOr(nil),
+		commit.CommitHeight, commit.CustomBlob.UnwrapOr(nil),
+		toRemoteScriptKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The same commitment is usually tracked multiple times, so we only
+	// write to disk if the state actually changed.
+	existing, ok := m.latest[chanPoint]
+	if ok && existing.CommitHeight.Val >= commit.CommitHeight {
+		return nil
+	}
+
+	log.Tracef("Tracking remote commitment of channel %v at height %d",
+		chanPoint, commit.CommitHeight)
+
+	m.latest[chanPoint] = backup
+
+	err = writeBackupsFile(m.cfg.LatestBackupsFile, m.latest)
+	if err != nil {
+		return fmt.Errorf("unable to persist latest channel states: %w",
+			err)
+	}
+
+	return nil
+}
+
+// ExportChannelBackups creates an encrypted backup of the given channels. If no
+// channels are given, all channels with a known asset state are included. The
+// backup only covers the current remote commitment of each channel.
+func (m *ChannelBackupManager) ExportChannelBackups(ctx context.Context,
+	chanPoints ...wire.OutPoint) ([]byte, error) {
+
+	m.mu.RLock()
+	var backups []*cmsg.ChannelBackup
+	if len(chanPoints) == 0 {
+		for _, backup := range m.latest {
+			backups = append(backups, backup)
+		}
+	}
+	for _, chanPoint := range chanPoints {
+		backup, ok := m.latest[chanPoint]
+		if !ok {
+			m.mu.RUnlock()
+			return nil, fmt.Errorf("%w: %v",
+				ErrChannelBackupNotFound, chanPoint)
+		}
+
+		backups = append(backups, backup)
+	}
+	m.mu.RUnlock()
+
+	// We sort the backups to make the export deterministic.
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].ChanPoint.Val.ChanPoint.String() <
+			backups[j].ChanPoint.Val.ChanPoint.String()
+	})
+
+	log.Infof("Exporting backups of %d channels", len(backups))
+
+	return encryptChannelBackups(ctx, backups, m.cfg.KeyRing)
+}
+
+// RestoreChannelBackups decrypts the given channel backup and stores the
+// contained channels, so our assets can be swept once the remote party force
+// closes them. The funding outpoints of the restored channels are returned.
+func (m *ChannelBackupManager) RestoreChannelBackups(ctx context.Context,
+	blob []byte) ([]wire.OutPoint, error) {
+
+	backups, err := decryptChannelBackups(ctx, blob, m.cfg.KeyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	// We import the script keys of our outputs on the remote commitments
+	// first, so the assets are recognized as ours once they are swept.
+	chanPoints := make([]wire.OutPoint, 0, len(backups))
+	for _, backup := range backups {
+		err := m.cfg.AddrBook.InsertScriptKey(
+			ctx, backup.ToRemoteScriptKey(), true,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to insert script "+
+				"key: %w", err)
+		}
+
+		chanPoints = append(chanPoints, backup.ChanPoint.Val.ChanPoint)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, backup := range backups {
+		m.restored[backup.ChanPoint.Val.ChanPoint] = backup
+	}
+
+	err = writeBackupsFile(m.cfg.RestoredBackupsFile, m.restored)
+	if err != nil {
+		return nil, fmt.Errorf("unable to persist restored channel "+
+			"backups: %w", err)
+	}
+
+	log.Infof("Restored backups of %d channels", len(backups))
+
+	return chanPoints, nil
+}
+
+// FetchRestoredBackup returns the restored backup of the given channel, if
+// there is one.
+func (m *ChannelBackupManager) FetchRestoredBackup(
+	chanPoint wire.OutPoint) lfn.Option[*cmsg.ChannelBackup] {
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	backup, ok := m.restored[chanPoint]
+	if !ok {
+		return lfn.None[*cmsg.ChannelBackup]()
+	}
+
+	return lfn.Some(backup)
+}
+
+// readBackupsFile reads the channel backups that were written to the given
+// file with writeBackupsFile. A file that doesn't exist yet contains no
+// backups.
+func readBackupsFile(path string) ([]*cmsg.ChannelBackup, error) {
+	backupBytes, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to read channel backups: %w",
+			err)
+	}
+
+	backups, err := cmsg.DecodeChannelBackups(bytes.NewReader(backupBytes))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode channel backups: %w",
+			err)
+	}
+
+	return backups, nil
+}
+
+// writeBackupsFile writes the given channel backups to the given file. The file
+// is replaced atomically, so a crash can't leave a partially written file
+// behind.
+func writeBackupsFile(path string,
+	backupMap map[wire.OutPoint]*cmsg.ChannelBackup) error {
+
+	backups := make([]*cmsg.ChannelBackup, 0, len(backupMap))
+	for _, backup := range backupMap {
+		backups = append(backups, backup)
+	}
+
+	var buf bytes.Buffer
+	if err := cmsg.EncodeChannelBackups(&buf, backups); err != nil {
+		return fmt.Errorf("unable to encode channel backups: %w", err)
+	}
+
+	tempFile := path + ".tmp"
+	if err := os.WriteFile(tempFile, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("unable to write channel backups: %w", err)
+	}
+
+	if err := os.Rename(tempFile, path); err != nil {
+		return fmt.Errorf("unable to replace channel backups: %w", err)
+	}
+
+	return nil
+}
+
+// encryptChannelBackups encodes and encrypts the given channel backups. The
+// resulting blob consists of the magic bytes and the version in plain text,
+// followed by the encrypted backups.
+func encryptChannelBackups(ctx context.Context, backups []*cmsg.ChannelBackup,
+	keyRing tapgarden.KeyRing) ([]byte, error) {
+
+	var payload bytes.Buffer
+	if err := cmsg.EncodeChannelBackups(&payload, backups); err != nil {
+		return nil, fmt.Errorf("unable to encode channel backups: %w",
+			err)
+	}
+
+	encrypter, err := tapbackup.NewEncrypter(ctx, keyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	var blob bytes.Buffer
+	blob.Write(ChannelBackupMagicBytes[:])
+	blob.WriteByte(ChannelBackupVersion)
+
+	err = encrypter.EncryptPayloadToWriter(payload.Bytes(), &blob)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt channel backups: %w",
+			err)
+	}
+
+	return blob.Bytes(), nil
+}
+
+// decryptChannelBackups decrypts and decodes channel backups that were created
+// with encryptChannelBackups.
+func decryptChannelBackups(ctx context.Context, blob []byte,
+	keyRing tapgarden.KeyRing) ([]*cmsg.ChannelBackup, error) {
+
+	magicLen := len(ChannelBackupMagicBytes)
+	if len(blob) < magicLen+1 ||
+		!bytes.Equal(blob[:magicLen], ChannelBackupMagicBytes[:]) {
+
+		return nil, fmt.Errorf("%w: invalid magic bytes",
+			ErrInvalidChannelBackup)
+	}
+
+	version := blob[magicLen]
+	if version != ChannelBackupVersion {
+		return nil, fmt.Errorf("%w: unknown version %d",
+			ErrInvalidChannelBackup, version)
+	}
+
+	encrypter, err := tapbackup.NewEncrypter(ctx, keyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := encrypter.DecryptPayloadFromReader(
+		bytes.NewReader(blob[magicLen+1:]),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt channel backups: %w",
+			err)
+	}
+
+	backups, err := cmsg.DecodeChannelBackups(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode channel backups: %w",
+			err)
+	}
+
+	return backups, nil
+}
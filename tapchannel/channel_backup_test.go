@@ -0,0 +1,351 @@
+package tapchannel
+
+import (
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	cmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
+	"github.com/lightningnetwork/lnd/channeldb"
+	lfn "github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeyRing is a key ring that always derives the same key.
+type testKeyRing struct {
+	privKey *btcec.PrivateKey
+}
+
+// DeriveNextKey returns the key of the key ring.
+func (k *testKeyRing) DeriveNextKey(context.Context,
+	keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{PubKey: k.privKey.PubKey()}, nil
+}
+
+// DeriveKey returns the key of the key ring.
+func (k *testKeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     k.privKey.PubKey(),
+	}, nil
+}
+
+// IsLocalKey returns true for all keys.
+func (k *testKeyRing) IsLocalKey(context.Context, keychain.KeyDescriptor) bool {
+	return true
+}
+
+// testAssetChannel is an asset channel with a fixed to remote key.
+type testAssetChannel struct {
+	chanState lnwallet.AuxChanState
+	keys      lnwallet.CommitmentKeyRing
+}
+
+// newTestAssetChannel creates a new asset channel with a random channel point
+// and to remote key.
+func newTestAssetChannel(t *testing.T) *testAssetChannel {
+	return &testAssetChannel{
+		chanState: lnwallet.AuxChanState{
+			FundingOutpoint: test.RandOp(t),
+			CustomBlob: lfn.Some[tlv.Blob](
+				cmsg.NewOpenChannel(nil, 0).Bytes(),
+			),
+		},
+		keys: lnwallet.CommitmentKeyRing{
+			ToRemoteKey: test.RandPubKey(t),
+		},
+	}
+}
+
+// commitment returns a remote commitment of the channel at the given height.
+func (c *testAssetChannel) commitment(
+	height uint64) channeldb.ChannelCommitment {
+
+	commitment := cmsg.NewCommitment(
+		nil, nil, nil, nil, lnwallet.CommitAuxLeaves{
+			RemoteAuxLeaf: lfn.Some(remoteAuxLeaf(height)),
+		},
+	)
+
+	return channeldb.ChannelCommitment{
+		CommitHeight: height,
+		CustomBlob:   lfn.Some[tlv.Blob](commitment.Bytes()),
+	}
+}
+
+// remoteAuxLeaf returns an auxiliary leaf of our to_remote output that is
+// unique for the given commit height, like the leaf of a changing asset
+// balance would be.
+func remoteAuxLeaf(height uint64) txscript.TapLeaf {
+	return txscript.NewBaseTapLeaf(binary.BigEndian.AppendUint64(
+		[]byte{txscript.OP_RETURN}, height,
+	))
+}
+
+// commitTx returns the remote commitment transaction of the channel at the
+// given height and the outpoint of our to_remote output.
+func (c *testAssetChannel) commitTx(t *testing.T,
+	height uint64) (*wire.MsgTx, wire.OutPoint) {
+
+	scriptTree, err := input.NewRemoteCommitScriptTree(
+		c.keys.ToRemoteKey, lfn.Some(remoteAuxLeaf(height)),
+	)
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: c.chanState.FundingOutpoint})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: test.RandBytes(34)})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: scriptTree.PkScript()})
+
+	return tx, wire.OutPoint{Hash: tx.TxHash(), Index: 1}
+}
+
+// track tracks the remote commitment of the channel at the given height.
+func (c *testAssetChannel) track(t *testing.T, m *ChannelBackupManager,
+	height uint64) {
+
+	err := m.TrackRemoteCommit(c.chanState, c.commitment(height), c.keys)
+	require.NoError(t, err)
+}
+
+// newTestChannelBackupManager creates a new channel backup manager that
+// persists its state in a temporary directory.
+func newTestChannelBackupManager(t *testing.T,
+	keyRing *testKeyRing) *ChannelBackupManager {
+
+	dir := t.TempDir()
+	return NewChannelBackupManager(&ChannelBackupCfg{
+		KeyRing: keyRing,
+		RestoredBackupsFile: filepath.Join(
+			dir, "channel-assets.backup",
+		),
+		LatestBackupsFile: filepath.Join(dir, "channel-assets.state"),
+	})
+}
+
+// TestChannelBackupExport tests that the latest remote commitment of each
+// asset channel is exported.
+func TestChannelBackupExport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyRing := &testKeyRing{privKey: test.RandPrivKey()}
+	m := newTestChannelBackupManager(t, keyRing)
+
+	channel1, channel2 := newTestAssetChannel(t), newTestAssetChannel(t)
+	channel1.track(t, m, 5)
+	channel1.track(t, m, 7)
+	channel2.track(t, m, 3)
+
+	// An older commitment must not replace the latest one.
+	channel1.track(t, m, 6)
+
+	// Channels without any assets are ignored.
+	err := m.TrackRemoteCommit(
+		lnwallet.AuxChanState{FundingOutpoint: test.RandOp(t)},
+		channeldb.ChannelCommitment{CommitHeight: 1}, channel1.keys,
+	)
+	require.NoError(t, err)
+
+	blob, err := m.ExportChannelBackups(ctx)
+	require.NoError(t, err)
+
+	backups, err := decryptChannelBackups(ctx, blob, keyRing)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+
+	heights := make(map[wire.OutPoint]uint64)
+	for _, backup := range backups {
+		chanPoint := backup.ChanPoint.Val.ChanPoint
+		heights[chanPoint] = backup.CommitHeight.Val
+	}
+	require.Equal(t, map[wire.OutPoint]uint64{
+		channel1.chanState.FundingOutpoint: 7,
+		channel2.chanState.FundingOutpoint: 3,
+	}, heights)
+
+	// We can also export a single channel.
+	blob, err = m.ExportChannelBackups(
+		ctx, channel2.chanState.FundingOutpoint,
+	)
+	require.NoError(t, err)
+
+	backups, err = decryptChannelBackups(ctx, blob, keyRing)
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	// Exporting an unknown channel fails.
+	_, err = m.ExportChannelBackups(ctx, test.RandOp(t))
+	require.ErrorIs(t, err, ErrChannelBackupNotFound)
+
+	// A backup can't be decrypted with a different key.
+	otherKeyRing := &testKeyRing{privKey: test.RandPrivKey()}
+	_, err = decryptChannelBackups(ctx, blob, otherKeyRing)
+	require.ErrorContains(t, err, "unable to decrypt")
+
+	_, err = decryptChannelBackups(ctx, blob[1:], keyRing)
+	require.ErrorIs(t, err, ErrInvalidChannelBackup)
+}
+
+// TestChannelBackupRestoredPersistence tests that restored backups are loaded
+// from disk on startup.
+func TestChannelBackupRestoredPersistence(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &testKeyRing{privKey: test.RandPrivKey()}
+	m := newTestChannelBackupManager(t, keyRing)
+	require.NoError(t, m.Start())
+
+	channel := newTestAssetChannel(t)
+	channel.track(t, m, 9)
+
+	chanPoint := channel.chanState.FundingOutpoint
+	require.True(t, m.FetchRestoredBackup(chanPoint).IsNone())
+
+	m.mu.Lock()
+	m.restored[chanPoint] = m.latest[chanPoint]
+	err := writeBackupsFile(m.cfg.RestoredBackupsFile, m.restored)
+	require.NoError(t, err)
+	m.mu.Unlock()
+
+	restartedManager := NewChannelBackupManager(m.cfg)
+	require.NoError(t, restartedManager.Start())
+
+	backup, err := restartedManager.FetchRestoredBackup(
+		chanPoint,
+	).UnwrapOrErr(ErrChannelBackupNotFound)
+	require.NoError(t, err)
+	require.EqualValues(t, 9, backup.CommitHeight.Val)
+}
+
+// TestChannelBackupLatestPersistence tests that the latest remote commitment
+// state of each channel is loaded from disk on startup, so backups can still be
+// exported after a restart.
+func TestChannelBackupLatestPersistence(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyRing := &testKeyRing{privKey: test.RandPrivKey()}
+	m := newTestChannelBackupManager(t, keyRing)
+	require.NoError(t, m.Start())
+
+	channel := newTestAssetChannel(t)
+	channel.track(t, m, 3)
+	channel.track(t, m, 8)
+
+	chanPoint := channel.chanState.FundingOutpoint
+	restartedManager := NewChannelBackupManager(m.cfg)
+
+	// Before the state is loaded, the channel is unknown.
+	_, err := restartedManager.ExportChannelBackups(ctx, chanPoint)
+	require.ErrorIs(t, err, ErrChannelBackupNotFound)
+
+	require.NoError(t, restartedManager.Start())
+
+	blob, err := restartedManager.ExportChannelBackups(ctx, chanPoint)
+	require.NoError(t, err)
+
+	backups, err := decryptChannelBackups(ctx, blob, keyRing)
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	require.EqualValues(t, 8, backups[0].CommitHeight.Val)
+
+	// Newer commitments are persisted after the restart as well.
+	channel.track(t, restartedManager, 9)
+
+	secondRestart := NewChannelBackupManager(m.cfg)
+	require.NoError(t, secondRestart.Start())
+	require.EqualValues(
+		t, 9, secondRestart.latest[chanPoint].CommitHeight.Val,
+	)
+}
+
+// TestReqFromChannelBackup tests that a resolution request without a commit
+// blob is filled in from a restored channel backup.
+func TestReqFromChannelBackup(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &testKeyRing{privKey: test.RandPrivKey()}
+	m := newTestChannelBackupManager(t, keyRing)
+
+	channel := newTestAssetChannel(t)
+	channel.track(t, m, 4)
+
+	chanPoint := channel.chanState.FundingOutpoint
+	m.restored[chanPoint] = m.latest[chanPoint]
+
+	sweeper := NewAuxSweeper(&AuxSweeperCfg{
+		ChannelBackups: m,
+	})
+
+	commitTx, contractPoint := channel.commitTx(t, 4)
+	newReq := func() lnwallet.ResolutionReq {
+		keys := channel.keys
+		return lnwallet.ResolutionReq{
+			ChanPoint:     chanPoint,
+			Type:          input.TaprootRemoteCommitSpend,
+			KeyRing:       &keys,
+			CommitTx:      commitTx,
+			ContractPoint: contractPoint,
+		}
+	}
+
+	req, ok := sweeper.reqFromChannelBackup(newReq())
+	require.True(t, ok)
+	require.Equal(
+		t, channel.commitment(4).CustomBlob.UnwrapOr(nil),
+		req.CommitBlob.UnwrapOr(nil),
+	)
+	require.Equal(
+		t, channel.chanState.CustomBlob.UnwrapOr(nil),
+		req.FundingBlob.UnwrapOr(nil),
+	)
+
+	// Only our output on the remote commitment can be swept with a backup.
+	req = newReq()
+	req.Type = input.TaprootLocalCommitSpend
+	_, ok = sweeper.reqFromChannelBackup(req)
+	require.False(t, ok)
+
+	// A backup with a different script key doesn't belong to the channel.
+	req = newReq()
+	req.KeyRing.ToRemoteKey = test.RandPubKey(t)
+	_, ok = sweeper.reqFromChannelBackup(req)
+	require.False(t, ok)
+
+	// A stale backup uses the same script key, but its asset outputs don't
+	// match the to_remote output of the confirmed commitment.
+	req = newReq()
+	req.CommitTx, req.ContractPoint = channel.commitTx(t, 5)
+	_, ok = sweeper.reqFromChannelBackup(req)
+	require.False(t, ok)
+
+	// The output being resolved must be part of the commitment.
+	req = newReq()
+	req.ContractPoint.Index = 2
+	_, ok = sweeper.reqFromChannelBackup(req)
+	require.False(t, ok)
+
+	req = newReq()
+	req.CommitTx = nil
+	_, ok = sweeper.reqFromChannelBackup(req)
+	require.False(t, ok)
+
+	// Channels without a restored backup can't be resolved.
+	req = newReq()
+	req.ChanPoint = test.RandOp(t)
+	_, ok = sweeper.reqFromChannelBackup(req)
+	require.False(t, ok)
+}
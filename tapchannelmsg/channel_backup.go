@@ -0,0 +1,251 @@
+package tapchannelmsg
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// MaxNumChannelBackups is the maximum number of channel backups that
+	// are allowed in a single backup list.
+	MaxNumChannelBackups = 65536
+
+	// ChannelBackupMaxSize is the maximum size of a single encoded channel
+	// backup. A backup contains the funding and remote commitment records,
+	// which both contain asset outputs with their proofs.
+	ChannelBackupMaxSize = 2 * MaxNumOutputs * OutputMaxSize
+)
+
+// ChanPointRecord is a record that wraps the funding outpoint of a channel.
+type ChanPointRecord struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+}
+
+// Record creates a Record out of a ChanPointRecord.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (c *ChanPointRecord) Record() tlv.Record {
+	// Note that we set the type here as zero, as when used with a
+	// tlv.RecordT, the type param will be used as the type.
+	return tlv.MakeStaticRecord(
+		0, &c.ChanPoint, 36, asset.OutPointEncoder,
+		asset.OutPointDecoder,
+	)
+}
+
+// ChannelBackup is a record that contains all the asset related information of
+// a channel that is needed to claim our assets from the remote party's
+// commitment transaction if we lost our channel state and the remote party
+// force closes the channel (for example after lnd's data loss protection
+// kicked in).
+type ChannelBackup struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint tlv.RecordT[tlv.TlvType0, ChanPointRecord]
+
+	// FundingBlob is the serialized OpenChannel record of the channel,
+	// which contains the proofs of the assets that were committed to the
+	// funding output.
+	FundingBlob tlv.RecordT[tlv.TlvType1, []byte]
+
+	// CommitHeight is the height of the remote commitment the commit blob
+	// belongs to.
+	CommitHeight tlv.RecordT[tlv.TlvType2, uint64]
+
+	// CommitBlob is the serialized Commitment record of the remote party's
+	// commitment, which contains the asset allocations of all outputs.
+	CommitBlob tlv.RecordT[tlv.TlvType3, []byte]
+
+	// ToRemoteInternalKey is the internal key of the script key of our
+	// asset output on the remote party's commitment.
+	ToRemoteInternalKey tlv.RecordT[tlv.TlvType4, *btcec.PublicKey]
+
+	// ToRemoteTapscriptRoot is the tapscript root that was used to tweak
+	// the internal key of the script key of our asset output on the remote
+	// party's commitment.
+	ToRemoteTapscriptRoot tlv.RecordT[tlv.TlvType5, []byte]
+}
+
+// NewChannelBackup creates a new ChannelBackup record for the given channel.
+// The to remote script key must contain the tweak information that is needed
+// to spend our asset output on the remote party's commitment.
+func NewChannelBackup(chanPoint wire.OutPoint, fundingBlob []byte,
+	commitHeight uint64, commitBlob []byte,
+	toRemoteScriptKey asset.ScriptKey) (*ChannelBackup, error) {
+
+	if toRemoteScriptKey.TweakedScriptKey == nil {
+		return nil, fmt.Errorf("to remote script key is missing " +
+			"tweak information")
+	}
+	tweakedKey := toRemoteScriptKey.TweakedScriptKey
+
+	return &ChannelBackup{
+		ChanPoint: tlv.NewRecordT[tlv.TlvType0](ChanPointRecord{
+			ChanPoint: chanPoint,
+		}),
+		FundingBlob: tlv.NewPrimitiveRecord[tlv.TlvType1](fundingBlob),
+		CommitHeight: tlv.NewPrimitiveRecord[tlv.TlvType2](
+			commitHeight,
+		),
+		CommitBlob: tlv.NewPrimitiveRecord[tlv.TlvType3](commitBlob),
+		ToRemoteInternalKey: tlv.NewPrimitiveRecord[tlv.TlvType4](
+			tweakedKey.RawKey.PubKey,
+		),
+		ToRemoteTapscriptRoot: tlv.NewPrimitiveRecord[tlv.TlvType5](
+			tweakedKey.Tweak,
+		),
+	}, nil
+}
+
+// FundingState decodes the OpenChannel record of the channel.
+func (c *ChannelBackup) FundingState() (*OpenChannel, error) {
+	return DecodeOpenChannel(c.FundingBlob.Val)
+}
+
+// RemoteCommitment decodes the Commitment record of the remote party's
+// commitment.
+func (c *ChannelBackup) RemoteCommitment() (*Commitment, error) {
+	return DecodeCommitment(c.CommitBlob.Val)
+}
+
+// ToRemoteScriptKey returns the script key of our asset output on the remote
+// party's commitment, including the information needed to spend it.
+func (c *ChannelBackup) ToRemoteScriptKey() asset.ScriptKey {
+	internalKey := c.ToRemoteInternalKey.Val
+	tapscriptRoot := c.ToRemoteTapscriptRoot.Val
+	outputKey := txscript.ComputeTaprootOutputKey(
+		internalKey, tapscriptRoot,
+	)
+
+	return asset.ScriptKey{
+		PubKey: asset.NewScriptKey(outputKey).PubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: internalKey,
+			},
+			Tweak: tapscriptRoot,
+		},
+	}
+}
+
+// records returns the records that make up the ChannelBackup.
+func (c *ChannelBackup) records() []tlv.Record {
+	return []tlv.Record{
+		c.ChanPoint.Record(),
+		c.FundingBlob.Record(),
+		c.CommitHeight.Record(),
+		c.CommitBlob.Record(),
+		c.ToRemoteInternalKey.Record(),
+		c.ToRemoteTapscriptRoot.Record(),
+	}
+}
+
+// Encode serializes the ChannelBackup to the given io.Writer.
+func (c *ChannelBackup) Encode(w io.Writer) error {
+	tlvStream, err := tlv.NewStream(c.records()...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// Decode deserializes the ChannelBackup from the given io.Reader.
+func (c *ChannelBackup) Decode(r io.Reader) error {
+	tlvStream, err := tlv.NewStream(c.records()...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Decode(r)
+}
+
+// Bytes returns the serialized ChannelBackup record.
+func (c *ChannelBackup) Bytes() []byte {
+	var buf bytes.Buffer
+	_ = c.Encode(&buf)
+	return buf.Bytes()
+}
+
+// DecodeChannelBackup deserializes a ChannelBackup from the given blob. The
+// contained funding and commitment records are decoded as well to make sure
+// the backup is usable.
+func DecodeChannelBackup(blob tlv.Blob) (*ChannelBackup, error) {
+	var c ChannelBackup
+	err := c.Decode(bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.FundingState(); err != nil {
+		return nil, fmt.Errorf("invalid funding blob: %w", err)
+	}
+	if _, err := c.RemoteCommitment(); err != nil {
+		return nil, fmt.Errorf("invalid commit blob: %w", err)
+	}
+
+	return &c, nil
+}
+
+// EncodeChannelBackups serializes the given list of channel backups to the
+// given io.Writer.
+func EncodeChannelBackups(w io.Writer, backups []*ChannelBackup) error {
+	var buf [8]byte
+	if err := tlv.WriteVarInt(w, uint64(len(backups)), &buf); err != nil {
+		return err
+	}
+
+	for _, backup := range backups {
+		backupBytes := backup.Bytes()
+		err := asset.InlineVarBytesEncoder(w, &backupBytes, &buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeChannelBackups deserializes a list of channel backups from the given
+// io.Reader.
+func DecodeChannelBackups(r io.Reader) ([]*ChannelBackup, error) {
+	var buf [8]byte
+	numBackups, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Avoid OOM by limiting the number of backups we accept.
+	if numBackups > MaxNumChannelBackups {
+		return nil, fmt.Errorf("%w: too many channel backups",
+			ErrListInvalid)
+	}
+
+	backups := make([]*ChannelBackup, 0, numBackups)
+	for i := uint64(0); i < numBackups; i++ {
+		var backupBytes []byte
+		err := asset.InlineVarBytesDecoder(
+			r, &backupBytes, &buf, ChannelBackupMaxSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		backup, err := DecodeChannelBackup(backupBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		backups = append(backups, backup)
+	}
+
+	return backups, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	lfn "github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/stretchr/testify/require"
@@ -555,3 +556,93 @@ func TestProofChunk(t *testing.T) {
 		require.Equal(t, proofChunk, newChunk)
 	})
 }
+
+// TestChannelBackup tests encoding and decoding of a list of ChannelBackup
+// records.
+func TestChannelBackup(t *testing.T) {
+	t.Parallel()
+
+	oddTxBlockHex, err := os.ReadFile(oddTxBlockHexFileName)
+	require.NoError(t, err)
+
+	oddTxBlockBytes, err := hex.DecodeString(
+		strings.Trim(string(oddTxBlockHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	var oddTxBlock wire.MsgBlock
+	err = oddTxBlock.Deserialize(bytes.NewReader(oddTxBlockBytes))
+	require.NoError(t, err)
+
+	randGen := asset.RandGenesis(t, asset.Normal)
+	originalRandProof := proof.RandProof(
+		t, randGen, test.RandPubKey(t), oddTxBlock, 0, 1,
+	)
+
+	// Proofs don't Encode everything, so we need to do a quick Encode/
+	// Decode cycle to make sure we can compare it afterward.
+	proofBytes, err := proof.Encode(&originalRandProof)
+	require.NoError(t, err)
+	randProof, err := proof.Decode(proofBytes)
+	require.NoError(t, err)
+
+	output := NewAssetOutput([32]byte{1}, 1000, *randProof)
+	fundingBlob := NewOpenChannel([]*AssetOutput{output}, 2).Bytes()
+	commitBlob := NewCommitment(
+		nil, []*AssetOutput{output}, nil, nil,
+		lnwallet.CommitAuxLeaves{},
+	).Bytes()
+
+	internalKey := test.RandPubKey(t)
+	tapscriptRoot := test.RandBytes(32)
+	scriptKey := asset.ScriptKey{
+		PubKey: asset.NewScriptKey(txscript.ComputeTaprootOutputKey(
+			internalKey, tapscriptRoot,
+		)).PubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: internalKey,
+			},
+			Tweak: tapscriptRoot,
+		},
+	}
+
+	// A script key without tweak information can't be used to sweep the
+	// output, so it's rejected.
+	_, err = NewChannelBackup(
+		test.RandOp(t), fundingBlob, 1, commitBlob, asset.ScriptKey{
+			PubKey: internalKey,
+		},
+	)
+	require.ErrorContains(t, err, "missing tweak information")
+
+	backup1, err := NewChannelBackup(
+		test.RandOp(t), fundingBlob, 1, commitBlob, scriptKey,
+	)
+	require.NoError(t, err)
+	backup2, err := NewChannelBackup(
+		test.RandOp(t), fundingBlob, 42, commitBlob, scriptKey,
+	)
+	require.NoError(t, err)
+
+	backups := []*ChannelBackup{backup1, backup2}
+
+	var b bytes.Buffer
+	require.NoError(t, EncodeChannelBackups(&b, backups))
+
+	decodedBackups, err := DecodeChannelBackups(&b)
+	require.NoError(t, err)
+	require.Equal(t, backups, decodedBackups)
+
+	// The script key must be restored including its tweak information.
+	require.Equal(t, scriptKey, decodedBackups[1].ToRemoteScriptKey())
+
+	commitment, err := decodedBackups[1].RemoteCommitment()
+	require.NoError(t, err)
+	require.Len(t, commitment.RemoteOutputs(), 1)
+
+	// A backup with an invalid commitment blob is rejected.
+	backup1.CommitBlob.Val = []byte{0xff}
+	_, err = DecodeChannelBackup(backup1.Bytes())
+	require.ErrorContains(t, err, "invalid commit blob")
+}
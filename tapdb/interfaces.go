@@ -93,6 +93,17 @@ type BatchedQuerier interface {
 
 	// Backend returns the type of the database backend used.
 	Backend() sqlc.BackendType
+
+	// SearchAssetsSqlite searches the asset search index using the FTS5
+	// table of a SQLite database. The backend specific search queries are
+	// written by hand, so they aren't part of the sqlc.Querier interface.
+	SearchAssetsSqlite(ctx context.Context,
+		arg sqlc.SearchAssetsParams) ([]sqlc.SearchAssetsRow, error)
+
+	// SearchAssetsPostgres searches the asset search index using the
+	// full-text search of a Postgres database.
+	SearchAssetsPostgres(ctx context.Context,
+		arg sqlc.SearchAssetsParams) ([]sqlc.SearchAssetsRow, error)
 }
 
 // txExecutorOptions is a struct that holds the options for the transaction
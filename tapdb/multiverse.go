@@ -10,6 +10,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
@@ -51,6 +52,41 @@ type (
 	// QueryMultiverseLeaves is used to query for a set of leaves based on
 	// the proof type and asset ID (or group key)
 	QueryMultiverseLeaves = sqlc.QueryMultiverseLeavesParams
+
+	// UniverseLeafEvent is a universe leaf together with its cursor and
+	// the universe it was inserted into.
+	UniverseLeafEvent = sqlc.FetchUniverseLeavesAfterRow
+
+	// UniverseLeafEventsQuery is used to query for the universe leaves
+	// that were inserted after a given cursor.
+	UniverseLeafEventsQuery = sqlc.FetchUniverseLeavesAfterParams
+
+	// UniverseLeafSize is the key, block height and proof size of a
+	// universe leaf.
+	UniverseLeafSize = sqlc.FetchUniverseLeafSizesRow
+
+	// UniverseLeafProofsQuery is used to query for a page of the leaf
+	// proofs of a universe, ordered by the leaf ID.
+	UniverseLeafProofsQuery = sqlc.FetchUniverseLeafProofsParams
+
+	// UniverseLeafProof is a universe leaf together with its proof.
+	UniverseLeafProof = sqlc.FetchUniverseLeafProofsRow
+
+	// UniverseLeavesWithoutHeightQuery is used to query for the leaves of
+	// a universe that don't have a block height stored yet.
+	UniverseLeavesWithoutHeightQuery = sqlc.FetchUniverseLeavesWithoutHeightParams
+
+	// UniverseLeafWithoutHeight is a universe leaf together with its
+	// proof, that doesn't have a block height stored yet.
+	UniverseLeafWithoutHeight = sqlc.FetchUniverseLeavesWithoutHeightRow
+
+	// UniverseLeafBlockHeight is used to set the block height of a
+	// universe leaf.
+	UniverseLeafBlockHeight = sqlc.UpdateUniverseLeafBlockHeightParams
+
+	// DeleteNewProofEvents is used to delete the oldest new proof events
+	// of a universe.
+	DeleteNewProofEvents = sqlc.DeleteOldestNewProofEventsParams
 )
 
 // BaseMultiverseStore is used to interact with a set of base universe
@@ -72,6 +108,51 @@ type BaseMultiverseStore interface {
 	// given target namespace (proof type in this case).
 	FetchMultiverseRoot(ctx context.Context,
 		proofNamespace string) (MultiverseRoot, error)
+
+	// FetchUniverseLeavesAfter returns the universe leaves that were
+	// inserted after the given leaf ID, ordered by their ID.
+	FetchUniverseLeavesAfter(ctx context.Context,
+		arg UniverseLeafEventsQuery) ([]UniverseLeafEvent, error)
+
+	// FetchLatestUniverseLeafID returns the ID of the most recently
+	// inserted universe leaf.
+	FetchLatestUniverseLeafID(ctx context.Context) (int64, error)
+
+	// FetchUniverseLeafSizes returns the ID, key, block height and proof
+	// size of all leaves of a universe, ordered by their block height.
+	FetchUniverseLeafSizes(ctx context.Context,
+		namespace string) ([]UniverseLeafSize, error)
+
+	// FetchUniverseLeafProofs returns the proofs of the leaves of a
+	// universe with an ID greater than the given one, ordered by their ID.
+	FetchUniverseLeafProofs(ctx context.Context,
+		arg UniverseLeafProofsQuery) ([]UniverseLeafProof, error)
+
+	// FetchUniverseLeavesWithoutHeight returns the proofs of the leaves
+	// of a universe that don't have a block height stored yet.
+	FetchUniverseLeavesWithoutHeight(ctx context.Context,
+		arg UniverseLeavesWithoutHeightQuery) (
+		[]UniverseLeafWithoutHeight, error)
+
+	// UpdateUniverseLeafBlockHeight sets the block height of a universe
+	// leaf.
+	UpdateUniverseLeafBlockHeight(ctx context.Context,
+		arg UniverseLeafBlockHeight) error
+
+	// DeleteUniverseLeaf deletes a single universe leaf.
+	DeleteUniverseLeaf(ctx context.Context, id int64) error
+
+	// DeleteLeafProofSyncLogs deletes the proof sync log entries of a
+	// single universe leaf.
+	DeleteLeafProofSyncLogs(ctx context.Context, proofLeafID int64) error
+
+	// DeleteMultiverseRoot deletes the root of a multiverse tree.
+	DeleteMultiverseRoot(ctx context.Context, namespaceRoot string) error
+
+	// DeleteOldestNewProofEvents deletes the oldest new proof events of a
+	// universe.
+	DeleteOldestNewProofEvents(ctx context.Context,
+		arg DeleteNewProofEvents) error
 }
 
 // BaseMultiverseOptions is the set of options for multiverse queries.
@@ -106,6 +187,11 @@ type BatchedMultiverse interface {
 type MultiverseStoreConfig struct {
 	// Caches is the set of cache configurations for the multiverse store.
 	Caches MultiverseCacheConfig
+
+	// WarmStartFile is the file the state of the caches is written to on
+	// shutdown and loaded from on startup. If empty, the caches always
+	// start empty.
+	WarmStartFile string
 }
 
 // DefaultMultiverseStoreConfig returns the default configuration for the
@@ -322,6 +408,28 @@ func (b *MultiverseStore) UniverseRootNode(ctx context.Context,
 func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
 
+	// Queries for a key prefix are only used to diff a part of the
+	// universe during sync, so we don't cache them.
+	if len(q.KeyPrefix) > 0 {
+		var leafKeys []universe.LeafKey
+		readTx := NewBaseUniverseReadTx()
+		dbErr := b.db.ExecTx(
+			ctx, &readTx, func(db BaseMultiverseStore) error {
+				var err error
+				leafKeys, err = mintingKeys(
+					ctx, db, q, q.Id.String(),
+				)
+
+				return err
+			},
+		)
+		if dbErr != nil {
+			return nil, dbErr
+		}
+
+		return leafKeys, nil
+	}
+
 	// First, check to see if we have the leaf keys cached.
 	leafKeys := b.leafKeysCache.fetchLeafKeys(q)
 	if len(leafKeys) > 0 {
@@ -601,6 +709,164 @@ func (b *MultiverseStore) fillSyncerCache(ctx context.Context) error {
 	return nil
 }
 
+// BranchNodes returns the nodes of a universe tree that are a number of
+// levels below the branch identified by a key prefix.
+func (b *MultiverseStore) BranchNodes(ctx context.Context,
+	q universe.BranchNodesQuery) ([]mssmt.Node, error) {
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	var prefix [32]byte
+	copy(prefix[:], q.KeyPrefix)
+
+	var nodes []mssmt.Node
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(db, q.Id.String()),
+		)
+
+		var err error
+		nodes, err = universeTree.BranchNodes(
+			ctx, prefix, len(q.KeyPrefix)*8, q.Depth,
+		)
+
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return nodes, nil
+}
+
+// FetchLeafEvents returns the leaves that were inserted after the cursor of
+// the given query, ordered by their cursor. The ID of a leaf in the database is
+// used as its cursor.
+func (b *MultiverseStore) FetchLeafEvents(ctx context.Context,
+	q universe.LeafEventsQuery) ([]universe.LeafEvent, error) {
+
+	params := UniverseLeafEventsQuery{
+		AfterID:  int64(q.AfterCursor),
+		NumLimit: q.Limit,
+	}
+	if q.AssetID != nil {
+		params.AssetID = q.AssetID[:]
+	}
+	if q.GroupKey != nil {
+		params.GroupKey = schnorr.SerializePubKey(q.GroupKey)
+	}
+	if q.ProofType != universe.ProofTypeUnspecified {
+		params.ProofType = sqlStr(q.ProofType.String())
+	}
+	if params.NumLimit == 0 {
+		params.NumLimit = universe.RequestPageSize
+	}
+
+	var events []universe.LeafEvent
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		dbLeaves, err := db.FetchUniverseLeavesAfter(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		events = make([]universe.LeafEvent, 0, len(dbLeaves))
+		for _, dbLeaf := range dbLeaves {
+			event, err := parseLeafEvent(dbLeaf)
+			if err != nil {
+				return fmt.Errorf("unable to parse leaf %d: %w",
+					dbLeaf.ID, err)
+			}
+
+			events = append(events, *event)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return events, nil
+}
+
+// parseLeafEvent converts a universe leaf from the database into a leaf event.
+func parseLeafEvent(dbLeaf UniverseLeafEvent) (*universe.LeafEvent, error) {
+	proofType, err := universe.ParseStrProofType(dbLeaf.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Universes of grouped assets are only identified by their group key,
+	// the asset ID stored with the root is the one of the first leaf.
+	id := universe.Identifier{
+		ProofType: proofType,
+	}
+	if len(dbLeaf.GroupKey) != 0 {
+		id.GroupKey, err = schnorr.ParsePubKey(dbLeaf.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	} else {
+		copy(id.AssetID[:], dbLeaf.AssetID)
+	}
+
+	scriptPub, err := schnorr.ParsePubKey(dbLeaf.ScriptKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+	scriptKey := asset.NewScriptKey(scriptPub)
+
+	var outPoint wire.OutPoint
+	err = readOutPoint(
+		bytes.NewReader(dbLeaf.MintingPoint), 0, 0, &outPoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.LeafEvent{
+		Cursor: uint64(dbLeaf.ID),
+		ID:     id,
+		Key: universe.LeafKey{
+			OutPoint:  outPoint,
+			ScriptKey: &scriptKey,
+		},
+	}, nil
+}
+
+// LatestLeafCursor returns the cursor of the most recently inserted leaf, or
+// zero if no leaves were inserted yet.
+func (b *MultiverseStore) LatestLeafCursor(ctx context.Context) (uint64,
+	error) {
+
+	var cursor uint64
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		leafID, err := db.FetchLatestUniverseLeafID(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		cursor = uint64(leafID)
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return cursor, nil
+}
+
 // FetchProofLeaf returns a proof leaf for the target key. If the key doesn't
 // have a script key specified, then all the proof leafs for the outpoint will
 // be returned. If neither are specified, then all inserted proof
@@ -878,6 +1144,50 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 	return nil
 }
 
+// deleteMultiverseUniverse removes the universe with the given ID from its
+// multiverse tree and deletes the entire universe tree.
+func deleteMultiverseUniverse(ctx context.Context, tx BaseMultiverseStore,
+	id universe.Identifier) error {
+
+	multiverseNS, err := namespaceForProof(id.ProofType)
+	if err != nil {
+		return err
+	}
+
+	multiverseTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(tx, multiverseNS),
+	)
+
+	multiverseLeafKey := id.Bytes()
+	_, err = multiverseTree.Delete(ctx, multiverseLeafKey)
+	if err != nil {
+		return err
+	}
+
+	err = deleteUniverseTree(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	// If this was the last universe of its proof type, the multiverse
+	// tree is now empty and its root node is gone, so we also need to
+	// remove the multiverse root that references it.
+	multiverseRoot, err := multiverseTree.Root(ctx)
+	if err != nil {
+		return err
+	}
+	if multiverseRoot.NodeHash() != mssmt.EmptyTree[0].NodeHash() {
+		return nil
+	}
+
+	err = tx.DeleteMultiverseRoot(ctx, multiverseNS)
+	if err != nil {
+		return fmt.Errorf("unable to delete multiverse root: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteUniverse delete an entire universe sub-tree.
 func (b *MultiverseStore) DeleteUniverse(ctx context.Context,
 	id universe.Identifier) (string, error) {
@@ -885,22 +1195,7 @@ func (b *MultiverseStore) DeleteUniverse(ctx context.Context,
 	var writeTx BaseUniverseStoreOptions
 
 	dbErr := b.db.ExecTx(ctx, &writeTx, func(tx BaseMultiverseStore) error {
-		multiverseNS, err := namespaceForProof(id.ProofType)
-		if err != nil {
-			return err
-		}
-
-		multiverseTree := mssmt.NewCompactedTree(
-			newTreeStoreWrapperTx(tx, multiverseNS),
-		)
-
-		multiverseLeafKey := id.Bytes()
-		_, err = multiverseTree.Delete(ctx, multiverseLeafKey)
-		if err != nil {
-			return err
-		}
-
-		return deleteUniverseTree(ctx, tx, id)
+		return deleteMultiverseUniverse(ctx, tx, id)
 	})
 	if dbErr != nil {
 		return "", dbErr
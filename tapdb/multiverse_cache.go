@@ -52,6 +52,12 @@ type MultiverseCacheConfig struct {
 	// serves all paginated queries for root nodes that use different
 	// parameters than the syncer cache.
 	RootNodePageCacheSize uint64 `long:"root-node-page-cache-size" description:"The size of the root node page cache for all requests that aren't served by the syncer cache."`
+
+	// WarmStart is a flag that indicates if the state of the root node and
+	// leaf key caches should be written to disk on shutdown and loaded
+	// back on startup. Loaded entries are only used if the multiverse
+	// hasn't changed since they were written.
+	WarmStart bool `long:"warm-start" description:"If the root node and leaf key caches should be persisted on shutdown and loaded on startup."`
 }
 
 // DefaultMultiverseCacheConfig returns the default configuration for the
@@ -276,6 +282,9 @@ type syncerRootNodeCache struct {
 	// Therefore, we never need to do a full wipe of the cache.
 	universeRoots map[universe.IdentifierKey]universe.Root
 
+	// warm tracks the roots that were loaded from disk on startup.
+	warm *warmEntries[universe.IdentifierKey]
+
 	*cacheLogger
 }
 
@@ -293,6 +302,7 @@ func newSyncerRootNodeCache(enabled bool,
 	return &syncerRootNodeCache{
 		preAllocSize:  preAllocSize,
 		universeRoots: rootsMap,
+		warm:          newWarmEntries[universe.IdentifierKey](),
 		cacheLogger:   newCacheLogger("syncer_universe_roots"),
 		enabled:       enabled,
 	}
@@ -382,6 +392,7 @@ func (r *syncerRootNodeCache) fetchRoots(q universe.RootNodesQuery,
 		}
 
 		rootNodes[idx] = root
+		r.warm.hit(id)
 	}
 
 	// This was a cache hit.
@@ -407,6 +418,8 @@ func (r *syncerRootNodeCache) fetchRoot(id universe.Identifier) *universe.Root {
 	}
 
 	r.Hit()
+	r.warm.hit(id.Key())
+
 	return &root
 }
 
@@ -456,6 +469,7 @@ func (r *syncerRootNodeCache) addOrReplace(root universe.Root) {
 		// the map. The key list doesn't need to be updated, as the key
 		// never changes.
 		r.universeRoots[root.ID.Key()] = root
+		r.warm.remove(root.ID.Key())
 
 		return
 	}
@@ -484,6 +498,7 @@ func (r *syncerRootNodeCache) remove(key universe.IdentifierKey) {
 
 		// Remove the entry from the map.
 		delete(r.universeRoots, key)
+		r.warm.remove(key)
 	}
 }
 
@@ -506,6 +521,9 @@ type rootNodeCache struct {
 
 	allRoots *rootPageCache
 
+	// warm tracks the root pages that were loaded from disk on startup.
+	warm *warmEntries[rootPageQueryKey]
+
 	*cacheLogger
 
 	// TODO(roasbeef): cache for issuance vs transfer roots?
@@ -517,6 +535,7 @@ func newRootNodeCache(cacheSize uint64) *rootNodeCache {
 		cacheSize:   cacheSize,
 		rootIndex:   newRootIndex(),
 		allRoots:    newRootPageCache(cacheSize),
+		warm:        newWarmEntries[rootPageQueryKey](),
 		cacheLogger: newCacheLogger("universe_roots"),
 	}
 }
@@ -535,10 +554,12 @@ func (r *rootNodeCache) fetchRoots(q universe.RootNodesQuery,
 
 	// Attempt to read directly from the root node cache.
 	rootNodeCache := r.allRoots.Load()
-	rootNodes, _ := rootNodeCache.Get(newRootPageQuery(q))
+	pageQuery := newRootPageQuery(q)
+	rootNodes, _ := rootNodeCache.Get(pageQuery)
 
 	if len(rootNodes) > 0 {
 		r.Hit()
+		r.warm.hit(pageQuery)
 	} else {
 		r.Miss()
 	}
@@ -572,6 +593,7 @@ func (r *rootNodeCache) wipeCache() {
 
 	r.allRoots.wipe(r.cacheSize)
 	r.rootIndex.wipe()
+	r.warm.clear()
 }
 
 // cachedLeafKeys is used to cache the set of leaf keys for a given universe.
@@ -620,6 +642,10 @@ type universeLeafPageCache struct {
 
 	leafCache *lru.Cache[universeIDKey, *leafPageCache]
 
+	// warm tracks the leaf key pages that were loaded from disk on
+	// startup.
+	warm *warmEntries[warmLeafPageKey]
+
 	*cacheLogger
 }
 
@@ -632,6 +658,7 @@ func newUniverseLeafPageCache(numCachedUniverses,
 		leafCache: lru.NewCache[universeIDKey, *leafPageCache](
 			numCachedUniverses,
 		),
+		warm:        newWarmEntries[warmLeafPageKey](),
 		cacheLogger: newCacheLogger("universe_leaf_keys"),
 	}
 }
@@ -640,11 +667,17 @@ func newUniverseLeafPageCache(numCachedUniverses,
 func (u *universeLeafPageCache) fetchLeafKeys(
 	q universe.UniverseLeafKeysQuery) []universe.LeafKey {
 
-	leafPageCache, err := u.leafCache.Get(q.Id.String())
+	idStr := q.Id.String()
+	leafPageCache, err := u.leafCache.Get(idStr)
 	if err == nil {
-		leafKeys, err := leafPageCache.Get(newLeafQuery(q))
+		leafQuery := newLeafQuery(q)
+		leafKeys, err := leafPageCache.Get(leafQuery)
 		if err == nil {
 			u.Hit()
+			u.warm.hit(warmLeafPageKey{
+				id:    idStr,
+				query: leafQuery,
+			})
 			log.Tracef("read leaf keys for %v from cache",
 				q.Id.StringForLog())
 			return *leafKeys
@@ -660,11 +693,17 @@ func (u *universeLeafPageCache) fetchLeafKeys(
 func (u *universeLeafPageCache) cacheLeafKeys(q universe.UniverseLeafKeysQuery,
 	keys []universe.LeafKey) {
 
-	cachedKeys := cachedLeafKeys(keys)
+	log.Debugf("storing leaf keys for %v in cache", q.Id.StringForLog())
 
-	idStr := q.Id.String()
+	u.insertPage(q.Id.String(), newLeafQuery(q), keys)
+}
 
-	log.Debugf("storing leaf keys for %v in cache", q.Id.StringForLog())
+// insertPage stores the given page of leaf keys in the page cache of the
+// universe with the given ID key.
+func (u *universeLeafPageCache) insertPage(idStr universeIDKey,
+	query leafQueryKey, keys []universe.LeafKey) {
+
+	cachedKeys := cachedLeafKeys(keys)
 
 	pageCache, err := u.leafCache.Get(idStr)
 	if err != nil {
@@ -686,7 +725,7 @@ func (u *universeLeafPageCache) cacheLeafKeys(q universe.UniverseLeafKeysQuery,
 	}
 
 	// Add the to the page cache.
-	if _, err := pageCache.Put(newLeafQuery(q), &cachedKeys); err != nil {
+	if _, err := pageCache.Put(query, &cachedKeys); err != nil {
 		log.Errorf("unable to store leaf resp: %v", err)
 	}
 }
@@ -696,4 +735,7 @@ func (u *universeLeafPageCache) wipeCache(id universeIDKey) {
 	log.Debugf("wiping leaf keys for %s in cache", id)
 
 	u.leafCache.Delete(id)
+	u.warm.removeIf(func(key warmLeafPageKey) bool {
+		return key.id == id
+	})
 }
@@ -2,7 +2,10 @@ package tapdb
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -157,6 +160,22 @@ func TestMultiverseSyncerCache(t *testing.T) {
 	}
 }
 
+// requireEqualRoots asserts that the given lists of universe roots are equal.
+func requireEqualRoots(t *testing.T, expected, actual []universe.Root) {
+	require.Len(t, actual, len(expected))
+	for idx := range expected {
+		require.Equal(t, expected[idx].ID.Key(), actual[idx].ID.Key())
+		require.True(t, mssmt.IsEqualNode(
+			expected[idx].Node, actual[idx].Node,
+		))
+		require.Equal(t, expected[idx].AssetName, actual[idx].AssetName)
+		require.Equal(
+			t, expected[idx].GroupedAssets,
+			actual[idx].GroupedAssets,
+		)
+	}
+}
+
 func genRandomAsset(t *testing.T) *universe.Item {
 	proofType := universe.ProofTypeIssuance
 	if test.RandBool() {
@@ -275,3 +294,256 @@ func assertAllLeavesInRoots(t *testing.T, allLeaves []*universe.Item,
 			"idx %d", leaf.ID.StringForLog(), idx)
 	}
 }
+
+// TestMultiverseCacheWarmStart tests that the state of the multiverse caches
+// is persisted and loaded again, and that only the entries of the multiverse
+// roots that didn't change are used.
+func TestMultiverseCacheWarmStart(t *testing.T) {
+	ctx := context.Background()
+	db := NewTestDB(t)
+	cfg := DefaultMultiverseStoreConfig()
+	cfg.Caches.SyncerCacheEnabled = true
+	cfg.WarmStartFile = filepath.Join(t.TempDir(), "multiverse.state")
+
+	newStore := func() *MultiverseStore {
+		dbTxer := NewTransactionExecutor(
+			db, func(tx *sql.Tx) BaseMultiverseStore {
+				return db.WithTx(tx)
+			},
+		)
+
+		return NewMultiverseStore(dbTxer, cfg)
+	}
+
+	// We insert assets of both proof types into the multiverse.
+	var (
+		items                     []*universe.Item
+		numIssuance, numTransfers int
+	)
+	for len(items) < 10 || numIssuance == 0 || numTransfers == 0 {
+		item := genRandomAsset(t)
+		if item.ID.ProofType == universe.ProofTypeIssuance {
+			numIssuance++
+		} else {
+			numTransfers++
+		}
+
+		items = append(items, item)
+	}
+
+	multiverse := newStore()
+	require.NoError(t, multiverse.UpsertProofLeafBatch(ctx, items))
+
+	// Without a state on disk, nothing is loaded.
+	require.NoError(t, multiverse.LoadCacheState(ctx))
+	for _, stats := range multiverse.WarmStartStats() {
+		require.Zero(t, stats.Loaded)
+	}
+
+	// We now fill the caches by querying the roots and the leaf keys of
+	// all universes.
+	roots := queryRoots(t, multiverse, 5)
+	require.Len(t, roots, len(items))
+
+	amountsQuery := universe.RootNodesQuery{
+		WithAmountsById: true,
+		Limit:           universe.RequestPageSize,
+	}
+	amountRoots, err := multiverse.RootNodes(ctx, amountsQuery)
+	require.NoError(t, err)
+
+	leafKeysQuery := func(
+		item *universe.Item) universe.UniverseLeafKeysQuery {
+
+		return universe.UniverseLeafKeysQuery{
+			Id:    item.ID,
+			Limit: universe.RequestPageSize,
+		}
+	}
+	for _, item := range items {
+		keys, err := multiverse.UniverseLeafKeys(
+			ctx, leafKeysQuery(item),
+		)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+	}
+
+	require.NoError(t, multiverse.StoreCacheState(ctx))
+	require.FileExists(t, cfg.WarmStartFile)
+
+	// A new store loads the state, and serves all queries from the loaded
+	// entries. The state file is removed once it was loaded.
+	multiverse = newStore()
+	require.NoError(t, multiverse.LoadCacheState(ctx))
+	require.NoFileExists(t, cfg.WarmStartFile)
+
+	requireEqualRoots(t, roots, queryRoots(t, multiverse, 5))
+	warmRoots, err := multiverse.RootNodes(ctx, amountsQuery)
+	require.NoError(t, err)
+	requireEqualRoots(t, amountRoots, warmRoots)
+
+	item := items[0]
+	keys, err := multiverse.UniverseLeafKeys(ctx, leafKeysQuery(item))
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, item.Key.UniverseKey(), keys[0].UniverseKey())
+
+	require.Zero(t, multiverse.syncerCache.miss.Load())
+	require.Zero(t, multiverse.rootNodeCache.miss.Load())
+	require.Zero(t, multiverse.leafKeysCache.miss.Load())
+
+	require.Equal(t, []MultiverseCacheWarmStats{{
+		Cache:  "syncer_universe_roots",
+		Loaded: int64(len(items)),
+		Hits:   int64(len(items)),
+	}, {
+		Cache:  "universe_roots",
+		Loaded: 1,
+		Hits:   1,
+	}, {
+		Cache:  "universe_leaf_keys",
+		Loaded: int64(len(items)),
+		Hits:   1,
+	}}, multiverse.WarmStartStats())
+
+	// If an issuance universe changes while the daemon is down, only the
+	// leaf keys of the transfer universes are still loaded.
+	require.NoError(t, multiverse.StoreCacheState(ctx))
+
+	newItem := genRandomAsset(t)
+	for newItem.ID.ProofType != universe.ProofTypeIssuance {
+		newItem = genRandomAsset(t)
+	}
+	err = newStore().UpsertProofLeafBatch(ctx, []*universe.Item{newItem})
+	require.NoError(t, err)
+
+	multiverse = newStore()
+	require.NoError(t, multiverse.LoadCacheState(ctx))
+	require.True(t, multiverse.syncerCache.isEmpty())
+	require.Equal(t, []MultiverseCacheWarmStats{{
+		Cache:     "syncer_universe_roots",
+		Discarded: int64(len(items)),
+	}, {
+		Cache:     "universe_roots",
+		Discarded: 1,
+	}, {
+		Cache:     "universe_leaf_keys",
+		Loaded:    int64(numTransfers),
+		Discarded: int64(numIssuance),
+	}}, multiverse.WarmStartStats())
+
+	// A corrupted state isn't loaded.
+	require.NoError(t, multiverse.StoreCacheState(ctx))
+	state, err := os.ReadFile(cfg.WarmStartFile)
+	require.NoError(t, err)
+	state[len(state)/2] ^= 0x01
+	require.NoError(t, os.WriteFile(cfg.WarmStartFile, state, 0600))
+
+	multiverse = newStore()
+	err = multiverse.LoadCacheState(ctx)
+	require.ErrorIs(t, err, errCacheStateChecksum)
+	require.True(t, multiverse.syncerCache.isEmpty())
+}
+
+// TestMultiverseCacheStateEncoding tests that a multiverse cache state can be
+// encoded and decoded again.
+func TestMultiverseCacheStateEncoding(t *testing.T) {
+	t.Parallel()
+
+	groupedID := randUniverseID(t, true)
+	groupedRoot := universe.Root{
+		ID:        groupedID,
+		AssetName: "grouped",
+		Node: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()),
+			test.RandInt[uint64](),
+		),
+		GroupedAssets: map[asset.ID]uint64{
+			asset.RandID(t): test.RandInt[uint64](),
+			asset.RandID(t): test.RandInt[uint64](),
+		},
+	}
+
+	// A root without amounts must stay distinguishable from a root with
+	// an empty set of amounts.
+	plainRoot := universe.Root{
+		ID: randUniverseID(t, false),
+		Node: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()),
+			test.RandInt[uint64](),
+		),
+	}
+	emptyRoot := plainRoot
+	emptyRoot.GroupedAssets = map[asset.ID]uint64{}
+
+	scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+	state := &multiverseCacheState{
+		issuanceRoot: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()), 1,
+		),
+		transferRoot: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()), 2,
+		),
+		syncerRoots: []universe.Root{groupedRoot, plainRoot},
+		rootPages: []cachedRootPage{{
+			query: rootPageQueryKey{
+				withAmountsById: true,
+				leafQueryKey: leafQueryKey{
+					sortDirection: universe.SortDescending,
+					offset:        10,
+					limit:         20,
+				},
+			},
+			roots: []universe.Root{groupedRoot, emptyRoot},
+		}},
+		leafPages: []cachedLeafPage{{
+			warmLeafPageKey: warmLeafPageKey{
+				id: groupedID.String(),
+				query: leafQueryKey{
+					limit: 5,
+				},
+			},
+			keys: []universe.LeafKey{{
+				OutPoint:  test.RandOp(t),
+				ScriptKey: &scriptKey,
+			}},
+		}},
+	}
+
+	stateBytes, err := encodeMultiverseCacheState(state)
+	require.NoError(t, err)
+
+	decoded, err := decodeMultiverseCacheState(stateBytes)
+	require.NoError(t, err)
+
+	require.True(t, mssmt.IsEqualNode(
+		state.issuanceRoot, decoded.issuanceRoot,
+	))
+	require.True(t, mssmt.IsEqualNode(
+		state.transferRoot, decoded.transferRoot,
+	))
+	requireEqualRoots(t, state.syncerRoots, decoded.syncerRoots)
+	require.Nil(t, decoded.syncerRoots[1].GroupedAssets)
+
+	require.Len(t, decoded.rootPages, 1)
+	require.Equal(t, state.rootPages[0].query, decoded.rootPages[0].query)
+	requireEqualRoots(
+		t, state.rootPages[0].roots, decoded.rootPages[0].roots,
+	)
+	require.NotNil(t, decoded.rootPages[0].roots[1].GroupedAssets)
+
+	require.Len(t, decoded.leafPages, 1)
+	require.Equal(
+		t, state.leafPages[0].warmLeafPageKey,
+		decoded.leafPages[0].warmLeafPageKey,
+	)
+	require.Len(t, decoded.leafPages[0].keys, 1)
+	require.Equal(
+		t, state.leafPages[0].keys[0].UniverseKey(),
+		decoded.leafPages[0].keys[0].UniverseKey(),
+	)
+
+	// A truncated state fails the checksum check.
+	_, err = decodeMultiverseCacheState(stateBytes[:len(stateBytes)-1])
+	require.ErrorIs(t, err, errCacheStateChecksum)
+}
@@ -0,0 +1,997 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/tlvenc"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	stateIssuanceHashType tlv.Type = 0
+	stateIssuanceSumType  tlv.Type = 2
+	stateTransferHashType tlv.Type = 4
+	stateTransferSumType  tlv.Type = 6
+	stateSyncerRootsType  tlv.Type = 8
+	stateRootPagesType    tlv.Type = 10
+	stateLeafPagesType    tlv.Type = 12
+
+	cacheRootProofTypeType     tlv.Type = 0
+	cacheRootAssetIDType       tlv.Type = 2
+	cacheRootGroupKeyType      tlv.Type = 4
+	cacheRootHashType          tlv.Type = 6
+	cacheRootSumType           tlv.Type = 8
+	cacheRootAssetNameType     tlv.Type = 10
+	cacheRootGroupedAssetsType tlv.Type = 12
+
+	groupedAssetIDType     tlv.Type = 0
+	groupedAssetAmountType tlv.Type = 2
+
+	leafQuerySortType   tlv.Type = 0
+	leafQueryOffsetType tlv.Type = 2
+	leafQueryLimitType  tlv.Type = 4
+
+	rootPageWithAmountsType tlv.Type = 0
+	rootPageQueryType       tlv.Type = 2
+	rootPageRootsType       tlv.Type = 4
+
+	leafPageIDType    tlv.Type = 0
+	leafPageQueryType tlv.Type = 2
+	leafPageKeysType  tlv.Type = 4
+
+	leafKeyOutPointType  tlv.Type = 0
+	leafKeyScriptKeyType tlv.Type = 2
+)
+
+const (
+	// multiverseCacheStateVersion is the version of the format the cache
+	// state is written to disk with. A state of a different version is
+	// ignored.
+	multiverseCacheStateVersion uint32 = 0
+
+	// maxWarmStartLeafKeys is the maximum number of leaf keys that are
+	// written to disk. The leaf key pages of the most recently used
+	// universes are written first, so only the pages of the least
+	// recently used universes are dropped if the limit is reached.
+	maxWarmStartLeafKeys = 1_000_000
+)
+
+var (
+	// multiverseCacheStateMagic are the magic bytes at the start of a
+	// cache state file.
+	multiverseCacheStateMagic = [4]byte{'t', 'm', 'c', 's'}
+
+	// errCacheStateChecksum is returned if the checksum of a cache state
+	// file doesn't match its content.
+	errCacheStateChecksum = errors.New("cache state checksum mismatch")
+)
+
+// MultiverseCacheWarmStats are the statistics of a multiverse cache about the
+// entries that were loaded from disk on startup.
+type MultiverseCacheWarmStats struct {
+	// Cache is the name of the cache.
+	Cache string
+
+	// Loaded is the number of entries that were loaded from disk and were
+	// still valid.
+	Loaded int64
+
+	// Discarded is the number of entries that were read from disk but
+	// were discarded because the multiverse changed since they were
+	// written.
+	Discarded int64
+
+	// Hits is the number of loaded entries that served at least one
+	// request before they were evicted or invalidated.
+	Hits int64
+}
+
+// warmEntries keeps track of the entries of a cache that were loaded from
+// disk on startup, to measure how many of them are actually used.
+type warmEntries[K comparable] struct {
+	sync.Mutex
+
+	// entries are the loaded entries that haven't served a request yet.
+	entries map[K]struct{}
+
+	// numEntries is the number of entries in the map above. This allows
+	// us to skip the lock on every cache hit once all loaded entries were
+	// either hit or invalidated.
+	numEntries atomic.Int64
+
+	loaded    atomic.Int64
+	discarded atomic.Int64
+	hits      atomic.Int64
+}
+
+// newWarmEntries creates a new empty set of warm entries.
+func newWarmEntries[K comparable]() *warmEntries[K] {
+	return &warmEntries[K]{
+		entries: make(map[K]struct{}),
+	}
+}
+
+// add marks the given entry as loaded from disk.
+func (w *warmEntries[K]) add(key K) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.loaded.Add(1)
+	if _, ok := w.entries[key]; ok {
+		return
+	}
+
+	w.entries[key] = struct{}{}
+	w.numEntries.Add(1)
+}
+
+// discard records the given number of entries that were read from disk but
+// weren't loaded.
+func (w *warmEntries[K]) discard(num int) {
+	w.discarded.Add(int64(num))
+}
+
+// hit records a cache hit for the given entry. Only the first hit of an entry
+// that was loaded from disk is counted.
+func (w *warmEntries[K]) hit(key K) {
+	if w.numEntries.Load() == 0 {
+		return
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	if _, ok := w.entries[key]; !ok {
+		return
+	}
+
+	delete(w.entries, key)
+	w.numEntries.Add(-1)
+	w.hits.Add(1)
+}
+
+// remove stops tracking the given entry, because it was replaced or removed
+// from the cache.
+func (w *warmEntries[K]) remove(key K) {
+	w.removeIf(func(k K) bool {
+		return k == key
+	})
+}
+
+// removeIf stops tracking all entries that match the given predicate.
+func (w *warmEntries[K]) removeIf(match func(K) bool) {
+	if w.numEntries.Load() == 0 {
+		return
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	for key := range w.entries {
+		if match(key) {
+			delete(w.entries, key)
+			w.numEntries.Add(-1)
+		}
+	}
+}
+
+// clear stops tracking all entries, because the whole cache was wiped.
+func (w *warmEntries[K]) clear() {
+	if w.numEntries.Load() == 0 {
+		return
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	w.entries = make(map[K]struct{})
+	w.numEntries.Store(0)
+}
+
+// stats returns the warm start statistics of the entries.
+func (w *warmEntries[K]) stats(cache string) MultiverseCacheWarmStats {
+	return MultiverseCacheWarmStats{
+		Cache:     cache,
+		Loaded:    w.loaded.Load(),
+		Discarded: w.discarded.Load(),
+		Hits:      w.hits.Load(),
+	}
+}
+
+// warmLeafPageKey identifies a single page of leaf keys of a universe.
+type warmLeafPageKey struct {
+	id    universeIDKey
+	query leafQueryKey
+}
+
+// cachedRootPage is a page of roots of the root node page cache.
+type cachedRootPage struct {
+	query rootPageQueryKey
+	roots []universe.Root
+}
+
+// cachedLeafPage is a page of leaf keys of the universe leaf page cache.
+type cachedLeafPage struct {
+	warmLeafPageKey
+	keys []universe.LeafKey
+}
+
+// multiverseCacheState is the state of the multiverse caches that is written
+// to disk on shutdown. The pages are ordered from the most to the least
+// recently used.
+type multiverseCacheState struct {
+	// issuanceRoot and transferRoot are the multiverse roots at the time
+	// the state was written. Entries are only valid as long as the
+	// multiverse roots they were read under don't change.
+	issuanceRoot mssmt.Node
+	transferRoot mssmt.Node
+
+	syncerRoots []universe.Root
+	rootPages   []cachedRootPage
+	leafPages   []cachedLeafPage
+}
+
+// groupedAssetAmount is the amount of a single asset of a grouped universe
+// root.
+type groupedAssetAmount struct {
+	id     asset.ID
+	amount uint64
+}
+
+// encodeGroupedAssetAmount encodes the amount of an asset of a group.
+func encodeGroupedAssetAmount(a groupedAssetAmount) ([]byte, error) {
+	assetID := [32]byte(a.id)
+
+	return tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(groupedAssetIDType, &assetID),
+		tlv.MakePrimitiveRecord(groupedAssetAmountType, &a.amount),
+	)
+}
+
+// decodeGroupedAssetAmount decodes the amount of an asset of a group.
+func decodeGroupedAssetAmount(b []byte) (groupedAssetAmount, error) {
+	var (
+		assetID [32]byte
+		amount  uint64
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(groupedAssetIDType, &assetID),
+		tlv.MakePrimitiveRecord(groupedAssetAmountType, &amount),
+	)
+	if err != nil {
+		return groupedAssetAmount{}, err
+	}
+
+	return groupedAssetAmount{
+		id:     asset.ID(assetID),
+		amount: amount,
+	}, nil
+}
+
+// encodeCacheRoot encodes a universe root of a cache.
+func encodeCacheRoot(root universe.Root) ([]byte, error) {
+	var (
+		proofType = uint8(root.ID.ProofType)
+		assetID   = [32]byte(root.ID.AssetID)
+		rootHash  = [32]byte(root.Node.NodeHash())
+		rootSum   = root.Node.NodeSum()
+		assetName = []byte(root.AssetName)
+	)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(cacheRootProofTypeType, &proofType),
+		tlv.MakePrimitiveRecord(cacheRootAssetIDType, &assetID),
+	}
+
+	if root.ID.GroupKey != nil {
+		groupKey := fn.ToArray[[32]byte](
+			schnorr.SerializePubKey(root.ID.GroupKey),
+		)
+		records = append(records, tlv.MakePrimitiveRecord(
+			cacheRootGroupKeyType, &groupKey,
+		))
+	}
+
+	records = append(
+		records,
+		tlv.MakePrimitiveRecord(cacheRootHashType, &rootHash),
+		tlv.MakePrimitiveRecord(cacheRootSumType, &rootSum),
+		tlv.MakePrimitiveRecord(cacheRootAssetNameType, &assetName),
+	)
+
+	// We need to distinguish between a nil map (amounts weren't
+	// requested) and an empty map, so the amounts are only present if the
+	// map is set.
+	if root.GroupedAssets != nil {
+		amounts := make(
+			[]groupedAssetAmount, 0, len(root.GroupedAssets),
+		)
+		for id, amount := range root.GroupedAssets {
+			amounts = append(amounts, groupedAssetAmount{
+				id:     id,
+				amount: amount,
+			})
+		}
+
+		amountsBytes, err := tlvenc.EncodeList(
+			amounts, encodeGroupedAssetAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			cacheRootGroupedAssetsType, &amountsBytes,
+		))
+	}
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeCacheRoot decodes a universe root encoded with encodeCacheRoot.
+func decodeCacheRoot(b []byte) (universe.Root, error) {
+	var (
+		root         universe.Root
+		proofType    uint8
+		assetID      [32]byte
+		groupKey     [32]byte
+		rootHash     [32]byte
+		rootSum      uint64
+		assetName    []byte
+		amountsBytes []byte
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(cacheRootProofTypeType, &proofType),
+		tlv.MakePrimitiveRecord(cacheRootAssetIDType, &assetID),
+		tlv.MakePrimitiveRecord(cacheRootGroupKeyType, &groupKey),
+		tlv.MakePrimitiveRecord(cacheRootHashType, &rootHash),
+		tlv.MakePrimitiveRecord(cacheRootSumType, &rootSum),
+		tlv.MakePrimitiveRecord(cacheRootAssetNameType, &assetName),
+		tlv.MakePrimitiveRecord(
+			cacheRootGroupedAssetsType, &amountsBytes,
+		),
+	)
+	if err != nil {
+		return root, err
+	}
+
+	root.ID.ProofType = universe.ProofType(proofType)
+	root.ID.AssetID = asset.ID(assetID)
+	if _, ok := parsedTypes[cacheRootGroupKeyType]; ok {
+		root.ID.GroupKey, err = schnorr.ParsePubKey(groupKey[:])
+		if err != nil {
+			return root, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	}
+
+	root.Node = mssmt.NewComputedBranch(rootHash, rootSum)
+	root.AssetName = string(assetName)
+
+	if _, ok := parsedTypes[cacheRootGroupedAssetsType]; ok {
+		amounts, err := tlvenc.DecodeList(
+			amountsBytes, decodeGroupedAssetAmount,
+		)
+		if err != nil {
+			return root, err
+		}
+
+		root.GroupedAssets = make(map[asset.ID]uint64, len(amounts))
+		for _, a := range amounts {
+			root.GroupedAssets[a.id] = a.amount
+		}
+	}
+
+	return root, nil
+}
+
+// encodeLeafQuery encodes a leaf query key.
+func encodeLeafQuery(q leafQueryKey) ([]byte, error) {
+	var (
+		sortDirection = uint8(q.sortDirection)
+		offset        = uint32(q.offset)
+		limit         = uint32(q.limit)
+	)
+
+	return tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(leafQuerySortType, &sortDirection),
+		tlv.MakePrimitiveRecord(leafQueryOffsetType, &offset),
+		tlv.MakePrimitiveRecord(leafQueryLimitType, &limit),
+	)
+}
+
+// decodeLeafQuery decodes a leaf query key encoded with encodeLeafQuery.
+func decodeLeafQuery(b []byte) (leafQueryKey, error) {
+	var (
+		sortDirection uint8
+		offset, limit uint32
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(leafQuerySortType, &sortDirection),
+		tlv.MakePrimitiveRecord(leafQueryOffsetType, &offset),
+		tlv.MakePrimitiveRecord(leafQueryLimitType, &limit),
+	)
+	if err != nil {
+		return leafQueryKey{}, err
+	}
+
+	return leafQueryKey{
+		sortDirection: universe.SortDirection(sortDirection),
+		offset:        int32(offset),
+		limit:         int32(limit),
+	}, nil
+}
+
+// encodeCacheRootPage encodes a page of the root node page cache.
+func encodeCacheRootPage(page cachedRootPage) ([]byte, error) {
+	var withAmounts uint8
+	if page.query.withAmountsById {
+		withAmounts = 1
+	}
+
+	query, err := encodeLeafQuery(page.query.leafQueryKey)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := tlvenc.EncodeList(page.roots, encodeCacheRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(rootPageWithAmountsType, &withAmounts),
+		tlv.MakePrimitiveRecord(rootPageQueryType, &query),
+		tlv.MakePrimitiveRecord(rootPageRootsType, &roots),
+	)
+}
+
+// decodeCacheRootPage decodes a root page encoded with encodeCacheRootPage.
+func decodeCacheRootPage(b []byte) (cachedRootPage, error) {
+	var (
+		page                   cachedRootPage
+		withAmounts            uint8
+		queryBytes, rootsBytes []byte
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(rootPageWithAmountsType, &withAmounts),
+		tlv.MakePrimitiveRecord(rootPageQueryType, &queryBytes),
+		tlv.MakePrimitiveRecord(rootPageRootsType, &rootsBytes),
+	)
+	if err != nil {
+		return page, err
+	}
+
+	page.query.withAmountsById = withAmounts == 1
+	page.query.leafQueryKey, err = decodeLeafQuery(queryBytes)
+	if err != nil {
+		return page, err
+	}
+
+	page.roots, err = tlvenc.DecodeList(rootsBytes, decodeCacheRoot)
+	if err != nil {
+		return page, err
+	}
+
+	return page, nil
+}
+
+// encodeCacheLeafKey encodes a leaf key of the leaf key page cache. Only the
+// x-only script key is stored, as that's all the universe key is derived
+// from.
+func encodeCacheLeafKey(key universe.LeafKey) ([]byte, error) {
+	outPoint := key.OutPoint
+	scriptKey := fn.ToArray[[32]byte](
+		schnorr.SerializePubKey(key.ScriptKey.PubKey),
+	)
+
+	return tlvenc.EncodeStream(
+		tlv.MakeStaticRecord(
+			leafKeyOutPointType, &outPoint, 36,
+			asset.OutPointEncoder, asset.OutPointDecoder,
+		),
+		tlv.MakePrimitiveRecord(leafKeyScriptKeyType, &scriptKey),
+	)
+}
+
+// decodeCacheLeafKey decodes a leaf key encoded with encodeCacheLeafKey.
+func decodeCacheLeafKey(b []byte) (universe.LeafKey, error) {
+	var (
+		key       universe.LeafKey
+		outPoint  wire.OutPoint
+		scriptKey [32]byte
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakeStaticRecord(
+			leafKeyOutPointType, &outPoint, 36,
+			asset.OutPointEncoder, asset.OutPointDecoder,
+		),
+		tlv.MakePrimitiveRecord(leafKeyScriptKeyType, &scriptKey),
+	)
+	if err != nil {
+		return key, err
+	}
+
+	scriptKeyPub, err := schnorr.ParsePubKey(scriptKey[:])
+	if err != nil {
+		return key, fmt.Errorf("unable to parse script key: %w", err)
+	}
+	fullScriptKey := asset.NewScriptKey(scriptKeyPub)
+
+	key.OutPoint = outPoint
+	key.ScriptKey = &fullScriptKey
+
+	return key, nil
+}
+
+// encodeCacheLeafPage encodes a page of the universe leaf page cache.
+func encodeCacheLeafPage(page cachedLeafPage) ([]byte, error) {
+	id := []byte(page.id)
+
+	query, err := encodeLeafQuery(page.query)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := tlvenc.EncodeList(page.keys, encodeCacheLeafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(leafPageIDType, &id),
+		tlv.MakePrimitiveRecord(leafPageQueryType, &query),
+		tlv.MakePrimitiveRecord(leafPageKeysType, &keys),
+	)
+}
+
+// decodeCacheLeafPage decodes a leaf page encoded with encodeCacheLeafPage.
+func decodeCacheLeafPage(b []byte) (cachedLeafPage, error) {
+	var (
+		page                      cachedLeafPage
+		id, queryBytes, keysBytes []byte
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(leafPageIDType, &id),
+		tlv.MakePrimitiveRecord(leafPageQueryType, &queryBytes),
+		tlv.MakePrimitiveRecord(leafPageKeysType, &keysBytes),
+	)
+	if err != nil {
+		return page, err
+	}
+
+	page.id = universeIDKey(id)
+	page.query, err = decodeLeafQuery(queryBytes)
+	if err != nil {
+		return page, err
+	}
+
+	page.keys, err = tlvenc.DecodeList(keysBytes, decodeCacheLeafKey)
+	if err != nil {
+		return page, err
+	}
+
+	return page, nil
+}
+
+// encodeMultiverseCacheState serializes the given cache state as a TLV stream
+// behind the magic bytes and the version of the format. The state is followed
+// by its SHA256 checksum, so a partially written or corrupted file is
+// detected when it's read again.
+func encodeMultiverseCacheState(s *multiverseCacheState) ([]byte, error) {
+	var (
+		issuanceHash = [32]byte(s.issuanceRoot.NodeHash())
+		issuanceSum  = s.issuanceRoot.NodeSum()
+		transferHash = [32]byte(s.transferRoot.NodeHash())
+		transferSum  = s.transferRoot.NodeSum()
+	)
+
+	syncerRoots, err := tlvenc.EncodeList(s.syncerRoots, encodeCacheRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	rootPages, err := tlvenc.EncodeList(s.rootPages, encodeCacheRootPage)
+	if err != nil {
+		return nil, err
+	}
+
+	leafPages, err := tlvenc.EncodeList(s.leafPages, encodeCacheLeafPage)
+	if err != nil {
+		return nil, err
+	}
+
+	stateBytes, err := tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(stateIssuanceHashType, &issuanceHash),
+		tlv.MakePrimitiveRecord(stateIssuanceSumType, &issuanceSum),
+		tlv.MakePrimitiveRecord(stateTransferHashType, &transferHash),
+		tlv.MakePrimitiveRecord(stateTransferSumType, &transferSum),
+		tlv.MakePrimitiveRecord(stateSyncerRootsType, &syncerRoots),
+		tlv.MakePrimitiveRecord(stateRootPagesType, &rootPages),
+		tlv.MakePrimitiveRecord(stateLeafPagesType, &leafPages),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	b := append([]byte{}, multiverseCacheStateMagic[:]...)
+	b = binary.BigEndian.AppendUint32(b, multiverseCacheStateVersion)
+	b = append(b, stateBytes...)
+
+	checksum := sha256.Sum256(b)
+
+	return append(b, checksum[:]...), nil
+}
+
+// decodeMultiverseCacheState deserializes a cache state written by
+// encodeMultiverseCacheState.
+func decodeMultiverseCacheState(b []byte) (*multiverseCacheState, error) {
+	const preambleLen = len(multiverseCacheStateMagic) + 4
+	if len(b) < preambleLen+sha256.Size {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	content, checksum := b[:len(b)-sha256.Size], b[len(b)-sha256.Size:]
+	expectedChecksum := sha256.Sum256(content)
+	if !bytes.Equal(checksum, expectedChecksum[:]) {
+		return nil, errCacheStateChecksum
+	}
+
+	magic := content[:len(multiverseCacheStateMagic)]
+	if !bytes.Equal(magic, multiverseCacheStateMagic[:]) {
+		return nil, fmt.Errorf("invalid cache state magic bytes")
+	}
+
+	version := binary.BigEndian.Uint32(
+		content[len(multiverseCacheStateMagic):preambleLen],
+	)
+	if version != multiverseCacheStateVersion {
+		return nil, fmt.Errorf("unsupported cache state version %d",
+			version)
+	}
+
+	var (
+		s                                 multiverseCacheState
+		issuanceHash, transferHash        [32]byte
+		issuanceSum, transferSum          uint64
+		syncerRoots, rootPages, leafPages []byte
+	)
+	_, err := tlvenc.DecodeStream(
+		content[preambleLen:],
+		tlv.MakePrimitiveRecord(stateIssuanceHashType, &issuanceHash),
+		tlv.MakePrimitiveRecord(stateIssuanceSumType, &issuanceSum),
+		tlv.MakePrimitiveRecord(stateTransferHashType, &transferHash),
+		tlv.MakePrimitiveRecord(stateTransferSumType, &transferSum),
+		tlv.MakePrimitiveRecord(stateSyncerRootsType, &syncerRoots),
+		tlv.MakePrimitiveRecord(stateRootPagesType, &rootPages),
+		tlv.MakePrimitiveRecord(stateLeafPagesType, &leafPages),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.issuanceRoot = mssmt.NewComputedBranch(issuanceHash, issuanceSum)
+	s.transferRoot = mssmt.NewComputedBranch(transferHash, transferSum)
+
+	s.syncerRoots, err = tlvenc.DecodeList(syncerRoots, decodeCacheRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rootPages, err = tlvenc.DecodeList(rootPages, decodeCacheRootPage)
+	if err != nil {
+		return nil, err
+	}
+
+	s.leafPages, err = tlvenc.DecodeList(leafPages, decodeCacheLeafPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// pages returns all cached root pages, ordered from the most to the least
+// recently used.
+func (r *rootNodeCache) pages() []cachedRootPage {
+	r.Lock()
+	defer r.Unlock()
+
+	var pages []cachedRootPage
+	r.allRoots.Load().RangeFILO(
+		func(q rootPageQueryKey, roots universeRootPage) bool {
+			pages = append(pages, cachedRootPage{
+				query: q,
+				roots: roots,
+			})
+
+			return true
+		},
+	)
+
+	return pages
+}
+
+// hotPages returns the cached leaf key pages of the most recently used
+// universes, until the given maximum number of leaf keys is reached.
+func (u *universeLeafPageCache) hotPages(maxKeys int) []cachedLeafPage {
+	u.Lock()
+	defer u.Unlock()
+
+	var (
+		pages   []cachedLeafPage
+		numKeys int
+	)
+	addPage := func(id universeIDKey, q leafQueryKey,
+		keys *cachedLeafKeys) bool {
+
+		numKeys += len(*keys)
+		if numKeys > maxKeys {
+			return false
+		}
+
+		pages = append(pages, cachedLeafPage{
+			warmLeafPageKey: warmLeafPageKey{
+				id:    id,
+				query: q,
+			},
+			keys: *keys,
+		})
+
+		return true
+	}
+
+	u.leafCache.RangeFILO(
+		func(id universeIDKey, pageCache *leafPageCache) bool {
+			pageCache.RangeFILO(
+				func(q leafQueryKey, k *cachedLeafKeys) bool {
+					return addPage(id, q, k)
+				},
+			)
+
+			return numKeys <= maxKeys
+		},
+	)
+
+	return pages
+}
+
+// currentMultiverseRoots returns the current issuance and transfer multiverse
+// roots. The root of an empty tree is returned for a multiverse that doesn't
+// have any universes yet.
+func (b *MultiverseStore) currentMultiverseRoots(
+	ctx context.Context) (mssmt.Node, mssmt.Node, error) {
+
+	fetchRoot := func(proofType universe.ProofType) (mssmt.Node, error) {
+		rootNode, err := b.MultiverseRootNode(ctx, proofType)
+		switch {
+		case errors.Is(err, ErrNoMultiverseRoot):
+			return mssmt.EmptyTree[0], nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		root := mssmt.EmptyTree[0]
+		rootNode.WhenSome(func(r universe.MultiverseRoot) {
+			root = r.Node
+		})
+
+		return root, nil
+	}
+
+	issuanceRoot, err := fetchRoot(universe.ProofTypeIssuance)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch issuance "+
+			"multiverse root: %w", err)
+	}
+
+	transferRoot, err := fetchRoot(universe.ProofTypeTransfer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch transfer "+
+			"multiverse root: %w", err)
+	}
+
+	return issuanceRoot, transferRoot, nil
+}
+
+// StoreCacheState writes the root node and leaf key caches to the configured
+// warm start file, so they can be loaded again on the next startup. This is a
+// no-op if no warm start file is configured.
+//
+// NOTE: This should only be called on shutdown, after the universe server
+// stopped serving requests.
+func (b *MultiverseStore) StoreCacheState(ctx context.Context) error {
+	if b.cfg.WarmStartFile == "" {
+		return nil
+	}
+
+	var (
+		state multiverseCacheState
+		err   error
+	)
+	state.issuanceRoot, state.transferRoot, err =
+		b.currentMultiverseRoots(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.syncerCache.RLock()
+	for _, key := range b.syncerCache.universeKeyList {
+		state.syncerRoots = append(
+			state.syncerRoots, b.syncerCache.universeRoots[key],
+		)
+	}
+	b.syncerCache.RUnlock()
+
+	state.rootPages = b.rootNodeCache.pages()
+	state.leafPages = b.leafKeysCache.hotPages(maxWarmStartLeafKeys)
+
+	// We first write to a temporary file and then rename it, so we never
+	// leave a partially written state behind.
+	stateBytes, err := encodeMultiverseCacheState(&state)
+	if err != nil {
+		return fmt.Errorf("unable to encode cache state: %w", err)
+	}
+
+	tempFile := b.cfg.WarmStartFile + ".tmp"
+	err = os.WriteFile(tempFile, stateBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to write cache state: %w", err)
+	}
+
+	if err := os.Rename(tempFile, b.cfg.WarmStartFile); err != nil {
+		return fmt.Errorf("unable to rename cache state file: %w", err)
+	}
+
+	log.Infof("Stored multiverse cache state with %d syncer roots, %d "+
+		"root pages and %d leaf key pages", len(state.syncerRoots),
+		len(state.rootPages), len(state.leafPages))
+
+	return nil
+}
+
+// LoadCacheState loads the root node and leaf key caches from the configured
+// warm start file. Entries are only loaded if the multiverse root they were
+// written under is still the current one, otherwise they are discarded. The
+// file is removed after it was read, so a state is never loaded twice. This is
+// a no-op if no warm start file is configured or the file doesn't exist.
+//
+// NOTE: This should be called on startup, before the universe server starts
+// serving requests.
+func (b *MultiverseStore) LoadCacheState(ctx context.Context) error {
+	if b.cfg.WarmStartFile == "" {
+		return nil
+	}
+
+	stateBytes, err := os.ReadFile(b.cfg.WarmStartFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		log.Infof("No multiverse cache state found, starting with " +
+			"empty caches")
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to read cache state: %w", err)
+	}
+
+	if err := os.Remove(b.cfg.WarmStartFile); err != nil {
+		return fmt.Errorf("unable to remove cache state file: %w", err)
+	}
+
+	state, err := decodeMultiverseCacheState(stateBytes)
+	if err != nil {
+		return fmt.Errorf("unable to decode cache state: %w", err)
+	}
+
+	issuanceRoot, transferRoot, err := b.currentMultiverseRoots(ctx)
+	if err != nil {
+		return err
+	}
+
+	// All the leaves of a universe are committed to by the multiverse
+	// root of its proof type. So all entries of a proof type are still
+	// valid if that multiverse root didn't change.
+	issuanceValid := mssmt.IsEqualNode(state.issuanceRoot, issuanceRoot)
+	transferValid := mssmt.IsEqualNode(state.transferRoot, transferRoot)
+	validFor := func(proofType universe.ProofType) bool {
+		switch proofType {
+		case universe.ProofTypeIssuance:
+			return issuanceValid
+
+		case universe.ProofTypeTransfer:
+			return transferValid
+
+		default:
+			return false
+		}
+	}
+
+	// The syncer cache and the root pages contain the roots of both
+	// proof types, so they're only valid if neither multiverse changed.
+	rootsValid := issuanceValid && transferValid
+
+	syncerCache := b.syncerCache
+	switch {
+	case rootsValid && syncerCache.enabled && len(state.syncerRoots) > 0:
+		syncerCache.Lock()
+		syncerCache.replaceCache(state.syncerRoots)
+		for _, root := range state.syncerRoots {
+			syncerCache.warm.add(root.ID.Key())
+		}
+		syncerCache.Unlock()
+
+	default:
+		syncerCache.warm.discard(len(state.syncerRoots))
+	}
+
+	rootCache := b.rootNodeCache
+	for idx := len(state.rootPages) - 1; idx >= 0; idx-- {
+		page := state.rootPages[idx]
+		if !rootsValid {
+			rootCache.warm.discard(1)
+			continue
+		}
+
+		rootCache.cacheRoots(universe.RootNodesQuery{
+			WithAmountsById: page.query.withAmountsById,
+			SortDirection:   page.query.sortDirection,
+			Offset:          page.query.offset,
+			Limit:           page.query.limit,
+		}, page.roots)
+		rootCache.warm.add(page.query)
+	}
+
+	leafCache := b.leafKeysCache
+	for idx := len(state.leafPages) - 1; idx >= 0; idx-- {
+		page := state.leafPages[idx]
+
+		// The universe ID key is prefixed by the proof type of the
+		// universe.
+		proofTypeStr, _, _ := strings.Cut(page.id, "-")
+		proofType, err := universe.ParseStrProofType(proofTypeStr)
+		if err != nil || !validFor(proofType) {
+			leafCache.warm.discard(1)
+			continue
+		}
+
+		leafCache.insertPage(page.id, page.query, page.keys)
+		leafCache.warm.add(page.warmLeafPageKey)
+	}
+
+	log.Infof("Loaded multiverse cache state (issuance_valid=%v, "+
+		"transfer_valid=%v)", issuanceValid, transferValid)
+
+	return nil
+}
+
+// WarmStartStats returns the statistics about the cache entries that were
+// loaded from disk on startup.
+func (b *MultiverseStore) WarmStartStats() []MultiverseCacheWarmStats {
+	return []MultiverseCacheWarmStats{
+		b.syncerCache.warm.stats(b.syncerCache.name),
+		b.rootNodeCache.warm.stats(b.rootNodeCache.name),
+		b.leafKeysCache.warm.stats(b.leafKeysCache.name),
+	}
+}
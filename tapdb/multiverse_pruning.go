@@ -0,0 +1,344 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// leafProofBatchSize is the number of leaf proofs that are fetched and decoded
+// at once, to backfill their block height or to find the leaves they spend.
+const leafProofBatchSize = 1000
+
+// backfillLeafBlockHeights stores the block height of all leaves of the
+// universe with the given namespace that were inserted before the block height
+// was stored along with them.
+func backfillLeafBlockHeights(ctx context.Context, db BaseMultiverseStore,
+	namespace string) error {
+
+	for {
+		leaves, err := db.FetchUniverseLeavesWithoutHeight(
+			ctx, UniverseLeavesWithoutHeightQuery{
+				Namespace: namespace,
+				NumLimit:  leafProofBatchSize,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch leaves: %w", err)
+		}
+
+		if len(leaves) == 0 {
+			return nil
+		}
+
+		for _, leaf := range leaves {
+			var leafProof proof.Proof
+			err := leafProof.Decode(bytes.NewReader(leaf.LeafProof))
+			if err != nil {
+				return fmt.Errorf("unable to decode proof of "+
+					"leaf %d: %w", leaf.ID, err)
+			}
+
+			err = db.UpdateUniverseLeafBlockHeight(
+				ctx, UniverseLeafBlockHeight{
+					BlockHeight: sqlInt32(
+						leafProof.BlockHeight,
+					),
+					ID: leaf.ID,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update block "+
+					"height of leaf %d: %w", leaf.ID, err)
+			}
+		}
+	}
+}
+
+// fetchSpentLeafKeys returns the keys of the leaves of the universe with the
+// given namespace whose assets were spent by another leaf of the universe.
+func fetchSpentLeafKeys(ctx context.Context, db BaseMultiverseStore,
+	namespace string) (map[universe.UniverseKey]struct{}, error) {
+
+	spentKeys := make(map[universe.UniverseKey]struct{})
+	var afterID int64
+	for {
+		leaves, err := db.FetchUniverseLeafProofs(
+			ctx, UniverseLeafProofsQuery{
+				Namespace: namespace,
+				AfterID:   afterID,
+				NumLimit:  leafProofBatchSize,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf proofs: "+
+				"%w", err)
+		}
+
+		if len(leaves) == 0 {
+			return spentKeys, nil
+		}
+
+		for _, leaf := range leaves {
+			err := addSpentLeafKeys(spentKeys, leaf.LeafProof)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse "+
+					"leaf %d: %w", leaf.ID, err)
+			}
+
+			afterID = leaf.ID
+		}
+	}
+}
+
+// addSpentLeafKeys adds the keys of the leaves spent by the asset of the given
+// leaf proof to the set of spent keys.
+func addSpentLeafKeys(spentKeys map[universe.UniverseKey]struct{},
+	rawProof []byte) error {
+
+	var leafProof proof.Proof
+	err := leafProof.Decode(bytes.NewReader(rawProof))
+	if err != nil {
+		return fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	for _, witness := range leafProof.Asset.Witnesses() {
+		prevID := witness.PrevID
+		if prevID == nil || *prevID == asset.ZeroPrevID {
+			continue
+		}
+
+		scriptKey, err := btcec.ParsePubKey(prevID.ScriptKey[:])
+		if err != nil {
+			return fmt.Errorf("unable to parse previous script "+
+				"key: %w", err)
+		}
+
+		prevKey := universe.LeafKey{
+			OutPoint:  prevID.OutPoint,
+			ScriptKey: fn.Ptr(asset.NewScriptKey(scriptKey)),
+		}
+		spentKeys[prevKey.UniverseKey()] = struct{}{}
+	}
+
+	return nil
+}
+
+// pruneUniverseLeaves removes the leaves selected by the query from the
+// universe, and returns the summary of the removed leaves along with the new
+// root of the universe. If no leaves are left, the universe is removed
+// entirely and no root is returned.
+func pruneUniverseLeaves(ctx context.Context, db BaseMultiverseStore,
+	q universe.PruneQuery) (universe.PruneResult, mssmt.Node, error) {
+
+	var (
+		id        = q.ID
+		namespace = id.String()
+		result    universe.PruneResult
+	)
+
+	err := backfillLeafBlockHeights(ctx, db, namespace)
+	if err != nil {
+		return result, nil, err
+	}
+
+	// The leaves are ordered by their block height, so the oldest leaves
+	// come first.
+	leaves, err := db.FetchUniverseLeafSizes(ctx, namespace)
+	if err != nil {
+		return result, nil, fmt.Errorf("unable to fetch leaves: %w",
+			err)
+	}
+
+	// Unless the universe is removed entirely, only leaves of assets
+	// that were spent by another leaf are pruned. A later transfer of an
+	// unspent asset needs the leaf as its previous proof.
+	var spentKeys map[universe.UniverseKey]struct{}
+	if !q.PruneAll {
+		spentKeys, err = fetchSpentLeafKeys(ctx, db, namespace)
+		if err != nil {
+			return result, nil, err
+		}
+	}
+
+	var (
+		prunedLeaves []UniverseLeafSize
+		nextIndex    int
+		numKept      = uint64(len(leaves))
+	)
+	for i, leaf := range leaves {
+		var leafKey universe.UniverseKey
+		copy(leafKey[:], leaf.LeafNodeKey)
+
+		if _, spent := spentKeys[leafKey]; !spent && !q.PruneAll {
+			continue
+		}
+
+		height := extractSqlInt32[uint32](leaf.BlockHeight)
+		tooOld := height < q.MinBlockHeight
+		tooMany := q.MaxLeaves != 0 && numKept > q.MaxLeaves
+		if !q.PruneAll && !tooOld && !tooMany {
+			break
+		}
+
+		prunedLeaves = append(prunedLeaves, leaf)
+		nextIndex = i + 1
+		numKept--
+	}
+
+	numPruned := len(prunedLeaves)
+	if numPruned == 0 {
+		return result, nil, nil
+	}
+
+	// Leaves older than the first leaf after the pruned ones would be
+	// pruned again once spent, so the caller can reject them on
+	// insertion.
+	if nextIndex < len(leaves) {
+		result.MinKeptHeight = extractSqlInt32[uint32](
+			leaves[nextIndex].BlockHeight,
+		)
+	}
+
+	for _, leaf := range prunedLeaves {
+		result.NumLeaves++
+		result.NumBytes += uint64(leaf.ProofSize)
+	}
+
+	// If no leaves are left, we remove the entire universe, which also
+	// removes its events.
+	if numKept == 0 {
+		result.NumUniverses = 1
+
+		err := deleteMultiverseUniverse(ctx, db, id)
+		if err != nil {
+			return result, nil, fmt.Errorf("unable to delete "+
+				"universe: %w", err)
+		}
+
+		return result, nil, nil
+	}
+
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(db, namespace),
+	)
+	for _, leaf := range prunedLeaves {
+		leafKey, err := newKey(leaf.LeafNodeKey)
+		if err != nil {
+			return result, nil, err
+		}
+
+		_, err = universeTree.Delete(ctx, leafKey)
+		if err != nil {
+			return result, nil, fmt.Errorf("unable to delete leaf "+
+				"%d from universe tree: %w", leaf.ID, err)
+		}
+
+		err = db.DeleteLeafProofSyncLogs(ctx, leaf.ID)
+		if err != nil {
+			return result, nil, fmt.Errorf("unable to delete "+
+				"proof sync logs of leaf %d: %w", leaf.ID, err)
+		}
+
+		err = db.DeleteUniverseLeaf(ctx, leaf.ID)
+		if err != nil {
+			return result, nil, fmt.Errorf("unable to delete "+
+				"leaf %d: %w", leaf.ID, err)
+		}
+	}
+
+	// To keep the universe stats consistent with the leaves that are
+	// left, we remove one new proof event per pruned leaf, starting with
+	// the oldest ones.
+	err = db.DeleteOldestNewProofEvents(ctx, DeleteNewProofEvents{
+		NamespaceRoot: namespace,
+		NumLimit:      int32(numPruned),
+	})
+	if err != nil {
+		return result, nil, fmt.Errorf("unable to delete new proof "+
+			"events: %w", err)
+	}
+
+	// Finally, the multiverse leaf of the universe needs to commit to the
+	// new universe root.
+	universeRoot, err := universeTree.Root(ctx)
+	if err != nil {
+		return result, nil, err
+	}
+
+	multiverseNS, err := namespaceForProof(id.ProofType)
+	if err != nil {
+		return result, nil, err
+	}
+	multiverseTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(db, multiverseNS),
+	)
+	_, err = multiverseTree.Insert(
+		ctx, id.Bytes(), multiverseLeafNode(id, universeRoot),
+	)
+	if err != nil {
+		return result, nil, fmt.Errorf("unable to update multiverse "+
+			"leaf: %w", err)
+	}
+
+	return result, universeRoot, nil
+}
+
+// PruneLeaves removes the leaves selected by the query from the universe and
+// updates its multiverse leaf. If no leaves are left, the universe is removed
+// entirely.
+//
+// NOTE: This is part of the universe.LeafPruner interface.
+func (b *MultiverseStore) PruneLeaves(ctx context.Context,
+	q universe.PruneQuery) (universe.PruneResult, error) {
+
+	var (
+		writeTx      BaseMultiverseOptions
+		result       universe.PruneResult
+		universeRoot mssmt.Node
+	)
+	dbErr := b.db.ExecTx(ctx, &writeTx, func(db BaseMultiverseStore) error {
+		var err error
+		result, universeRoot, err = pruneUniverseLeaves(ctx, db, q)
+		return err
+	})
+	if dbErr != nil {
+		return universe.PruneResult{}, dbErr
+	}
+
+	if result.NumLeaves == 0 {
+		return result, nil
+	}
+
+	// Invalidate the caches since we just updated the root.
+	id := q.ID
+	b.rootNodeCache.wipeCache()
+	b.proofCache.Delete(id.String())
+	b.leafKeysCache.wipeCache(id.String())
+
+	cachedRoot := b.syncerCache.fetchRoot(id)
+	switch {
+	case universeRoot == nil:
+		b.syncerCache.remove(id.Key())
+
+	case cachedRoot != nil:
+		b.syncerCache.addOrReplace(universe.Root{
+			ID:        id,
+			AssetName: cachedRoot.AssetName,
+			Node:      universeRoot,
+		})
+	}
+
+	return result, nil
+}
+
+// A compile time assertion to ensure that MultiverseStore implements the
+// universe.LeafPruner interface.
+var _ universe.LeafPruner = (*MultiverseStore)(nil)
@@ -0,0 +1,486 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// rfqQuoteTypeBuy is the database value of a buy quote.
+	rfqQuoteTypeBuy = 0
+
+	// rfqQuoteTypeSell is the database value of a sell quote.
+	rfqQuoteTypeSell = 1
+)
+
+type (
+	// NewRfqQuote is used to insert a new accepted RFQ quote.
+	NewRfqQuote = sqlc.InsertRfqQuoteParams
+
+	// RfqQuote is an accepted RFQ quote returned from a query.
+	RfqQuote = sqlc.RfqQuote
+
+	// QueryRfqQuotesParams is used to query for the history of accepted
+	// RFQ quotes.
+	QueryRfqQuotesParams = sqlc.QueryRfqQuotesParams
+
+	// RfqQuoteAlias is the SCID alias of an expired quote, along with the
+	// base SCID it maps to.
+	RfqQuoteAlias = sqlc.FetchExpiredRfqQuoteAliasesRow
+
+	// NewRfqQuoteHtlc is used to track an HTLC that was accepted under
+	// the policy of a quote.
+	NewRfqQuoteHtlc = sqlc.UpsertRfqQuoteHtlcParams
+
+	// RfqQuoteHtlc is an HTLC that was accepted under the policy of a
+	// quote, returned from a query.
+	RfqQuoteHtlc = sqlc.FetchRfqQuoteHtlcsRow
+
+	// DeleteRfqQuoteHtlcParams is used to stop tracking an HTLC.
+	DeleteRfqQuoteHtlcParams = sqlc.DeleteRfqQuoteHtlcParams
+)
+
+// RfqQuoteStore is the set of queries that are needed to persist accepted RFQ
+// quotes and the HTLCs that were accepted under their policies.
+type RfqQuoteStore interface {
+	// InsertRfqQuote inserts a new accepted quote, unless a quote with
+	// the same ID already exists.
+	InsertRfqQuote(ctx context.Context, arg NewRfqQuote) error
+
+	// FetchActiveRfqQuotes returns all quotes that expire after the given
+	// time.
+	FetchActiveRfqQuotes(ctx context.Context,
+		now time.Time) ([]RfqQuote, error)
+
+	// QueryRfqQuotes returns the quotes that match the given query.
+	QueryRfqQuotes(ctx context.Context,
+		arg QueryRfqQuotesParams) ([]RfqQuote, error)
+
+	// FetchExpiredRfqQuoteAliases returns the SCID aliases of all quotes
+	// that expired at the given time and still have an alias.
+	FetchExpiredRfqQuoteAliases(ctx context.Context,
+		now time.Time) ([]RfqQuoteAlias, error)
+
+	// ClearRfqQuoteBaseScid marks the SCID alias of a quote as removed.
+	ClearRfqQuoteBaseScid(ctx context.Context, scid int64) error
+
+	// DeleteExpiredRfqQuotes deletes all quotes that expired before the
+	// given time and have no SCID alias left.
+	DeleteExpiredRfqQuotes(ctx context.Context,
+		expiredBefore time.Time) (int64, error)
+
+	// UpsertRfqQuoteHtlc tracks an HTLC that was accepted under the
+	// policy of a quote.
+	UpsertRfqQuoteHtlc(ctx context.Context, arg NewRfqQuoteHtlc) error
+
+	// DeleteRfqQuoteHtlc stops tracking an HTLC for all quotes.
+	DeleteRfqQuoteHtlc(ctx context.Context,
+		arg DeleteRfqQuoteHtlcParams) error
+
+	// FetchRfqQuoteHtlcs returns the HTLCs that were accepted under the
+	// policy of a quote.
+	FetchRfqQuoteHtlcs(ctx context.Context,
+		quoteID int64) ([]RfqQuoteHtlc, error)
+}
+
+// RfqQuoteTxOptions defines the set of db txn options the RfqQuoteStore
+// understands.
+type RfqQuoteTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *RfqQuoteTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewRfqQuoteReadTx creates a new read transaction option set.
+func NewRfqQuoteReadTx() RfqQuoteTxOptions {
+	return RfqQuoteTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedRfqQuoteStore combines the RfqQuoteStore interface with the
+// BatchedTx interface, allowing for multiple queries to be executed in a single
+// SQL transaction.
+type BatchedRfqQuoteStore interface {
+	RfqQuoteStore
+
+	BatchedTx[RfqQuoteStore]
+}
+
+// RfqQuoteDB is a database backed store of the accepted RFQ quotes.
+//
+// NOTE: This implements the rfq.QuoteStore interface.
+type RfqQuoteDB struct {
+	db BatchedRfqQuoteStore
+}
+
+// NewRfqQuoteDB creates a new RFQ quote store from the given database.
+func NewRfqQuoteDB(db BatchedRfqQuoteStore) *RfqQuoteDB {
+	return &RfqQuoteDB{
+		db: db,
+	}
+}
+
+// StoreQuote persists an accepted quote, unless a quote with the same ID is
+// already stored.
+func (r *RfqQuoteDB) StoreQuote(ctx context.Context,
+	quote *rfq.QuoteRecord) error {
+
+	id := quote.ID()
+	peer := quote.Peer()
+	assetRate := quote.AssetRate()
+	newQuote := NewRfqQuote{
+		QuoteID:         id[:],
+		Scid:            int64(quote.Scid()),
+		LocalAccept:     quote.LocalAccept,
+		Peer:            peer[:],
+		RateCoefficient: assetRate.Rate.Coefficient.Bytes(),
+		RateScale:       int16(assetRate.Rate.Scale),
+		Expiry:          assetRate.Expiry.UTC(),
+		CreatedAt:       quote.CreatedAt.UTC(),
+	}
+
+	var specifier asset.Specifier
+	switch {
+	case quote.BuyAccept != nil:
+		accept := quote.BuyAccept
+		specifier = accept.Request.AssetSpecifier
+		newQuote.QuoteType = rfqQuoteTypeBuy
+		newQuote.RequestVersion = int16(accept.Request.Version)
+		newQuote.AcceptVersion = int16(accept.Version)
+		newQuote.MaxAmount = int64(accept.Request.AssetMaxAmt)
+
+	case quote.SellAccept != nil:
+		accept := quote.SellAccept
+		specifier = accept.Request.AssetSpecifier
+		newQuote.QuoteType = rfqQuoteTypeSell
+		newQuote.RequestVersion = int16(accept.Request.Version)
+		newQuote.AcceptVersion = int16(accept.Version)
+		newQuote.MaxAmount = int64(accept.Request.PaymentMaxAmt)
+
+	default:
+		return fmt.Errorf("quote %x is neither a buy nor a sell quote",
+			id[:])
+	}
+
+	newQuote.AssetID, newQuote.GroupKey = specifier.AsBytes()
+	quote.BaseScid.WhenSome(func(baseScid lnwire.ShortChannelID) {
+		newQuote.BaseScid = sqlInt64(baseScid.ToUint64())
+	})
+
+	var writeTx RfqQuoteTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.InsertRfqQuote(ctx, newQuote)
+	})
+}
+
+// FetchActiveQuotes returns all quotes that have not expired at the given
+// time, along with the HTLCs accepted under their policies.
+func (r *RfqQuoteDB) FetchActiveQuotes(ctx context.Context,
+	now time.Time) ([]*rfq.QuoteRecord, error) {
+
+	var quotes []*rfq.QuoteRecord
+
+	readTx := NewRfqQuoteReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		rows, err := db.FetchActiveRfqQuotes(ctx, now.UTC())
+		if err != nil {
+			return err
+		}
+
+		quotes, err = parseRfqQuotes(ctx, db, rows)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return quotes, nil
+}
+
+// QueryQuotes returns the stored quotes that match the given query, most
+// recently accepted first.
+func (r *RfqQuoteDB) QueryQuotes(ctx context.Context,
+	query rfq.QuoteQuery) ([]*rfq.QuoteRecord, error) {
+
+	params := QueryRfqQuotesParams{
+		StartTime: query.StartTime.UTC(),
+		EndTime:   query.EndTime.UTC(),
+		NumOffset: query.Offset,
+		NumLimit:  query.Limit,
+	}
+	query.Peer.WhenSome(func(peer route.Vertex) {
+		params.Peer = peer[:]
+	})
+
+	var quotes []*rfq.QuoteRecord
+
+	readTx := NewRfqQuoteReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		rows, err := db.QueryRfqQuotes(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		quotes, err = parseRfqQuotes(ctx, db, rows)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return quotes, nil
+}
+
+// TrackHtlc records that the given HTLC was accepted under the policy of the
+// quote with the given ID.
+func (r *RfqQuoteDB) TrackHtlc(ctx context.Context, id rfqmsg.ID,
+	circuitKey models.CircuitKey, amt lnwire.MilliSatoshi) error {
+
+	var writeTx RfqQuoteTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.UpsertRfqQuoteHtlc(ctx, NewRfqQuoteHtlc{
+			QuoteID:    id[:],
+			ChanID:     int64(circuitKey.ChanID.ToUint64()),
+			HtlcID:     int64(circuitKey.HtlcID),
+			AmountMsat: int64(amt),
+		})
+	})
+}
+
+// UntrackHtlc removes the given HTLC from the policies of all quotes it was
+// accepted under.
+func (r *RfqQuoteDB) UntrackHtlc(ctx context.Context,
+	circuitKey models.CircuitKey) error {
+
+	var writeTx RfqQuoteTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.DeleteRfqQuoteHtlc(ctx, DeleteRfqQuoteHtlcParams{
+			ChanID: int64(circuitKey.ChanID.ToUint64()),
+			HtlcID: int64(circuitKey.HtlcID),
+		})
+	})
+}
+
+// FetchExpiredAliases returns the SCID aliases of the quotes that have
+// expired at the given time, mapped to the base SCID of their channel.
+func (r *RfqQuoteDB) FetchExpiredAliases(ctx context.Context,
+	now time.Time) (map[rfq.SerialisedScid]lnwire.ShortChannelID, error) {
+
+	aliases := make(map[rfq.SerialisedScid]lnwire.ShortChannelID)
+
+	readTx := NewRfqQuoteReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		rows, err := db.FetchExpiredRfqQuoteAliases(ctx, now.UTC())
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			scid := rfq.SerialisedScid(uint64(row.Scid))
+			aliases[scid] = lnwire.NewShortChanIDFromInt(
+				extractSqlInt64[uint64](row.BaseScid),
+			)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return aliases, nil
+}
+
+// RemoveAlias records that the SCID alias of the quote was removed.
+func (r *RfqQuoteDB) RemoveAlias(ctx context.Context,
+	scid rfq.SerialisedScid) error {
+
+	var writeTx RfqQuoteTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.ClearRfqQuoteBaseScid(ctx, int64(scid))
+	})
+}
+
+// PruneQuotes deletes the quotes that expired before the given time and have
+// no SCID alias left. The number of deleted quotes is returned.
+func (r *RfqQuoteDB) PruneQuotes(ctx context.Context,
+	expiredBefore time.Time) (int64, error) {
+
+	var numDeleted int64
+
+	var writeTx RfqQuoteTxOptions
+	dbErr := r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		var err error
+		numDeleted, err = db.DeleteExpiredRfqQuotes(
+			ctx, expiredBefore.UTC(),
+		)
+		return err
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return numDeleted, nil
+}
+
+// parseRfqQuotes parses the given quotes from the database and fetches the
+// HTLCs that were accepted under their policies.
+func parseRfqQuotes(ctx context.Context, db RfqQuoteStore,
+	rows []RfqQuote) ([]*rfq.QuoteRecord, error) {
+
+	quotes := make([]*rfq.QuoteRecord, 0, len(rows))
+	for _, row := range rows {
+		quote, err := parseRfqQuote(row)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse quote %x: %w",
+				row.QuoteID, err)
+		}
+
+		htlcs, err := db.FetchRfqQuoteHtlcs(ctx, row.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch HTLCs of "+
+				"quote %x: %w", row.QuoteID, err)
+		}
+
+		for _, htlc := range htlcs {
+			circuitKey := models.CircuitKey{
+				ChanID: lnwire.NewShortChanIDFromInt(
+					uint64(htlc.ChanID),
+				),
+				HtlcID: uint64(htlc.HtlcID),
+			}
+			quote.Htlcs[circuitKey] = lnwire.MilliSatoshi(
+				htlc.AmountMsat,
+			)
+		}
+
+		quotes = append(quotes, quote)
+	}
+
+	return quotes, nil
+}
+
+// parseRfqQuote parses an accepted quote from the database.
+func parseRfqQuote(row RfqQuote) (*rfq.QuoteRecord, error) {
+	var id rfqmsg.ID
+	if len(row.QuoteID) != len(id) {
+		return nil, fmt.Errorf("invalid quote ID length %d",
+			len(row.QuoteID))
+	}
+	copy(id[:], row.QuoteID)
+
+	peer, err := route.NewVertexFromBytes(row.Peer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse peer: %w", err)
+	}
+
+	var assetID *asset.ID
+	if len(row.AssetID) > 0 {
+		assetID = new(asset.ID)
+		copy(assetID[:], row.AssetID)
+	}
+
+	var groupKey *btcec.PublicKey
+	if len(row.GroupKey) > 0 {
+		groupKey, err = btcec.ParsePubKey(row.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	}
+
+	specifier, err := asset.NewSpecifier(assetID, groupKey, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	assetRate := rfqmsg.NewAssetRate(
+		rfqmath.BigIntFixedPoint{
+			Coefficient: rfqmath.BigInt{}.FromBytes(
+				row.RateCoefficient,
+			),
+			Scale: uint8(row.RateScale),
+		},
+		row.Expiry.UTC(),
+	)
+
+	baseScid := fn.None[lnwire.ShortChannelID]()
+	if row.BaseScid.Valid {
+		baseScid = fn.Some(lnwire.NewShortChanIDFromInt(
+			extractSqlInt64[uint64](row.BaseScid),
+		))
+	}
+
+	var quote *rfq.QuoteRecord
+	switch row.QuoteType {
+	case rfqQuoteTypeBuy:
+		quote = rfq.NewBuyQuoteRecord(rfqmsg.BuyAccept{
+			Peer: peer,
+			Request: rfqmsg.BuyRequest{
+				Peer: peer,
+				Version: rfqmsg.WireMsgDataVersion(
+					row.RequestVersion,
+				),
+				ID:             id,
+				AssetSpecifier: specifier,
+				AssetMaxAmt:    uint64(row.MaxAmount),
+			},
+			Version: rfqmsg.WireMsgDataVersion(
+				row.AcceptVersion,
+			),
+			ID:        id,
+			AssetRate: assetRate,
+		}, row.LocalAccept, baseScid)
+
+	case rfqQuoteTypeSell:
+		quote = rfq.NewSellQuoteRecord(rfqmsg.SellAccept{
+			Peer: peer,
+			Request: rfqmsg.SellRequest{
+				Peer: peer,
+				Version: rfqmsg.WireMsgDataVersion(
+					row.RequestVersion,
+				),
+				ID:             id,
+				AssetSpecifier: specifier,
+				PaymentMaxAmt: lnwire.MilliSatoshi(
+					row.MaxAmount,
+				),
+			},
+			Version: rfqmsg.WireMsgDataVersion(
+				row.AcceptVersion,
+			),
+			ID:        id,
+			AssetRate: assetRate,
+		}, row.LocalAccept, baseScid)
+
+	default:
+		return nil, fmt.Errorf("unknown quote type %d", row.QuoteType)
+	}
+
+	quote.CreatedAt = row.CreatedAt.UTC()
+
+	return quote, nil
+}
+
+// A compile-time assertion to ensure that RfqQuoteDB implements the
+// rfq.QuoteStore interface.
+var _ rfq.QuoteStore = (*RfqQuoteDB)(nil)
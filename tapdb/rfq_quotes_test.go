@@ -0,0 +1,269 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// randQuoteID returns a random quote ID whose SCID alias doesn't fit into a
+// signed 64-bit integer.
+func randQuoteID() rfqmsg.ID {
+	var id rfqmsg.ID
+	copy(id[:], test.RandBytes(32))
+	id[24] |= 0x80
+
+	return id
+}
+
+// assertQuoteEqual asserts that both quote records are equal.
+func assertQuoteEqual(t *testing.T, expected, actual *rfq.QuoteRecord) {
+	require.Equal(t, expected.ID(), actual.ID())
+	require.Equal(t, expected.Peer(), actual.Peer())
+	require.Equal(t, expected.Scid(), actual.Scid())
+	require.Equal(t, expected.LocalAccept, actual.LocalAccept)
+	require.Equal(t, expected.BaseScid, actual.BaseScid)
+	require.Equal(t, expected.Htlcs, actual.Htlcs)
+	require.True(t, expected.CreatedAt.Equal(actual.CreatedAt))
+
+	expectedRate, actualRate := expected.AssetRate(), actual.AssetRate()
+	require.True(t, expectedRate.Rate.Equals(actualRate.Rate))
+	require.True(t, expectedRate.Expiry.Equal(actualRate.Expiry))
+
+	if expected.BuyAccept != nil {
+		require.NotNil(t, actual.BuyAccept)
+
+		expectedReq, actualReq := expected.BuyAccept.Request,
+			actual.BuyAccept.Request
+		require.Equal(t, expectedReq.AssetMaxAmt, actualReq.AssetMaxAmt)
+		require.Equal(t, expectedReq.Version, actualReq.Version)
+		require.Equal(
+			t, expected.BuyAccept.Version, actual.BuyAccept.Version,
+		)
+
+		expectedID, expectedKey := expectedReq.AssetSpecifier.AsBytes()
+		actualID, actualKey := actualReq.AssetSpecifier.AsBytes()
+		require.Equal(t, expectedID, actualID)
+		require.Equal(t, expectedKey, actualKey)
+
+		return
+	}
+
+	require.NotNil(t, actual.SellAccept)
+
+	expectedReq, actualReq := expected.SellAccept.Request,
+		actual.SellAccept.Request
+	require.Equal(t, expectedReq.PaymentMaxAmt, actualReq.PaymentMaxAmt)
+	require.Equal(t, expectedReq.Version, actualReq.Version)
+
+	expectedID, expectedKey := expectedReq.AssetSpecifier.AsBytes()
+	actualID, actualKey := actualReq.AssetSpecifier.AsBytes()
+	require.Equal(t, expectedID, actualID)
+	require.Equal(t, expectedKey, actualKey)
+}
+
+// TestRfqQuoteDB tests that accepted quotes are persisted along with their
+// SCID aliases and the HTLCs accepted under their policies, and that expired
+// quotes are pruned once their alias was removed.
+func TestRfqQuoteDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+	dbTxer := NewTransactionExecutor(
+		db, func(tx *sql.Tx) RfqQuoteStore {
+			return db.WithTx(tx)
+		},
+	)
+	store := NewRfqQuoteDB(dbTxer)
+
+	now := time.Now().Truncate(time.Second)
+	peer1, err := route.NewVertexFromBytes(
+		test.RandPubKey(t).SerializeCompressed(),
+	)
+	require.NoError(t, err)
+	peer2, err := route.NewVertexFromBytes(
+		test.RandPubKey(t).SerializeCompressed(),
+	)
+	require.NoError(t, err)
+
+	rate := rfqmath.NewBigIntFixedPoint(math.MaxUint64, 3)
+	rate.Coefficient = rate.Coefficient.Mul(
+		rfqmath.NewBigIntFromUint64(100),
+	)
+
+	// We store a buy quote that we accepted, which has an SCID alias and
+	// specifies the asset by both its ID and group key.
+	assetID := asset.RandID(t)
+	buyID := randQuoteID()
+	buyQuote := rfq.NewBuyQuoteRecord(rfqmsg.BuyAccept{
+		Peer: peer1,
+		Request: rfqmsg.BuyRequest{
+			Peer:    peer1,
+			Version: rfqmsg.V1,
+			ID:      buyID,
+			AssetSpecifier: asset.NewSpecifierOptionalGroupPubKey(
+				assetID, test.RandPubKey(t),
+			),
+			AssetMaxAmt: 1000,
+		},
+		Version: rfqmsg.V1,
+		ID:      buyID,
+		AssetRate: rfqmsg.NewAssetRate(
+			rate, now.Add(time.Hour).UTC(),
+		),
+	}, true, fn.Some(lnwire.NewShortChanIDFromInt(math.MaxUint64-1)))
+	buyQuote.CreatedAt = now.Add(-time.Minute).UTC()
+	require.NoError(t, store.StoreQuote(ctx, buyQuote))
+
+	// Storing the same quote again is a no-op.
+	require.NoError(t, store.StoreQuote(ctx, buyQuote))
+
+	// We also store a sell quote that our peer accepted, which specifies
+	// the asset by its group key only.
+	sellID := randQuoteID()
+	sellQuote := rfq.NewSellQuoteRecord(rfqmsg.SellAccept{
+		Peer: peer2,
+		Request: rfqmsg.SellRequest{
+			Peer:    peer2,
+			Version: rfqmsg.V1,
+			ID:      sellID,
+			AssetSpecifier: asset.NewSpecifierFromGroupKey(
+				*test.RandPubKey(t),
+			),
+			PaymentMaxAmt: 50_000_000,
+		},
+		Version: rfqmsg.V1,
+		ID:      sellID,
+		AssetRate: rfqmsg.NewAssetRate(
+			rfqmath.NewBigIntFixedPoint(42_000, 2),
+			now.Add(time.Hour).UTC(),
+		),
+	}, false, fn.None[lnwire.ShortChannelID]())
+	sellQuote.CreatedAt = now.UTC()
+	require.NoError(t, store.StoreQuote(ctx, sellQuote))
+
+	// And finally a buy quote that has already expired.
+	expiredID := randQuoteID()
+	expiredQuote := rfq.NewBuyQuoteRecord(rfqmsg.BuyAccept{
+		Peer: peer1,
+		Request: rfqmsg.BuyRequest{
+			Peer:           peer1,
+			ID:             expiredID,
+			AssetSpecifier: asset.NewSpecifierFromId(assetID),
+			AssetMaxAmt:    10,
+		},
+		ID: expiredID,
+		AssetRate: rfqmsg.NewAssetRate(
+			rfqmath.NewBigIntFixedPoint(1, 0),
+			now.Add(-time.Hour).UTC(),
+		),
+	}, true, fn.Some(lnwire.NewShortChanIDFromInt(1234)))
+	expiredQuote.CreatedAt = now.Add(-2 * time.Hour).UTC()
+	require.NoError(t, store.StoreQuote(ctx, expiredQuote))
+
+	// An HTLC that was forwarded from one quote to the other is tracked
+	// for both of them, and untracking it removes it from both.
+	forwardKey := models.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(math.MaxUint64 - 5),
+		HtlcID: 7,
+	}
+	require.NoError(t, store.TrackHtlc(ctx, buyID, forwardKey, 1_000))
+	require.NoError(t, store.TrackHtlc(ctx, sellID, forwardKey, 1_000))
+	require.NoError(t, store.UntrackHtlc(ctx, forwardKey))
+
+	htlcKey := models.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(12345),
+		HtlcID: 3,
+	}
+	require.NoError(t, store.TrackHtlc(ctx, buyID, htlcKey, 2_000))
+
+	// Tracking the same HTLC again updates its amount.
+	require.NoError(t, store.TrackHtlc(ctx, buyID, htlcKey, 3_000))
+	buyQuote.Htlcs[htlcKey] = 3_000
+
+	// HTLCs can't be tracked for unknown quotes.
+	err = store.TrackHtlc(ctx, randQuoteID(), htlcKey, 1)
+	require.Error(t, err)
+
+	// Only the quotes that haven't expired yet are active.
+	activeQuotes, err := store.FetchActiveQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, activeQuotes, 2)
+	assertQuoteEqual(t, buyQuote, activeQuotes[0])
+	assertQuoteEqual(t, sellQuote, activeQuotes[1])
+	require.EqualValues(t, 3_000, activeQuotes[0].HtlcAmount())
+
+	// The history contains all quotes, most recent first, and can be
+	// filtered by peer and time.
+	allQuotes, err := store.QueryQuotes(ctx, rfq.QuoteQuery{
+		EndTime: now,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, allQuotes, 3)
+	assertQuoteEqual(t, sellQuote, allQuotes[0])
+	assertQuoteEqual(t, buyQuote, allQuotes[1])
+	assertQuoteEqual(t, expiredQuote, allQuotes[2])
+
+	peerQuotes, err := store.QueryQuotes(ctx, rfq.QuoteQuery{
+		Peer:      fn.Some(peer1),
+		StartTime: now.Add(-time.Hour),
+		EndTime:   now,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, peerQuotes, 1)
+	assertQuoteEqual(t, buyQuote, peerQuotes[0])
+
+	pagedQuotes, err := store.QueryQuotes(ctx, rfq.QuoteQuery{
+		EndTime: now,
+		Offset:  1,
+		Limit:   1,
+	})
+	require.NoError(t, err)
+	require.Len(t, pagedQuotes, 1)
+	assertQuoteEqual(t, buyQuote, pagedQuotes[0])
+
+	// The alias of the expired quote needs to be removed before the quote
+	// can be pruned.
+	aliases, err := store.FetchExpiredAliases(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, map[rfq.SerialisedScid]lnwire.ShortChannelID{
+		expiredQuote.Scid(): lnwire.NewShortChanIDFromInt(1234),
+	}, aliases)
+
+	numPruned, err := store.PruneQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, numPruned)
+
+	require.NoError(t, store.RemoveAlias(ctx, expiredQuote.Scid()))
+
+	aliases, err = store.FetchExpiredAliases(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, aliases)
+
+	numPruned, err = store.PruneQuotes(ctx, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numPruned)
+
+	allQuotes, err = store.QueryQuotes(ctx, rfq.QuoteQuery{
+		EndTime: now,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, allQuotes, 2)
+}
@@ -0,0 +1,281 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+// InsertSpvHeaderParams is used to insert a header of the SPV header chain.
+type InsertSpvHeaderParams = sqlc.InsertSpvHeaderParams
+
+// FetchSpvHeadersParams is used to fetch a range of headers of the SPV header
+// chain.
+type FetchSpvHeadersParams = sqlc.FetchSpvHeadersParams
+
+// SpvHeaderStore is the set of queries that are needed to maintain the SPV
+// header chain.
+type SpvHeaderStore interface {
+	// InsertSpvHeader inserts a header.
+	InsertSpvHeader(ctx context.Context, arg InsertSpvHeaderParams) error
+
+	// DeleteSpvHeadersFrom deletes all headers at or above the given
+	// height.
+	DeleteSpvHeadersFrom(ctx context.Context, blockHeight int32) error
+
+	// FetchSpvHeader fetches the header at the given height.
+	FetchSpvHeader(ctx context.Context,
+		blockHeight int32) (sqlc.SpvHeader, error)
+
+	// FetchSpvHeaders fetches the headers within the given range of
+	// heights, in ascending order.
+	FetchSpvHeaders(ctx context.Context,
+		arg FetchSpvHeadersParams) ([]sqlc.SpvHeader, error)
+
+	// FetchBestSpvHeader fetches the header with the greatest height.
+	FetchBestSpvHeader(ctx context.Context) (sqlc.SpvHeader, error)
+}
+
+// SpvHeaderTxOptions defines the set of db txn options the SpvHeaderStore
+// understands.
+type SpvHeaderTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *SpvHeaderTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewSpvHeaderReadTx creates a new read transaction option set.
+func NewSpvHeaderReadTx() SpvHeaderTxOptions {
+	return SpvHeaderTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedSpvHeaderStore combines the SpvHeaderStore interface with the
+// BatchedTx interface, allowing for multiple queries to be executed in a
+// single SQL transaction.
+type BatchedSpvHeaderStore interface {
+	SpvHeaderStore
+
+	BatchedTx[SpvHeaderStore]
+}
+
+// SpvHeaderDB is a database backed store of the SPV header chain.
+type SpvHeaderDB struct {
+	db BatchedSpvHeaderStore
+}
+
+// NewSpvHeaderDB creates a new SPV header chain store from the given database.
+func NewSpvHeaderDB(db BatchedSpvHeaderStore) *SpvHeaderDB {
+	return &SpvHeaderDB{
+		db: db,
+	}
+}
+
+// parseSpvHeader decodes a header of the SPV header chain.
+func parseSpvHeader(dbHeader sqlc.SpvHeader) (proof.StoredHeader, error) {
+	var header wire.BlockHeader
+	err := header.Deserialize(bytes.NewReader(dbHeader.Header))
+	if err != nil {
+		return proof.StoredHeader{}, fmt.Errorf("unable to decode "+
+			"header: %w", err)
+	}
+
+	return proof.StoredHeader{
+		Header: header,
+		Height: uint32(dbHeader.BlockHeight),
+	}, nil
+}
+
+// spvHeaderQuery is a query that fetches a single header of the SPV header
+// chain.
+type spvHeaderQuery func(q SpvHeaderStore) (sqlc.SpvHeader, error)
+
+// fetchHeader fetches a single header with the given query.
+func (s *SpvHeaderDB) fetchHeader(ctx context.Context,
+	query spvHeaderQuery) (*proof.StoredHeader, error) {
+
+	var dbHeader sqlc.SpvHeader
+	readTx := NewSpvHeaderReadTx()
+	dbErr := s.db.ExecTx(ctx, &readTx, func(q SpvHeaderStore) error {
+		var err error
+		dbHeader, err = query(q)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, proof.ErrHeaderNotFound
+
+	case dbErr != nil:
+		return nil, fmt.Errorf("unable to fetch header: %w", dbErr)
+	}
+
+	header, err := parseSpvHeader(dbHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
+
+// FetchHeader returns the header at the given height.
+//
+// NOTE: This is part of the proof.HeaderChainStore interface.
+func (s *SpvHeaderDB) FetchHeader(ctx context.Context,
+	height uint32) (*proof.StoredHeader, error) {
+
+	return s.fetchHeader(ctx, func(q SpvHeaderStore) (sqlc.SpvHeader,
+		error) {
+
+		return q.FetchSpvHeader(ctx, int32(height))
+	})
+}
+
+// FetchHeaders returns the headers from the start height up to and including
+// the end height, in ascending order.
+//
+// NOTE: This is part of the proof.HeaderChainStore interface.
+func (s *SpvHeaderDB) FetchHeaders(ctx context.Context, startHeight,
+	endHeight uint32) ([]proof.StoredHeader, error) {
+
+	var dbHeaders []sqlc.SpvHeader
+	readTx := NewSpvHeaderReadTx()
+	dbErr := s.db.ExecTx(ctx, &readTx, func(q SpvHeaderStore) error {
+		var err error
+		dbHeaders, err = q.FetchSpvHeaders(ctx, FetchSpvHeadersParams{
+			StartHeight: int32(startHeight),
+			EndHeight:   int32(endHeight),
+		})
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to fetch headers: %w", dbErr)
+	}
+
+	headers := make([]proof.StoredHeader, 0, len(dbHeaders))
+	for _, dbHeader := range dbHeaders {
+		header, err := parseSpvHeader(dbHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		headers = append(headers, header)
+	}
+
+	return headers, nil
+}
+
+// FetchBestHeader returns the header with the greatest height.
+//
+// NOTE: This is part of the proof.HeaderChainStore interface.
+func (s *SpvHeaderDB) FetchBestHeader(
+	ctx context.Context) (*proof.StoredHeader, error) {
+
+	return s.fetchHeader(ctx, func(q SpvHeaderStore) (sqlc.SpvHeader,
+		error) {
+
+		return q.FetchBestSpvHeader(ctx)
+	})
+}
+
+// ConnectHeaders atomically replaces all headers at or above the height of the
+// first given header with the given headers. The headers must be in ascending
+// order without gaps, and the first one must link to the stored header at the
+// height below it, unless it is the genesis block.
+//
+// NOTE: This is part of the proof.HeaderChainStore interface.
+func (s *SpvHeaderDB) ConnectHeaders(ctx context.Context,
+	headers []proof.StoredHeader) error {
+
+	if len(headers) == 0 {
+		return nil
+	}
+
+	for i := 1; i < len(headers); i++ {
+		prev, header := headers[i-1], headers[i]
+		if header.Height != prev.Height+1 ||
+			header.Header.PrevBlock != prev.Header.BlockHash() {
+
+			return fmt.Errorf("%w: header at height %d doesn't "+
+				"link to previous header",
+				proof.ErrHeaderNotConnected, header.Height)
+		}
+	}
+
+	first := headers[0]
+	var writeTx SpvHeaderTxOptions
+	dbErr := s.db.ExecTx(ctx, &writeTx, func(q SpvHeaderStore) error {
+		// The headers must extend the stored chain right below them,
+		// which a concurrent writer could have replaced.
+		if first.Height > 0 {
+			dbPrev, err := q.FetchSpvHeader(
+				ctx, int32(first.Height-1),
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: no header at height %d",
+					proof.ErrHeaderNotConnected,
+					first.Height-1)
+			}
+			if err != nil {
+				return err
+			}
+
+			if !bytes.Equal(
+				dbPrev.BlockHash, first.Header.PrevBlock[:],
+			) {
+
+				return fmt.Errorf("%w: header at height %d "+
+					"doesn't link to stored header",
+					proof.ErrHeaderNotConnected,
+					first.Height)
+			}
+		}
+
+		err := q.DeleteSpvHeadersFrom(ctx, int32(first.Height))
+		if err != nil {
+			return err
+		}
+
+		for _, header := range headers {
+			var headerBuf bytes.Buffer
+			err := header.Header.Serialize(&headerBuf)
+			if err != nil {
+				return fmt.Errorf("unable to encode header: %w",
+					err)
+			}
+
+			blockHash := header.Header.BlockHash()
+			err = q.InsertSpvHeader(ctx, InsertSpvHeaderParams{
+				BlockHash:   blockHash[:],
+				BlockHeight: int32(header.Height),
+				Header:      headerBuf.Bytes(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to connect headers: %w", dbErr)
+	}
+
+	return nil
+}
+
+// A compile-time assertion to ensure SpvHeaderDB meets the
+// proof.HeaderChainStore interface.
+var _ proof.HeaderChainStore = (*SpvHeaderDB)(nil)
@@ -0,0 +1,106 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// randHeaderChain creates a chain of random headers that starts at the given
+// height and links to the given previous block.
+func randHeaderChain(prevBlock chainhash.Hash, height uint32,
+	num int) []proof.StoredHeader {
+
+	headers := make([]proof.StoredHeader, 0, num)
+	for i := 0; i < num; i++ {
+		header := proof.StoredHeader{
+			Header: wire.BlockHeader{
+				Version:    1,
+				PrevBlock:  prevBlock,
+				MerkleRoot: test.RandHash(),
+				Timestamp: time.Unix(
+					test.RandInt[int64]()%1e9, 0,
+				),
+				Bits:  test.RandInt[uint32](),
+				Nonce: test.RandInt[uint32](),
+			},
+			Height: height + uint32(i),
+		}
+		headers = append(headers, header)
+		prevBlock = header.Header.BlockHash()
+	}
+
+	return headers
+}
+
+// TestSpvHeaderDB tests that the contiguous headers of the SPV header chain
+// can be stored, fetched and replaced by another branch.
+func TestSpvHeaderDB(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	dbTxer := NewTransactionExecutor(
+		db, func(tx *sql.Tx) SpvHeaderStore {
+			return db.WithTx(tx)
+		},
+	)
+	store := NewSpvHeaderDB(dbTxer)
+	ctx := context.Background()
+
+	_, err := store.FetchBestHeader(ctx)
+	require.ErrorIs(t, err, proof.ErrHeaderNotFound)
+
+	// The chain can only start with a header at height zero.
+	chain := randHeaderChain(test.RandHash(), 0, 10)
+	err = store.ConnectHeaders(ctx, chain[1:])
+	require.ErrorIs(t, err, proof.ErrHeaderNotConnected)
+
+	require.NoError(t, store.ConnectHeaders(ctx, chain[:5]))
+	require.NoError(t, store.ConnectHeaders(ctx, chain[5:]))
+
+	fetched, err := store.FetchHeader(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, chain[3], *fetched)
+
+	_, err = store.FetchHeader(ctx, 10)
+	require.ErrorIs(t, err, proof.ErrHeaderNotFound)
+
+	fetched, err = store.FetchBestHeader(ctx)
+	require.NoError(t, err)
+	require.Equal(t, chain[9], *fetched)
+
+	headers, err := store.FetchHeaders(ctx, 2, 20)
+	require.NoError(t, err)
+	require.Equal(t, chain[2:], headers)
+
+	// Headers that don't link to the stored chain, or to each other, are
+	// refused.
+	err = store.ConnectHeaders(ctx, randHeaderChain(test.RandHash(), 10, 2))
+	require.ErrorIs(t, err, proof.ErrHeaderNotConnected)
+
+	unlinked := randHeaderChain(chain[9].Header.BlockHash(), 10, 2)
+	unlinked[1].Header.PrevBlock = test.RandHash()
+	err = store.ConnectHeaders(ctx, unlinked)
+	require.ErrorIs(t, err, proof.ErrHeaderNotConnected)
+
+	err = store.ConnectHeaders(
+		ctx, randHeaderChain(chain[9].Header.BlockHash(), 12, 2),
+	)
+	require.ErrorIs(t, err, proof.ErrHeaderNotConnected)
+
+	// A branch replaces all headers above its fork point, even if it's
+	// shorter.
+	branch := randHeaderChain(chain[5].Header.BlockHash(), 6, 2)
+	require.NoError(t, store.ConnectHeaders(ctx, branch))
+
+	headers, err = store.FetchHeaders(ctx, 0, 20)
+	require.NoError(t, err)
+	require.Equal(t, append(chain[:6:6], branch...), headers)
+}
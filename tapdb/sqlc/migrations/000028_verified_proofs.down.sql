@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS verified_proofs_block_hash_idx;
+DROP TABLE IF EXISTS verified_proofs;
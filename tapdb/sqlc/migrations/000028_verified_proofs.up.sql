@@ -0,0 +1,23 @@
+-- verified_proofs is a cache of proofs that were already fully verified as
+-- part of a proof file, so their state transitions don't need to be verified
+-- again when we see the same proof file prefix in a later transfer.
+CREATE TABLE IF NOT EXISTS verified_proofs (
+    -- The chained hash of the proof within its proof file, which commits to
+    -- the proof and all proofs that precede it in the file.
+    proof_hash BLOB PRIMARY KEY CHECK(length(proof_hash) = 32),
+
+    -- The hash of the block the proof's anchor transaction was confirmed in.
+    -- This is used to invalidate cached proofs if the block is re-organized
+    -- out of the chain.
+    block_hash BLOB NOT NULL CHECK(length(block_hash) = 32),
+
+    -- The height of the block the proof's anchor transaction was confirmed
+    -- in.
+    block_height INTEGER NOT NULL,
+
+    -- The time the proof was verified.
+    verified_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS verified_proofs_block_hash_idx
+    ON verified_proofs(block_hash);
@@ -0,0 +1,13 @@
+-- spv_headers holds the block headers of the SPV header chain that is used to
+-- verify proofs without a chain backend. The headers are taken from the
+-- proofs that were verified, so the chain they represent usually has gaps.
+CREATE TABLE IF NOT EXISTS spv_headers (
+    -- The hash of the block header.
+    block_hash BLOB PRIMARY KEY CHECK(length(block_hash) = 32),
+
+    -- The height of the block. There can only be a single header per height.
+    block_height INTEGER NOT NULL UNIQUE,
+
+    -- The serialized block header.
+    header BLOB NOT NULL CHECK(length(header) = 80)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS universe_server_failures_server_id;
+
+DROP TABLE IF EXISTS universe_server_failures;
+
+ALTER TABLE universe_servers DROP COLUMN reputation_score;
+
+ALTER TABLE universe_servers DROP COLUMN identity_key;
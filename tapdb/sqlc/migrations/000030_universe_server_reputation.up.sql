@@ -0,0 +1,27 @@
+-- The identity key is the node key a universe server must authenticate with.
+-- It is pinned the first time we connect to the server, if it wasn't given
+-- when adding the server.
+ALTER TABLE universe_servers
+ADD COLUMN identity_key BLOB CHECK(length(identity_key) = 33);
+
+-- The reputation score of a universe server is lowered each time the server
+-- serves invalid proofs, and raised with each successful sync. Servers with a
+-- score below the configured threshold are banned from syncing.
+ALTER TABLE universe_servers
+ADD COLUMN reputation_score INTEGER NOT NULL DEFAULT 100;
+
+-- universe_server_failures holds the history of the failures that lowered the
+-- reputation of a universe server.
+CREATE TABLE IF NOT EXISTS universe_server_failures (
+    id INTEGER PRIMARY KEY,
+
+    server_id BIGINT NOT NULL REFERENCES universe_servers(id)
+        ON DELETE CASCADE,
+
+    failure_time TIMESTAMP NOT NULL,
+
+    reason TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_server_failures_server_id
+ON universe_server_failures(server_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS universe_commitment_leaves;
+
+DROP TABLE IF EXISTS universe_commitments;
@@ -0,0 +1,62 @@
+-- universe_commitments holds the commitments to the multiverse roots that were
+-- anchored in the chain. The anchor output key is the internal key tweaked
+-- with the branch of the issuance and transfer multiverse roots.
+CREATE TABLE IF NOT EXISTS universe_commitments (
+    id INTEGER PRIMARY KEY,
+
+    -- The hash of the anchor transaction.
+    txid BLOB NOT NULL UNIQUE CHECK(length(txid) = 32),
+
+    -- The serialized anchor transaction.
+    anchor_tx BLOB NOT NULL,
+
+    -- The index of the anchor output that commits to the multiverse roots.
+    output_index INTEGER NOT NULL,
+
+    internal_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    issuance_root_hash BLOB NOT NULL CHECK(length(issuance_root_hash) = 32),
+
+    issuance_root_sum BIGINT NOT NULL,
+
+    transfer_root_hash BLOB NOT NULL CHECK(length(transfer_root_hash) = 32),
+
+    transfer_root_sum BIGINT NOT NULL,
+
+    -- The height of the chain when the anchor transaction was created.
+    height_hint INTEGER NOT NULL,
+
+    -- The fields below are only set once the anchor transaction confirmed.
+    block_height INTEGER,
+
+    block_header BLOB CHECK(length(block_header) = 80),
+
+    merkle_proof BLOB,
+
+    created_at TIMESTAMP NOT NULL
+);
+
+-- universe_commitment_leaves holds a snapshot of the multiverse leaves that a
+-- commitment commits to. They're needed to create the inclusion proofs of a
+-- universe root in a committed multiverse root, as the multiverse trees only
+-- keep their latest state.
+CREATE TABLE IF NOT EXISTS universe_commitment_leaves (
+    commitment_id BIGINT NOT NULL REFERENCES universe_commitments(id)
+        ON DELETE CASCADE,
+
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- The key of the leaf in the multiverse tree, which is the hash of the
+    -- universe identifier.
+    leaf_key BLOB NOT NULL CHECK(length(leaf_key) = 32),
+
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    group_key BLOB CHECK(length(group_key) = 32),
+
+    universe_root_hash BLOB NOT NULL CHECK(length(universe_root_hash) = 32),
+
+    universe_root_sum BIGINT NOT NULL,
+
+    UNIQUE(commitment_id, proof_type, leaf_key)
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS universe_equivocations_detected_at_idx;
+
+DROP TABLE IF EXISTS universe_equivocations;
+
+DROP TABLE IF EXISTS universe_signed_roots;
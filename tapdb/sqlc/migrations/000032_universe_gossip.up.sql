@@ -0,0 +1,70 @@
+-- universe_signed_roots holds the signed snapshots of the multiverse roots of
+-- the universe servers, including the local one. Each server signs a single
+-- snapshot per epoch.
+CREATE TABLE IF NOT EXISTS universe_signed_roots (
+    id INTEGER PRIMARY KEY,
+
+    -- The node key of the universe server that signed the roots.
+    server_key BLOB NOT NULL CHECK(length(server_key) = 33),
+
+    epoch BIGINT NOT NULL,
+
+    issuance_root_hash BLOB NOT NULL CHECK(length(issuance_root_hash) = 32),
+
+    issuance_root_sum BIGINT NOT NULL,
+
+    transfer_root_hash BLOB NOT NULL CHECK(length(transfer_root_hash) = 32),
+
+    transfer_root_sum BIGINT NOT NULL,
+
+    signature BLOB NOT NULL,
+
+    received_at TIMESTAMP NOT NULL,
+
+    UNIQUE(server_key, epoch)
+);
+
+-- universe_equivocations holds the evidence of universe servers equivocating,
+-- which are two conflicting snapshots of the multiverse roots a server signed
+-- for the same epoch. The first snapshot is the one that was known first.
+CREATE TABLE IF NOT EXISTS universe_equivocations (
+    id INTEGER PRIMARY KEY,
+
+    server_key BLOB NOT NULL CHECK(length(server_key) = 33),
+
+    epoch BIGINT NOT NULL,
+
+    first_issuance_root_hash BLOB NOT NULL
+        CHECK(length(first_issuance_root_hash) = 32),
+
+    first_issuance_root_sum BIGINT NOT NULL,
+
+    first_transfer_root_hash BLOB NOT NULL
+        CHECK(length(first_transfer_root_hash) = 32),
+
+    first_transfer_root_sum BIGINT NOT NULL,
+
+    first_signature BLOB NOT NULL,
+
+    second_issuance_root_hash BLOB NOT NULL
+        CHECK(length(second_issuance_root_hash) = 32),
+
+    second_issuance_root_sum BIGINT NOT NULL,
+
+    second_transfer_root_hash BLOB NOT NULL
+        CHECK(length(second_transfer_root_hash) = 32),
+
+    second_transfer_root_sum BIGINT NOT NULL,
+
+    second_signature BLOB NOT NULL,
+
+    -- The host of the universe server the second snapshot was received from.
+    reporter TEXT NOT NULL,
+
+    detected_at TIMESTAMP NOT NULL,
+
+    UNIQUE(server_key, epoch)
+);
+
+CREATE INDEX IF NOT EXISTS universe_equivocations_detected_at_idx
+ON universe_equivocations(detected_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS universe_leaves_namespace_block_height_idx;
+
+ALTER TABLE universe_leaves DROP COLUMN block_height;
@@ -0,0 +1,8 @@
+-- The block height of the anchor transaction of the proof of a universe leaf.
+-- This is used to prune old leaves according to the universe retention
+-- policy. Leaves that were inserted before this column was added have no block
+-- height until the pruner backfills it from their proof.
+ALTER TABLE universe_leaves ADD COLUMN block_height INTEGER;
+
+CREATE INDEX IF NOT EXISTS universe_leaves_namespace_block_height_idx
+ON universe_leaves (leaf_node_namespace, block_height);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS universe_asset_search_text_idx;
+
+DROP INDEX IF EXISTS universe_asset_search_group_key_idx;
+
+DROP TABLE IF EXISTS universe_asset_search;
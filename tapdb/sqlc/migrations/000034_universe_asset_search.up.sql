@@ -0,0 +1,40 @@
+-- universe_asset_search is the search index over the assets of the issuance
+-- universes. Each row holds the searchable fields of an asset, which are
+-- extracted from its genesis and its revealed meta data.
+CREATE TABLE IF NOT EXISTS universe_asset_search (
+    id INTEGER PRIMARY KEY,
+
+    -- The ID of the indexed asset.
+    asset_id BLOB UNIQUE NOT NULL CHECK(length(asset_id) = 32),
+
+    -- The name (tag) of the asset.
+    asset_name TEXT NOT NULL,
+
+    -- The type of the asset.
+    asset_type SMALLINT NOT NULL,
+
+    -- The compressed tweaked group key of the asset, if it has one.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The decimal display of the asset, if its meta data is JSON and
+    -- specifies one.
+    decimal_display INTEGER,
+
+    -- The revealed meta data of the asset, if it is JSON.
+    meta_json TEXT,
+
+    -- The block height of the genesis transaction of the asset.
+    genesis_height INTEGER,
+
+    -- The normalized text that is matched against search queries. It
+    -- consists of the lower case words of the asset name and the keys and
+    -- values of the meta JSON, separated by spaces.
+    search_text TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_asset_search_group_key_idx
+ON universe_asset_search (group_key);
+
+-- The full-text index over the search text. This statement is replaced with
+-- an FTS5 virtual table and the triggers that keep it up to date on sqlite.
+CREATE INDEX IF NOT EXISTS universe_asset_search_text_idx ON universe_asset_search USING GIN (to_tsvector('simple', search_text));
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS rfq_quote_htlcs_circuit_key_idx;
+
+DROP TABLE IF EXISTS rfq_quote_htlcs;
+
+DROP INDEX IF EXISTS rfq_quotes_created_at_idx;
+
+DROP INDEX IF EXISTS rfq_quotes_expiry_idx;
+
+DROP TABLE IF EXISTS rfq_quotes;
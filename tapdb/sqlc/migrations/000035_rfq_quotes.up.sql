@@ -0,0 +1,69 @@
+-- rfq_quotes holds the RFQ quotes that were accepted either by our node or by
+-- one of our peers. The quotes are kept beyond their expiry so their history
+-- can be queried, until they are pruned.
+CREATE TABLE IF NOT EXISTS rfq_quotes (
+    id INTEGER PRIMARY KEY,
+
+    -- The ID of the quote request the quote was accepted for.
+    quote_id BLOB UNIQUE NOT NULL CHECK(length(quote_id) = 32),
+
+    -- The SCID alias derived from the quote ID. The uint64 value is stored
+    -- as a signed integer, which means it can be negative.
+    scid BIGINT NOT NULL,
+
+    -- The type of the quote, which is 0 for a buy and 1 for a sell quote.
+    quote_type SMALLINT NOT NULL CHECK(quote_type IN (0, 1)),
+
+    -- Whether the quote was accepted by our node, as opposed to our peer.
+    local_accept BOOLEAN NOT NULL,
+
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    request_version SMALLINT NOT NULL,
+
+    accept_version SMALLINT NOT NULL,
+
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The maximum asset amount of a buy quote, or the maximum payment
+    -- amount in milli-satoshis of a sell quote.
+    max_amount BIGINT NOT NULL,
+
+    rate_coefficient BLOB NOT NULL,
+
+    rate_scale SMALLINT NOT NULL,
+
+    expiry TIMESTAMP NOT NULL,
+
+    -- The base SCID of the channel the SCID alias of the quote was added
+    -- for. This is NULL if no alias was added or it was already removed.
+    base_scid BIGINT,
+
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rfq_quotes_expiry_idx ON rfq_quotes(expiry);
+
+CREATE INDEX IF NOT EXISTS rfq_quotes_created_at_idx
+    ON rfq_quotes(created_at);
+
+-- rfq_quote_htlcs holds the HTLCs that were accepted under the policy of a
+-- quote and still count towards its maximum amount.
+CREATE TABLE IF NOT EXISTS rfq_quote_htlcs (
+    quote_id BIGINT NOT NULL REFERENCES rfq_quotes(id) ON DELETE CASCADE,
+
+    -- The incoming channel ID and HTLC ID, which together form the circuit
+    -- key of the HTLC.
+    chan_id BIGINT NOT NULL,
+
+    htlc_id BIGINT NOT NULL,
+
+    amount_msat BIGINT NOT NULL,
+
+    PRIMARY KEY(quote_id, chan_id, htlc_id)
+);
+
+CREATE INDEX IF NOT EXISTS rfq_quote_htlcs_circuit_key_idx
+    ON rfq_quote_htlcs(chan_id, htlc_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS universe_commitment_leaves_removed_idx;
+
+ALTER TABLE universe_commitment_leaves DROP COLUMN removed_commitment_id;
@@ -0,0 +1,17 @@
+-- Commitments only store the multiverse leaves that changed since the
+-- previous commitment instead of a full copy of them. A leaf row is part of
+-- the snapshot of every commitment starting with the one that added it, up to
+-- but excluding the one that removed it, if any.
+ALTER TABLE universe_commitment_leaves ADD COLUMN removed_commitment_id BIGINT;
+
+-- Each commitment used to store a full copy of the multiverse leaves, so the
+-- leaves of a commitment are removed by the next commitment that has leaves.
+UPDATE universe_commitment_leaves
+SET removed_commitment_id = (
+    SELECT MIN(newer.commitment_id)
+    FROM universe_commitment_leaves newer
+    WHERE newer.commitment_id > universe_commitment_leaves.commitment_id
+);
+
+CREATE INDEX IF NOT EXISTS universe_commitment_leaves_removed_idx
+ON universe_commitment_leaves (removed_commitment_id);
@@ -0,0 +1,2 @@
+-- This file is empty on purpose. There is nothing to roll back for this
+-- migration.
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- The SPV header chain used to be built from the headers of verified proofs,
+-- so it usually had gaps. It is now a contiguous chain starting at the genesis
+-- block that is synced from bitcoin nodes, which the stored headers can't be
+-- part of. The chain is synced again on the next startup.
+DELETE FROM spv_headers;
@@ -0,0 +1,5 @@
+-- Universe commitments form a single chain where each commitment spends the
+-- output of the previous one. The genesis outpoint is the commitment output
+-- that started the chain. Commitments created before the chain was introduced
+-- have no genesis outpoint and are each the genesis of their own chain.
+ALTER TABLE universe_commitments ADD COLUMN genesis_outpoint BLOB;
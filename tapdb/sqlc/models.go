@@ -319,6 +319,32 @@ type ProofTransferLog struct {
 	TimeUnix         time.Time
 }
 
+type RfqQuote struct {
+	ID              int64
+	QuoteID         []byte
+	Scid            int64
+	QuoteType       int16
+	LocalAccept     bool
+	Peer            []byte
+	RequestVersion  int16
+	AcceptVersion   int16
+	AssetID         []byte
+	GroupKey        []byte
+	MaxAmount       int64
+	RateCoefficient []byte
+	RateScale       int16
+	Expiry          time.Time
+	BaseScid        sql.NullInt64
+	CreatedAt       time.Time
+}
+
+type RfqQuoteHtlc struct {
+	QuoteID    int64
+	ChanID     int64
+	HtlcID     int64
+	AmountMsat int64
+}
+
 type ScriptKey struct {
 	ScriptKeyID      int64
 	InternalKeyID    int64
@@ -327,6 +353,12 @@ type ScriptKey struct {
 	DeclaredKnown    sql.NullBool
 }
 
+type SpvHeader struct {
+	BlockHash   []byte
+	BlockHeight int32
+	Header      []byte
+}
+
 type TapscriptEdge struct {
 	EdgeID     int64
 	RootHashID int64
@@ -345,6 +377,65 @@ type TapscriptRoot struct {
 	BranchOnly bool
 }
 
+type UniverseAssetSearch struct {
+	ID             int64
+	AssetID        []byte
+	AssetName      string
+	AssetType      int16
+	GroupKey       []byte
+	DecimalDisplay sql.NullInt32
+	MetaJson       sql.NullString
+	GenesisHeight  sql.NullInt32
+	SearchText     string
+}
+
+type UniverseCommitment struct {
+	ID               int64
+	Txid             []byte
+	AnchorTx         []byte
+	OutputIndex      int32
+	InternalKeyID    int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	HeightHint       int32
+	BlockHeight      sql.NullInt32
+	BlockHeader      []byte
+	MerkleProof      []byte
+	CreatedAt        time.Time
+	GenesisOutpoint  []byte
+}
+
+type UniverseCommitmentLeafe struct {
+	CommitmentID        int64
+	ProofType           string
+	LeafKey             []byte
+	AssetID             []byte
+	GroupKey            []byte
+	UniverseRootHash    []byte
+	UniverseRootSum     int64
+	RemovedCommitmentID sql.NullInt64
+}
+
+type UniverseEquivocation struct {
+	ID                     int64
+	ServerKey              []byte
+	Epoch                  int64
+	FirstIssuanceRootHash  []byte
+	FirstIssuanceRootSum   int64
+	FirstTransferRootHash  []byte
+	FirstTransferRootSum   int64
+	FirstSignature         []byte
+	SecondIssuanceRootHash []byte
+	SecondIssuanceRootSum  int64
+	SecondTransferRootHash []byte
+	SecondTransferRootSum  int64
+	SecondSignature        []byte
+	Reporter               string
+	DetectedAt             time.Time
+}
+
 type UniverseEvent struct {
 	EventID        int64
 	EventType      string
@@ -361,6 +452,7 @@ type UniverseLeafe struct {
 	UniverseRootID    int64
 	LeafNodeKey       []byte
 	LeafNodeNamespace string
+	BlockHeight       sql.NullInt32
 }
 
 type UniverseRoot struct {
@@ -372,9 +464,30 @@ type UniverseRoot struct {
 }
 
 type UniverseServer struct {
-	ID           int64
-	ServerHost   string
-	LastSyncTime time.Time
+	ID              int64
+	ServerHost      string
+	LastSyncTime    time.Time
+	IdentityKey     []byte
+	ReputationScore int32
+}
+
+type UniverseServerFailure struct {
+	ID          int64
+	ServerID    int64
+	FailureTime time.Time
+	Reason      string
+}
+
+type UniverseSignedRoot struct {
+	ID               int64
+	ServerKey        []byte
+	Epoch            int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	Signature        []byte
+	ReceivedAt       time.Time
 }
 
 type UniverseStat struct {
@@ -384,3 +497,10 @@ type UniverseStat struct {
 	GroupKey         []byte
 	ProofType        string
 }
+
+type VerifiedProof struct {
+	ProofHash   []byte
+	BlockHash   []byte
+	BlockHeight int32
+	VerifiedAt  time.Time
+}
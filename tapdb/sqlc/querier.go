@@ -23,24 +23,39 @@ type Querier interface {
 	AssetsInBatch(ctx context.Context, rawKey []byte) ([]AssetsInBatchRow, error)
 	BindMintingBatchWithTapSibling(ctx context.Context, arg BindMintingBatchWithTapSiblingParams) error
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error
+	ClearRfqQuoteBaseScid(ctx context.Context, scid int64) error
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	ConfirmUniverseCommitment(ctx context.Context, arg ConfirmUniverseCommitmentParams) (int64, error)
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
+	DeleteExpiredRfqQuotes(ctx context.Context, expiredBefore time.Time) (int64, error)
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
 	DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFederationProofSyncLogParams) error
+	DeleteLeafProofSyncLogs(ctx context.Context, proofLeafID int64) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteMultiverseLeaf(ctx context.Context, arg DeleteMultiverseLeafParams) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeleteMultiverseRoot(ctx context.Context, namespaceRoot string) error
+	DeleteOldestNewProofEvents(ctx context.Context, arg DeleteOldestNewProofEventsParams) error
+	DeletePendingUniverseCommitments(ctx context.Context, firstAbandonedID int64) (int64, error)
+	DeleteRfqQuoteHtlc(ctx context.Context, arg DeleteRfqQuoteHtlcParams) error
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
+	DeleteSpvHeadersFrom(ctx context.Context, blockHeight int32) error
+	DeleteStaleUniverseCommitmentLeaves(ctx context.Context, latestConfirmedID sql.NullInt64) error
 	DeleteTapscriptTreeEdges(ctx context.Context, rootHash []byte) error
 	DeleteTapscriptTreeNodes(ctx context.Context) error
 	DeleteTapscriptTreeRoot(ctx context.Context, rootHash []byte) error
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
+	DeleteUniverseAssetSearchEntries(ctx context.Context, namespace string) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
+	DeleteUniverseLeaf(ctx context.Context, id int64) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
+	DeleteUniverseProofSyncLogs(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseServer(ctx context.Context, arg DeleteUniverseServerParams) error
+	DeleteVerifiedProofsByBlock(ctx context.Context, blockHash []byte) (int64, error)
+	FetchActiveRfqQuotes(ctx context.Context, now time.Time) ([]RfqQuote, error)
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
 	FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error)
 	FetchAddrEventByAddrKeyAndOutpoint(ctx context.Context, arg FetchAddrEventByAddrKeyAndOutpointParams) (FetchAddrEventByAddrKeyAndOutpointRow, error)
@@ -61,9 +76,11 @@ type Querier interface {
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]FetchAssetsForBatchRow, error)
+	FetchBestSpvHeader(ctx context.Context) (SpvHeader, error)
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
+	FetchExpiredRfqQuoteAliases(ctx context.Context, now time.Time) ([]FetchExpiredRfqQuoteAliasesRow, error)
 	FetchGenesisByAssetID(ctx context.Context, assetID []byte) (GenesisInfoView, error)
 	FetchGenesisByID(ctx context.Context, genAssetID int64) (FetchGenesisByIDRow, error)
 	FetchGenesisID(ctx context.Context, arg FetchGenesisIDParams) (int64, error)
@@ -73,23 +90,35 @@ type Querier interface {
 	FetchGroupByGroupKey(ctx context.Context, groupKey []byte) (FetchGroupByGroupKeyRow, error)
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
 	FetchInternalKeyLocator(ctx context.Context, rawKey []byte) (FetchInternalKeyLocatorRow, error)
+	FetchLatestUniverseLeafID(ctx context.Context) (int64, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
 	FetchMultiverseRoot(ctx context.Context, namespaceRoot string) (FetchMultiverseRootRow, error)
+	FetchRfqQuoteHtlcs(ctx context.Context, quoteID int64) ([]FetchRfqQuoteHtlcsRow, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
 	FetchSeedlingByID(ctx context.Context, seedlingID int64) (AssetSeedling, error)
 	FetchSeedlingID(ctx context.Context, arg FetchSeedlingIDParams) (int64, error)
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
+	FetchSignedMultiverseRoot(ctx context.Context, arg FetchSignedMultiverseRootParams) (FetchSignedMultiverseRootRow, error)
+	FetchSpvHeader(ctx context.Context, blockHeight int32) (SpvHeader, error)
+	FetchSpvHeaders(ctx context.Context, arg FetchSpvHeadersParams) ([]SpvHeader, error)
 	// Sort the nodes by node_index here instead of returning the indices.
 	FetchTapscriptTree(ctx context.Context, rootHash []byte) ([]FetchTapscriptTreeRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
+	FetchUnindexedIssuanceAssets(ctx context.Context, numLimit int32) ([]FetchUnindexedIssuanceAssetsRow, error)
+	FetchUniverseCommitmentID(ctx context.Context, txid []byte) (int64, error)
 	FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error)
+	FetchUniverseLeafProofs(ctx context.Context, arg FetchUniverseLeafProofsParams) ([]FetchUniverseLeafProofsRow, error)
+	FetchUniverseLeafSizes(ctx context.Context, namespace string) ([]FetchUniverseLeafSizesRow, error)
+	FetchUniverseLeavesAfter(ctx context.Context, arg FetchUniverseLeavesAfterParams) ([]FetchUniverseLeavesAfterRow, error)
+	FetchUniverseLeavesWithoutHeight(ctx context.Context, arg FetchUniverseLeavesWithoutHeightParams) ([]FetchUniverseLeavesWithoutHeightRow, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
+	FetchVerifiedProof(ctx context.Context, proofHash []byte) (VerifiedProof, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
@@ -107,8 +136,16 @@ type Querier interface {
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertRfqQuote(ctx context.Context, arg InsertRfqQuoteParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertSpvHeader(ctx context.Context, arg InsertSpvHeaderParams) error
+	InsertSignedMultiverseRoot(ctx context.Context, arg InsertSignedMultiverseRootParams) error
+	InsertUniverseCommitment(ctx context.Context, arg InsertUniverseCommitmentParams) (int64, error)
+	InsertUniverseCommitmentLeaf(ctx context.Context, arg InsertUniverseCommitmentLeafParams) error
+	InsertUniverseEquivocation(ctx context.Context, arg InsertUniverseEquivocationParams) (int64, error)
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
+	InsertUniverseServerFailure(ctx context.Context, arg InsertUniverseServerFailureParams) error
+	InsertVerifiedProof(ctx context.Context, arg InsertVerifiedProofParams) error
 	LogProofTransferAttempt(ctx context.Context, arg LogProofTransferAttemptParams) error
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
@@ -138,16 +175,26 @@ type Querier interface {
 	// Join on genesis_info_view to get leaf related fields.
 	QueryFederationProofSyncLog(ctx context.Context, arg QueryFederationProofSyncLogParams) ([]QueryFederationProofSyncLogRow, error)
 	QueryFederationUniSyncConfigs(ctx context.Context) ([]FederationUniSyncConfig, error)
+	QueryLatestUniverseCommitmentLeaves(ctx context.Context) ([]QueryLatestUniverseCommitmentLeavesRow, error)
 	QueryMultiverseLeaves(ctx context.Context, arg QueryMultiverseLeavesParams) ([]QueryMultiverseLeavesRow, error)
 	QueryPassiveAssets(ctx context.Context, transferID int64) ([]QueryPassiveAssetsRow, error)
 	QueryProofTransferAttempts(ctx context.Context, arg QueryProofTransferAttemptsParams) ([]time.Time, error)
+	QueryRfqQuotes(ctx context.Context, arg QueryRfqQuotesParams) ([]RfqQuote, error)
+	QuerySignedMultiverseRoots(ctx context.Context, minEpoch int64) ([]QuerySignedMultiverseRootsRow, error)
 	// TODO(roasbeef): use the universe id instead for the grouping? so namespace
 	// root, simplifies queries
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
+	QueryUniverseCommitmentLeaves(ctx context.Context, commitmentID int64) ([]QueryUniverseCommitmentLeavesRow, error)
+	QueryUniverseCommitments(ctx context.Context, arg QueryUniverseCommitmentsParams) ([]QueryUniverseCommitmentsRow, error)
+	QueryUniverseEquivocations(ctx context.Context, arg QueryUniverseEquivocationsParams) ([]UniverseEquivocation, error)
 	QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error)
+	QueryUniverseServerFailures(ctx context.Context, arg QueryUniverseServerFailuresParams) ([]QueryUniverseServerFailuresRow, error)
 	QueryUniverseServers(ctx context.Context, arg QueryUniverseServersParams) ([]UniverseServer, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	RemoveUniverseCommitmentLeaf(ctx context.Context, arg RemoveUniverseCommitmentLeafParams) error
+	ReopenUniverseCommitmentLeaves(ctx context.Context, firstAbandonedID sql.NullInt64) error
+	ReplaceUniverseCommitmentTx(ctx context.Context, arg ReplaceUniverseCommitmentTxParams) (int64, error)
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
 	SetTransferOutputProofDeliveryStatus(ctx context.Context, arg SetTransferOutputProofDeliveryStatusParams) error
@@ -156,12 +203,15 @@ type Querier interface {
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
+	UpdateUniverseLeafBlockHeight(ctx context.Context, arg UpdateUniverseLeafBlockHeightParams) error
+	UpdateUniverseServerReputation(ctx context.Context, arg UpdateUniverseServerReputationParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error)
 	UpsertAsset(ctx context.Context, arg UpsertAssetParams) (int64, error)
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int64, error)
 	UpsertAssetGroupWitness(ctx context.Context, arg UpsertAssetGroupWitnessParams) (int64, error)
 	UpsertAssetMeta(ctx context.Context, arg UpsertAssetMetaParams) (int64, error)
 	UpsertAssetProofByID(ctx context.Context, arg UpsertAssetProofByIDParams) error
+	UpsertAssetSearchEntry(ctx context.Context, arg UpsertAssetSearchEntryParams) error
 	UpsertAssetWitness(ctx context.Context, arg UpsertAssetWitnessParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int64, error)
 	UpsertFederationGlobalSyncConfig(ctx context.Context, arg UpsertFederationGlobalSyncConfigParams) error
@@ -173,6 +223,7 @@ type Querier interface {
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int64, error)
 	UpsertMultiverseLeaf(ctx context.Context, arg UpsertMultiverseLeafParams) (int64, error)
 	UpsertMultiverseRoot(ctx context.Context, arg UpsertMultiverseRootParams) (int64, error)
+	UpsertRfqQuoteHtlc(ctx context.Context, arg UpsertRfqQuoteHtlcParams) error
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int64, error)
 	UpsertTapscriptTreeEdge(ctx context.Context, arg UpsertTapscriptTreeEdgeParams) (int64, error)
@@ -0,0 +1,62 @@
+-- name: InsertRfqQuote :exec
+INSERT INTO rfq_quotes (
+    quote_id, scid, quote_type, local_accept, peer, request_version,
+    accept_version, asset_id, group_key, max_amount, rate_coefficient,
+    rate_scale, expiry, base_scid, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (quote_id) DO NOTHING;
+
+-- name: FetchActiveRfqQuotes :many
+SELECT id, quote_id, scid, quote_type, local_accept, peer, request_version,
+    accept_version, asset_id, group_key, max_amount, rate_coefficient,
+    rate_scale, expiry, base_scid, created_at
+FROM rfq_quotes
+WHERE expiry > @now
+ORDER BY id;
+
+-- name: QueryRfqQuotes :many
+SELECT id, quote_id, scid, quote_type, local_accept, peer, request_version,
+    accept_version, asset_id, group_key, max_amount, rate_coefficient,
+    rate_scale, expiry, base_scid, created_at
+FROM rfq_quotes
+WHERE (peer = sqlc.narg('peer') OR sqlc.narg('peer') IS NULL)
+    AND created_at >= @start_time
+    AND created_at <= @end_time
+ORDER BY created_at DESC, id DESC
+LIMIT @num_limit OFFSET @num_offset;
+
+-- name: FetchExpiredRfqQuoteAliases :many
+SELECT scid, base_scid
+FROM rfq_quotes
+WHERE expiry <= @now AND base_scid IS NOT NULL;
+
+-- name: ClearRfqQuoteBaseScid :exec
+UPDATE rfq_quotes
+SET base_scid = NULL
+WHERE scid = $1;
+
+-- name: DeleteExpiredRfqQuotes :execrows
+DELETE FROM rfq_quotes
+WHERE expiry < @expired_before AND base_scid IS NULL;
+
+-- name: UpsertRfqQuoteHtlc :exec
+INSERT INTO rfq_quote_htlcs (
+    quote_id, chan_id, htlc_id, amount_msat
+) VALUES (
+    (SELECT id FROM rfq_quotes WHERE rfq_quotes.quote_id = @quote_id),
+    @chan_id, @htlc_id, @amount_msat
+)
+ON CONFLICT (quote_id, chan_id, htlc_id)
+    DO UPDATE SET amount_msat = EXCLUDED.amount_msat;
+
+-- name: DeleteRfqQuoteHtlc :exec
+DELETE FROM rfq_quote_htlcs
+WHERE chan_id = @chan_id AND htlc_id = @htlc_id;
+
+-- name: FetchRfqQuoteHtlcs :many
+SELECT chan_id, htlc_id, amount_msat
+FROM rfq_quote_htlcs
+WHERE quote_id = $1
+ORDER BY chan_id, htlc_id;
@@ -0,0 +1,27 @@
+-- name: InsertSpvHeader :exec
+INSERT INTO spv_headers (
+    block_hash, block_height, header
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: FetchSpvHeader :one
+SELECT *
+FROM spv_headers
+WHERE block_height = $1;
+
+-- name: FetchSpvHeaders :many
+SELECT *
+FROM spv_headers
+WHERE block_height >= @start_height AND block_height <= @end_height
+ORDER BY block_height ASC;
+
+-- name: FetchBestSpvHeader :one
+SELECT *
+FROM spv_headers
+ORDER BY block_height DESC
+LIMIT 1;
+
+-- name: DeleteSpvHeadersFrom :exec
+DELETE FROM spv_headers
+WHERE block_height >= $1;
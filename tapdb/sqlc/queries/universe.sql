@@ -39,20 +39,76 @@ WHERE namespace_root = @namespace_root;
 -- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
-    leaf_node_namespace, minting_point
+    leaf_node_namespace, minting_point, block_height
 ) VALUES (
     @asset_genesis_id, @script_key_bytes, @universe_root_id, @leaf_node_key,
-    @leaf_node_namespace, @minting_point
+    @leaf_node_namespace, @minting_point, @block_height
 ) ON CONFLICT (minting_point, script_key_bytes)
     -- This is a NOP, minting_point and script_key_bytes are the unique fields
-    -- that caused the conflict.
+    -- that caused the conflict. The block height is updated to backfill it
+    -- for leaves that were inserted before it was stored.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes;
+                  script_key_bytes = EXCLUDED.script_key_bytes,
+                  block_height = EXCLUDED.block_height;
 
 -- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = @namespace;
 
+-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE id = @id;
+
+-- name: FetchUniverseLeafSizes :many
+SELECT leaves.id, leaves.leaf_node_key, leaves.block_height,
+       LENGTH(nodes.value) AS proof_size
+FROM universe_leaves AS leaves
+JOIN mssmt_nodes AS nodes
+    ON leaves.leaf_node_key = nodes.key
+       AND leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = @namespace
+ORDER BY leaves.block_height, leaves.id;
+
+-- name: FetchUniverseLeavesWithoutHeight :many
+SELECT leaves.id, nodes.value AS leaf_proof
+FROM universe_leaves AS leaves
+JOIN mssmt_nodes AS nodes
+    ON leaves.leaf_node_key = nodes.key
+       AND leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = @namespace
+    AND leaves.block_height IS NULL
+ORDER BY leaves.id
+LIMIT @num_limit;
+
+-- name: FetchUniverseLeafProofs :many
+SELECT leaves.id, nodes.value AS leaf_proof
+FROM universe_leaves AS leaves
+JOIN mssmt_nodes AS nodes
+    ON leaves.leaf_node_key = nodes.key
+       AND leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = @namespace
+    AND leaves.id > @after_id
+ORDER BY leaves.id
+LIMIT @num_limit;
+
+-- name: UpdateUniverseLeafBlockHeight :exec
+UPDATE universe_leaves
+SET block_height = @block_height
+WHERE id = @id;
+
+-- name: DeleteOldestNewProofEvents :exec
+DELETE FROM universe_events
+WHERE event_id IN (
+    SELECT events.event_id
+    FROM universe_events AS events
+    JOIN universe_roots AS roots
+        ON events.universe_root_id = roots.id
+    WHERE roots.namespace_root = @namespace_root
+        AND events.event_type = 'NEW_PROOF'
+    ORDER BY events.event_time, events.event_id
+    LIMIT @num_limit
+);
+
 -- name: QueryUniverseLeaves :many
 SELECT leaves.script_key_bytes, gen.gen_asset_id, nodes.value AS genesis_proof, 
        nodes.sum AS sum_amt, gen.asset_id
@@ -72,11 +128,39 @@ WHERE leaves.leaf_node_namespace = @namespace
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves AS leaves
 WHERE leaves.leaf_node_namespace = @namespace
+    AND (leaves.leaf_node_key >= sqlc.narg('min_leaf_node_key') OR
+         sqlc.narg('min_leaf_node_key') IS NULL)
+    AND (leaves.leaf_node_key <= sqlc.narg('max_leaf_node_key') OR
+         sqlc.narg('max_leaf_node_key') IS NULL)
 ORDER BY 
     CASE WHEN sqlc.narg('sort_direction') = 0 THEN leaves.id END ASC,
     CASE WHEN sqlc.narg('sort_direction') = 1 THEN leaves.id END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: FetchUniverseLeavesAfter :many
+SELECT leaves.id, leaves.minting_point, leaves.script_key_bytes,
+       roots.asset_id, roots.group_key, roots.proof_type
+FROM universe_leaves AS leaves
+JOIN universe_roots AS roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_assets AS gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE leaves.id > @after_id
+    AND (gen.asset_id = sqlc.narg('asset_id') OR
+         sqlc.narg('asset_id') IS NULL)
+    AND (roots.group_key = sqlc.narg('group_key') OR
+         sqlc.narg('group_key') IS NULL)
+    AND (roots.proof_type = sqlc.narg('proof_type') OR
+         sqlc.narg('proof_type') IS NULL)
+ORDER BY leaves.id ASC
+LIMIT @num_limit;
+
+-- name: FetchLatestUniverseLeafID :one
+SELECT id
+FROM universe_leaves
+ORDER BY id DESC
+LIMIT 1;
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
@@ -99,9 +183,9 @@ LIMIT @num_limit OFFSET @num_offset;
 
 -- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
-    server_host, last_sync_time
+    server_host, last_sync_time, identity_key
 ) VALUES (
-    @server_host, @last_sync_time
+    @server_host, @last_sync_time, @identity_key
 );
 
 -- name: DeleteUniverseServer :exec
@@ -119,6 +203,25 @@ WHERE (id = sqlc.narg('id') OR sqlc.narg('id') IS NULL) AND
       (server_host = sqlc.narg('server_host')
            OR sqlc.narg('server_host') IS NULL);
 
+-- name: UpdateUniverseServerReputation :exec
+UPDATE universe_servers
+SET reputation_score = @reputation_score
+WHERE id = @server_id;
+
+-- name: InsertUniverseServerFailure :exec
+INSERT INTO universe_server_failures (
+    server_id, failure_time, reason
+) VALUES (
+    @server_id, @failure_time, @reason
+);
+
+-- name: QueryUniverseServerFailures :many
+SELECT failure_time, reason
+FROM universe_server_failures
+WHERE server_id = @server_id
+ORDER BY failure_time DESC, id DESC
+LIMIT @num_limit;
+
 -- name: InsertNewSyncEvent :exec
 WITH group_key_root_id AS (
     SELECT id
@@ -462,6 +565,18 @@ WHERE
     (attempt_counter >= sqlc.narg('min_attempt_counter')
         OR sqlc.narg('min_attempt_counter') IS NULL);
 
+-- name: DeleteUniverseProofSyncLogs :exec
+DELETE FROM federation_proof_sync_log
+WHERE universe_root_id IN (
+    SELECT id
+    FROM universe_roots
+    WHERE namespace_root = @namespace_root
+);
+
+-- name: DeleteLeafProofSyncLogs :exec
+DELETE FROM federation_proof_sync_log
+WHERE proof_leaf_id = @proof_leaf_id;
+
 -- name: UpsertMultiverseRoot :one
 INSERT INTO multiverse_roots (namespace_root, proof_type)
 VALUES (@namespace_root, @proof_type)
@@ -497,6 +612,10 @@ RETURNING id;
 DELETE FROM multiverse_leaves
 WHERE leaf_node_namespace = @namespace AND leaf_node_key = @leaf_node_key;
 
+-- name: DeleteMultiverseRoot :exec
+DELETE FROM multiverse_roots
+WHERE namespace_root = @namespace_root;
+
 -- name: QueryMultiverseLeaves :many
 SELECT r.namespace_root, r.proof_type, l.asset_id, l.group_key, 
        smt_nodes.value AS universe_root_hash, smt_nodes.sum AS universe_root_sum
@@ -0,0 +1,80 @@
+-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    txid, anchor_tx, output_index, internal_key_id, issuance_root_hash,
+    issuance_root_sum, transfer_root_hash, transfer_root_sum, height_hint,
+    genesis_outpoint, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+RETURNING id;
+
+-- name: InsertUniverseCommitmentLeaf :exec
+INSERT INTO universe_commitment_leaves (
+    commitment_id, proof_type, leaf_key, asset_id, group_key,
+    universe_root_hash, universe_root_sum
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: ConfirmUniverseCommitment :one
+UPDATE universe_commitments
+SET block_height = $2, block_header = $3, merkle_proof = $4
+WHERE txid = $1
+RETURNING id;
+
+-- name: ReplaceUniverseCommitmentTx :execrows
+UPDATE universe_commitments
+SET txid = @new_txid, anchor_tx = @anchor_tx, output_index = @output_index,
+    height_hint = @height_hint
+WHERE txid = @old_txid AND block_height IS NULL;
+
+-- name: QueryUniverseCommitments :many
+SELECT commitments.id, commitments.txid, commitments.anchor_tx,
+    commitments.output_index, keys.raw_key, keys.key_family, keys.key_index,
+    commitments.issuance_root_hash, commitments.issuance_root_sum,
+    commitments.transfer_root_hash, commitments.transfer_root_sum,
+    commitments.height_hint, commitments.block_height,
+    commitments.block_header, commitments.merkle_proof,
+    commitments.genesis_outpoint
+FROM universe_commitments commitments
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+WHERE (commitments.block_height IS NOT NULL) = @confirmed
+ORDER BY commitments.id DESC
+LIMIT @num_limit;
+
+-- name: RemoveUniverseCommitmentLeaf :exec
+UPDATE universe_commitment_leaves
+SET removed_commitment_id = @removed_commitment_id
+WHERE removed_commitment_id IS NULL AND proof_type = @proof_type
+    AND leaf_key = @leaf_key;
+
+-- name: QueryLatestUniverseCommitmentLeaves :many
+SELECT proof_type, leaf_key, universe_root_hash, universe_root_sum
+FROM universe_commitment_leaves
+WHERE removed_commitment_id IS NULL;
+
+-- name: QueryUniverseCommitmentLeaves :many
+SELECT proof_type, asset_id, group_key, universe_root_hash, universe_root_sum
+FROM universe_commitment_leaves
+WHERE commitment_id <= @commitment_id AND (
+    removed_commitment_id IS NULL OR removed_commitment_id > @commitment_id
+);
+
+-- name: FetchUniverseCommitmentID :one
+SELECT id
+FROM universe_commitments
+WHERE txid = $1;
+
+-- name: DeleteStaleUniverseCommitmentLeaves :exec
+DELETE FROM universe_commitment_leaves
+WHERE removed_commitment_id <= @latest_confirmed_id;
+
+-- name: ReopenUniverseCommitmentLeaves :exec
+UPDATE universe_commitment_leaves
+SET removed_commitment_id = NULL
+WHERE removed_commitment_id >= @first_abandoned_id;
+
+-- name: DeletePendingUniverseCommitments :execrows
+DELETE FROM universe_commitments
+WHERE id >= @first_abandoned_id AND block_height IS NULL;
@@ -0,0 +1,44 @@
+-- name: InsertSignedMultiverseRoot :exec
+INSERT INTO universe_signed_roots (
+    server_key, epoch, issuance_root_hash, issuance_root_sum,
+    transfer_root_hash, transfer_root_sum, signature, received_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+ON CONFLICT (server_key, epoch) DO NOTHING;
+
+-- name: FetchSignedMultiverseRoot :one
+SELECT server_key, epoch, issuance_root_hash, issuance_root_sum,
+    transfer_root_hash, transfer_root_sum, signature
+FROM universe_signed_roots
+WHERE server_key = $1 AND epoch = $2;
+
+-- name: QuerySignedMultiverseRoots :many
+SELECT server_key, epoch, issuance_root_hash, issuance_root_sum,
+    transfer_root_hash, transfer_root_sum, signature
+FROM universe_signed_roots
+WHERE epoch >= @min_epoch
+ORDER BY epoch, id;
+
+-- name: InsertUniverseEquivocation :one
+INSERT INTO universe_equivocations (
+    server_key, epoch, first_issuance_root_hash, first_issuance_root_sum,
+    first_transfer_root_hash, first_transfer_root_sum, first_signature,
+    second_issuance_root_hash, second_issuance_root_sum,
+    second_transfer_root_hash, second_transfer_root_sum, second_signature,
+    reporter, detected_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+ON CONFLICT (server_key, epoch) DO NOTHING
+RETURNING id;
+
+-- name: QueryUniverseEquivocations :many
+SELECT id, server_key, epoch, first_issuance_root_hash,
+    first_issuance_root_sum, first_transfer_root_hash,
+    first_transfer_root_sum, first_signature, second_issuance_root_hash,
+    second_issuance_root_sum, second_transfer_root_hash,
+    second_transfer_root_sum, second_signature, reporter, detected_at
+FROM universe_equivocations
+WHERE detected_at >= @start_time AND detected_at <= @end_time
+ORDER BY detected_at, id;
@@ -0,0 +1,44 @@
+-- name: UpsertAssetSearchEntry :exec
+INSERT INTO universe_asset_search (
+    asset_id, asset_name, asset_type, group_key, decimal_display, meta_json,
+    genesis_height, search_text
+) VALUES (
+    @asset_id, @asset_name, @asset_type, @group_key, @decimal_display,
+    @meta_json, @genesis_height, @search_text
+)
+ON CONFLICT (asset_id)
+    DO UPDATE SET asset_name = EXCLUDED.asset_name,
+        asset_type = EXCLUDED.asset_type, group_key = EXCLUDED.group_key,
+        decimal_display = EXCLUDED.decimal_display,
+        meta_json = EXCLUDED.meta_json,
+        genesis_height = EXCLUDED.genesis_height,
+        search_text = EXCLUDED.search_text;
+
+-- name: FetchUnindexedIssuanceAssets :many
+SELECT DISTINCT gen.asset_id, gen.asset_tag AS asset_name, gen.asset_type,
+    gen.block_height AS genesis_height,
+    group_info.tweaked_group_key AS group_key,
+    assets_meta.meta_data_type, assets_meta.meta_data_blob
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_info_view gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+LEFT JOIN key_group_info_view group_info
+    ON gen.gen_asset_id = group_info.gen_asset_id
+LEFT JOIN assets_meta
+    ON gen.meta_hash = assets_meta.meta_data_hash
+LEFT JOIN universe_asset_search search
+    ON gen.asset_id = search.asset_id
+WHERE roots.proof_type = 'issuance' AND search.id IS NULL
+LIMIT @num_limit;
+
+-- name: DeleteUniverseAssetSearchEntries :exec
+DELETE FROM universe_asset_search
+WHERE asset_id IN (
+    SELECT gen.asset_id
+    FROM universe_leaves leaves
+    JOIN genesis_assets gen
+        ON leaves.asset_genesis_id = gen.gen_asset_id
+    WHERE leaves.leaf_node_namespace = @namespace
+);
@@ -0,0 +1,16 @@
+-- name: InsertVerifiedProof :exec
+INSERT INTO verified_proofs (
+    proof_hash, block_hash, block_height, verified_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (proof_hash) DO NOTHING;
+
+-- name: FetchVerifiedProof :one
+SELECT *
+FROM verified_proofs
+WHERE proof_hash = $1;
+
+-- name: DeleteVerifiedProofsByBlock :execrows
+DELETE FROM verified_proofs
+WHERE block_hash = $1;
@@ -49,6 +49,16 @@ func (q *Queries) DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFe
 	return err
 }
 
+const DeleteLeafProofSyncLogs = `-- name: DeleteLeafProofSyncLogs :exec
+DELETE FROM federation_proof_sync_log
+WHERE proof_leaf_id = $1
+`
+
+func (q *Queries) DeleteLeafProofSyncLogs(ctx context.Context, proofLeafID int64) error {
+	_, err := q.db.ExecContext(ctx, DeleteLeafProofSyncLogs, proofLeafID)
+	return err
+}
+
 const DeleteMultiverseLeaf = `-- name: DeleteMultiverseLeaf :exec
 DELETE FROM multiverse_leaves
 WHERE leaf_node_namespace = $1 AND leaf_node_key = $2
@@ -64,6 +74,40 @@ func (q *Queries) DeleteMultiverseLeaf(ctx context.Context, arg DeleteMultiverse
 	return err
 }
 
+const DeleteMultiverseRoot = `-- name: DeleteMultiverseRoot :exec
+DELETE FROM multiverse_roots
+WHERE namespace_root = $1
+`
+
+func (q *Queries) DeleteMultiverseRoot(ctx context.Context, namespaceRoot string) error {
+	_, err := q.db.ExecContext(ctx, DeleteMultiverseRoot, namespaceRoot)
+	return err
+}
+
+const DeleteOldestNewProofEvents = `-- name: DeleteOldestNewProofEvents :exec
+DELETE FROM universe_events
+WHERE event_id IN (
+    SELECT events.event_id
+    FROM universe_events AS events
+    JOIN universe_roots AS roots
+        ON events.universe_root_id = roots.id
+    WHERE roots.namespace_root = $1
+        AND events.event_type = 'NEW_PROOF'
+    ORDER BY events.event_time, events.event_id
+    LIMIT $2
+)
+`
+
+type DeleteOldestNewProofEventsParams struct {
+	NamespaceRoot string
+	NumLimit      int32
+}
+
+func (q *Queries) DeleteOldestNewProofEvents(ctx context.Context, arg DeleteOldestNewProofEventsParams) error {
+	_, err := q.db.ExecContext(ctx, DeleteOldestNewProofEvents, arg.NamespaceRoot, arg.NumLimit)
+	return err
+}
+
 const DeleteUniverseEvents = `-- name: DeleteUniverseEvents :exec
 WITH root_id AS (
     SELECT id
@@ -79,6 +123,16 @@ func (q *Queries) DeleteUniverseEvents(ctx context.Context, namespaceRoot string
 	return err
 }
 
+const DeleteUniverseLeaf = `-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUniverseLeaf(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, DeleteUniverseLeaf, id)
+	return err
+}
+
 const DeleteUniverseLeaves = `-- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = $1
@@ -89,6 +143,20 @@ func (q *Queries) DeleteUniverseLeaves(ctx context.Context, namespace string) er
 	return err
 }
 
+const DeleteUniverseProofSyncLogs = `-- name: DeleteUniverseProofSyncLogs :exec
+DELETE FROM federation_proof_sync_log
+WHERE universe_root_id IN (
+    SELECT id
+    FROM universe_roots
+    WHERE namespace_root = $1
+)
+`
+
+func (q *Queries) DeleteUniverseProofSyncLogs(ctx context.Context, namespaceRoot string) error {
+	_, err := q.db.ExecContext(ctx, DeleteUniverseProofSyncLogs, namespaceRoot)
+	return err
+}
+
 const DeleteUniverseRoot = `-- name: DeleteUniverseRoot :exec
 DELETE FROM universe_roots
 WHERE namespace_root = $1
@@ -142,17 +210,23 @@ const FetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves AS leaves
 WHERE leaves.leaf_node_namespace = $1
+    AND (leaves.leaf_node_key >= $2 OR
+         $2 IS NULL)
+    AND (leaves.leaf_node_key <= $3 OR
+         $3 IS NULL)
 ORDER BY 
-    CASE WHEN $2 = 0 THEN leaves.id END ASC,
-    CASE WHEN $2 = 1 THEN leaves.id END DESC
-LIMIT $4 OFFSET $3
+    CASE WHEN $4 = 0 THEN leaves.id END ASC,
+    CASE WHEN $4 = 1 THEN leaves.id END DESC
+LIMIT $6 OFFSET $5
 `
 
 type FetchUniverseKeysParams struct {
-	Namespace     string
-	SortDirection interface{}
-	NumOffset     int32
-	NumLimit      int32
+	Namespace      string
+	MinLeafNodeKey []byte
+	MaxLeafNodeKey []byte
+	SortDirection  interface{}
+	NumOffset      int32
+	NumLimit       int32
 }
 
 type FetchUniverseKeysRow struct {
@@ -163,6 +237,8 @@ type FetchUniverseKeysRow struct {
 func (q *Queries) FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error) {
 	rows, err := q.db.QueryContext(ctx, FetchUniverseKeys,
 		arg.Namespace,
+		arg.MinLeafNodeKey,
+		arg.MaxLeafNodeKey,
 		arg.SortDirection,
 		arg.NumOffset,
 		arg.NumLimit,
@@ -188,6 +264,229 @@ func (q *Queries) FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysPa
 	return items, nil
 }
 
+const FetchLatestUniverseLeafID = `-- name: FetchLatestUniverseLeafID :one
+SELECT id
+FROM universe_leaves
+ORDER BY id DESC
+LIMIT 1
+`
+
+func (q *Queries) FetchLatestUniverseLeafID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, FetchLatestUniverseLeafID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const FetchUniverseLeafProofs = `-- name: FetchUniverseLeafProofs :many
+SELECT leaves.id, nodes.value AS leaf_proof
+FROM universe_leaves AS leaves
+JOIN mssmt_nodes AS nodes
+    ON leaves.leaf_node_key = nodes.key
+       AND leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = $1
+    AND leaves.id > $2
+ORDER BY leaves.id
+LIMIT $3
+`
+
+type FetchUniverseLeafProofsParams struct {
+	Namespace string
+	AfterID   int64
+	NumLimit  int32
+}
+
+type FetchUniverseLeafProofsRow struct {
+	ID        int64
+	LeafProof []byte
+}
+
+func (q *Queries) FetchUniverseLeafProofs(ctx context.Context, arg FetchUniverseLeafProofsParams) ([]FetchUniverseLeafProofsRow, error) {
+	rows, err := q.db.QueryContext(ctx, FetchUniverseLeafProofs, arg.Namespace, arg.AfterID, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseLeafProofsRow
+	for rows.Next() {
+		var i FetchUniverseLeafProofsRow
+		if err := rows.Scan(&i.ID, &i.LeafProof); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const FetchUniverseLeafSizes = `-- name: FetchUniverseLeafSizes :many
+SELECT leaves.id, leaves.leaf_node_key, leaves.block_height,
+       LENGTH(nodes.value) AS proof_size
+FROM universe_leaves AS leaves
+JOIN mssmt_nodes AS nodes
+    ON leaves.leaf_node_key = nodes.key
+       AND leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = $1
+ORDER BY leaves.block_height, leaves.id
+`
+
+type FetchUniverseLeafSizesRow struct {
+	ID          int64
+	LeafNodeKey []byte
+	BlockHeight sql.NullInt32
+	ProofSize   int64
+}
+
+func (q *Queries) FetchUniverseLeafSizes(ctx context.Context, namespace string) ([]FetchUniverseLeafSizesRow, error) {
+	rows, err := q.db.QueryContext(ctx, FetchUniverseLeafSizes, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseLeafSizesRow
+	for rows.Next() {
+		var i FetchUniverseLeafSizesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeafNodeKey,
+			&i.BlockHeight,
+			&i.ProofSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const FetchUniverseLeavesAfter = `-- name: FetchUniverseLeavesAfter :many
+SELECT leaves.id, leaves.minting_point, leaves.script_key_bytes,
+       roots.asset_id, roots.group_key, roots.proof_type
+FROM universe_leaves AS leaves
+JOIN universe_roots AS roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_assets AS gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE leaves.id > $1
+    AND (gen.asset_id = $2 OR
+         $2 IS NULL)
+    AND (roots.group_key = $3 OR
+         $3 IS NULL)
+    AND (roots.proof_type = $4 OR
+         $4 IS NULL)
+ORDER BY leaves.id ASC
+LIMIT $5
+`
+
+type FetchUniverseLeavesAfterParams struct {
+	AfterID   int64
+	AssetID   []byte
+	GroupKey  []byte
+	ProofType sql.NullString
+	NumLimit  int32
+}
+
+type FetchUniverseLeavesAfterRow struct {
+	ID             int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	AssetID        []byte
+	GroupKey       []byte
+	ProofType      string
+}
+
+func (q *Queries) FetchUniverseLeavesAfter(ctx context.Context, arg FetchUniverseLeavesAfterParams) ([]FetchUniverseLeavesAfterRow, error) {
+	rows, err := q.db.QueryContext(ctx, FetchUniverseLeavesAfter,
+		arg.AfterID,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseLeavesAfterRow
+	for rows.Next() {
+		var i FetchUniverseLeavesAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const FetchUniverseLeavesWithoutHeight = `-- name: FetchUniverseLeavesWithoutHeight :many
+SELECT leaves.id, nodes.value AS leaf_proof
+FROM universe_leaves AS leaves
+JOIN mssmt_nodes AS nodes
+    ON leaves.leaf_node_key = nodes.key
+       AND leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = $1
+    AND leaves.block_height IS NULL
+ORDER BY leaves.id
+LIMIT $2
+`
+
+type FetchUniverseLeavesWithoutHeightParams struct {
+	Namespace string
+	NumLimit  int32
+}
+
+type FetchUniverseLeavesWithoutHeightRow struct {
+	ID        int64
+	LeafProof []byte
+}
+
+func (q *Queries) FetchUniverseLeavesWithoutHeight(ctx context.Context, arg FetchUniverseLeavesWithoutHeightParams) ([]FetchUniverseLeavesWithoutHeightRow, error) {
+	rows, err := q.db.QueryContext(ctx, FetchUniverseLeavesWithoutHeight, arg.Namespace, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseLeavesWithoutHeightRow
+	for rows.Next() {
+		var i FetchUniverseLeavesWithoutHeightRow
+		if err := rows.Scan(&i.ID, &i.LeafProof); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const FetchUniverseRoot = `-- name: FetchUniverseRoot :one
 SELECT universe_roots.asset_id, group_key, proof_type,
        mssmt_nodes.hash_key root_hash, mssmt_nodes.sum root_sum,
@@ -326,19 +625,39 @@ func (q *Queries) InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEvent
 
 const InsertUniverseServer = `-- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
-    server_host, last_sync_time
+    server_host, last_sync_time, identity_key
 ) VALUES (
-    $1, $2
+    $1, $2, $3
 )
 `
 
 type InsertUniverseServerParams struct {
 	ServerHost   string
 	LastSyncTime time.Time
+	IdentityKey  []byte
 }
 
 func (q *Queries) InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error {
-	_, err := q.db.ExecContext(ctx, InsertUniverseServer, arg.ServerHost, arg.LastSyncTime)
+	_, err := q.db.ExecContext(ctx, InsertUniverseServer, arg.ServerHost, arg.LastSyncTime, arg.IdentityKey)
+	return err
+}
+
+const InsertUniverseServerFailure = `-- name: InsertUniverseServerFailure :exec
+INSERT INTO universe_server_failures (
+    server_id, failure_time, reason
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type InsertUniverseServerFailureParams struct {
+	ServerID    int64
+	FailureTime time.Time
+	Reason      string
+}
+
+func (q *Queries) InsertUniverseServerFailure(ctx context.Context, arg InsertUniverseServerFailureParams) error {
+	_, err := q.db.ExecContext(ctx, InsertUniverseServerFailure, arg.ServerID, arg.FailureTime, arg.Reason)
 	return err
 }
 
@@ -905,8 +1224,49 @@ func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeav
 	return items, nil
 }
 
+const QueryUniverseServerFailures = `-- name: QueryUniverseServerFailures :many
+SELECT failure_time, reason
+FROM universe_server_failures
+WHERE server_id = $1
+ORDER BY failure_time DESC, id DESC
+LIMIT $2
+`
+
+type QueryUniverseServerFailuresParams struct {
+	ServerID int64
+	NumLimit int32
+}
+
+type QueryUniverseServerFailuresRow struct {
+	FailureTime time.Time
+	Reason      string
+}
+
+func (q *Queries) QueryUniverseServerFailures(ctx context.Context, arg QueryUniverseServerFailuresParams) ([]QueryUniverseServerFailuresRow, error) {
+	rows, err := q.db.QueryContext(ctx, QueryUniverseServerFailures, arg.ServerID, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryUniverseServerFailuresRow
+	for rows.Next() {
+		var i QueryUniverseServerFailuresRow
+		if err := rows.Scan(&i.FailureTime, &i.Reason); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const QueryUniverseServers = `-- name: QueryUniverseServers :many
-SELECT id, server_host, last_sync_time FROM universe_servers
+SELECT id, server_host, last_sync_time, identity_key, reputation_score FROM universe_servers
 WHERE (id = $1 OR $1 IS NULL) AND
       (server_host = $2
            OR $2 IS NULL)
@@ -926,7 +1286,13 @@ func (q *Queries) QueryUniverseServers(ctx context.Context, arg QueryUniverseSer
 	var items []UniverseServer
 	for rows.Next() {
 		var i UniverseServer
-		if err := rows.Scan(&i.ID, &i.ServerHost, &i.LastSyncTime); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerHost,
+			&i.LastSyncTime,
+			&i.IdentityKey,
+			&i.ReputationScore,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1002,7 +1368,7 @@ func (q *Queries) QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow
 }
 
 const UniverseLeaves = `-- name: UniverseLeaves :many
-SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace FROM universe_leaves
+SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace, block_height FROM universe_leaves
 `
 
 func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
@@ -1022,6 +1388,7 @@ func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
 			&i.UniverseRootID,
 			&i.LeafNodeKey,
 			&i.LeafNodeNamespace,
+			&i.BlockHeight,
 		); err != nil {
 			return nil, err
 		}
@@ -1099,6 +1466,38 @@ func (q *Queries) UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([
 	return items, nil
 }
 
+const UpdateUniverseLeafBlockHeight = `-- name: UpdateUniverseLeafBlockHeight :exec
+UPDATE universe_leaves
+SET block_height = $1
+WHERE id = $2
+`
+
+type UpdateUniverseLeafBlockHeightParams struct {
+	BlockHeight sql.NullInt32
+	ID          int64
+}
+
+func (q *Queries) UpdateUniverseLeafBlockHeight(ctx context.Context, arg UpdateUniverseLeafBlockHeightParams) error {
+	_, err := q.db.ExecContext(ctx, UpdateUniverseLeafBlockHeight, arg.BlockHeight, arg.ID)
+	return err
+}
+
+const UpdateUniverseServerReputation = `-- name: UpdateUniverseServerReputation :exec
+UPDATE universe_servers
+SET reputation_score = $1
+WHERE id = $2
+`
+
+type UpdateUniverseServerReputationParams struct {
+	ReputationScore int32
+	ServerID        int64
+}
+
+func (q *Queries) UpdateUniverseServerReputation(ctx context.Context, arg UpdateUniverseServerReputationParams) error {
+	_, err := q.db.ExecContext(ctx, UpdateUniverseServerReputation, arg.ReputationScore, arg.ServerID)
+	return err
+}
+
 const UpsertFederationGlobalSyncConfig = `-- name: UpsertFederationGlobalSyncConfig :exec
 INSERT INTO federation_global_sync_config (
     proof_type, allow_sync_insert, allow_sync_export
@@ -1284,15 +1683,17 @@ func (q *Queries) UpsertMultiverseRoot(ctx context.Context, arg UpsertMultiverse
 const UpsertUniverseLeaf = `-- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
-    leaf_node_namespace, minting_point
+    leaf_node_namespace, minting_point, block_height
 ) VALUES (
     $1, $2, $3, $4,
-    $5, $6
+    $5, $6, $7
 ) ON CONFLICT (minting_point, script_key_bytes)
     -- This is a NOP, minting_point and script_key_bytes are the unique fields
-    -- that caused the conflict.
+    -- that caused the conflict. The block height is updated to backfill it
+    -- for leaves that were inserted before it was stored.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes
+                  script_key_bytes = EXCLUDED.script_key_bytes,
+                  block_height = EXCLUDED.block_height
 `
 
 type UpsertUniverseLeafParams struct {
@@ -1302,6 +1703,7 @@ type UpsertUniverseLeafParams struct {
 	LeafNodeKey       []byte
 	LeafNodeNamespace string
 	MintingPoint      []byte
+	BlockHeight       sql.NullInt32
 }
 
 func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error {
@@ -1312,6 +1714,7 @@ func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf
 		arg.LeafNodeKey,
 		arg.LeafNodeNamespace,
 		arg.MintingPoint,
+		arg.BlockHeight,
 	)
 	return err
 }
@@ -0,0 +1,198 @@
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// The queries in this file are written by hand, as the full-text search
+// syntax of SQLite (FTS5) and Postgres (tsvector) differs too much for a
+// single query that sqlc could generate code for.
+
+// searchAssetsFilter is the part of the asset search queries that filters,
+// sorts and paginates the matching assets. The matches are expected in a
+// common table expression called results.
+const searchAssetsFilter = `
+SELECT asset_id, asset_name, asset_type, group_key, decimal_display,
+    meta_json, genesis_height, rank
+FROM results
+WHERE (asset_type = $2 OR $2 IS NULL) AND
+      (group_key = $3 OR $3 IS NULL) AND
+      (decimal_display = $4 OR $4 IS NULL)
+ORDER BY
+    CASE WHEN $5 = 'relevance' THEN rank END DESC,
+    CASE WHEN $5 = 'asset_name' AND $6 = 0 THEN asset_name END ASC,
+    CASE WHEN $5 = 'asset_name' AND $6 = 1 THEN asset_name END DESC,
+    CASE WHEN $5 = 'asset_id' AND $6 = 0 THEN asset_id END ASC,
+    CASE WHEN $5 = 'asset_id' AND $6 = 1 THEN asset_id END DESC,
+    CASE WHEN $5 = 'genesis_height' AND $6 = 0 THEN
+             genesis_height END ASC,
+    CASE WHEN $5 = 'genesis_height' AND $6 = 1 THEN
+             genesis_height END DESC,
+    asset_id ASC
+LIMIT $7 OFFSET $8
+`
+
+// SearchAssetsSqlite matches the search text against the FTS5 table of the
+// asset search index. The rank is the negated BM25 score, so a higher rank
+// means a better match on both backends.
+const SearchAssetsSqlite = `WITH results AS (
+    SELECT search.asset_id, search.asset_name, search.asset_type,
+        search.group_key, search.decimal_display, search.meta_json,
+        search.genesis_height, matches.rank
+    FROM (
+        SELECT rowid AS search_id,
+            -bm25(universe_asset_search_fts) AS rank
+        FROM universe_asset_search_fts
+        WHERE universe_asset_search_fts MATCH $1
+    ) matches
+    JOIN universe_asset_search search
+        ON search.id = matches.search_id
+)` + searchAssetsFilter
+
+// SearchAssetsPostgres matches the search text against the tsvector of the
+// search text of the asset search index.
+const SearchAssetsPostgres = `WITH results AS (
+    SELECT search.asset_id, search.asset_name, search.asset_type,
+        search.group_key, search.decimal_display, search.meta_json,
+        search.genesis_height,
+        ts_rank(
+            to_tsvector('simple', search.search_text),
+            plainto_tsquery('simple', $1::TEXT)
+        ) AS rank
+    FROM universe_asset_search search
+    WHERE to_tsvector('simple', search.search_text) @@
+        plainto_tsquery('simple', $1::TEXT)
+)` + searchAssetsFilter
+
+// FilterAssetsSqlite returns all assets of the asset search index, as no
+// search text was given. The first parameter is always NULL and only exists
+// to share the parameters of the search queries.
+const FilterAssetsSqlite = `WITH results AS (
+    SELECT asset_id, asset_name, asset_type, group_key, decimal_display,
+        meta_json, genesis_height, 0.0 AS rank
+    FROM universe_asset_search
+    WHERE $1 IS NULL
+)` + searchAssetsFilter
+
+// FilterAssetsPostgres is the Postgres version of FilterAssetsSqlite.
+const FilterAssetsPostgres = `WITH results AS (
+    SELECT asset_id, asset_name, asset_type, group_key, decimal_display,
+        meta_json, genesis_height, 0::REAL AS rank
+    FROM universe_asset_search
+    WHERE $1::TEXT IS NULL
+)` + searchAssetsFilter
+
+// SearchAssetsParams are the parameters of an asset search.
+type SearchAssetsParams struct {
+	// SearchWords are the normalized words that all need to be contained
+	// in the search text of a matching asset. If empty, all assets match.
+	SearchWords    []string
+	AssetType      sql.NullInt16
+	GroupKey       []byte
+	DecimalDisplay sql.NullInt32
+	SortBy         sql.NullString
+	SortDirection  sql.NullInt16
+	NumOffset      int32
+	NumLimit       int32
+}
+
+// SearchAssetsRow is a single asset returned by an asset search.
+type SearchAssetsRow struct {
+	AssetID        []byte
+	AssetName      string
+	AssetType      int16
+	GroupKey       []byte
+	DecimalDisplay sql.NullInt32
+	MetaJson       sql.NullString
+	GenesisHeight  sql.NullInt32
+	Rank           float64
+}
+
+// SearchAssetsSqlite searches the asset search index using the FTS5 table of
+// a SQLite database.
+func (q *Queries) SearchAssetsSqlite(ctx context.Context,
+	arg SearchAssetsParams) ([]SearchAssetsRow, error) {
+
+	if len(arg.SearchWords) == 0 {
+		return q.searchAssets(
+			ctx, FilterAssetsSqlite, sql.NullString{}, arg,
+		)
+	}
+
+	// Each word is quoted, so it's matched as a plain string instead of
+	// being interpreted as an FTS5 operator. Multiple strings are
+	// implicitly combined with AND.
+	quoted := make([]string, 0, len(arg.SearchWords))
+	for _, word := range arg.SearchWords {
+		quoted = append(quoted, `"`+word+`"`)
+	}
+	searchText := sql.NullString{
+		String: strings.Join(quoted, " "),
+		Valid:  true,
+	}
+
+	return q.searchAssets(ctx, SearchAssetsSqlite, searchText, arg)
+}
+
+// SearchAssetsPostgres searches the asset search index using the full-text
+// search of a Postgres database.
+func (q *Queries) SearchAssetsPostgres(ctx context.Context,
+	arg SearchAssetsParams) ([]SearchAssetsRow, error) {
+
+	if len(arg.SearchWords) == 0 {
+		return q.searchAssets(
+			ctx, FilterAssetsPostgres, sql.NullString{}, arg,
+		)
+	}
+
+	searchText := sql.NullString{
+		String: strings.Join(arg.SearchWords, " "),
+		Valid:  true,
+	}
+
+	return q.searchAssets(ctx, SearchAssetsPostgres, searchText, arg)
+}
+
+// searchAssets runs the given asset search query.
+func (q *Queries) searchAssets(ctx context.Context, query string,
+	searchText sql.NullString,
+	arg SearchAssetsParams) ([]SearchAssetsRow, error) {
+
+	rows, err := q.db.QueryContext(
+		ctx, query, searchText, arg.AssetType, arg.GroupKey,
+		arg.DecimalDisplay, arg.SortBy, arg.SortDirection,
+		arg.NumLimit, arg.NumOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []SearchAssetsRow
+	for rows.Next() {
+		var i SearchAssetsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.AssetName,
+			&i.AssetType,
+			&i.GroupKey,
+			&i.DecimalDisplay,
+			&i.MetaJson,
+			&i.GenesisHeight,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -35,13 +35,71 @@ const (
 	// defaultConnMaxLifetime is the maximum amount of time a connection can
 	// be reused for before it is closed.
 	defaultConnMaxLifetime = 10 * time.Minute
+
+	// assetSearchIndexPg is the statement that creates the full-text index
+	// of the universe asset search table on postgres.
+	//
+	// nolint: lll
+	assetSearchIndexPg = "CREATE INDEX IF NOT EXISTS universe_asset_search_text_idx ON universe_asset_search USING GIN (to_tsvector('simple', search_text));"
+
+	// assetSearchIndexSqlite replaces assetSearchIndexPg on sqlite. It
+	// creates an external content FTS5 table over the search text of the
+	// universe asset search table, and the triggers that keep both tables
+	// in sync.
+	assetSearchIndexSqlite = `
+CREATE VIRTUAL TABLE IF NOT EXISTS universe_asset_search_fts
+USING fts5(
+    search_text, content='universe_asset_search', content_rowid='id'
+);
+
+CREATE TRIGGER IF NOT EXISTS universe_asset_search_fts_insert
+AFTER INSERT ON universe_asset_search BEGIN
+    INSERT INTO universe_asset_search_fts (rowid, search_text)
+    VALUES (new.id, new.search_text);
+END;
+
+CREATE TRIGGER IF NOT EXISTS universe_asset_search_fts_delete
+AFTER DELETE ON universe_asset_search BEGIN
+    INSERT INTO universe_asset_search_fts (
+        universe_asset_search_fts, rowid, search_text
+    ) VALUES ('delete', old.id, old.search_text);
+END;
+
+CREATE TRIGGER IF NOT EXISTS universe_asset_search_fts_update
+AFTER UPDATE ON universe_asset_search BEGIN
+    INSERT INTO universe_asset_search_fts (
+        universe_asset_search_fts, rowid, search_text
+    ) VALUES ('delete', old.id, old.search_text);
+    INSERT INTO universe_asset_search_fts (rowid, search_text)
+    VALUES (new.id, new.search_text);
+END;`
+
+	// assetSearchDropIndexPg is the statement that drops the full-text
+	// index of the universe asset search table on postgres.
+	assetSearchDropIndexPg = "DROP INDEX IF EXISTS " +
+		"universe_asset_search_text_idx;"
+
+	// assetSearchDropIndexSqlite replaces assetSearchDropIndexPg on sqlite.
+	// It drops the FTS5 table and its triggers.
+	assetSearchDropIndexSqlite = `
+DROP TRIGGER IF EXISTS universe_asset_search_fts_update;
+
+DROP TRIGGER IF EXISTS universe_asset_search_fts_delete;
+
+DROP TRIGGER IF EXISTS universe_asset_search_fts_insert;
+
+DROP TABLE IF EXISTS universe_asset_search_fts;`
 )
 
 var (
 	// sqliteSchemaReplacements is a map of schema strings that need to be
-	// replaced for sqlite. There currently aren't any replacements, because
-	// the SQL files are written with SQLite compatibility in mind.
-	sqliteSchemaReplacements = map[string]string{}
+	// replaced for sqlite. The SQL files are written with SQLite
+	// compatibility in mind, so the only replacements are the full-text
+	// search indexes, which use the FTS5 extension on sqlite.
+	sqliteSchemaReplacements = map[string]string{
+		assetSearchIndexPg:     assetSearchIndexSqlite,
+		assetSearchDropIndexPg: assetSearchDropIndexSqlite,
+	}
 )
 
 // SqliteConfig holds all the config arguments needed to interact with our
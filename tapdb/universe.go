@@ -3,6 +3,7 @@ package tapdb
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -80,6 +81,10 @@ type BaseUniverseStore interface {
 	// DeleteUniverseEvents is used to delete a universe sync event.
 	DeleteUniverseEvents(ctx context.Context, namespace string) error
 
+	// DeleteUniverseProofSyncLogs is used to delete the proof sync log
+	// entries of all leaves of a universe tree.
+	DeleteUniverseProofSyncLogs(ctx context.Context, namespace string) error
+
 	// FetchUniverseRoot fetches the root of a universe based on the
 	// namespace key, which is a function of the asset ID and the group
 	// key.
@@ -110,6 +115,15 @@ type BaseUniverseStore interface {
 	// DeleteMultiverseLeaf deletes a multiverse leaf from the database.
 	DeleteMultiverseLeaf(ctx context.Context,
 		arg DeleteMultiverseLeaf) error
+
+	// UpsertAssetSearchEntry inserts or updates the search index entry of
+	// an asset.
+	UpsertAssetSearchEntry(ctx context.Context, arg AssetSearchEntry) error
+
+	// DeleteUniverseAssetSearchEntries deletes the search index entries of
+	// all assets that have a leaf in the given universe tree.
+	DeleteUniverseAssetSearchEntries(ctx context.Context,
+		namespace string) error
 }
 
 // BaseUniverseStoreOptions is the set of options for universe tree queries.
@@ -434,6 +448,17 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		return nil, err
 	}
 
+	// Assets are made searchable by their issuance proofs, which reveal
+	// their meta data.
+	if id.ProofType == universe.ProofTypeIssuance {
+		err = dbTx.UpsertAssetSearchEntry(
+			ctx, issuanceSearchEntry(leaf, &leafProof, metaReveal),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to index asset: %w", err)
+		}
+	}
+
 	scriptKeyBytes := schnorr.SerializePubKey(key.ScriptKey.PubKey)
 	err = dbTx.UpsertUniverseLeaf(ctx, UpsertUniverseLeaf{
 		AssetGenesisID:    assetGenID,
@@ -442,6 +467,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		LeafNodeKey:       smtKey[:],
 		LeafNodeNamespace: namespace,
 		MintingPoint:      mintingPointBytes,
+		BlockHeight:       sqlInt32(leafProof.BlockHeight),
 	})
 	if err != nil {
 		return nil, err
@@ -477,14 +503,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	// Construct a leaf node for insertion into the multiverse tree. The
 	// leaf node includes a reference to the lower tree via the lower tree
 	// root hash.
-	universeRootHash := universeRoot.NodeHash()
-	assetGroupSum := universeRoot.NodeSum()
-
-	if id.ProofType == universe.ProofTypeIssuance {
-		assetGroupSum = 1
-	}
-
-	uniLeafNode := mssmt.NewLeafNode(universeRootHash[:], assetGroupSum)
+	uniLeafNode := multiverseLeafNode(id, universeRoot)
 
 	// Use asset ID (or asset group hash) as the upper tree leaf node key.
 	// This is the same as the asset specific universe ID.
@@ -549,6 +568,22 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	}, nil
 }
 
+// multiverseLeafNode returns the leaf node of the universe with the given ID
+// and root in the multiverse tree. The leaf node references the universe tree
+// via its root hash.
+func multiverseLeafNode(id universe.Identifier,
+	universeRoot mssmt.Node) *mssmt.LeafNode {
+
+	universeRootHash := universeRoot.NodeHash()
+	assetGroupSum := universeRoot.NodeSum()
+
+	if id.ProofType == universe.ProofTypeIssuance {
+		assetGroupSum = 1
+	}
+
+	return mssmt.NewLeafNode(universeRootHash[:], assetGroupSum)
+}
+
 // FetchIssuanceProof returns an issuance proof for the target key. If the key
 // doesn't have a script key specified, then all the proofs for the minting
 // outpoint will be returned. If neither are specified, then proofs for all the
@@ -716,11 +751,24 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 	q universe.UniverseLeafKeysQuery,
 	namespace string) ([]universe.LeafKey, error) {
 
+	// If the keys are restricted to a key prefix, then we'll query for the
+	// range of leaf node keys that start with that prefix.
+	var minLeafNodeKey, maxLeafNodeKey []byte
+	if len(q.KeyPrefix) > 0 {
+		minLeafNodeKey = make([]byte, sha256.Size)
+		maxLeafNodeKey = bytes.Repeat([]byte{0xff}, sha256.Size)
+
+		copy(minLeafNodeKey, q.KeyPrefix)
+		copy(maxLeafNodeKey, q.KeyPrefix)
+	}
+
 	universeKeys, err := dbTx.FetchUniverseKeys(
 		ctx, UniverseLeafKeysQuery{
-			Namespace:     namespace,
-			SortDirection: sqlInt16(q.SortDirection),
-			NumOffset:     q.Offset,
+			Namespace:      namespace,
+			MinLeafNodeKey: minLeafNodeKey,
+			MaxLeafNodeKey: maxLeafNodeKey,
+			SortDirection:  sqlInt16(q.SortDirection),
+			NumOffset:      q.Offset,
 			NumLimit: func() int32 {
 				if q.Limit == 0 {
 					return universe.RequestPageSize
@@ -878,6 +926,25 @@ func deleteUniverseTree(ctx context.Context,
 			"nodes: %w", err)
 	}
 
+	// Delete the proof sync log entries that reference the leaves, before
+	// deleting the leaves themselves.
+	err = db.DeleteUniverseProofSyncLogs(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to delete universe proof sync "+
+			"logs: %w", err)
+	}
+
+	// The assets of an issuance universe are no longer known once it's
+	// deleted, so we remove them from the search index while we can still
+	// look them up by their leaves.
+	if id.ProofType == universe.ProofTypeIssuance {
+		err = db.DeleteUniverseAssetSearchEntries(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to delete asset search "+
+				"entries: %w", err)
+		}
+	}
+
 	// Delete all leaves in the universe table.
 	err = db.DeleteUniverseLeaves(ctx, namespace)
 	if err != nil {
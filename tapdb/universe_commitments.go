@@ -0,0 +1,784 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+type (
+	// NewUniverseCommitment is used to insert a new universe commitment.
+	NewUniverseCommitment = sqlc.InsertUniverseCommitmentParams
+
+	// NewUniverseCommitmentLeaf is used to insert a multiverse leaf that a
+	// universe commitment commits to.
+	NewUniverseCommitmentLeaf = sqlc.InsertUniverseCommitmentLeafParams
+
+	// ConfirmUniverseCommitmentParams is used to mark a universe
+	// commitment as confirmed.
+	ConfirmUniverseCommitmentParams = sqlc.ConfirmUniverseCommitmentParams
+
+	// QueryUniverseCommitmentsParams is used to query for universe
+	// commitments.
+	QueryUniverseCommitmentsParams = sqlc.QueryUniverseCommitmentsParams
+
+	// UniverseCommitmentRow is a universe commitment returned from a
+	// query.
+	UniverseCommitmentRow = sqlc.QueryUniverseCommitmentsRow
+
+	// UniverseCommitmentLeaf is a multiverse leaf that a universe
+	// commitment commits to, returned from a query.
+	UniverseCommitmentLeaf = sqlc.QueryUniverseCommitmentLeavesRow
+
+	// LatestUniverseCommitmentLeaf is a multiverse leaf that the latest
+	// universe commitment commits to, returned from a query.
+	LatestUniverseCommitmentLeaf = sqlc.QueryLatestUniverseCommitmentLeavesRow
+
+	// RemoveUniverseCommitmentLeafParams is used to mark a multiverse leaf
+	// as removed by a universe commitment.
+	RemoveUniverseCommitmentLeafParams = sqlc.RemoveUniverseCommitmentLeafParams
+
+	// ReplaceUniverseCommitmentTxParams is used to replace the anchor
+	// transaction of a pending universe commitment.
+	ReplaceUniverseCommitmentTxParams = sqlc.ReplaceUniverseCommitmentTxParams
+)
+
+// UniCommitmentStore is the set of queries that are needed to keep track of
+// the chain commitments to the multiverse roots.
+type UniCommitmentStore interface {
+	// UpsertInternalKey inserts a new or updates an existing internal key
+	// into the database and returns the primary key.
+	UpsertInternalKey(ctx context.Context, arg InternalKey) (int64, error)
+
+	// InsertUniverseCommitment inserts a new, not yet confirmed universe
+	// commitment and returns its ID.
+	InsertUniverseCommitment(ctx context.Context,
+		arg NewUniverseCommitment) (int64, error)
+
+	// InsertUniverseCommitmentLeaf inserts a multiverse leaf that was
+	// added or changed by a universe commitment.
+	InsertUniverseCommitmentLeaf(ctx context.Context,
+		arg NewUniverseCommitmentLeaf) error
+
+	// RemoveUniverseCommitmentLeaf marks the latest version of the given
+	// multiverse leaf as removed by a universe commitment.
+	RemoveUniverseCommitmentLeaf(ctx context.Context,
+		arg RemoveUniverseCommitmentLeafParams) error
+
+	// QueryLatestUniverseCommitmentLeaves returns the multiverse leaves
+	// that the latest universe commitment commits to.
+	QueryLatestUniverseCommitmentLeaves(
+		ctx context.Context) ([]LatestUniverseCommitmentLeaf, error)
+
+	// FetchUniverseCommitmentID returns the ID of the universe commitment
+	// with the given anchor transaction.
+	FetchUniverseCommitmentID(ctx context.Context,
+		txid []byte) (int64, error)
+
+	// ConfirmUniverseCommitment marks the universe commitment with the
+	// given anchor transaction as confirmed and returns its ID.
+	ConfirmUniverseCommitment(ctx context.Context,
+		arg ConfirmUniverseCommitmentParams) (int64, error)
+
+	// ReplaceUniverseCommitmentTx replaces the anchor transaction of the
+	// pending universe commitment with the given anchor transaction and
+	// returns the number of updated commitments.
+	ReplaceUniverseCommitmentTx(ctx context.Context,
+		arg ReplaceUniverseCommitmentTxParams) (int64, error)
+
+	// QueryUniverseCommitments returns the confirmed or unconfirmed
+	// universe commitments, starting with the most recent one.
+	QueryUniverseCommitments(ctx context.Context,
+		arg QueryUniverseCommitmentsParams) ([]UniverseCommitmentRow,
+		error)
+
+	// QueryUniverseCommitmentLeaves returns the multiverse leaves that the
+	// universe commitment with the given ID commits to.
+	QueryUniverseCommitmentLeaves(ctx context.Context,
+		commitmentID int64) ([]UniverseCommitmentLeaf, error)
+
+	// DeleteStaleUniverseCommitmentLeaves deletes the multiverse leaves
+	// that were removed by the given confirmed universe commitment or an
+	// older one.
+	DeleteStaleUniverseCommitmentLeaves(ctx context.Context,
+		latestConfirmedID sql.NullInt64) error
+
+	// ReopenUniverseCommitmentLeaves undoes the removal of the multiverse
+	// leaves that were removed by the given universe commitment or a
+	// newer one.
+	ReopenUniverseCommitmentLeaves(ctx context.Context,
+		firstAbandonedID sql.NullInt64) error
+
+	// DeletePendingUniverseCommitments deletes the given universe
+	// commitment and all newer ones, if they aren't confirmed, and returns
+	// the number of deleted commitments.
+	DeletePendingUniverseCommitments(ctx context.Context,
+		firstAbandonedID int64) (int64, error)
+}
+
+// UniCommitmentTxOptions defines the set of db txn options the
+// UniCommitmentStore understands.
+type UniCommitmentTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (u *UniCommitmentTxOptions) ReadOnly() bool {
+	return u.readOnly
+}
+
+// NewUniCommitmentReadTx creates a new read transaction option set.
+func NewUniCommitmentReadTx() UniCommitmentTxOptions {
+	return UniCommitmentTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedUniCommitmentStore combines the UniCommitmentStore interface with the
+// BatchedTx interface, allowing for multiple queries to be executed in a single
+// SQL transaction.
+type BatchedUniCommitmentStore interface {
+	UniCommitmentStore
+
+	BatchedTx[UniCommitmentStore]
+}
+
+// UniverseCommitmentDB is a database backed log of the chain commitments to
+// the multiverse roots.
+//
+// NOTE: This implements the universe.CommitmentLog interface.
+type UniverseCommitmentDB struct {
+	db BatchedUniCommitmentStore
+
+	clock clock.Clock
+
+	// snapshotMtx guards the cached snapshot below.
+	snapshotMtx sync.Mutex
+
+	// snapshotID is the ID of the commitment the cached snapshot belongs
+	// to.
+	snapshotID int64
+
+	// snapshot is an in-memory copy of the multiverse trees that the
+	// latest confirmed commitment commits to. Building the trees is
+	// expensive, so we only do it once per commitment.
+	snapshot *universe.MultiverseSnapshot
+}
+
+// NewUniverseCommitmentDB creates a new universe commitment log from the given
+// database.
+func NewUniverseCommitmentDB(db BatchedUniCommitmentStore,
+	clock clock.Clock) *UniverseCommitmentDB {
+
+	return &UniverseCommitmentDB{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// commitmentLeafKey is the key of a multiverse leaf within the snapshot of a
+// universe commitment.
+type commitmentLeafKey struct {
+	proofType string
+	leafKey   [32]byte
+}
+
+// InsertCommitment stores a new, not yet confirmed commitment along with the
+// multiverse leaves it commits to. Only the leaves that changed since the
+// latest commitment are stored, the others are shared with it.
+func (u *UniverseCommitmentDB) InsertCommitment(ctx context.Context,
+	commitment *universe.Commitment,
+	leaves []universe.MultiverseLeaf) error {
+
+	var txBuf bytes.Buffer
+	if err := commitment.AnchorTx.Serialize(&txBuf); err != nil {
+		return fmt.Errorf("unable to serialize anchor tx: %w", err)
+	}
+
+	txid := commitment.AnchorTx.TxHash()
+	issuanceRoot := commitment.IssuanceRoot.NodeHash()
+	transferRoot := commitment.TransferRoot.NodeHash()
+	keyDesc := commitment.InternalKey
+
+	genesisOutPoint, err := encodeOutpoint(commitment.GenesisOutPoint)
+	if err != nil {
+		return fmt.Errorf("unable to encode genesis outpoint: %w", err)
+	}
+
+	var writeTx UniCommitmentTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniCommitmentStore) error {
+		keyID, err := db.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    keyDesc.PubKey.SerializeCompressed(),
+			KeyFamily: int32(keyDesc.Family),
+			KeyIndex:  int32(keyDesc.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrUpsertInternalKey, err)
+		}
+
+		// We fetch the leaves of the latest commitment before
+		// inserting the new one, so we only need to store the leaves
+		// that changed.
+		latestLeaves, err := db.QueryLatestUniverseCommitmentLeaves(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch latest commitment "+
+				"leaves: %w", err)
+		}
+
+		prevLeaves := make(
+			map[commitmentLeafKey]LatestUniverseCommitmentLeaf,
+			len(latestLeaves),
+		)
+		for _, leaf := range latestLeaves {
+			key := commitmentLeafKey{
+				proofType: leaf.ProofType,
+			}
+			copy(key.leafKey[:], leaf.LeafKey)
+			prevLeaves[key] = leaf
+		}
+
+		commitmentID, err := db.InsertUniverseCommitment(
+			ctx, NewUniverseCommitment{
+				Txid:             txid[:],
+				AnchorTx:         txBuf.Bytes(),
+				OutputIndex:      int32(commitment.OutputIndex),
+				InternalKeyID:    keyID,
+				IssuanceRootHash: issuanceRoot[:],
+				IssuanceRootSum: int64(
+					commitment.IssuanceRoot.NodeSum(),
+				),
+				TransferRootHash: transferRoot[:],
+				TransferRootSum: int64(
+					commitment.TransferRoot.NodeSum(),
+				),
+				HeightHint:      int32(commitment.HeightHint),
+				GenesisOutpoint: genesisOutPoint,
+				CreatedAt:       u.clock.Now().UTC(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert commitment: %w",
+				err)
+		}
+
+		removeLeaf := func(key commitmentLeafKey) error {
+			err := db.RemoveUniverseCommitmentLeaf(
+				ctx, RemoveUniverseCommitmentLeafParams{
+					RemovedCommitmentID: sqlInt64(
+						commitmentID,
+					),
+					ProofType: key.proofType,
+					LeafKey:   key.leafKey[:],
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to remove "+
+					"commitment leaf %x: %w", key.leafKey,
+					err)
+			}
+
+			return nil
+		}
+
+		for _, leaf := range leaves {
+			// The value of a multiverse leaf is the root hash of
+			// the universe it represents.
+			key := commitmentLeafKey{
+				proofType: leaf.ID.ProofType.String(),
+				leafKey:   leaf.ID.Bytes(),
+			}
+			rootSum := int64(leaf.NodeSum())
+
+			prevLeaf, ok := prevLeaves[key]
+			delete(prevLeaves, key)
+
+			switch {
+			// Unchanged leaves are shared with the previous
+			// commitment.
+			case ok && bytes.Equal(
+				prevLeaf.UniverseRootHash, leaf.Value,
+			) && prevLeaf.UniverseRootSum == rootSum:
+
+				continue
+
+			// The previous version of a changed leaf is replaced
+			// by the new one.
+			case ok:
+				if err := removeLeaf(key); err != nil {
+					return err
+				}
+			}
+
+			var assetID, groupKey []byte
+			if leaf.ID.GroupKey != nil {
+				groupKey = schnorr.SerializePubKey(
+					leaf.ID.GroupKey,
+				)
+			} else {
+				assetID = fn.ByteSlice(leaf.ID.AssetID)
+			}
+
+			err := db.InsertUniverseCommitmentLeaf(
+				ctx, NewUniverseCommitmentLeaf{
+					CommitmentID:     commitmentID,
+					ProofType:        key.proofType,
+					LeafKey:          key.leafKey[:],
+					AssetID:          assetID,
+					GroupKey:         groupKey,
+					UniverseRootHash: leaf.Value,
+					UniverseRootSum:  rootSum,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert "+
+					"commitment leaf %x: %w", key.leafKey,
+					err)
+			}
+		}
+
+		// Any leaves that are left were removed from the multiverse
+		// since the previous commitment.
+		for key := range prevLeaves {
+			if err := removeLeaf(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ConfirmCommitment marks the commitment with the given anchor transaction as
+// confirmed. The multiverse leaves that aren't part of the snapshot of this or
+// any newer commitment are no longer needed, so they're deleted.
+func (u *UniverseCommitmentDB) ConfirmCommitment(ctx context.Context,
+	txid chainhash.Hash, blockHeight uint32, header wire.BlockHeader,
+	merkleProof *proof.TxMerkleProof) error {
+
+	var headerBuf bytes.Buffer
+	if err := header.Serialize(&headerBuf); err != nil {
+		return fmt.Errorf("unable to serialize block header: %w", err)
+	}
+
+	var proofBuf bytes.Buffer
+	if err := merkleProof.Encode(&proofBuf); err != nil {
+		return fmt.Errorf("unable to encode merkle proof: %w", err)
+	}
+
+	var writeTx UniCommitmentTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniCommitmentStore) error {
+		commitmentID, err := db.ConfirmUniverseCommitment(
+			ctx, ConfirmUniverseCommitmentParams{
+				Txid:        txid[:],
+				BlockHeight: sqlInt32(blockHeight),
+				BlockHeader: headerBuf.Bytes(),
+				MerkleProof: proofBuf.Bytes(),
+			},
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("%w: txid=%v",
+				universe.ErrNoCommitment, txid)
+
+		case err != nil:
+			return fmt.Errorf("unable to confirm commitment: %w",
+				err)
+		}
+
+		return db.DeleteStaleUniverseCommitmentLeaves(
+			ctx, sqlInt64(commitmentID),
+		)
+	})
+}
+
+// ReplaceAnchorTx replaces the anchor transaction of the pending commitment
+// with the given anchor transaction, for example after its fee was bumped. The
+// commitment keeps its roots, internal key and multiverse leaves.
+func (u *UniverseCommitmentDB) ReplaceAnchorTx(ctx context.Context,
+	txid chainhash.Hash, anchorTx *wire.MsgTx, outputIndex,
+	heightHint uint32) error {
+
+	var txBuf bytes.Buffer
+	if err := anchorTx.Serialize(&txBuf); err != nil {
+		return fmt.Errorf("unable to serialize anchor tx: %w", err)
+	}
+
+	newTxid := anchorTx.TxHash()
+
+	var writeTx UniCommitmentTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniCommitmentStore) error {
+		numReplaced, err := db.ReplaceUniverseCommitmentTx(
+			ctx, ReplaceUniverseCommitmentTxParams{
+				NewTxid:     newTxid[:],
+				AnchorTx:    txBuf.Bytes(),
+				OutputIndex: int32(outputIndex),
+				HeightHint:  int32(heightHint),
+				OldTxid:     txid[:],
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to replace anchor tx: %w",
+				err)
+		}
+
+		if numReplaced == 0 {
+			return fmt.Errorf("%w: no pending commitment with "+
+				"txid=%v", universe.ErrNoCommitment, txid)
+		}
+
+		return nil
+	})
+}
+
+// AbandonCommitment deletes the pending commitment with the given anchor
+// transaction along with all newer pending commitments, which share its
+// multiverse leaves. The leaves they replaced are restored, so the next
+// commitment is based on the latest remaining one.
+func (u *UniverseCommitmentDB) AbandonCommitment(ctx context.Context,
+	txid chainhash.Hash) error {
+
+	var writeTx UniCommitmentTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniCommitmentStore) error {
+		commitmentID, err := db.FetchUniverseCommitmentID(ctx, txid[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("%w: txid=%v",
+				universe.ErrNoCommitment, txid)
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch commitment: %w",
+				err)
+		}
+
+		err = db.ReopenUniverseCommitmentLeaves(
+			ctx, sqlInt64(commitmentID),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to restore commitment "+
+				"leaves: %w", err)
+		}
+
+		// The leaves the commitments added are deleted along with
+		// them.
+		numDeleted, err := db.DeletePendingUniverseCommitments(
+			ctx, commitmentID,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete commitments: %w",
+				err)
+		}
+
+		if numDeleted == 0 {
+			return fmt.Errorf("commitment %v is already confirmed",
+				txid)
+		}
+
+		return nil
+	})
+}
+
+// PendingCommitments returns all commitments that aren't confirmed yet, oldest
+// first.
+func (u *UniverseCommitmentDB) PendingCommitments(
+	ctx context.Context) ([]*universe.Commitment, error) {
+
+	var commitments []*universe.Commitment
+
+	readTx := NewUniCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniCommitmentStore) error {
+		rows, err := db.QueryUniverseCommitments(
+			ctx, QueryUniverseCommitmentsParams{
+				Confirmed: false,
+				NumLimit:  math.MaxInt32,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		commitments = make([]*universe.Commitment, 0, len(rows))
+		for i := len(rows) - 1; i >= 0; i-- {
+			commitment, err := parseUniverseCommitment(rows[i])
+			if err != nil {
+				return err
+			}
+
+			commitments = append(commitments, commitment)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return commitments, nil
+}
+
+// LatestCommitment returns the latest confirmed chain commitment. If there is
+// none, universe.ErrNoCommitment is returned.
+//
+// NOTE: This is part of the universe.Canonical interface.
+func (u *UniverseCommitmentDB) LatestCommitment(
+	ctx context.Context) (*universe.Commitment, error) {
+
+	_, commitment, err := u.latestCommitment(ctx)
+	return commitment, err
+}
+
+// latestCommitment returns the latest confirmed chain commitment along with
+// its ID.
+func (u *UniverseCommitmentDB) latestCommitment(
+	ctx context.Context) (int64, *universe.Commitment, error) {
+
+	var (
+		commitmentID int64
+		commitment   *universe.Commitment
+	)
+
+	readTx := NewUniCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniCommitmentStore) error {
+		rows, err := db.QueryUniverseCommitments(
+			ctx, QueryUniverseCommitmentsParams{
+				Confirmed: true,
+				NumLimit:  1,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			return universe.ErrNoCommitment
+		}
+
+		commitmentID = rows[0].ID
+		commitment, err = parseUniverseCommitment(rows[0])
+
+		return err
+	})
+	if dbErr != nil {
+		return 0, nil, dbErr
+	}
+
+	return commitmentID, commitment, nil
+}
+
+// CommittedProof returns the given universe proof of the universe with the
+// given ID together with the latest chain commitment to its universe root. If
+// the universe root changed since the latest commitment,
+// universe.ErrNoCommitment is returned.
+//
+// NOTE: This is part of the universe.Canonical interface.
+func (u *UniverseCommitmentDB) CommittedProof(ctx context.Context,
+	id universe.Identifier,
+	leafProof *universe.Proof) (*universe.CommittedIssuanceProof, error) {
+
+	commitmentID, commitment, err := u.latestCommitment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := u.fetchSnapshot(ctx, commitmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseProof, err := snapshot.Proof(
+		ctx, id, leafProof.UniverseRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.CommittedIssuanceProof{
+		ChainProof:               commitment,
+		TaprootAssetProof:        leafProof,
+		MultiverseInclusionProof: multiverseProof,
+	}, nil
+}
+
+// fetchSnapshot returns the multiverse trees that the commitment with the
+// given ID commits to, building them from the stored leaves if they aren't
+// cached yet.
+func (u *UniverseCommitmentDB) fetchSnapshot(ctx context.Context,
+	commitmentID int64) (*universe.MultiverseSnapshot, error) {
+
+	u.snapshotMtx.Lock()
+	defer u.snapshotMtx.Unlock()
+
+	if u.snapshot != nil && u.snapshotID == commitmentID {
+		return u.snapshot, nil
+	}
+
+	var leaves []universe.MultiverseLeaf
+
+	readTx := NewUniCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniCommitmentStore) error {
+		dbLeaves, err := db.QueryUniverseCommitmentLeaves(
+			ctx, commitmentID,
+		)
+		if err != nil {
+			return err
+		}
+
+		leaves, err = fn.MapErr(dbLeaves, parseUniCommitmentLeaf)
+
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	snapshot, err := universe.NewMultiverseSnapshot(ctx, leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	u.snapshotID = commitmentID
+	u.snapshot = snapshot
+
+	return snapshot, nil
+}
+
+// parseUniCommitmentLeaf parses a multiverse leaf of a universe commitment
+// from the database.
+func parseUniCommitmentLeaf(
+	dbLeaf UniverseCommitmentLeaf) (universe.MultiverseLeaf, error) {
+
+	var (
+		leaf universe.MultiverseLeaf
+		err  error
+	)
+
+	leaf.ID.ProofType, err = universe.ParseStrProofType(dbLeaf.ProofType)
+	if err != nil {
+		return leaf, err
+	}
+
+	if len(dbLeaf.AssetID) > 0 {
+		copy(leaf.ID.AssetID[:], dbLeaf.AssetID)
+	}
+	if len(dbLeaf.GroupKey) > 0 {
+		leaf.ID.GroupKey, err = schnorr.ParsePubKey(dbLeaf.GroupKey)
+		if err != nil {
+			return leaf, err
+		}
+	}
+
+	leaf.LeafNode = mssmt.NewLeafNode(
+		dbLeaf.UniverseRootHash, uint64(dbLeaf.UniverseRootSum),
+	)
+
+	return leaf, nil
+}
+
+// parseUniverseCommitment parses a universe commitment from the database.
+func parseUniverseCommitment(
+	row UniverseCommitmentRow) (*universe.Commitment, error) {
+
+	var anchorTx wire.MsgTx
+	err := anchorTx.Deserialize(bytes.NewReader(row.AnchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	internalKey, err := btcec.ParsePubKey(row.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse internal key: %w", err)
+	}
+
+	issuanceHash, err := newKey(row.IssuanceRootHash)
+	if err != nil {
+		return nil, err
+	}
+	transferHash, err := newKey(row.TransferRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	issuanceRoot := mssmt.NewComputedBranch(
+		issuanceHash, uint64(row.IssuanceRootSum),
+	)
+	transferRoot := mssmt.NewComputedBranch(
+		transferHash, uint64(row.TransferRootSum),
+	)
+
+	commitment := &universe.Commitment{
+		UniverseRoot: universe.NewCommitmentRoot(
+			issuanceRoot, transferRoot,
+		),
+		IssuanceRoot: issuanceRoot,
+		TransferRoot: transferRoot,
+		AnchorTx:     &anchorTx,
+		OutputIndex:  uint32(row.OutputIndex),
+		InternalKey: keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(row.KeyFamily),
+				Index:  uint32(row.KeyIndex),
+			},
+			PubKey: internalKey,
+		},
+		HeightHint: uint32(row.HeightHint),
+	}
+
+	// Commitments created before they were chained don't have a genesis
+	// outpoint, each of them is the genesis of its own chain.
+	commitment.GenesisOutPoint = commitment.OutPoint()
+	if len(row.GenesisOutpoint) > 0 {
+		err = readOutPoint(
+			bytes.NewReader(row.GenesisOutpoint), 0, 0,
+			&commitment.GenesisOutPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode genesis "+
+				"outpoint: %w", err)
+		}
+	}
+
+	// The remaining fields are only set once the commitment confirmed.
+	if !row.BlockHeight.Valid {
+		return commitment, nil
+	}
+
+	commitment.BlockHeight = uint32(row.BlockHeight.Int32)
+
+	err = commitment.BlockHeader.Deserialize(
+		bytes.NewReader(row.BlockHeader),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode block header: %w", err)
+	}
+
+	var merkleProof proof.TxMerkleProof
+	err = merkleProof.Decode(bytes.NewReader(row.MerkleProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode merkle proof: %w", err)
+	}
+	commitment.MerkleProof = &merkleProof
+
+	return commitment, nil
+}
+
+// A compile-time assertion to ensure UniverseCommitmentDB satisfies the
+// universe.CommitmentLog interface.
+var _ universe.CommitmentLog = (*UniverseCommitmentDB)(nil)
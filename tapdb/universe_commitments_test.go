@@ -0,0 +1,357 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"fmt"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCommitment creates a commitment to the current state of the given
+// multiverse, along with the multiverse leaves it commits to. The commitment
+// spends the output of the given previous commitment or starts a new chain if
+// there is none.
+func newTestCommitment(t *testing.T, multiverse *MultiverseStore,
+	prev *universe.Commitment) (*universe.Commitment,
+	[]universe.MultiverseLeaf) {
+
+	ctx := context.Background()
+
+	leaves, err := universe.FetchMultiverseLeaves(ctx, multiverse)
+	require.NoError(t, err)
+
+	issuanceRoot, transferRoot, err := universe.MultiverseRoots(
+		ctx, leaves,
+	)
+	require.NoError(t, err)
+
+	root := universe.NewCommitmentRoot(issuanceRoot, transferRoot)
+	internalKey := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  test.RandInt[uint32](),
+		},
+		PubKey: test.RandPubKey(t),
+	}
+	pkScript, err := universe.CommitmentPkScript(internalKey.PubKey, root)
+	require.NoError(t, err)
+
+	prevOutPoint := test.RandOp(t)
+	if prev != nil {
+		prevOutPoint = prev.OutPoint()
+	}
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: prevOutPoint,
+	})
+	anchorTx.AddTxOut(wire.NewTxOut(1000, test.RandBytes(34)))
+	anchorTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+
+	commitment := &universe.Commitment{
+		UniverseRoot: root,
+		IssuanceRoot: issuanceRoot,
+		TransferRoot: transferRoot,
+		AnchorTx:     anchorTx,
+		OutputIndex:  1,
+		InternalKey:  internalKey,
+		HeightHint:   100,
+	}
+
+	commitment.GenesisOutPoint = commitment.OutPoint()
+	if prev != nil {
+		commitment.GenesisOutPoint = prev.GenesisOutPoint
+	}
+
+	return commitment, leaves
+}
+
+// confirmTestCommitment confirms the given commitment in a new block at the
+// given height.
+func confirmTestCommitment(t *testing.T, store *UniverseCommitmentDB,
+	commitment *universe.Commitment, height uint32) {
+
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+
+	txs := []*wire.MsgTx{otherTx, commitment.AnchorTx}
+	merkleProof, err := proof.NewTxMerkleProof(txs, 1)
+	require.NoError(t, err)
+
+	blockTxs := []*btcutil.Tx{
+		btcutil.NewTx(otherTx), btcutil.NewTx(commitment.AnchorTx),
+	}
+	header := wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  test.RandHash(),
+		MerkleRoot: blockchain.CalcMerkleRoot(blockTxs, false),
+		Timestamp:  time.Unix(test.RandInt[int64]()%1e9, 0),
+	}
+
+	err = store.ConfirmCommitment(
+		context.Background(), commitment.AnchorTx.TxHash(), height,
+		header, merkleProof,
+	)
+	require.NoError(t, err)
+}
+
+// TestUniverseCommitments tests that chain commitments to the multiverse roots
+// can be stored and confirmed, and that universe proofs are only returned with
+// a chain commitment as long as their universe root didn't change.
+func TestUniverseCommitments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+	multiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+	dbTxer := NewTransactionExecutor(
+		db, func(tx *sql.Tx) UniCommitmentStore {
+			return db.WithTx(tx)
+		},
+	)
+	store := NewUniverseCommitmentDB(
+		dbTxer, clock.NewTestClock(time.Now()),
+	)
+
+	_, err := store.LatestCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// We start out with a single issuance proof in the multiverse.
+	id := randUniverseID(t, false)
+	insertLeaf := func() universe.LeafKey {
+		leafKey := randLeafKey(t)
+		leaf := randMintingLeaf(
+			t, asset.RandGenesis(t, asset.Normal), id.GroupKey,
+		)
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		return leafKey
+	}
+	fetchProof := func(leafKey universe.LeafKey) *universe.Proof {
+		proofs, err := multiverse.FetchProofLeaf(ctx, id, leafKey)
+		require.NoError(t, err)
+		require.Len(t, proofs, 1)
+
+		return proofs[0]
+	}
+	acceptAnyHeader := func(wire.BlockHeader, uint32) error {
+		return nil
+	}
+
+	// The tip of the commitment chain is tracked as if it was the unspent
+	// commitment output on chain.
+	var tip wire.OutPoint
+	isTip := func(outPoint wire.OutPoint, _ []byte) error {
+		if outPoint != tip {
+			return fmt.Errorf("outpoint %v was spent", outPoint)
+		}
+
+		return nil
+	}
+
+	leafKey := insertLeaf()
+
+	// A new commitment is pending until it's confirmed, so there's no
+	// latest commitment yet.
+	commitment, leaves := newTestCommitment(t, multiverse, nil)
+	require.NoError(t, store.InsertCommitment(ctx, commitment, leaves))
+
+	genesis := commitment.OutPoint()
+	tip = genesis
+
+	pending, err := store.PendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(
+		t, commitment.AnchorTx.TxHash(), pending[0].AnchorTx.TxHash(),
+	)
+	require.Equal(t, commitment.InternalKey, pending[0].InternalKey)
+	require.Equal(t, commitment.HeightHint, pending[0].HeightHint)
+	require.Equal(t, genesis, pending[0].GenesisOutPoint)
+	require.True(t, pending[0].IsGenesis())
+	require.False(t, pending[0].Confirmed())
+
+	_, err = store.LatestCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// Once confirmed, the proof is returned along with the commitment,
+	// which is valid.
+	confirmTestCommitment(t, store, commitment, 200)
+
+	pending, err = store.PendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// Commitments created before they were chained have no genesis
+	// outpoint, so they're the genesis of their own chain.
+	_, err = db.ExecContext(
+		ctx, "UPDATE universe_commitments SET genesis_outpoint = NULL",
+	)
+	require.NoError(t, err)
+
+	latest, err := store.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, latest.BlockHeight)
+	require.Equal(t, genesis, latest.GenesisOutPoint)
+	require.NoError(t, latest.Verify(acceptAnyHeader, genesis, isTip))
+
+	committedProof, err := store.CommittedProof(
+		ctx, id, fetchProof(leafKey),
+	)
+	require.NoError(t, err)
+	require.NoError(
+		t, committedProof.Verify(id, acceptAnyHeader, genesis, isTip),
+	)
+
+	// The commitment doesn't verify for another universe or another
+	// chain.
+	otherID := id
+	otherID.ProofType = universe.ProofTypeTransfer
+	err = committedProof.Verify(otherID, acceptAnyHeader, genesis, isTip)
+	require.ErrorIs(t, err, universe.ErrInvalidCommitment)
+	require.ErrorIs(
+		t, latest.Verify(acceptAnyHeader, test.RandOp(t), isTip),
+		universe.ErrInvalidCommitment,
+	)
+
+	// After the universe changed, the latest commitment no longer commits
+	// to its root.
+	newLeafKey := insertLeaf()
+
+	_, err = store.CommittedProof(ctx, id, fetchProof(newLeafKey))
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// A new commitment commits to the new root again, and the leaves of
+	// the old commitment are deleted as they're no longer needed.
+	// It spends the output of the previous commitment, which is no longer
+	// the tip of the chain.
+	newCommitment, newLeaves := newTestCommitment(t, multiverse, latest)
+	err = store.InsertCommitment(ctx, newCommitment, newLeaves)
+	require.NoError(t, err)
+	confirmTestCommitment(t, store, newCommitment, 300)
+	tip = newCommitment.OutPoint()
+
+	require.ErrorIs(
+		t, latest.Verify(acceptAnyHeader, genesis, isTip),
+		universe.ErrInvalidCommitment,
+	)
+
+	committedProof, err = store.CommittedProof(
+		ctx, id, fetchProof(leafKey),
+	)
+	require.NoError(t, err)
+	require.NoError(
+		t, committedProof.Verify(id, acceptAnyHeader, genesis, isTip),
+	)
+	require.EqualValues(t, 300, committedProof.ChainProof.BlockHeight)
+	require.Equal(t, genesis, committedProof.ChainProof.GenesisOutPoint)
+	require.NoError(t, committedProof.ChainProof.VerifyLink(latest))
+	require.Error(t, latest.VerifyLink(committedProof.ChainProof))
+
+	oldLeaves, err := db.QueryUniverseCommitmentLeaves(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, oldLeaves)
+
+	// A leaf of another universe is added, so a new commitment only
+	// stores that leaf and shares the unchanged one.
+	otherUniID := randUniverseID(t, false)
+	otherLeaf := randMintingLeaf(
+		t, asset.RandGenesis(t, asset.Normal), otherUniID.GroupKey,
+	)
+	_, err = multiverse.UpsertProofLeaf(
+		ctx, otherUniID, randLeafKey(t), &otherLeaf, nil,
+	)
+	require.NoError(t, err)
+
+	pendingCommitment, pendingLeaves := newTestCommitment(
+		t, multiverse, committedProof.ChainProof,
+	)
+	err = store.InsertCommitment(ctx, pendingCommitment, pendingLeaves)
+	require.NoError(t, err)
+
+	pendingRows, err := db.QueryUniverseCommitmentLeaves(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, pendingRows, len(pendingLeaves))
+	require.Len(t, pendingRows, len(newLeaves)+1)
+
+	var numAdded int
+	err = db.QueryRowContext(
+		ctx, "SELECT COUNT(*) FROM universe_commitment_leaves "+
+			"WHERE commitment_id = 3",
+	).Scan(&numAdded)
+	require.NoError(t, err)
+	require.Equal(t, 1, numAdded)
+
+	// The anchor transaction of the pending commitment can be replaced,
+	// but only once.
+	replacementTx := pendingCommitment.AnchorTx.Copy()
+	replacementTx.TxOut[0].Value--
+	err = store.ReplaceAnchorTx(
+		ctx, pendingCommitment.AnchorTx.TxHash(), replacementTx, 1, 301,
+	)
+	require.NoError(t, err)
+
+	err = store.ReplaceAnchorTx(
+		ctx, pendingCommitment.AnchorTx.TxHash(), replacementTx, 1, 301,
+	)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	pending, err = store.PendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, replacementTx.TxHash(), pending[0].AnchorTx.TxHash())
+	require.EqualValues(t, 301, pending[0].HeightHint)
+	require.Equal(t, genesis, pending[0].GenesisOutPoint)
+	require.Equal(
+		t, pendingCommitment.UniverseRoot.NodeHash(),
+		pending[0].UniverseRoot.NodeHash(),
+	)
+
+	// Confirmed commitments can't be replaced.
+	err = store.ReplaceAnchorTx(
+		ctx, newCommitment.AnchorTx.TxHash(), replacementTx, 1, 301,
+	)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// Abandoning the pending commitment restores the leaves of the latest
+	// confirmed one, which is still valid.
+	err = store.AbandonCommitment(ctx, replacementTx.TxHash())
+	require.NoError(t, err)
+
+	pending, err = store.PendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	latestRows, err := db.QueryLatestUniverseCommitmentLeaves(ctx)
+	require.NoError(t, err)
+	require.Len(t, latestRows, len(newLeaves))
+
+	latest, err = store.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 300, latest.BlockHeight)
+	require.NoError(t, latest.Verify(acceptAnyHeader, genesis, isTip))
+
+	// Confirmed and unknown commitments can't be abandoned.
+	err = store.AbandonCommitment(ctx, newCommitment.AnchorTx.TxHash())
+	require.Error(t, err)
+
+	err = store.AbandonCommitment(ctx, test.RandHash())
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+}
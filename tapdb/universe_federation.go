@@ -62,6 +62,27 @@ type (
 
 	// QueryUniServersParams is used to query for universe servers.
 	QueryUniServersParams = sqlc.QueryUniverseServersParams
+
+	// UniServerReputationParams is used to update the reputation score of
+	// a universe server.
+	UniServerReputationParams = sqlc.UpdateUniverseServerReputationParams
+
+	// NewUniServerFailure is used to log a failure of a universe server.
+	NewUniServerFailure = sqlc.InsertUniverseServerFailureParams
+
+	// QueryUniServerFailuresParams is used to query for the failures of a
+	// universe server.
+	QueryUniServerFailuresParams = sqlc.QueryUniverseServerFailuresParams
+
+	// UniServerFailure is a failure of a universe server returned from a
+	// query.
+	UniServerFailure = sqlc.QueryUniverseServerFailuresRow
+)
+
+const (
+	// maxServerFailures is the maximum number of recent failures that are
+	// returned as part of the reputation of a universe server.
+	maxServerFailures = 10
 )
 
 var (
@@ -144,6 +165,20 @@ type UniverseServerStore interface {
 	QueryUniverseServers(ctx context.Context,
 		arg sqlc.QueryUniverseServersParams) ([]sqlc.UniverseServer,
 		error)
+
+	// UpdateUniverseServerReputation sets the reputation score of a
+	// universe server.
+	UpdateUniverseServerReputation(ctx context.Context,
+		arg UniServerReputationParams) error
+
+	// InsertUniverseServerFailure logs a new failure of a universe server.
+	InsertUniverseServerFailure(ctx context.Context,
+		arg NewUniServerFailure) error
+
+	// QueryUniverseServerFailures returns the most recent failures of a
+	// universe server, newest first.
+	QueryUniverseServerFailures(ctx context.Context,
+		arg QueryUniServerFailuresParams) ([]UniServerFailure, error)
 }
 
 // UniverseFederationOptions is the database tx object for the universe server store.
@@ -226,20 +261,32 @@ func (u *UniverseFederationDB) UniverseServers(
 			return err
 		}
 
-		uniServers = fn.Map(servers,
-			func(s sqlc.UniverseServer) universe.ServerAddr {
-				return universe.NewServerAddr(
-					s.ID, s.ServerHost,
-				)
-			},
-		)
+		uniServers, err = fn.MapErr(servers, parseUniverseServer)
 
-		return nil
+		return err
 	})
 
 	return uniServers, dbErr
 }
 
+// parseUniverseServer parses the address of a universe server from its DB
+// row.
+func parseUniverseServer(s sqlc.UniverseServer) (universe.ServerAddr, error) {
+	addr := universe.NewServerAddr(s.ID, s.ServerHost)
+
+	if len(s.IdentityKey) != 0 {
+		identityKey, err := btcec.ParsePubKey(s.IdentityKey)
+		if err != nil {
+			return addr, fmt.Errorf("unable to parse identity key "+
+				"of server %v: %w", s.ServerHost, err)
+		}
+
+		addr.IdentityKey = identityKey
+	}
+
+	return addr, nil
+}
+
 // AddServers adds a slice of servers to the federation.
 func (u *UniverseFederationDB) AddServers(ctx context.Context,
 	addrs ...universe.ServerAddr) error {
@@ -251,6 +298,11 @@ func (u *UniverseFederationDB) AddServers(ctx context.Context,
 				ServerHost:   a.HostStr(),
 				LastSyncTime: time.Now(),
 			}
+			if a.IdentityKey != nil {
+				addr.IdentityKey =
+					a.IdentityKey.SerializeCompressed()
+			}
+
 			return db.InsertUniverseServer(ctx, addr)
 		})
 	})
@@ -308,6 +360,188 @@ func (u *UniverseFederationDB) LogNewSyncs(ctx context.Context,
 	})
 }
 
+// fetchUniverseServer fetches the DB row of the given universe server. If the
+// server isn't part of the federation, nil is returned.
+func fetchUniverseServer(ctx context.Context, db UniverseServerStore,
+	addr universe.ServerAddr) (*sqlc.UniverseServer, error) {
+
+	// Servers are identified by their host string if it's set, and by
+	// their ID otherwise.
+	var query QueryUniServersParams
+	if addr.HostStr() != "" {
+		query.ServerHost = sqlStr(addr.HostStr())
+	} else {
+		query.ID = sqlInt64(addr.ID)
+	}
+
+	servers, err := db.QueryUniverseServers(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(servers) == 0 {
+		return nil, nil
+	}
+
+	return &servers[0], nil
+}
+
+// QueryServerReputations returns the reputation of the given servers, or of
+// all servers of the federation if none are given. Servers that aren't part of
+// the federation are skipped.
+func (u *UniverseFederationDB) QueryServerReputations(ctx context.Context,
+	addrs ...universe.ServerAddr) ([]universe.ServerReputation, error) {
+
+	var reputations []universe.ServerReputation
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		reputations = nil
+
+		var servers []sqlc.UniverseServer
+		if len(addrs) == 0 {
+			var err error
+			servers, err = db.QueryUniverseServers(
+				ctx, QueryUniServersParams{},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, addr := range addrs {
+			server, err := fetchUniverseServer(ctx, db, addr)
+			if err != nil {
+				return err
+			}
+
+			if server != nil {
+				servers = append(servers, *server)
+			}
+		}
+
+		for _, server := range servers {
+			addr, err := parseUniverseServer(server)
+			if err != nil {
+				return err
+			}
+
+			failures, err := db.QueryUniverseServerFailures(
+				ctx, QueryUniServerFailuresParams{
+					ServerID: server.ID,
+					NumLimit: maxServerFailures,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			reputations = append(
+				reputations, universe.ServerReputation{
+					Addr:  addr,
+					Score: server.ReputationScore,
+					Failures: fn.Map(
+						failures,
+						parseUniServerFailure,
+					),
+				},
+			)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return reputations, nil
+}
+
+// parseUniServerFailure parses a universe server failure from its DB row.
+func parseUniServerFailure(f UniServerFailure) universe.ServerFailure {
+	return universe.ServerFailure{
+		Time:   f.FailureTime.UTC(),
+		Reason: f.Reason,
+	}
+}
+
+// LogServerFailure records a failure of the given server and lowers its
+// reputation score by the given penalty.
+func (u *UniverseFederationDB) LogServerFailure(ctx context.Context,
+	addr universe.ServerAddr, reason string, penalty int32) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		server, err := fetchUniverseServer(ctx, db, addr)
+		if err != nil {
+			return err
+		}
+
+		// Servers that aren't part of the federation don't have a
+		// reputation.
+		if server == nil {
+			return nil
+		}
+
+		err = db.InsertUniverseServerFailure(ctx, NewUniServerFailure{
+			ServerID:    server.ID,
+			FailureTime: u.clock.Now().UTC(),
+			Reason:      reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		score := server.ReputationScore - penalty
+		if score < 0 {
+			score = 0
+		}
+
+		return db.UpdateUniverseServerReputation(
+			ctx, UniServerReputationParams{
+				ReputationScore: score,
+				ServerID:        server.ID,
+			},
+		)
+	})
+}
+
+// RewardServer raises the reputation score of the given server by the given
+// reward, up to universe.MaxReputationScore.
+func (u *UniverseFederationDB) RewardServer(ctx context.Context,
+	addr universe.ServerAddr, reward int32) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		server, err := fetchUniverseServer(ctx, db, addr)
+		if err != nil {
+			return err
+		}
+
+		// Servers that aren't part of the federation don't have a
+		// reputation.
+		if server == nil {
+			return nil
+		}
+
+		score := server.ReputationScore + reward
+		if score > universe.MaxReputationScore {
+			score = universe.MaxReputationScore
+		}
+
+		if score == server.ReputationScore {
+			return nil
+		}
+
+		return db.UpdateUniverseServerReputation(
+			ctx, UniServerReputationParams{
+				ReputationScore: score,
+				ServerID:        server.ID,
+			},
+		)
+	})
+}
+
 // UpsertFederationProofSyncLog upserts a federation proof sync log entry for a
 // given universe server and proof.
 func (u *UniverseFederationDB) UpsertFederationProofSyncLog(
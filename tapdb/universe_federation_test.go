@@ -513,3 +513,94 @@ func TestFederationConfigCRUD(t *testing.T) {
 	localCfg = fn.MakeSlice(groupNewCfg, assetCfg)
 	require.Equal(t, localCfg, dbLocalCfg)
 }
+
+// TestFederationServerReputation tests that the identity key and reputation of
+// universe servers are stored, and that failures lower the reputation.
+func TestFederationServerReputation(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	// We add one server with a pinned identity key, and one without.
+	identityKey := test.RandPubKey(t)
+	authAddr := universe.NewServerAddrFromStr("localhost:10001")
+	authAddr.IdentityKey = identityKey
+	plainAddr := universe.NewServerAddrFromStr("localhost:10002")
+
+	err := fedDB.AddServers(ctx, authAddr, plainAddr)
+	require.NoError(t, err)
+
+	dbAddrs, err := fedDB.UniverseServers(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, 2)
+	require.True(t, identityKey.IsEqual(dbAddrs[0].IdentityKey))
+	require.Nil(t, dbAddrs[1].IdentityKey)
+
+	// Both servers start with the maximum score and no failures.
+	reputations, err := fedDB.QueryServerReputations(ctx)
+	require.NoError(t, err)
+	require.Len(t, reputations, 2)
+	for _, reputation := range reputations {
+		require.Equal(
+			t, universe.MaxReputationScore, reputation.Score,
+		)
+		require.Empty(t, reputation.Failures)
+	}
+
+	// Each failure lowers the score of the server, and is returned newest
+	// first.
+	for _, reason := range []string{"first", "second"} {
+		err = fedDB.LogServerFailure(ctx, authAddr, reason, 30)
+		require.NoError(t, err)
+
+		testClock.SetTime(testClock.Now().Add(time.Second))
+	}
+
+	reputations, err = fedDB.QueryServerReputations(ctx, authAddr)
+	require.NoError(t, err)
+	require.Len(t, reputations, 1)
+	require.EqualValues(t, 40, reputations[0].Score)
+	require.True(t, identityKey.IsEqual(reputations[0].Addr.IdentityKey))
+	require.Len(t, reputations[0].Failures, 2)
+	require.Equal(t, "second", reputations[0].Failures[0].Reason)
+	require.Equal(t, "first", reputations[0].Failures[1].Reason)
+
+	// The score never drops below zero, and rewards never raise it above
+	// the maximum.
+	err = fedDB.LogServerFailure(ctx, authAddr, "third", 100)
+	require.NoError(t, err)
+	err = fedDB.RewardServer(ctx, plainAddr, 10)
+	require.NoError(t, err)
+
+	reputations, err = fedDB.QueryServerReputations(
+		ctx, authAddr, plainAddr,
+	)
+	require.NoError(t, err)
+	require.Len(t, reputations, 2)
+	require.Zero(t, reputations[0].Score)
+	require.Equal(t, universe.MaxReputationScore, reputations[1].Score)
+
+	// Servers that aren't part of the federation don't have a reputation.
+	unknownAddr := universe.NewServerAddrFromStr("localhost:10003")
+	err = fedDB.LogServerFailure(ctx, unknownAddr, "unknown", 10)
+	require.NoError(t, err)
+
+	reputations, err = fedDB.QueryServerReputations(ctx, unknownAddr)
+	require.NoError(t, err)
+	require.Empty(t, reputations)
+
+	// Re-adding a removed server resets its reputation.
+	err = fedDB.RemoveServers(ctx, authAddr)
+	require.NoError(t, err)
+	err = fedDB.AddServers(ctx, authAddr)
+	require.NoError(t, err)
+
+	reputations, err = fedDB.QueryServerReputations(ctx, authAddr)
+	require.NoError(t, err)
+	require.Len(t, reputations, 1)
+	require.Equal(t, universe.MaxReputationScore, reputations[0].Score)
+	require.Empty(t, reputations[0].Failures)
+}
@@ -0,0 +1,412 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewSignedMultiverseRoot is used to insert a new signed multiverse
+	// root.
+	NewSignedMultiverseRoot = sqlc.InsertSignedMultiverseRootParams
+
+	// FetchSignedMultiverseRootParams is used to fetch the signed root of
+	// a universe server for an epoch.
+	FetchSignedMultiverseRootParams = sqlc.FetchSignedMultiverseRootParams
+
+	// SignedMultiverseRootRow is a signed multiverse root returned from a
+	// query.
+	SignedMultiverseRootRow = sqlc.QuerySignedMultiverseRootsRow
+
+	// NewUniverseEquivocation is used to insert the evidence of a universe
+	// server equivocating.
+	NewUniverseEquivocation = sqlc.InsertUniverseEquivocationParams
+
+	// QueryUniverseEquivocationsParams is used to query for the evidence
+	// of equivocations within a time range.
+	QueryUniverseEquivocationsParams = sqlc.QueryUniverseEquivocationsParams
+
+	// UniverseEquivocation is the evidence of a universe server
+	// equivocating, returned from a query.
+	UniverseEquivocation = sqlc.UniverseEquivocation
+)
+
+// RootGossipStore is the set of queries that are needed to keep track of the
+// signed multiverse roots of the universe servers.
+type RootGossipStore interface {
+	// InsertSignedMultiverseRoot inserts a new signed multiverse root,
+	// unless there already is one of the same server for the same epoch.
+	InsertSignedMultiverseRoot(ctx context.Context,
+		arg NewSignedMultiverseRoot) error
+
+	// FetchSignedMultiverseRoot returns the signed multiverse root of a
+	// universe server for an epoch.
+	FetchSignedMultiverseRoot(ctx context.Context,
+		arg FetchSignedMultiverseRootParams) (
+		sqlc.FetchSignedMultiverseRootRow, error)
+
+	// QuerySignedMultiverseRoots returns all signed multiverse roots for
+	// all epochs starting at the given one.
+	QuerySignedMultiverseRoots(ctx context.Context,
+		minEpoch int64) ([]SignedMultiverseRootRow, error)
+
+	// InsertUniverseEquivocation inserts the evidence of a universe server
+	// equivocating and returns its ID, unless there already is evidence
+	// for the same server and epoch.
+	InsertUniverseEquivocation(ctx context.Context,
+		arg NewUniverseEquivocation) (int64, error)
+
+	// QueryUniverseEquivocations returns the evidence of equivocations
+	// that were detected within a time range.
+	QueryUniverseEquivocations(ctx context.Context,
+		arg QueryUniverseEquivocationsParams) ([]UniverseEquivocation,
+		error)
+}
+
+// RootGossipTxOptions defines the set of db txn options the RootGossipStore
+// understands.
+type RootGossipTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *RootGossipTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewRootGossipReadTx creates a new read transaction option set.
+func NewRootGossipReadTx() RootGossipTxOptions {
+	return RootGossipTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedRootGossipStore combines the RootGossipStore interface with the
+// BatchedTx interface, allowing for multiple queries to be executed in a single
+// SQL transaction.
+type BatchedRootGossipStore interface {
+	RootGossipStore
+
+	BatchedTx[RootGossipStore]
+}
+
+// RootGossipDB is a database backed log of the signed multiverse roots of the
+// universe servers, and of the evidence of any of them equivocating.
+//
+// NOTE: This implements the universe.RootGossipLog interface.
+type RootGossipDB struct {
+	db BatchedRootGossipStore
+
+	clock clock.Clock
+}
+
+// NewRootGossipDB creates a new root gossip log from the given database.
+func NewRootGossipDB(db BatchedRootGossipStore,
+	clock clock.Clock) *RootGossipDB {
+
+	return &RootGossipDB{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// FetchSignedRoot returns the signed root of the universe server with the
+// given key for the given epoch.
+func (r *RootGossipDB) FetchSignedRoot(ctx context.Context,
+	serverKey *btcec.PublicKey,
+	epoch uint64) (*universe.SignedMultiverseRoot, error) {
+
+	var signedRoot *universe.SignedMultiverseRoot
+
+	readTx := NewRootGossipReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RootGossipStore) error {
+		var err error
+		signedRoot, err = fetchSignedRoot(ctx, db, serverKey, epoch)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return signedRoot, nil
+}
+
+// fetchSignedRoot returns the signed root of the universe server with the
+// given key for the given epoch, or universe.ErrNoSignedRoot if there is none.
+func fetchSignedRoot(ctx context.Context, db RootGossipStore,
+	serverKey *btcec.PublicKey,
+	epoch uint64) (*universe.SignedMultiverseRoot, error) {
+
+	row, err := db.FetchSignedMultiverseRoot(
+		ctx, FetchSignedMultiverseRootParams{
+			ServerKey: serverKey.SerializeCompressed(),
+			Epoch:     int64(epoch),
+		},
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, universe.ErrNoSignedRoot
+
+	case err != nil:
+		return nil, err
+	}
+
+	return parseSignedRoot(SignedMultiverseRootRow(row))
+}
+
+// InsertSignedRoot stores the given signed root, unless there already is a
+// signed root of the same universe server for the same epoch. The stored root
+// is returned in either case.
+func (r *RootGossipDB) InsertSignedRoot(ctx context.Context,
+	root *universe.SignedMultiverseRoot) (*universe.SignedMultiverseRoot,
+	error) {
+
+	issuanceRoot := root.IssuanceRoot.NodeHash()
+	transferRoot := root.TransferRoot.NodeHash()
+	newRoot := NewSignedMultiverseRoot{
+		ServerKey:        root.ServerKey.SerializeCompressed(),
+		Epoch:            int64(root.Epoch),
+		IssuanceRootHash: issuanceRoot[:],
+		IssuanceRootSum:  int64(root.IssuanceRoot.NodeSum()),
+		TransferRootHash: transferRoot[:],
+		TransferRootSum:  int64(root.TransferRoot.NodeSum()),
+		Signature:        root.Signature,
+		ReceivedAt:       r.clock.Now().UTC(),
+	}
+
+	var storedRoot *universe.SignedMultiverseRoot
+
+	var writeTx RootGossipTxOptions
+	dbErr := r.db.ExecTx(ctx, &writeTx, func(db RootGossipStore) error {
+		err := db.InsertSignedMultiverseRoot(ctx, newRoot)
+		if err != nil {
+			return fmt.Errorf("unable to insert signed root: %w",
+				err)
+		}
+
+		storedRoot, err = fetchSignedRoot(
+			ctx, db, root.ServerKey, root.Epoch,
+		)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return storedRoot, nil
+}
+
+// QuerySignedRoots returns all stored signed roots for all epochs starting at
+// the given one.
+func (r *RootGossipDB) QuerySignedRoots(ctx context.Context,
+	minEpoch uint64) ([]universe.SignedMultiverseRoot, error) {
+
+	var roots []universe.SignedMultiverseRoot
+
+	readTx := NewRootGossipReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RootGossipStore) error {
+		rows, err := db.QuerySignedMultiverseRoots(
+			ctx, int64(minEpoch),
+		)
+		if err != nil {
+			return err
+		}
+
+		roots = make([]universe.SignedMultiverseRoot, 0, len(rows))
+		for _, row := range rows {
+			root, err := parseSignedRoot(row)
+			if err != nil {
+				return err
+			}
+
+			roots = append(roots, *root)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return roots, nil
+}
+
+// LogEquivocation stores the given evidence of a universe server equivocating.
+// False is returned if evidence for the same universe server and epoch was
+// already stored.
+func (r *RootGossipDB) LogEquivocation(ctx context.Context,
+	equivocation *universe.Equivocation) (bool, error) {
+
+	first, second := &equivocation.First, &equivocation.Second
+	firstIssuanceRoot := first.IssuanceRoot.NodeHash()
+	firstTransferRoot := first.TransferRoot.NodeHash()
+	secondIssuanceRoot := second.IssuanceRoot.NodeHash()
+	secondTransferRoot := second.TransferRoot.NodeHash()
+	newEquivocation := NewUniverseEquivocation{
+		ServerKey:              first.ServerKey.SerializeCompressed(),
+		Epoch:                  int64(first.Epoch),
+		FirstIssuanceRootHash:  firstIssuanceRoot[:],
+		FirstIssuanceRootSum:   int64(first.IssuanceRoot.NodeSum()),
+		FirstTransferRootHash:  firstTransferRoot[:],
+		FirstTransferRootSum:   int64(first.TransferRoot.NodeSum()),
+		FirstSignature:         first.Signature,
+		SecondIssuanceRootHash: secondIssuanceRoot[:],
+		SecondIssuanceRootSum:  int64(second.IssuanceRoot.NodeSum()),
+		SecondTransferRootHash: secondTransferRoot[:],
+		SecondTransferRootSum:  int64(second.TransferRoot.NodeSum()),
+		SecondSignature:        second.Signature,
+		Reporter:               equivocation.Reporter,
+		DetectedAt:             equivocation.DetectedAt.UTC(),
+	}
+
+	var isNew bool
+
+	var writeTx RootGossipTxOptions
+	dbErr := r.db.ExecTx(ctx, &writeTx, func(db RootGossipStore) error {
+		_, err := db.InsertUniverseEquivocation(ctx, newEquivocation)
+		switch {
+		// Nothing is returned if the evidence was already stored.
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		isNew = true
+
+		return nil
+	})
+	if dbErr != nil {
+		return false, dbErr
+	}
+
+	return isNew, nil
+}
+
+// QueryEquivocations returns the evidence of equivocations that were detected
+// within the given time range.
+func (r *RootGossipDB) QueryEquivocations(ctx context.Context, startTime,
+	endTime time.Time) ([]universe.Equivocation, error) {
+
+	var equivocations []universe.Equivocation
+
+	readTx := NewRootGossipReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RootGossipStore) error {
+		rows, err := db.QueryUniverseEquivocations(
+			ctx, QueryUniverseEquivocationsParams{
+				StartTime: startTime.UTC(),
+				EndTime:   endTime.UTC(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		equivocations, err = fn.MapErr(rows, parseEquivocation)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return equivocations, nil
+}
+
+// newSignedRoot creates a signed multiverse root from its database fields.
+func newSignedRoot(serverKey *btcec.PublicKey, epoch int64, issuanceRootHash,
+	transferRootHash []byte, issuanceRootSum, transferRootSum int64,
+	sig []byte) (*universe.SignedMultiverseRoot, error) {
+
+	issuanceHash, err := newKey(issuanceRootHash)
+	if err != nil {
+		return nil, err
+	}
+	transferHash, err := newKey(transferRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.SignedMultiverseRoot{
+		ServerKey: serverKey,
+		Epoch:     uint64(epoch),
+		IssuanceRoot: mssmt.NewComputedBranch(
+			issuanceHash, uint64(issuanceRootSum),
+		),
+		TransferRoot: mssmt.NewComputedBranch(
+			transferHash, uint64(transferRootSum),
+		),
+		Signature: sig,
+	}, nil
+}
+
+// parseSignedRoot parses a signed multiverse root from the database.
+func parseSignedRoot(
+	row SignedMultiverseRootRow) (*universe.SignedMultiverseRoot, error) {
+
+	serverKey, err := btcec.ParsePubKey(row.ServerKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse server key: %w", err)
+	}
+
+	return newSignedRoot(
+		serverKey, row.Epoch, row.IssuanceRootHash,
+		row.TransferRootHash, row.IssuanceRootSum, row.TransferRootSum,
+		row.Signature,
+	)
+}
+
+// parseEquivocation parses the evidence of an equivocation from the database.
+func parseEquivocation(
+	row UniverseEquivocation) (universe.Equivocation, error) {
+
+	var equivocation universe.Equivocation
+
+	serverKey, err := btcec.ParsePubKey(row.ServerKey)
+	if err != nil {
+		return equivocation, fmt.Errorf("unable to parse server key: "+
+			"%w", err)
+	}
+
+	first, err := newSignedRoot(
+		serverKey, row.Epoch, row.FirstIssuanceRootHash,
+		row.FirstTransferRootHash, row.FirstIssuanceRootSum,
+		row.FirstTransferRootSum, row.FirstSignature,
+	)
+	if err != nil {
+		return equivocation, err
+	}
+
+	second, err := newSignedRoot(
+		serverKey, row.Epoch, row.SecondIssuanceRootHash,
+		row.SecondTransferRootHash, row.SecondIssuanceRootSum,
+		row.SecondTransferRootSum, row.SecondSignature,
+	)
+	if err != nil {
+		return equivocation, err
+	}
+
+	return universe.Equivocation{
+		First:      *first,
+		Second:     *second,
+		Reporter:   row.Reporter,
+		DetectedAt: row.DetectedAt.UTC(),
+	}, nil
+}
+
+// A compile-time assertion to ensure that RootGossipDB meets the
+// universe.RootGossipLog interface.
+var _ universe.RootGossipLog = (*RootGossipDB)(nil)
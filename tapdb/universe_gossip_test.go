@@ -0,0 +1,141 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// randSignedRoot returns a signed root with random multiverse roots and
+// signature for the given server and epoch.
+func randSignedRoot(serverKey *btcec.PublicKey,
+	epoch uint64) *universe.SignedMultiverseRoot {
+
+	issuanceHash, transferHash := test.RandHash(), test.RandHash()
+
+	return &universe.SignedMultiverseRoot{
+		ServerKey: serverKey,
+		Epoch:     epoch,
+		IssuanceRoot: mssmt.NewComputedBranch(
+			mssmt.NodeHash(issuanceHash),
+			uint64(test.RandInt[uint32]()),
+		),
+		TransferRoot: mssmt.NewComputedBranch(
+			mssmt.NodeHash(transferHash),
+			uint64(test.RandInt[uint32]()),
+		),
+		Signature: test.RandBytes(64),
+	}
+}
+
+// assertSignedRootEqual asserts that both signed roots are equal.
+func assertSignedRootEqual(t *testing.T, expected,
+	actual *universe.SignedMultiverseRoot) {
+
+	require.True(t, expected.ServerKey.IsEqual(actual.ServerKey))
+	require.Equal(t, expected.Epoch, actual.Epoch)
+	require.True(t, mssmt.IsEqualNode(
+		expected.IssuanceRoot, actual.IssuanceRoot,
+	))
+	require.True(t, mssmt.IsEqualNode(
+		expected.TransferRoot, actual.TransferRoot,
+	))
+	require.Equal(t, expected.Signature, actual.Signature)
+}
+
+// TestRootGossipDB tests that only the first signed root of a universe server
+// per epoch is stored, and that the evidence of equivocations is stored once
+// per server and epoch.
+func TestRootGossipDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+	testClock := clock.NewTestClock(time.Now())
+	dbTxer := NewTransactionExecutor(
+		db, func(tx *sql.Tx) RootGossipStore {
+			return db.WithTx(tx)
+		},
+	)
+	store := NewRootGossipDB(dbTxer, testClock)
+
+	serverKey := test.RandPubKey(t)
+	_, err := store.FetchSignedRoot(ctx, serverKey, 10)
+	require.ErrorIs(t, err, universe.ErrNoSignedRoot)
+
+	// The first root of a server for an epoch is stored, any later ones
+	// for the same epoch are not.
+	root := randSignedRoot(serverKey, 10)
+	storedRoot, err := store.InsertSignedRoot(ctx, root)
+	require.NoError(t, err)
+	assertSignedRootEqual(t, root, storedRoot)
+
+	conflictingRoot := randSignedRoot(serverKey, 10)
+	storedRoot, err = store.InsertSignedRoot(ctx, conflictingRoot)
+	require.NoError(t, err)
+	assertSignedRootEqual(t, root, storedRoot)
+	require.True(t, storedRoot.Conflicts(conflictingRoot))
+
+	storedRoot, err = store.FetchSignedRoot(ctx, serverKey, 10)
+	require.NoError(t, err)
+	assertSignedRootEqual(t, root, storedRoot)
+
+	// Roots of other epochs and servers are stored separately, and can be
+	// queried by their epoch.
+	_, err = store.InsertSignedRoot(ctx, randSignedRoot(serverKey, 11))
+	require.NoError(t, err)
+	_, err = store.InsertSignedRoot(
+		ctx, randSignedRoot(test.RandPubKey(t), 11),
+	)
+	require.NoError(t, err)
+
+	roots, err := store.QuerySignedRoots(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, roots, 3)
+	assertSignedRootEqual(t, root, &roots[0])
+
+	roots, err = store.QuerySignedRoots(ctx, 11)
+	require.NoError(t, err)
+	require.Len(t, roots, 2)
+
+	// The evidence of an equivocation is only stored once.
+	equivocation := &universe.Equivocation{
+		First:      *root,
+		Second:     *conflictingRoot,
+		Reporter:   "localhost:10029",
+		DetectedAt: testClock.Now(),
+	}
+	isNew, err := store.LogEquivocation(ctx, equivocation)
+	require.NoError(t, err)
+	require.True(t, isNew)
+
+	isNew, err = store.LogEquivocation(ctx, equivocation)
+	require.NoError(t, err)
+	require.False(t, isNew)
+
+	equivocations, err := store.QueryEquivocations(
+		ctx, testClock.Now().Add(-time.Minute),
+		testClock.Now().Add(time.Minute),
+	)
+	require.NoError(t, err)
+	require.Len(t, equivocations, 1)
+	assertSignedRootEqual(t, root, &equivocations[0].First)
+	assertSignedRootEqual(t, conflictingRoot, &equivocations[0].Second)
+	require.Equal(t, equivocation.Reporter, equivocations[0].Reporter)
+
+	// Equivocations outside the time range aren't returned.
+	equivocations, err = store.QueryEquivocations(
+		ctx, testClock.Now().Add(time.Minute),
+		testClock.Now().Add(time.Hour),
+	)
+	require.NoError(t, err)
+	require.Empty(t, equivocations)
+}
@@ -0,0 +1,369 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+type (
+	// AssetSearchEntry is the search index entry of a single asset.
+	AssetSearchEntry = sqlc.UpsertAssetSearchEntryParams
+
+	// AssetSearchParams is the query used to search the asset search
+	// index.
+	AssetSearchParams = sqlc.SearchAssetsParams
+
+	// AssetSearchRow is a single asset returned by an asset search.
+	AssetSearchRow = sqlc.SearchAssetsRow
+
+	// UnindexedIssuanceAsset is an asset of an issuance universe that
+	// isn't in the asset search index yet.
+	UnindexedIssuanceAsset = sqlc.FetchUnindexedIssuanceAssetsRow
+)
+
+// assetSearchBackfillBatchSize is the number of assets that are added to the
+// asset search index at a time when backfilling it.
+const assetSearchBackfillBatchSize = 500
+
+// AssetSearchStore is the set of queries that maintain and query the asset
+// search index.
+type AssetSearchStore interface {
+	// UpsertAssetSearchEntry inserts or updates the search index entry of
+	// an asset.
+	UpsertAssetSearchEntry(ctx context.Context, arg AssetSearchEntry) error
+
+	// FetchUnindexedIssuanceAssets returns the assets of the issuance
+	// universes that aren't in the asset search index yet.
+	FetchUnindexedIssuanceAssets(ctx context.Context,
+		numLimit int32) ([]UnindexedIssuanceAsset, error)
+
+	// SearchAssetsSqlite searches the asset search index using the FTS5
+	// table of a SQLite database.
+	SearchAssetsSqlite(ctx context.Context,
+		arg AssetSearchParams) ([]AssetSearchRow, error)
+
+	// SearchAssetsPostgres searches the asset search index using the
+	// full-text search of a Postgres database.
+	SearchAssetsPostgres(ctx context.Context,
+		arg AssetSearchParams) ([]AssetSearchRow, error)
+}
+
+// assetSearchWords splits the given text into the lower case words that are
+// matched by an asset search. Anything that isn't a letter or a digit
+// separates two words.
+func assetSearchWords(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for idx := range words {
+		words[idx] = strings.ToLower(words[idx])
+	}
+
+	return words
+}
+
+// metaJSONSearchWords returns the search words of all keys and values of the
+// given decoded meta JSON. The keys of objects are visited in sorted order to
+// make the result deterministic.
+func metaJSONSearchWords(value interface{}) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var words []string
+		for _, key := range keys {
+			words = append(words, assetSearchWords(key)...)
+			words = append(words, metaJSONSearchWords(v[key])...)
+		}
+
+		return words
+
+	case []interface{}:
+		var words []string
+		for _, elem := range v {
+			words = append(words, metaJSONSearchWords(elem)...)
+		}
+
+		return words
+
+	case string:
+		return assetSearchWords(v)
+
+	case float64:
+		return assetSearchWords(strconv.FormatFloat(v, 'f', -1, 64))
+
+	default:
+		return nil
+	}
+}
+
+// newAssetSearchEntry creates the search index entry of an asset. The meta
+// data is only indexed if it is valid JSON. The search text contains every
+// word of the asset name and the meta JSON once.
+func newAssetSearchEntry(assetID asset.ID, name string, assetType asset.Type,
+	groupKey *btcec.PublicKey, meta *proof.MetaReveal,
+	genesisHeight uint32) AssetSearchEntry {
+
+	entry := AssetSearchEntry{
+		AssetID:       fn.CopySlice(assetID[:]),
+		AssetName:     name,
+		AssetType:     int16(assetType),
+		GenesisHeight: sqlInt32(genesisHeight),
+	}
+	if groupKey != nil {
+		entry.GroupKey = groupKey.SerializeCompressed()
+	}
+
+	words := assetSearchWords(name)
+	if meta != nil && meta.Type == proof.MetaJson {
+		metaJSON, err := proof.DecodeMetaJSON(meta.Data)
+		if err == nil {
+			entry.MetaJson = sqlStr(string(meta.Data))
+			words = append(words, metaJSONSearchWords(metaJSON)...)
+		}
+
+		decDisplay, err := meta.DecDisplayOption()
+		if err == nil {
+			decDisplay.WhenSome(func(d uint32) {
+				entry.DecimalDisplay = sqlInt32(d)
+			})
+		}
+	}
+
+	uniqueWords := make([]string, 0, len(words))
+	seen := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		if _, ok := seen[word]; ok {
+			continue
+		}
+
+		seen[word] = struct{}{}
+		uniqueWords = append(uniqueWords, word)
+	}
+	entry.SearchText = strings.Join(uniqueWords, " ")
+
+	return entry
+}
+
+// issuanceSearchEntry creates the search index entry of the asset of the
+// given issuance leaf. The meta reveal is taken from the proof of the leaf if
+// it isn't given explicitly.
+func issuanceSearchEntry(leaf *universe.Leaf, leafProof *proof.Proof,
+	metaReveal *proof.MetaReveal) AssetSearchEntry {
+
+	if metaReveal == nil {
+		metaReveal = leafProof.MetaReveal
+	}
+
+	var groupKey *btcec.PublicKey
+	if leaf.GroupKey != nil {
+		groupKey = &leaf.GroupKey.GroupPubKey
+	}
+
+	return newAssetSearchEntry(
+		leaf.Genesis.ID(), leaf.Genesis.Tag, leaf.Genesis.Type,
+		groupKey, metaReveal, leafProof.BlockHeight,
+	)
+}
+
+// backfillAssetSearchIndex adds all assets of the issuance universes that were
+// inserted before the asset search index existed to the index.
+func backfillAssetSearchIndex(ctx context.Context, db AssetSearchStore) error {
+	for {
+		assets, err := db.FetchUnindexedIssuanceAssets(
+			ctx, assetSearchBackfillBatchSize,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch unindexed "+
+				"assets: %w", err)
+		}
+
+		for _, a := range assets {
+			var groupKey *btcec.PublicKey
+			if len(a.GroupKey) > 0 {
+				groupKey, err = btcec.ParsePubKey(a.GroupKey)
+				if err != nil {
+					return fmt.Errorf("unable to parse "+
+						"group key: %w", err)
+				}
+			}
+
+			var meta *proof.MetaReveal
+			if a.MetaDataType.Valid {
+				meta = &proof.MetaReveal{
+					Type: proof.MetaType(
+						a.MetaDataType.Int16,
+					),
+					Data: a.MetaDataBlob,
+				}
+			}
+
+			entry := newAssetSearchEntry(
+				fn.ToArray[asset.ID](a.AssetID), a.AssetName,
+				asset.Type(a.AssetType), groupKey, meta,
+				uint32(a.GenesisHeight.Int32),
+			)
+			err = db.UpsertAssetSearchEntry(ctx, entry)
+			if err != nil {
+				return fmt.Errorf("unable to index asset: %w",
+					err)
+			}
+		}
+
+		if len(assets) < assetSearchBackfillBatchSize {
+			return nil
+		}
+	}
+}
+
+// assetSearchSortToOrderBy maps the sort order of an asset search to the
+// sort column used by the search query.
+func assetSearchSortToOrderBy(s universe.AssetSearchSort) string {
+	switch s {
+	case universe.SearchSortByRelevance:
+		return "relevance"
+
+	case universe.SearchSortByAssetName:
+		return "asset_name"
+
+	case universe.SearchSortByAssetID:
+		return "asset_id"
+
+	case universe.SearchSortByGenesisHeight:
+		return "genesis_height"
+
+	default:
+		return ""
+	}
+}
+
+// SearchAssets returns the assets of the issuance universes that match the
+// given query.
+//
+// NOTE: This is part of the universe.AssetSearcher interface.
+func (u *UniverseStats) SearchAssets(ctx context.Context,
+	q universe.AssetSearchQuery) ([]universe.AssetSearchResult, error) {
+
+	// Assets that were inserted before the search index existed are only
+	// indexed on the first search, so we don't slow down the start of the
+	// daemon. New issuance assets are indexed as they are inserted, so
+	// this only needs to happen once.
+	if !u.searchBackfilled.Load() {
+		var writeTxOpts UniverseStatsOptions
+		err := u.db.ExecTx(
+			ctx, &writeTxOpts, func(db UniverseStatsStore) error {
+				return backfillAssetSearchIndex(ctx, db)
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to backfill asset "+
+				"search index: %w", err)
+		}
+
+		u.searchBackfilled.Store(true)
+	}
+
+	query := AssetSearchParams{
+		SearchWords:   assetSearchWords(q.Text),
+		SortBy:        sqlStr(assetSearchSortToOrderBy(q.SortBy)),
+		SortDirection: sqlInt16(q.SortDirection),
+		NumOffset:     int32(q.Offset),
+		NumLimit: func() int32 {
+			if q.Limit == 0 {
+				return int32(math.MaxInt32)
+			}
+
+			return int32(q.Limit)
+		}(),
+	}
+	if q.AssetTypeFilter != nil {
+		query.AssetType = sqlInt16(*q.AssetTypeFilter)
+	}
+	if q.GroupKeyFilter != nil {
+		query.GroupKey = q.GroupKeyFilter.SerializeCompressed()
+	}
+	if q.DecimalDisplayFilter != nil {
+		query.DecimalDisplay = sqlInt32(*q.DecimalDisplayFilter)
+	}
+
+	var results []universe.AssetSearchResult
+	readTx := NewUniverseStatsReadTx()
+	err := u.db.ExecTx(ctx, &readTx, func(db UniverseStatsStore) error {
+		var (
+			rows []AssetSearchRow
+			err  error
+		)
+		switch u.db.Backend() {
+		case sqlc.BackendTypeSqlite:
+			rows, err = db.SearchAssetsSqlite(ctx, query)
+
+		case sqlc.BackendTypePostgres:
+			rows, err = db.SearchAssetsPostgres(ctx, query)
+
+		default:
+			return fmt.Errorf("unknown database backend: %v",
+				u.db.Backend())
+		}
+		if err != nil {
+			return err
+		}
+
+		results = make([]universe.AssetSearchResult, 0, len(rows))
+		for _, row := range rows {
+			result := universe.AssetSearchResult{
+				AssetID:   fn.ToArray[asset.ID](row.AssetID),
+				AssetName: row.AssetName,
+				AssetType: asset.Type(row.AssetType),
+				GenesisHeight: uint32(
+					row.GenesisHeight.Int32,
+				),
+				MetaJSON: row.MetaJson.String,
+				Rank:     row.Rank,
+			}
+
+			if len(row.GroupKey) > 0 {
+				result.GroupKey, err = btcec.ParsePubKey(
+					row.GroupKey,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			if row.DecimalDisplay.Valid {
+				result.DecimalDisplay = fn.Some(
+					uint32(row.DecimalDisplay.Int32),
+				)
+			}
+
+			results = append(results, result)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// A compile-time assertion to ensure that UniverseStats implements the
+// universe.AssetSearcher interface.
+var _ universe.AssetSearcher = (*UniverseStats)(nil)
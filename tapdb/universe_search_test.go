@@ -0,0 +1,224 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewAssetSearchEntry tests that the search text of an asset contains the
+// normalized words of its name and its meta JSON.
+func TestNewAssetSearchEntry(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	jsonMeta := &proof.MetaReveal{
+		Type: proof.MetaJson,
+		Data: []byte(`{"decimal_display":2,"description":"A Stable ` +
+			`Coin","issuer":{"name":"ACME Corp.","website":` +
+			`"https://acme.example"},"tags":["usd","stable"]}`),
+	}
+
+	entry := newAssetSearchEntry(
+		assetID, "USD-coin", asset.Normal, groupKey, jsonMeta, 100,
+	)
+	require.Equal(t, assetID[:], entry.AssetID)
+	require.Equal(t, groupKey.SerializeCompressed(), entry.GroupKey)
+	require.Equal(t, sqlInt32(2), entry.DecimalDisplay)
+	require.Equal(t, sqlStr(string(jsonMeta.Data)), entry.MetaJson)
+	require.Equal(t, sqlInt32(100), entry.GenesisHeight)
+	require.Equal(
+		t, "usd coin decimal display 2 description a stable issuer "+
+			"name acme corp website https example tags",
+		entry.SearchText,
+	)
+
+	// Opaque meta data and invalid JSON aren't indexed.
+	opaqueMeta := &proof.MetaReveal{
+		Type: proof.MetaOpaque,
+		Data: []byte("silver"),
+	}
+	entry = newAssetSearchEntry(
+		assetID, "gold", asset.Collectible, nil, opaqueMeta, 100,
+	)
+	require.Nil(t, entry.GroupKey)
+	require.False(t, entry.MetaJson.Valid)
+	require.False(t, entry.DecimalDisplay.Valid)
+	require.Equal(t, "gold", entry.SearchText)
+
+	invalidMeta := &proof.MetaReveal{
+		Type: proof.MetaJson,
+		Data: []byte(`{"description":`),
+	}
+	entry = newAssetSearchEntry(
+		assetID, "gold", asset.Normal, nil, invalidMeta, 100,
+	)
+	require.False(t, entry.MetaJson.Valid)
+	require.Equal(t, "gold", entry.SearchText)
+}
+
+// TestUniverseSearchAssets tests that the assets of issuance universes can be
+// searched by their name and meta data, and that the index is backfilled and
+// cleaned up.
+func TestUniverseSearchAssets(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Now())
+	statsDB, _ := newUniverseStatsWithDB(db.BaseDB, testClock)
+
+	groupKey := test.RandPubKey(t)
+	trees := make(map[string]*BaseUniverseTree)
+	insertAsset := func(name string, assetType asset.Type,
+		groupKey *btcec.PublicKey, meta *proof.MetaReveal) asset.ID {
+
+		gen := asset.RandGenesis(t, assetType)
+		gen.Tag = name
+		gen.MetaHash = meta.MetaHash()
+
+		id := universe.Identifier{
+			AssetID:   gen.ID(),
+			GroupKey:  groupKey,
+			ProofType: universe.ProofTypeIssuance,
+		}
+		if groupKey != nil {
+			id.AssetID = asset.ID{}
+		}
+
+		tree, _ := newTestUniverseWithDb(db.BaseDB, id)
+		leaf := randMintingLeaf(t, gen, groupKey)
+		_, err := tree.RegisterIssuance(
+			ctx, randLeafKey(t), &leaf, meta,
+		)
+		require.NoError(t, err)
+
+		trees[name] = tree
+
+		return gen.ID()
+	}
+
+	goldMeta := &proof.MetaReveal{
+		Type: proof.MetaJson,
+		Data: []byte(`{"description":"Tokenized gold",` +
+			`"decimal_display":2}`),
+	}
+	silverMeta := &proof.MetaReveal{
+		Type: proof.MetaJson,
+		Data: []byte(`{"description":"Tokenized silver",` +
+			`"decimal_display":6}`),
+	}
+	nftMeta := &proof.MetaReveal{
+		Type: proof.MetaOpaque,
+		Data: []byte("an image"),
+	}
+
+	goldID := insertAsset("gold-bar", asset.Normal, nil, goldMeta)
+	silverID := insertAsset("silver", asset.Normal, groupKey, silverMeta)
+	nftID := insertAsset("gold-nft", asset.Collectible, groupKey, nftMeta)
+
+	search := func(stats *UniverseStats,
+		q universe.AssetSearchQuery) []asset.ID {
+
+		results, err := stats.SearchAssets(ctx, q)
+		require.NoError(t, err)
+
+		return fn.Map(
+			results, func(r universe.AssetSearchResult) asset.ID {
+				return r.AssetID
+			},
+		)
+	}
+	byName := func(text string) universe.AssetSearchQuery {
+		return universe.AssetSearchQuery{
+			Text:   text,
+			SortBy: universe.SearchSortByAssetName,
+		}
+	}
+
+	// The search text is matched against the name and the meta JSON, and
+	// all its words need to match.
+	require.Equal(
+		t, []asset.ID{goldID, nftID}, search(statsDB, byName("GOLD!")),
+	)
+	require.Equal(
+		t, []asset.ID{goldID, silverID},
+		search(statsDB, byName("tokenized")),
+	)
+	require.Equal(
+		t, []asset.ID{goldID},
+		search(statsDB, byName("tokenized gold")),
+	)
+	require.Empty(t, search(statsDB, byName("image")))
+
+	// The filters can be used without search text.
+	decDisplay := uint32(6)
+	require.Equal(t, []asset.ID{silverID}, search(
+		statsDB, universe.AssetSearchQuery{
+			DecimalDisplayFilter: &decDisplay,
+		},
+	))
+	collectible := asset.Collectible
+	require.Equal(t, []asset.ID{nftID}, search(
+		statsDB, universe.AssetSearchQuery{
+			GroupKeyFilter:  groupKey,
+			AssetTypeFilter: &collectible,
+		},
+	))
+
+	// The results can be sorted and paginated.
+	require.Equal(t, []asset.ID{silverID, nftID, goldID}, search(
+		statsDB, universe.AssetSearchQuery{
+			SortBy:        universe.SearchSortByAssetName,
+			SortDirection: universe.SortDescending,
+		},
+	))
+	require.Equal(t, []asset.ID{nftID}, search(
+		statsDB, universe.AssetSearchQuery{
+			SortBy: universe.SearchSortByAssetName,
+			Offset: 1,
+			Limit:  1,
+		},
+	))
+
+	results, err := statsDB.SearchAssets(ctx, byName("silver"))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "silver", results[0].AssetName)
+	require.Equal(t, fn.Some(decDisplay), results[0].DecimalDisplay)
+	require.Equal(t, string(silverMeta.Data), results[0].MetaJSON)
+	require.True(t, groupKey.IsEqual(results[0].GroupKey))
+	require.Positive(t, results[0].Rank)
+
+	// Assets that were inserted before the index existed are added to it
+	// on the first search.
+	_, err = db.ExecContext(ctx, "DELETE FROM universe_asset_search")
+	require.NoError(t, err)
+
+	freshStatsDB, _ := newUniverseStatsWithDB(db.BaseDB, testClock)
+	require.Equal(
+		t, []asset.ID{goldID, silverID},
+		search(freshStatsDB, byName("tokenized")),
+	)
+	require.Equal(t, []asset.ID{silverID}, search(
+		freshStatsDB, universe.AssetSearchQuery{
+			GroupKeyFilter:       groupKey,
+			DecimalDisplayFilter: &decDisplay,
+		},
+	))
+
+	// Deleting an issuance universe removes its assets from the index.
+	_, err = trees["gold-bar"].DeleteUniverse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []asset.ID{nftID}, search(statsDB, byName("gold")))
+}
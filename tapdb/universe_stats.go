@@ -84,6 +84,8 @@ type UniverseStatsStore interface {
 	// grouped by day in a Postgres specific format.
 	QueryAssetStatsPerDayPostgres(ctx context.Context,
 		q AssetStatsPerDayQueryPg) ([]AssetStatsPerDayPg, error)
+
+	AssetSearchStore
 }
 
 // UniverseStatsOptions defines the set of txn options for the universe stats.
@@ -297,6 +299,10 @@ type UniverseStats struct {
 	syncStatsMtx     sync.RWMutex
 	syncStatsCache   *atomicSyncStatsCache
 	syncStatsRefresh *time.Timer
+
+	// searchBackfilled is set once the assets that were inserted before
+	// the asset search index existed have been added to it.
+	searchBackfilled atomic.Bool
 }
 
 // statsOpts defines the set of options that can be used to configure the
@@ -1076,3 +1076,507 @@ func TestMultiverseRootSum(t *testing.T) {
 		})
 	}
 }
+
+// TestMultiverseBranchNodes tests that the nodes below the branches of a
+// universe tree can be fetched, and that the leaf keys of a universe can be
+// restricted to a key prefix.
+func TestMultiverseBranchNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+	id := randUniverseID(t, false)
+
+	// A universe that doesn't exist yet only has empty nodes.
+	nodes, err := multiverse.BranchNodes(ctx, universe.BranchNodesQuery{
+		Id:    id,
+		Depth: 8,
+	})
+	require.NoError(t, err)
+	require.Len(t, nodes, 256)
+	for _, node := range nodes {
+		require.True(t, mssmt.IsEqualNode(mssmt.EmptyTree[8], node))
+	}
+
+	const numLeaves = 20
+	leafKeys := make([]universe.LeafKey, numLeaves)
+	leaves := make([]universe.Leaf, numLeaves)
+	for i := range leafKeys {
+		leafKeys[i] = randLeafKey(t)
+		leaves[i] = randMintingLeaf(
+			t, asset.RandGenesis(t, asset.Normal), id.GroupKey,
+		)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKeys[i], &leaves[i], nil,
+		)
+		require.NoError(t, err)
+	}
+
+	// The nodes below the root hash up to the root of the universe.
+	nodes, err = multiverse.BranchNodes(ctx, universe.BranchNodesQuery{
+		Id:    id,
+		Depth: 8,
+	})
+	require.NoError(t, err)
+
+	for len(nodes) > 1 {
+		half := len(nodes) / 2
+		parents := make([]mssmt.Node, half)
+		for i := range parents {
+			parents[i] = mssmt.NewBranch(nodes[i], nodes[i+half])
+		}
+
+		nodes = parents
+	}
+
+	root, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root.Node, nodes[0]))
+
+	for i, leafKey := range leafKeys {
+		uniKey := leafKey.UniverseKey()
+
+		// The leaf keys for the first byte of the universe key are the
+		// ones that share that byte.
+		var numExpected int
+		for _, otherKey := range leafKeys {
+			if otherKey.UniverseKey()[0] == uniKey[0] {
+				numExpected++
+			}
+		}
+
+		keys, err := multiverse.UniverseLeafKeys(
+			ctx, universe.UniverseLeafKeysQuery{
+				Id:        id,
+				KeyPrefix: uniKey[:1],
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, keys, numExpected)
+		for _, key := range keys {
+			require.Equal(t, uniKey[0], key.UniverseKey()[0])
+		}
+
+		// The full universe key identifies the leaf itself.
+		keys, err = multiverse.UniverseLeafKeys(
+			ctx, universe.UniverseLeafKeysQuery{
+				Id:        id,
+				KeyPrefix: uniKey[:],
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		require.Equal(t, uniKey, keys[0].UniverseKey())
+
+		nodes, err := multiverse.BranchNodes(
+			ctx, universe.BranchNodesQuery{
+				Id:        id,
+				KeyPrefix: uniKey[:],
+			},
+		)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(
+			leaves[i].SmtLeafNode(), nodes[0],
+		))
+	}
+
+	// Queries that go below the leaves of the tree are rejected.
+	_, err = multiverse.BranchNodes(ctx, universe.BranchNodesQuery{
+		Id:        id,
+		KeyPrefix: make([]byte, 32),
+		Depth:     1,
+	})
+	require.Error(t, err)
+}
+
+// TestMultiverseLeafEvents tests that the leaves inserted into the universe
+// trees can be fetched in the order they were inserted, starting after a
+// cursor and filtered by asset ID, group key or proof type.
+func TestMultiverseLeafEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	cursor, err := multiverse.LatestLeafCursor(ctx)
+	require.NoError(t, err)
+	require.Zero(t, cursor)
+
+	events, err := multiverse.FetchLeafEvents(
+		ctx, universe.LeafEventsQuery{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// We insert leaves into an issuance universe of an asset and a
+	// transfer universe of an asset group in turns.
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	assetID := universe.Identifier{
+		AssetID:   assetGen.ID(),
+		ProofType: universe.ProofTypeIssuance,
+	}
+	groupID := randUniverseID(
+		t, true, withProofType(universe.ProofTypeTransfer),
+	)
+
+	const numLeaves = 6
+	var (
+		ids       []universe.Identifier
+		leafKeys  []universe.LeafKey
+		leaves    []universe.Leaf
+		groupGens []asset.Genesis
+	)
+	for i := 0; i < numLeaves; i++ {
+		id, gen := assetID, assetGen
+		if i%2 == 1 {
+			id, gen = groupID, asset.RandGenesis(t, asset.Normal)
+			groupGens = append(groupGens, gen)
+		}
+
+		leafKey := randLeafKey(t)
+		leaf := randMintingLeaf(t, gen, id.GroupKey)
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		ids = append(ids, id)
+		leafKeys = append(leafKeys, leafKey)
+		leaves = append(leaves, leaf)
+	}
+
+	// assertEvents asserts that the events are the ones of the leaves with
+	// the given indexes.
+	assertEvents := func(events []universe.LeafEvent, idxs ...int) {
+		t.Helper()
+
+		require.Len(t, events, len(idxs))
+		for i, idx := range idxs {
+			require.Equal(
+				t, ids[idx].String(), events[i].ID.String(),
+			)
+			require.Equal(
+				t, leafKeys[idx].UniverseKey(),
+				events[i].Key.UniverseKey(),
+			)
+
+			if i > 0 {
+				require.Greater(
+					t, events[i].Cursor, events[i-1].Cursor,
+				)
+			}
+		}
+	}
+
+	allEvents, err := multiverse.FetchLeafEvents(
+		ctx, universe.LeafEventsQuery{},
+	)
+	require.NoError(t, err)
+	assertEvents(allEvents, 0, 1, 2, 3, 4, 5)
+
+	cursor, err = multiverse.LatestLeafCursor(ctx)
+	require.NoError(t, err)
+	require.Equal(t, allEvents[numLeaves-1].Cursor, cursor)
+
+	// The events can be fetched page by page.
+	events, err = multiverse.FetchLeafEvents(ctx, universe.LeafEventsQuery{
+		Limit: 4,
+	})
+	require.NoError(t, err)
+	assertEvents(events, 0, 1, 2, 3)
+
+	events, err = multiverse.FetchLeafEvents(ctx, universe.LeafEventsQuery{
+		AfterCursor: events[3].Cursor,
+		Limit:       4,
+	})
+	require.NoError(t, err)
+	assertEvents(events, 4, 5)
+
+	// The events can be filtered by the universe they belong to.
+	events, err = multiverse.FetchLeafEvents(ctx, universe.LeafEventsQuery{
+		GroupKey: groupID.GroupKey,
+	})
+	require.NoError(t, err)
+	assertEvents(events, 1, 3, 5)
+
+	events, err = multiverse.FetchLeafEvents(ctx, universe.LeafEventsQuery{
+		ProofType: universe.ProofTypeIssuance,
+	})
+	require.NoError(t, err)
+	assertEvents(events, 0, 2, 4)
+
+	// The asset ID filter matches the asset of the leaf, also within the
+	// universe of an asset group.
+	events, err = multiverse.FetchLeafEvents(ctx, universe.LeafEventsQuery{
+		AssetID: fn.Ptr(groupGens[1].ID()),
+	})
+	require.NoError(t, err)
+	assertEvents(events, 3)
+
+	events, err = multiverse.FetchLeafEvents(ctx, universe.LeafEventsQuery{
+		AssetID:     fn.Ptr(assetGen.ID()),
+		AfterCursor: allEvents[0].Cursor,
+	})
+	require.NoError(t, err)
+	assertEvents(events, 2, 4)
+
+	// Upserting a known leaf again doesn't create a new event.
+	_, err = multiverse.UpsertProofLeaf(
+		ctx, ids[0], leafKeys[0], &leaves[0], nil,
+	)
+	require.NoError(t, err)
+
+	cursor, err = multiverse.LatestLeafCursor(ctx)
+	require.NoError(t, err)
+	require.Equal(t, allEvents[numLeaves-1].Cursor, cursor)
+}
+
+// insertTransferLeaf inserts a leaf into the transfer universe of the given
+// asset, that is anchored in the block with the given height and spends the
+// leaf with the given key if it's set. The key and proof size of the new leaf
+// are returned.
+func insertTransferLeaf(t *testing.T, multiverse *MultiverseStore,
+	assetGen asset.Genesis, height uint32,
+	spentKey *universe.LeafKey) (universe.LeafKey, uint64) {
+
+	t.Helper()
+
+	leaf := randMintingLeaf(t, assetGen, nil)
+
+	var leafProof proof.Proof
+	err := leafProof.Decode(bytes.NewReader(leaf.RawProof))
+	require.NoError(t, err)
+
+	leafProof.BlockHeight = height
+	if spentKey != nil {
+		leafProof.Asset.PrevWitnesses = []asset.Witness{{
+			PrevID: &asset.PrevID{
+				OutPoint: spentKey.OutPoint,
+				ID:       assetGen.ID(),
+				ScriptKey: asset.ToSerialized(
+					spentKey.ScriptKey.PubKey,
+				),
+			},
+		}}
+	}
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, leafProof.Encode(&proofBuf))
+	leaf.RawProof = proofBuf.Bytes()
+	leaf.Asset = &leafProof.Asset
+
+	id := universe.Identifier{
+		AssetID:   assetGen.ID(),
+		ProofType: universe.ProofTypeTransfer,
+	}
+	leafKey := randLeafKey(t)
+	_, err = multiverse.UpsertProofLeaf(
+		context.Background(), id, leafKey, &leaf, nil,
+	)
+	require.NoError(t, err)
+
+	return leafKey, uint64(len(leaf.RawProof))
+}
+
+// TestMultiversePruneLeaves tests that spent leaves can be pruned from a
+// universe by their block height and number, that unspent leaves are kept,
+// and that the multiverse tree is updated accordingly.
+func TestMultiversePruneLeaves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, db := newTestMultiverse(t)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	id := universe.Identifier{
+		AssetID:   assetGen.ID(),
+		ProofType: universe.ProofTypeTransfer,
+	}
+
+	var proofSizes []uint64
+	insertLeaf := func(height uint32,
+		spentKey *universe.LeafKey) universe.LeafKey {
+
+		leafKey, proofSize := insertTransferLeaf(
+			t, multiverse, assetGen, height, spentKey,
+		)
+		proofSizes = append(proofSizes, proofSize)
+
+		return leafKey
+	}
+
+	// We insert a chain of transfers anchored in increasing blocks, so
+	// the first leaves are the oldest ones, and each leaf spends the
+	// previous one. Along with them, an old leaf is inserted that isn't
+	// spent yet.
+	const startHeight = 100
+	var chainKeys []universe.LeafKey
+	for i := 0; i < 5; i++ {
+		var spentKey *universe.LeafKey
+		if i > 0 {
+			spentKey = &chainKeys[i-1]
+		}
+		chainKeys = append(
+			chainKeys, insertLeaf(uint32(startHeight+i), spentKey),
+		)
+
+		if i == 1 {
+			insertLeaf(startHeight+1, nil)
+		}
+	}
+
+	// assertLeafHeights asserts that the universe has leaves with the
+	// given block heights, and that its multiverse leaf commits to its
+	// root.
+	assertLeafHeights := func(heights ...uint32) {
+		t.Helper()
+
+		dbLeaves, err := db.UniverseLeaves(ctx)
+		require.NoError(t, err)
+		require.Len(t, dbLeaves, len(heights))
+		for i, dbLeaf := range dbLeaves {
+			require.Equal(
+				t, heights[i],
+				extractSqlInt32[uint32](dbLeaf.BlockHeight),
+			)
+		}
+
+		uniRoot, err := multiverse.UniverseRootNode(ctx, id)
+		require.NoError(t, err)
+		rootHash := uniRoot.NodeHash()
+
+		multiverseLeaves, err := multiverse.FetchLeaves(
+			ctx, nil, universe.ProofTypeTransfer,
+		)
+		require.NoError(t, err)
+		require.Len(t, multiverseLeaves, 1)
+		require.Equal(t, rootHash[:], multiverseLeaves[0].Value)
+		require.Equal(
+			t, uniRoot.NodeSum(), multiverseLeaves[0].NodeSum(),
+		)
+	}
+	assertLeafHeights(100, 101, 101, 102, 103, 104)
+
+	// The block height of leaves that were inserted before it was stored
+	// is backfilled from their proof.
+	dbLeaves, err := db.UniverseLeaves(ctx)
+	require.NoError(t, err)
+	err = db.UpdateUniverseLeafBlockHeight(ctx, UniverseLeafBlockHeight{
+		ID: dbLeaves[0].ID,
+	})
+	require.NoError(t, err)
+
+	// All spent leaves anchored before the minimum height are pruned,
+	// the unspent leaf is kept.
+	result, err := multiverse.PruneLeaves(ctx, universe.PruneQuery{
+		ID:             id,
+		MinBlockHeight: startHeight + 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, universe.PruneResult{
+		MinKeptHeight: startHeight + 1,
+		NumLeaves:     2,
+		NumBytes:      proofSizes[0] + proofSizes[1],
+	}, result)
+	assertLeafHeights(101, 102, 103, 104)
+
+	// Pruning again doesn't remove any more leaves.
+	result, err = multiverse.PruneLeaves(ctx, universe.PruneQuery{
+		ID:             id,
+		MinBlockHeight: startHeight + 2,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.NumLeaves)
+
+	// If the number of leaves is capped, the oldest spent ones are
+	// pruned. The unspent leaves are kept, even though that exceeds the
+	// cap.
+	result, err = multiverse.PruneLeaves(ctx, universe.PruneQuery{
+		ID:        id,
+		MaxLeaves: 1,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, result.NumLeaves)
+	require.EqualValues(t, startHeight+4, result.MinKeptHeight)
+	assertLeafHeights(101, 104)
+
+	// Pruning all leaves removes the universe entirely.
+	result, err = multiverse.PruneLeaves(ctx, universe.PruneQuery{
+		ID:       id,
+		PruneAll: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, universe.PruneResult{
+		NumLeaves:    2,
+		NumBytes:     proofSizes[2] + proofSizes[5],
+		NumUniverses: 1,
+	}, result)
+
+	dbLeaves, err = db.UniverseLeaves(ctx)
+	require.NoError(t, err)
+	require.Empty(t, dbLeaves)
+
+	multiverseLeaves, err := multiverse.FetchLeaves(
+		ctx, nil, universe.ProofTypeTransfer,
+	)
+	require.NoError(t, err)
+	require.Empty(t, multiverseLeaves)
+}
+
+// TestMultiversePruneUnspentLeaf tests that a leaf selected by the retention
+// policy isn't pruned while it's unspent, so a transfer spending it can still
+// be inserted after pruning, and that it's pruned once it's spent.
+func TestMultiversePruneUnspentLeaf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	id := universe.Identifier{
+		AssetID:   assetGen.ID(),
+		ProofType: universe.ProofTypeTransfer,
+	}
+
+	// The old leaf is spent by the middle one, which is the tip of the
+	// asset. Both are older than the minimum height of the policy.
+	oldKey, _ := insertTransferLeaf(t, multiverse, assetGen, 100, nil)
+	tipKey, _ := insertTransferLeaf(
+		t, multiverse, assetGen, 110, &oldKey,
+	)
+
+	query := universe.PruneQuery{
+		ID:             id,
+		MinBlockHeight: 150,
+	}
+	result, err := multiverse.PruneLeaves(ctx, query)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.NumLeaves)
+
+	_, err = multiverse.FetchProofLeaf(ctx, id, oldKey)
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+
+	// The tip is still there, so a transfer spending it finds its
+	// previous proof.
+	tipProofs, err := multiverse.FetchProofLeaf(ctx, id, tipKey)
+	require.NoError(t, err)
+	require.Len(t, tipProofs, 1)
+
+	newKey, _ := insertTransferLeaf(
+		t, multiverse, assetGen, 200, &tipKey,
+	)
+
+	// Now that the former tip is spent, it's pruned as well, while the
+	// new tip is kept.
+	result, err = multiverse.PruneLeaves(ctx, query)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.NumLeaves)
+	require.EqualValues(t, 200, result.MinKeptHeight)
+
+	_, err = multiverse.FetchProofLeaf(ctx, id, tipKey)
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+
+	_, err = multiverse.FetchProofLeaf(ctx, id, newKey)
+	require.NoError(t, err)
+}
@@ -0,0 +1,179 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+// InsertVerifiedProofParams is used to insert a new verified proof.
+type InsertVerifiedProofParams = sqlc.InsertVerifiedProofParams
+
+// VerifiedProofStore is the set of queries that are needed to maintain the
+// cache of verified proofs.
+type VerifiedProofStore interface {
+	// InsertVerifiedProof inserts a new verified proof. Proofs that are
+	// already known are ignored.
+	InsertVerifiedProof(ctx context.Context,
+		arg InsertVerifiedProofParams) error
+
+	// FetchVerifiedProof fetches the verified proof with the given hash.
+	FetchVerifiedProof(ctx context.Context,
+		proofHash []byte) (sqlc.VerifiedProof, error)
+
+	// DeleteVerifiedProofsByBlock deletes all verified proofs that are
+	// anchored in the block with the given hash.
+	DeleteVerifiedProofsByBlock(ctx context.Context,
+		blockHash []byte) (int64, error)
+}
+
+// VerifiedProofTxOptions defines the set of db txn options the
+// VerifiedProofStore understands.
+type VerifiedProofTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *VerifiedProofTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewVerifiedProofReadTx creates a new read transaction option set.
+func NewVerifiedProofReadTx() VerifiedProofTxOptions {
+	return VerifiedProofTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedVerifiedProofStore combines the VerifiedProofStore interface with the
+// BatchedTx interface, allowing for multiple queries to be executed in a
+// single SQL transaction.
+type BatchedVerifiedProofStore interface {
+	VerifiedProofStore
+
+	BatchedTx[VerifiedProofStore]
+}
+
+// VerifiedProofDB is a database backed cache of proofs that were already fully
+// verified.
+type VerifiedProofDB struct {
+	db BatchedVerifiedProofStore
+
+	clock clock.Clock
+}
+
+// NewVerifiedProofDB creates a new verified proof cache from the given
+// database.
+func NewVerifiedProofDB(db BatchedVerifiedProofStore,
+	clock clock.Clock) *VerifiedProofDB {
+
+	return &VerifiedProofDB{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// IsVerified returns true if the proof with the given hash was already
+// verified.
+//
+// NOTE: This is part of the proof.VerifiedProofCache interface.
+func (v *VerifiedProofDB) IsVerified(ctx context.Context,
+	proofHash [32]byte) (bool, error) {
+
+	var verified bool
+	readTx := NewVerifiedProofReadTx()
+	dbErr := v.db.ExecTx(ctx, &readTx, func(q VerifiedProofStore) error {
+		_, err := q.FetchVerifiedProof(ctx, proofHash[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		verified = true
+
+		return nil
+	})
+	if dbErr != nil {
+		return false, fmt.Errorf("unable to fetch verified proof: %w",
+			dbErr)
+	}
+
+	return verified, nil
+}
+
+// InsertVerified adds the given proofs to the cache.
+//
+// NOTE: This is part of the proof.VerifiedProofCache interface.
+func (v *VerifiedProofDB) InsertVerified(ctx context.Context,
+	proofs ...proof.VerifiedProof) error {
+
+	now := v.clock.Now().UTC()
+
+	var writeTx VerifiedProofTxOptions
+	dbErr := v.db.ExecTx(ctx, &writeTx, func(q VerifiedProofStore) error {
+		for _, p := range proofs {
+			err := q.InsertVerifiedProof(
+				ctx, InsertVerifiedProofParams{
+					ProofHash:   p.ProofHash[:],
+					BlockHash:   p.BlockHash[:],
+					BlockHeight: int32(p.BlockHeight),
+					VerifiedAt:  now,
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to insert verified proofs: %w", dbErr)
+	}
+
+	return nil
+}
+
+// PurgeBlock removes all proofs from the cache that are anchored in the block
+// with the given hash.
+//
+// NOTE: This is part of the proof.VerifiedProofCache interface.
+func (v *VerifiedProofDB) PurgeBlock(ctx context.Context,
+	blockHash chainhash.Hash) error {
+
+	var writeTx VerifiedProofTxOptions
+	dbErr := v.db.ExecTx(ctx, &writeTx, func(q VerifiedProofStore) error {
+		numDeleted, err := q.DeleteVerifiedProofsByBlock(
+			ctx, blockHash[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("Purged %d verified proofs of block %v", numDeleted,
+			blockHash)
+
+		return nil
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to purge verified proofs: %w", dbErr)
+	}
+
+	return nil
+}
+
+// A compile-time assertion to ensure VerifiedProofDB meets the
+// proof.VerifiedProofCache interface.
+var _ proof.VerifiedProofCache = (*VerifiedProofDB)(nil)
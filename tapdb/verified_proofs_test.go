@@ -0,0 +1,79 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// randVerifiedProof creates a random verified proof anchored in the given
+// block.
+func randVerifiedProof(blockHash chainhash.Hash) proof.VerifiedProof {
+
+	var proofHash [32]byte
+	copy(proofHash[:], test.RandBytes(32))
+
+	return proof.VerifiedProof{
+		ProofHash:   proofHash,
+		BlockHash:   blockHash,
+		BlockHeight: test.RandInt[uint32]() % 1_000_000,
+	}
+}
+
+// TestVerifiedProofDB tests that verified proofs can be cached and are purged
+// once their block is re-organized out.
+func TestVerifiedProofDB(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	dbTxer := NewTransactionExecutor(
+		db, func(tx *sql.Tx) VerifiedProofStore {
+			return db.WithTx(tx)
+		},
+	)
+	cache := NewVerifiedProofDB(dbTxer, clock.NewTestClock(time.Now()))
+	ctx := context.Background()
+
+	block1 := test.RandHash()
+	block2 := test.RandHash()
+	proof1 := randVerifiedProof(block1)
+	proof2 := randVerifiedProof(block1)
+	proof3 := randVerifiedProof(block2)
+
+	// Nothing is verified initially.
+	verified, err := cache.IsVerified(ctx, proof1.ProofHash)
+	require.NoError(t, err)
+	require.False(t, verified)
+
+	require.NoError(t, cache.InsertVerified(ctx, proof1, proof2, proof3))
+
+	// Inserting the same proof twice is fine.
+	require.NoError(t, cache.InsertVerified(ctx, proof1))
+
+	for _, p := range []proof.VerifiedProof{proof1, proof2, proof3} {
+		verified, err := cache.IsVerified(ctx, p.ProofHash)
+		require.NoError(t, err)
+		require.True(t, verified)
+	}
+
+	// Once the first block is re-organized out, only the proof of the
+	// second block is still cached.
+	require.NoError(t, cache.PurgeBlock(ctx, block1))
+
+	for _, p := range []proof.VerifiedProof{proof1, proof2} {
+		verified, err := cache.IsVerified(ctx, p.ProofHash)
+		require.NoError(t, err)
+		require.False(t, verified)
+	}
+
+	verified, err = cache.IsVerified(ctx, proof3.ProofHash)
+	require.NoError(t, err)
+	require.True(t, verified)
+}
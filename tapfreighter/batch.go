@@ -0,0 +1,132 @@
+package tapfreighter
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// DefaultMaxBatchAnchorTxWeight is the default maximum estimated
+	// weight of a single anchor transaction created for a batched send.
+	// Every recipient proof contains the full anchor transaction, so we
+	// keep the default well below the standardness limit.
+	DefaultMaxBatchAnchorTxWeight lntypes.WeightUnit = 40_000
+
+	// MaxBatchAnchorTxWeight is the maximum weight a single anchor
+	// transaction of a batched send can have, which is the maximum weight
+	// of a standard transaction.
+	MaxBatchAnchorTxWeight lntypes.WeightUnit = 400_000
+
+	// batchReservedInputs is the number of taproot key spend inputs we
+	// reserve weight for when estimating the size of a batch anchor
+	// transaction, since the actual inputs are only known after coin
+	// selection. This accounts for one asset anchor input and one BTC
+	// input that pays for the fees.
+	batchReservedInputs = 2
+)
+
+// AddressBatch is a set of Taproot Asset addresses that are sent to within a
+// single anchor transaction.
+type AddressBatch struct {
+	// Addrs is the list of addresses that are sent to in this batch. All
+	// addresses are for the same asset ID and are of the same version.
+	Addrs []*address.Tap
+
+	// EstimatedWeight is the estimated weight of the anchor transaction
+	// of this batch.
+	EstimatedWeight lntypes.WeightUnit
+}
+
+// batchKey is the key addresses are grouped by, since a single address parcel
+// can only send a single asset ID to addresses of the same version.
+type batchKey struct {
+	assetID asset.ID
+	version address.Version
+}
+
+// ChunkAddresses splits the given addresses into batches that can each be
+// sent with a single address parcel and whose anchor transaction is estimated
+// to be at or below the given maximum weight. Addresses for different asset
+// IDs or of different versions always end up in different batches. The order
+// of the addresses is preserved within each batch and the batches are
+// ordered by the first occurrence of their asset ID.
+func ChunkAddresses(addrs []*address.Tap,
+	maxWeight lntypes.WeightUnit) ([]*AddressBatch, error) {
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("at least one address must be specified")
+	}
+
+	if maxWeight > MaxBatchAnchorTxWeight {
+		return nil, fmt.Errorf("max anchor transaction weight %d "+
+			"exceeds maximum of %d", maxWeight,
+			MaxBatchAnchorTxWeight)
+	}
+
+	singleWeight := estimateBatchAnchorWeight(1)
+	if maxWeight < singleWeight {
+		return nil, fmt.Errorf("max anchor transaction weight %d too "+
+			"small, need at least %d", maxWeight, singleWeight)
+	}
+
+	var (
+		keys   []batchKey
+		groups = make(map[batchKey][]*address.Tap)
+	)
+	for _, addr := range addrs {
+		key := batchKey{
+			assetID: addr.AssetID,
+			version: addr.Version,
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], addr)
+	}
+
+	var batches []*AddressBatch
+	for _, key := range keys {
+		groupAddrs := groups[key]
+
+		current := &AddressBatch{}
+		for _, addr := range groupAddrs {
+			weight := estimateBatchAnchorWeight(
+				len(current.Addrs) + 1,
+			)
+			if weight > maxWeight {
+				batches = append(batches, current)
+				current = &AddressBatch{}
+				weight = singleWeight
+			}
+
+			current.Addrs = append(current.Addrs, addr)
+			current.EstimatedWeight = weight
+		}
+
+		batches = append(batches, current)
+	}
+
+	return batches, nil
+}
+
+// estimateBatchAnchorWeight estimates the weight of an anchor transaction that
+// sends to the given number of recipients. Each recipient gets its own P2TR
+// anchor output, in addition to the asset change and the BTC change outputs.
+func estimateBatchAnchorWeight(numRecipients int) lntypes.WeightUnit {
+	var weightEstimator input.TxWeightEstimator
+	for i := 0; i < batchReservedInputs; i++ {
+		weightEstimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	}
+
+	for i := 0; i < numRecipients+2; i++ {
+		weightEstimator.AddP2TROutput()
+	}
+
+	return weightEstimator.Weight()
+}
@@ -0,0 +1,84 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChunkAddresses tests that addresses are split into batches by asset ID,
+// address version and the estimated anchor transaction weight.
+func TestChunkAddresses(t *testing.T) {
+	t.Parallel()
+
+	var (
+		idA = asset.RandID(t)
+		idB = asset.RandID(t)
+	)
+	newAddrs := func(id asset.ID, version address.Version,
+		num int) []*address.Tap {
+
+		addrs := make([]*address.Tap, num)
+		for i := range addrs {
+			addrs[i] = &address.Tap{
+				Version: version,
+				AssetID: id,
+				Amount:  uint64(i + 1),
+			}
+		}
+
+		return addrs
+	}
+
+	addrsA := newAddrs(idA, address.V1, 5)
+	addrsB := newAddrs(idB, address.V1, 3)
+	addrsAV0 := newAddrs(idA, address.V0, 2)
+
+	// Interleave the addresses of the different assets to make sure the
+	// order within a batch is preserved.
+	addrs := []*address.Tap{
+		addrsA[0], addrsB[0], addrsA[1], addrsAV0[0], addrsA[2],
+		addrsB[1], addrsA[3], addrsAV0[1], addrsA[4], addrsB[2],
+	}
+
+	// With the default weight limit, we get one batch per asset ID and
+	// address version.
+	batches, err := ChunkAddresses(addrs, DefaultMaxBatchAnchorTxWeight)
+	require.NoError(t, err)
+	require.Len(t, batches, 3)
+	require.Equal(t, addrsA, batches[0].Addrs)
+	require.Equal(t, addrsB, batches[1].Addrs)
+	require.Equal(t, addrsAV0, batches[2].Addrs)
+	require.Equal(
+		t, estimateBatchAnchorWeight(5), batches[0].EstimatedWeight,
+	)
+
+	// If the weight limit only allows for two recipients, the batches
+	// are split further.
+	maxWeight := estimateBatchAnchorWeight(2)
+	batches, err = ChunkAddresses(addrs, maxWeight)
+	require.NoError(t, err)
+	require.Len(t, batches, 6)
+	require.Equal(t, addrsA[0:2], batches[0].Addrs)
+	require.Equal(t, addrsA[2:4], batches[1].Addrs)
+	require.Equal(t, addrsA[4:], batches[2].Addrs)
+	require.Equal(t, addrsB[0:2], batches[3].Addrs)
+	require.Equal(t, addrsB[2:], batches[4].Addrs)
+	require.Equal(t, addrsAV0, batches[5].Addrs)
+	for _, batch := range batches {
+		require.LessOrEqual(t, batch.EstimatedWeight, maxWeight)
+	}
+
+	// A weight limit that doesn't even allow a single recipient is
+	// rejected, as is a limit above the standardness limit.
+	_, err = ChunkAddresses(addrs, estimateBatchAnchorWeight(1)-1)
+	require.ErrorContains(t, err, "too small")
+
+	_, err = ChunkAddresses(addrs, MaxBatchAnchorTxWeight+1)
+	require.ErrorContains(t, err, "exceeds maximum")
+
+	_, err = ChunkAddresses(nil, DefaultMaxBatchAnchorTxWeight)
+	require.ErrorContains(t, err, "at least one address")
+}
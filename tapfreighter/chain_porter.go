@@ -94,6 +94,11 @@ type ChainPorterConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// VerifiedProofCache is an optional cache of proofs that were already
+	// verified, which allows us to skip re-verifying the input proofs of
+	// the transfer outputs.
+	VerifiedProofCache proof.VerifiedProofCache
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -295,6 +300,34 @@ func (p *ChainPorter) RequestShipment(req Parcel) (*OutboundParcel, error) {
 	}
 }
 
+// ImportPendingParcel logs an outbound parcel whose anchor transaction was
+// already signed and broadcast by another instance of the daemon (for example
+// before its state was restored from a backup) as a pending parcel. The parcel
+// is then handed to the porter, which re-broadcasts the anchor transaction,
+// waits for it to confirm and delivers any outstanding proofs.
+func (p *ChainPorter) ImportPendingParcel(ctx context.Context,
+	parcel *OutboundParcel) error {
+
+	err := p.cfg.ExportLog.LogPendingParcel(
+		ctx, parcel, defaultWalletLeaseIdentifier,
+		time.Now().Add(defaultBroadcastCoinLeaseDuration),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to log pending parcel: %w", err)
+	}
+
+	// We don't wait for the parcel to be re-broadcast, as resumed parcels
+	// don't deliver a broadcast response.
+	pendingParcel := NewPendingParcel(parcel)
+	reportPendingParcel(*pendingParcel)
+
+	if !fn.SendOrQuit[Parcel](p.outboundParcels, pendingParcel, p.Quit) {
+		return fmt.Errorf("ChainPorter shutting down")
+	}
+
+	return nil
+}
+
 // QueryParcels returns the set of confirmed or unconfirmed parcels. If the
 // anchor tx hash is Some, then a query for an parcel with the matching anchor
 // hash will be made.
@@ -564,7 +597,9 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 
 		// Before we import the proof into the proof archive, we'll
 		// validate it.
-		verifier := &proof.BaseVerifier{}
+		verifier := &proof.StreamVerifier{
+			Cache: p.cfg.VerifiedProofCache,
+		}
 		_, err = verifier.Verify(
 			ctx, bytes.NewReader(outputProof.Blob),
 			headerVerifier, proof.DefaultMerkleVerifier,
@@ -877,7 +912,9 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 	// those that do not.
 	reportProofTransfers(notDeliveringOutputs, pendingDeliveryOutputs)
 
-	deliver := func(ctx context.Context, out TransferOutput) error {
+	deliver := func(ctx context.Context, out TransferOutput) (bool,
+		error) {
+
 		key := out.ScriptKey.PubKey
 
 		// We just look for the full proof in the list of final proofs
@@ -891,8 +928,8 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 			}
 		}
 		if receiverProof == nil {
-			return fmt.Errorf("no proof found for output with "+
-				"script key %x", key.SerializeCompressed())
+			return false, fmt.Errorf("no proof found for output "+
+				"with script key %x", key.SerializeCompressed())
 		}
 
 		log.Debugf("Attempting to deliver proof (script_key=%x, "+
@@ -903,8 +940,8 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 			string(out.ProofCourierAddr),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to parse proof courier "+
-				"address: %w", err)
+			return false, fmt.Errorf("failed to parse proof "+
+				"courier address: %w", err)
 		}
 
 		// Initiate proof courier service handle from the proof
@@ -913,8 +950,8 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 			ctx, proofCourierAddr, true,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to initiate proof courier "+
-				"service handle: %w", err)
+			return false, fmt.Errorf("unable to initiate proof "+
+				"courier service handle: %w", err)
 		}
 
 		defer courier.Close()
@@ -938,10 +975,10 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 		// later.
 		var backoffExecErr *proof.BackoffExecError
 		if errors.As(err, &backoffExecErr) {
-			return nil
+			return false, nil
 		}
 		if err != nil {
-			return fmt.Errorf("failed to deliver proof via "+
+			return false, fmt.Errorf("failed to deliver proof via "+
 				"courier service: %w", err)
 		}
 
@@ -951,17 +988,40 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 			ctx, out.Anchor.OutPoint, out.Position,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to log proof delivery "+
-				"confirmation: %w", err)
+			return false, fmt.Errorf("unable to log proof "+
+				"delivery confirmation: %w", err)
 		}
 
-		return nil
+		return true, nil
+	}
+
+	// We track the delivery state of each recipient individually, so a
+	// failed delivery to one recipient doesn't affect the others.
+	deliveries := fn.Map(
+		pendingDeliveryOutputs,
+		func(out TransferOutput) *ProofDelivery {
+			return &ProofDelivery{
+				Output: out,
+			}
+		},
+	)
+	deliverAndReport := func(ctx context.Context, d *ProofDelivery) error {
+		d.Delivered, d.Error = deliver(ctx, d.Output)
+
+		// Notify subscribers about the outcome of this specific
+		// delivery attempt.
+		event := newAssetSendEvent(SendStateTransferProofs, *pkg)
+		event.Error = d.Error
+		event.ProofDeliveries = []ProofDelivery{*d}
+		p.publishSubscriberEvent(event)
+
+		return d.Error
 	}
 
 	// If we have a non-interactive proof, then we'll launch several
 	// goroutines to deliver the proof(s) to the receiver(s).
 	instanceErrors, err := fn.ParSliceErrCollect(
-		ctx, pendingDeliveryOutputs, deliver,
+		ctx, deliveries, deliverAndReport,
 	)
 	if err != nil {
 		return fmt.Errorf("error delivering proof(s): %w", err)
@@ -970,7 +1030,7 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 	// If there were any errors during the proof delivery process, we'll
 	// log them all here.
 	for idx := range instanceErrors {
-		output := pkg.OutboundPkg.Outputs[idx]
+		output := pendingDeliveryOutputs[idx]
 		instanceErr := instanceErrors[idx]
 
 		scriptPubKey := output.ScriptKey.PubKey.SerializeCompressed()
@@ -990,11 +1050,14 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 	// if any.
 	var firstErr error
 	fn.PeekMap(instanceErrors).WhenSome(func(kv fn.KV[int, error]) {
-		firstErr = err
+		firstErr = kv.Value
 	})
 
 	if firstErr != nil {
-		return firstErr
+		return &proofDeliveryError{
+			err:        firstErr,
+			deliveries: derefDeliveries(deliveries),
+		}
 	}
 
 	// At this point, the transfer is fully finalised and successful:
@@ -1004,8 +1067,11 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 	log.Infof("Parcel transfer is fully complete (anchor_txid=%v)",
 		pkg.OutboundPkg.AnchorTx.TxHash())
 
-	// Send out the final notification that the transfer is complete.
-	p.publishSubscriberEvent(newAssetSendEvent(SendStateComplete, *pkg))
+	// Send out the final notification that the transfer is complete,
+	// including the delivery state of all recipients.
+	completeEvent := newAssetSendEvent(SendStateComplete, *pkg)
+	completeEvent.ProofDeliveries = derefDeliveries(deliveries)
+	p.publishSubscriberEvent(completeEvent)
 
 	pkg.SendState = SendStateComplete
 
@@ -1053,7 +1119,7 @@ func (p *ChainPorter) importLocalAddresses(ctx context.Context,
 		// On restart, we'll get an error that the output has already
 		// been added to the wallet, so we'll catch this now and move
 		// along if so.
-		case strings.Contains(err.Error(), "already exists"):
+		case errors.Is(err, tapgarden.ErrOutputAlreadyImported):
 			break
 
 		default:
@@ -1084,7 +1150,8 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"address parcel")
 		}
 		fundSendRes, err := p.cfg.AssetWallet.FundAddressSend(
-			ctx, tapsend.Bip86Only, addrParcel.destAddrs...,
+			ctx, tapsend.Bip86Only, addrParcel.selectStrategy,
+			addrParcel.destAddrs...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fund address send: "+
@@ -1444,10 +1511,18 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				log.Errorf("unable to transfer receiver "+
 					"proof: %v", err)
 
-				p.publishSubscriberEvent(newAssetSendErrorEvent(
+				event := newAssetSendErrorEvent(
 					err, SendStateTransferProofs,
 					currentPkg,
-				))
+				)
+
+				var deliveryErr *proofDeliveryError
+				if errors.As(err, &deliveryErr) {
+					event.ProofDeliveries =
+						deliveryErr.deliveries
+				}
+
+				p.publishSubscriberEvent(event)
 			}
 		}()
 
@@ -1605,6 +1680,51 @@ type AssetSendEvent struct {
 	// Transfer is the on-disk level information that tracks the pending
 	// transfer.
 	Transfer *OutboundParcel
+
+	// ProofDeliveries is the proof delivery state of the recipients of
+	// the transfer. This is only set for events of the
+	// SendStateTransferProofs and SendStateComplete states. Events that
+	// report on an individual delivery attempt only contain a single
+	// entry.
+	ProofDeliveries []ProofDelivery
+}
+
+// ProofDelivery describes the proof delivery state of a single transfer
+// output whose proof needs to be delivered to a remote recipient.
+type ProofDelivery struct {
+	// Output is the transfer output the proof is delivered for.
+	Output TransferOutput
+
+	// Delivered is true if the proof was delivered successfully.
+	Delivered bool
+
+	// Error is set if the delivery attempt failed. A failed delivery is
+	// re-attempted when the pending parcel is resumed.
+	Error error
+}
+
+// proofDeliveryError is returned if the proof delivery to at least one
+// recipient failed. It carries the delivery state of all recipients.
+type proofDeliveryError struct {
+	err        error
+	deliveries []ProofDelivery
+}
+
+// Error returns the error message of the first failed delivery.
+func (e *proofDeliveryError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the first failed delivery.
+func (e *proofDeliveryError) Unwrap() error {
+	return e.err
+}
+
+// derefDeliveries returns a copy of the given proof deliveries.
+func derefDeliveries(deliveries []*ProofDelivery) []ProofDelivery {
+	return fn.Map(deliveries, func(d *ProofDelivery) ProofDelivery {
+		return *d
+	})
 }
 
 // Timestamp returns the timestamp of the event.
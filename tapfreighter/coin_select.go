@@ -10,6 +10,13 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+)
+
+const (
+	// bnbMaxTries is the maximum number of branches the exact match
+	// (branch-and-bound) coin selection explores before giving up.
+	bnbMaxTries = 100_000
 )
 
 // NewCoinSelect creates a new CoinSelect.
@@ -136,6 +143,79 @@ func (s *CoinSelect) ReleaseCoins(ctx context.Context,
 	return s.coinLister.ReleaseCoins(ctx, utxoOutpoints...)
 }
 
+// ListConsolidations returns the consolidations of all currently eligible
+// coins for the given threshold, without leasing any coins.
+func (s *CoinSelect) ListConsolidations(ctx context.Context,
+	threshold uint32) ([]*PlannedConsolidation, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	return s.listConsolidations(ctx, threshold)
+}
+
+// SelectConsolidation returns the largest consolidation of all currently
+// eligible coins for the given threshold and leases its anchor outputs for the
+// default lease duration. If no asset needs to be consolidated, nil is
+// returned.
+func (s *CoinSelect) SelectConsolidation(ctx context.Context,
+	threshold uint32) (*PlannedConsolidation, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	consolidations, err := s.listConsolidations(ctx, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(consolidations) == 0 {
+		return nil, nil
+	}
+
+	// We lease all anchor outputs of the consolidation at once. The asset
+	// IDs of a group can share anchor outputs, so leasing the coins of
+	// each asset ID separately would find the shared anchor outputs
+	// already leased.
+	consolidation := consolidations[0]
+	expiry := time.Now().Add(defaultCoinLeaseDuration)
+	err = s.coinLister.LeaseCoins(
+		ctx, defaultWalletLeaseIdentifier, expiry,
+		consolidation.AnchorPoints...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lease coins: %w", err)
+	}
+
+	return consolidation, nil
+}
+
+// listConsolidations lists all eligible coins and plans their consolidations
+// for the given threshold. The caller must hold the coin lock.
+func (s *CoinSelect) listConsolidations(ctx context.Context,
+	threshold uint32) ([]*PlannedConsolidation, error) {
+
+	// Expired leases would otherwise keep coins out of the consolidation.
+	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
+		return nil, fmt.Errorf("unable to delete expired leases: %w",
+			err)
+	}
+
+	// We only consider coins with BIP-0086 script keys, as we can't know
+	// how to sign for script tree based keys without additional
+	// information.
+	coins, err := s.coinLister.ListEligibleCoins(
+		ctx, CommitmentConstraints{
+			CoinSelectType: tapsend.Bip86Only,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list eligible coins: %w", err)
+	}
+
+	return planConsolidations(coins, threshold), nil
+}
+
 // selectForAmount selects a subset of the given eligible commitments which
 // cumulatively sum to at least the minimum required amount. The selection
 // strategy determines how the commitments are selected.
@@ -144,54 +224,166 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
-	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
-
 	switch strategy {
 	case PreferMaxAmount:
 		// Sort eligible commitments from the largest amount to
 		// smallest.
-		sort.Slice(
+		sort.SliceStable(
 			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
+				return eligibleCommitments[i].Asset.Amount >
 					eligibleCommitments[j].Asset.Amount
+			},
+		)
+
+		return selectInOrder(minTotalAmount, eligibleCommitments)
 
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
+	case PreferMinAmount:
+		// Sort eligible commitments from the smallest amount to
+		// largest, so we consume the small (dust) commitments first.
+		sort.SliceStable(
+			eligibleCommitments, func(i, j int) bool {
+				return eligibleCommitments[i].Asset.Amount <
+					eligibleCommitments[j].Asset.Amount
 			},
 		)
 
-		// Select the first subset of eligible commitments which
-		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
-
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += anchoredCommitment.Asset.Amount
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
-			}
+		return selectInOrder(minTotalAmount, eligibleCommitments)
+
+	case ExactMatch:
+		exactMatch, ok := selectExactMatch(
+			minTotalAmount, eligibleCommitments,
+		)
+		if ok {
+			return exactMatch, nil
 		}
 
+		log.Debugf("No exact match found for amount %d among %d "+
+			"eligible commitments, falling back to %v",
+			minTotalAmount, len(eligibleCommitments),
+			PreferMaxAmount)
+
+		return s.selectForAmount(
+			minTotalAmount, eligibleCommitments, PreferMaxAmount,
+		)
+
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
 			"strategy: %v", strategy)
 	}
+}
+
+// selectInOrder selects the first subset of the given (already sorted)
+// eligible commitments which cumulatively sum to at least the minimum required
+// amount.
+func selectInOrder(minTotalAmount uint64,
+	eligibleCommitments []*AnchoredCommitment) ([]*AnchoredCommitment,
+	error) {
+
+	var selectedCommitments []*AnchoredCommitment
+	amountSum := uint64(0)
+	for _, anchoredCommitment := range eligibleCommitments {
+		selectedCommitments = append(
+			selectedCommitments, anchoredCommitment,
+		)
+
+		// Keep track of the total amount of assets we've seen so far.
+		amountSum += anchoredCommitment.Asset.Amount
+		if amountSum >= minTotalAmount {
+			// At this point a target min amount was specified and
+			// has been reached.
+			break
+		}
+	}
 
 	// Having examined all the eligible commitments, return an error if the
 	// minimal funding amount was not reached.
 	if amountSum < minTotalAmount {
 		return nil, ErrMatchingAssetsNotFound
 	}
+
 	return selectedCommitments, nil
 }
 
+// selectExactMatch runs a depth-first branch-and-bound search for a subset of
+// the eligible commitments that sums to exactly the target amount. Commitments
+// are explored from the largest amount to the smallest, so the first match
+// found tends to use few inputs. The search is bounded by bnbMaxTries, the
+// boolean return value indicates whether an exact match was found.
+func selectExactMatch(target uint64,
+	eligibleCommitments []*AnchoredCommitment) ([]*AnchoredCommitment,
+	bool) {
+
+	if target == 0 || len(eligibleCommitments) == 0 {
+		return nil, false
+	}
+
+	candidates := make([]*AnchoredCommitment, len(eligibleCommitments))
+	copy(candidates, eligibleCommitments)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Asset.Amount > candidates[j].Asset.Amount
+	})
+
+	// remaining[i] is the sum of all candidate amounts from index i
+	// onward. This allows us to prune branches that can never reach the
+	// target anymore.
+	remaining := make([]uint64, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].Asset.Amount
+	}
+	if remaining[0] < target {
+		return nil, false
+	}
+
+	var (
+		selection []int
+		tries     int
+		search    func(idx int, sum uint64) bool
+	)
+	search = func(idx int, sum uint64) bool {
+		tries++
+		switch {
+		case sum == target:
+			return true
+
+		// Bound: we either overshot the target, ran out of candidates,
+		// can no longer reach the target with what's left or spent
+		// our search budget.
+		case sum > target, idx >= len(candidates),
+			sum+remaining[idx] < target, tries > bnbMaxTries:
+
+			return false
+		}
+
+		// Branch: first try including the current candidate, then try
+		// omitting it.
+		selection = append(selection, idx)
+		if search(idx+1, sum+candidates[idx].Asset.Amount) {
+			return true
+		}
+		selection = selection[:len(selection)-1]
+
+		// If the next candidate has the same amount as the one we just
+		// omitted, then omitting it and including the next would lead
+		// to an equivalent selection, so we skip over all of those.
+		next := idx + 1
+		for next < len(candidates) &&
+			candidates[next].Asset.Amount ==
+				candidates[idx].Asset.Amount {
+
+			next++
+		}
+
+		return search(next, sum)
+	}
+
+	if !search(0, 0) {
+		return nil, false
+	}
+
+	return fn.Map(selection, func(i int) *AnchoredCommitment {
+		return candidates[i]
+	}), true
+}
+
 var _ CoinSelector = (*CoinSelect)(nil)
+var _ ConsolidationCoinSelector = (*CoinSelect)(nil)
@@ -222,6 +222,145 @@ func TestCoinSelection(t *testing.T) {
 				},
 			},
 		},
+		// Test that when the PreferMinAmount strategy is employed
+		// the smallest commitments are selected first.
+		{
+			name:           "prefer min amount",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 10,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 990,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 20,
+					},
+				},
+			},
+			strategy: PreferMinAmount,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 10,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 20,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 990,
+					},
+				},
+			},
+		},
+
+		// Test that the ExactMatch strategy finds a subset that sums
+		// to exactly the target amount, even if a single larger
+		// commitment would also cover it.
+		{
+			name:           "exact match",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 600,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 400,
+					},
+				},
+			},
+			strategy: ExactMatch,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 600,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 400,
+					},
+				},
+			},
+		},
+
+		// Test that the ExactMatch strategy falls back to the
+		// PreferMaxAmount strategy if no exact match exists.
+		{
+			name:           "exact match fallback",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 300,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 900,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 250,
+					},
+				},
+			},
+			strategy: ExactMatch,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 900,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 300,
+					},
+				},
+			},
+		},
+		{
+			name:           "exact match not enough assets",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 980,
+					},
+				},
+			},
+			strategy:    ExactMatch,
+			expectedErr: ErrMatchingAssetsNotFound.Error(),
+		},
 		{
 			name:           "not enough assets",
 			minTotalAmount: 1000,
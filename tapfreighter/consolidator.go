@@ -0,0 +1,567 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// MinConsolidationThreshold is the minimum number of anchor outputs
+	// an asset needs to be spread over before a consolidation makes sense.
+	MinConsolidationThreshold = 2
+
+	// consolidationNote is the transfer note that is attached to all
+	// transfers created by the consolidator.
+	consolidationNote = "automatic asset UTXO consolidation"
+)
+
+// ConsolidatorConfig is the config for the asset UTXO consolidator.
+type ConsolidatorConfig struct {
+	// CoinSelector is used to select and lease the asset UTXOs of each
+	// consolidation.
+	CoinSelector ConsolidationCoinSelector
+
+	// AssetWallet is the asset-level wallet that we'll use to fund and
+	// sign the consolidation virtual transactions.
+	AssetWallet Wallet
+
+	// KeyRing is used to derive the script and internal keys of the
+	// consolidated output.
+	KeyRing KeyRing
+
+	// ChainBridge is used to estimate the current on-chain fee rate.
+	ChainBridge ChainBridge
+
+	// Porter is used to ship the signed consolidation transfers.
+	Porter Porter
+
+	// ChainParams are the chain parameters of the current network.
+	ChainParams *address.ChainParams
+
+	// Threshold is the number of anchor outputs an asset ID or asset group
+	// needs to be spread over before the asset is consolidated into a
+	// single anchor output.
+	Threshold uint32
+
+	// MaxFeeRate is the fee rate ceiling. Consolidations are only
+	// performed while the estimated on-chain fee rate is at or below this
+	// value.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// CheckInterval is the interval at which the asset UTXOs are checked
+	// for consolidation candidates. If this is zero, the background check
+	// is disabled and consolidations can only be triggered manually.
+	CheckInterval time.Duration
+}
+
+// PlannedConsolidation describes a planned merge of all anchor outputs that
+// carry a specific asset ID or asset group into a single anchor output.
+type PlannedConsolidation struct {
+	// AssetSpecifier identifies the asset ID or the asset group that is
+	// being consolidated.
+	AssetSpecifier asset.Specifier
+
+	// Inputs are the asset coins that will be spent by the consolidation,
+	// grouped by their asset ID.
+	Inputs map[asset.ID][]*AnchoredCommitment
+
+	// AnchorPoints is the de-duplicated list of anchor outputs that will
+	// be spent by the consolidation.
+	AnchorPoints []wire.OutPoint
+
+	// TotalAmount is the total amount of asset units that are merged.
+	TotalAmount uint64
+
+	// EstimatedFee is the estimated on-chain fee of the consolidation
+	// anchor transaction.
+	EstimatedFee btcutil.Amount
+}
+
+// ConsolidationPlan is the full set of consolidations the consolidator would
+// perform at the current point in time.
+type ConsolidationPlan struct {
+	// Consolidations is the list of planned consolidations.
+	Consolidations []*PlannedConsolidation
+
+	// FeeRate is the current estimated on-chain fee rate.
+	FeeRate chainfee.SatPerKWeight
+
+	// MaxFeeRate is the configured fee rate ceiling.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// FeeRateTooHigh is true if the current fee rate is above the
+	// configured ceiling, in which case no consolidation will be
+	// performed.
+	FeeRateTooHigh bool
+}
+
+// TotalEstimatedFee returns the sum of the estimated fees of all planned
+// consolidations.
+func (p *ConsolidationPlan) TotalEstimatedFee() btcutil.Amount {
+	var total btcutil.Amount
+	for _, c := range p.Consolidations {
+		total += c.EstimatedFee
+	}
+
+	return total
+}
+
+// Consolidator is a background service that watches the wallet's asset UTXOs
+// and merges all anchor outputs of an asset ID or asset group into a single
+// anchor output once their number passes the configured threshold. A
+// consolidation is a full value, interactive send to ourselves that is
+// shipped through the ChainPorter as a pre-signed parcel.
+type Consolidator struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ConsolidatorConfig
+
+	// consolidateMtx makes sure only one consolidation round runs at any
+	// given time.
+	consolidateMtx sync.Mutex
+
+	*fn.ContextGuard
+}
+
+// NewConsolidator creates a new asset UTXO consolidator from the given config.
+func NewConsolidator(cfg *ConsolidatorConfig) *Consolidator {
+	return &Consolidator{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the background consolidation check, if enabled.
+func (c *Consolidator) Start() error {
+	c.startOnce.Do(func() {
+		if c.cfg.CheckInterval == 0 {
+			log.Infof("Automatic asset UTXO consolidation disabled")
+			return
+		}
+
+		log.Infof("Starting Consolidator (threshold=%d, "+
+			"max_fee_rate=%v, interval=%v)", c.cfg.Threshold,
+			c.cfg.MaxFeeRate.FeePerKVByte(), c.cfg.CheckInterval)
+
+		c.Wg.Add(1)
+		go c.consolidationLoop()
+	})
+
+	return nil
+}
+
+// Stop stops all active goroutines.
+func (c *Consolidator) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Infof("Stopping Consolidator")
+
+		close(c.Quit)
+		c.Wg.Wait()
+	})
+
+	return nil
+}
+
+// consolidationLoop periodically checks the asset UTXOs for consolidation
+// candidates and consolidates them if the fee rate allows it.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Consolidator) consolidationLoop() {
+	defer c.Wg.Done()
+
+	ticker := time.NewTicker(c.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := c.WithCtxQuitNoTimeout()
+			_, _, err := c.Consolidate(ctx)
+			cancel()
+
+			// A failed consolidation is not critical, we'll just
+			// try again in the next round.
+			if err != nil {
+				log.Warnf("Unable to consolidate asset UTXOs: "+
+					"%v", err)
+			}
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// PlanConsolidations returns the consolidations that would be performed at the
+// current point in time, without actually performing them.
+func (c *Consolidator) PlanConsolidations(
+	ctx context.Context) (*ConsolidationPlan, error) {
+
+	feeRate, err := c.cfg.ChainBridge.EstimateFee(
+		ctx, tapsend.SendConfTarget,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	consolidations, err := c.cfg.CoinSelector.ListConsolidations(
+		ctx, c.cfg.Threshold,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ConsolidationPlan{
+		Consolidations: consolidations,
+		FeeRate:        feeRate,
+		MaxFeeRate:     c.cfg.MaxFeeRate,
+		FeeRateTooHigh: feeRate > c.cfg.MaxFeeRate,
+	}
+	for _, consolidation := range plan.Consolidations {
+		consolidation.EstimatedFee = estimateConsolidationFee(
+			len(consolidation.AnchorPoints), feeRate,
+		)
+	}
+
+	return plan, nil
+}
+
+// Consolidate plans and, if the current fee rate is below the configured
+// ceiling, performs all consolidations. If consolidations were performed, the
+// returned plan contains exactly those, next to the outbound parcels of the
+// shipped consolidations.
+func (c *Consolidator) Consolidate(ctx context.Context) (*ConsolidationPlan,
+	[]*OutboundParcel, error) {
+
+	c.consolidateMtx.Lock()
+	defer c.consolidateMtx.Unlock()
+
+	plan, err := c.PlanConsolidations(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(plan.Consolidations) == 0 {
+		log.Debugf("No asset UTXOs to consolidate")
+		return plan, nil, nil
+	}
+
+	if plan.FeeRateTooHigh {
+		log.Infof("Skipping consolidation of %d assets, fee rate %v "+
+			"above ceiling %v", len(plan.Consolidations),
+			plan.FeeRate.FeePerKVByte(),
+			plan.MaxFeeRate.FeePerKVByte())
+
+		return plan, nil, nil
+	}
+
+	// Shipping a consolidation spends anchor outputs that the other
+	// planned consolidations might share. So we select each consolidation
+	// again from the coins that are still available, right before we fund
+	// it. The selected anchor outputs are leased, so each round sees fewer
+	// coins until no asset needs to be consolidated anymore.
+	performed := &ConsolidationPlan{
+		FeeRate:    plan.FeeRate,
+		MaxFeeRate: plan.MaxFeeRate,
+	}
+	var parcels []*OutboundParcel
+	for {
+		consolidation, err := c.cfg.CoinSelector.SelectConsolidation(
+			ctx, c.cfg.Threshold,
+		)
+		if err != nil {
+			return performed, parcels, fmt.Errorf("unable to "+
+				"select consolidation: %w", err)
+		}
+		if consolidation == nil {
+			break
+		}
+
+		consolidation.EstimatedFee = estimateConsolidationFee(
+			len(consolidation.AnchorPoints), plan.FeeRate,
+		)
+
+		parcel, err := c.consolidate(ctx, consolidation)
+		if err != nil {
+			return performed, parcels, fmt.Errorf("unable to "+
+				"consolidate %s: %w",
+				consolidation.AssetSpecifier.String(), err)
+		}
+
+		performed.Consolidations = append(
+			performed.Consolidations, consolidation,
+		)
+		parcels = append(parcels, parcel)
+	}
+
+	return performed, parcels, nil
+}
+
+// consolidate funds, signs and ships a single planned consolidation. The anchor
+// outputs of the consolidation must already be leased.
+func (c *Consolidator) consolidate(ctx context.Context,
+	consolidation *PlannedConsolidation) (*OutboundParcel, error) {
+
+	log.Infof("Consolidating %d anchor outputs of %s (total_amount=%d)",
+		len(consolidation.AnchorPoints),
+		consolidation.AssetSpecifier.String(),
+		consolidation.TotalAmount)
+
+	// If anything goes wrong, we release the leased coins again.
+	success := false
+	defer func() {
+		if success {
+			return
+		}
+
+		err := c.cfg.CoinSelector.ReleaseCoins(
+			ctx, consolidation.AnchorPoints...,
+		)
+		if err != nil {
+			log.Errorf("Unable to release coins: %v", err)
+		}
+	}()
+
+	// All virtual packets of a grouped asset commit to the same anchor
+	// output, so we only need a single internal key.
+	internalKey, err := c.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive internal key: %w", err)
+	}
+
+	var (
+		vPackets         []*tappsbt.VPacket
+		inputCommitments = make(tappsbt.InputCommitments)
+	)
+
+	// We create one virtual packet per asset ID, as a virtual packet can
+	// only ever spend and create a single asset ID.
+	assetIDs := fn.Filter(
+		sortedAssetIDs(consolidation.Inputs), func(id asset.ID) bool {
+			return len(consolidation.Inputs[id]) > 0
+		},
+	)
+	for _, assetID := range assetIDs {
+		vPkt, commitments, err := c.fundConsolidation(
+			ctx, assetID, consolidation.Inputs[assetID],
+			internalKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		vPackets = append(vPackets, vPkt)
+		for prevID, inputCommitment := range commitments {
+			inputCommitments[prevID] = inputCommitment
+		}
+	}
+
+	preSignedParcel := NewPreSignedParcel(
+		vPackets, inputCommitments, consolidationNote,
+	)
+	parcel, err := c.cfg.Porter.RequestShipment(preSignedParcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ship consolidation: %w", err)
+	}
+
+	success = true
+
+	return parcel, nil
+}
+
+// fundConsolidation creates, funds and signs a full value interactive virtual
+// packet that sends all given coins of the asset ID to a new script key of
+// ours. The anchor outputs of the coins must already be leased.
+func (c *Consolidator) fundConsolidation(ctx context.Context,
+	assetID asset.ID, coins []*AnchoredCommitment,
+	internalKey keychain.KeyDescriptor) (*tappsbt.VPacket,
+	tappsbt.InputCommitments, error) {
+
+	var (
+		totalAmount  uint64
+		assetVersion asset.Version
+	)
+	for _, coin := range coins {
+		totalAmount += coin.Asset.Amount
+		if coin.Asset.Version > assetVersion {
+			assetVersion = coin.Asset.Version
+		}
+	}
+
+	scriptKey, err := c.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to derive script key: %w",
+			err)
+	}
+
+	vPkt := tappsbt.ForInteractiveSend(
+		assetID, totalAmount, asset.NewScriptKeyBip86(scriptKey), 0, 0,
+		0, internalKey, assetVersion, c.cfg.ChainParams,
+	)
+
+	// The packet spends exactly the planned coins, without another round
+	// of coin selection.
+	fundDesc := &tapsend.FundingDescriptor{
+		AssetSpecifier: asset.NewSpecifierFromId(assetID),
+		Amount:         totalAmount,
+		CoinSelectType: tapsend.Bip86Only,
+	}
+	funded, err := c.cfg.AssetWallet.FundPacketWithInputs(
+		ctx, fundDesc, vPkt, coins,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fund consolidation "+
+			"packet: %w", err)
+	}
+
+	_, err = c.cfg.AssetWallet.SignVirtualPacket(funded.VPacket)
+	if err != nil {
+		return funded.VPacket, nil, fmt.Errorf("unable to sign "+
+			"consolidation packet: %w", err)
+	}
+
+	return funded.VPacket, funded.InputCommitments, nil
+}
+
+// planConsolidations groups the given coins by their asset ID or asset group
+// and returns a planned consolidation for each group that is spread over at
+// least threshold anchor outputs.
+func planConsolidations(coins []*AnchoredCommitment,
+	threshold uint32) []*PlannedConsolidation {
+
+	if threshold < MinConsolidationThreshold {
+		threshold = MinConsolidationThreshold
+	}
+
+	type coinGroup struct {
+		specifier asset.Specifier
+		coins     []*AnchoredCommitment
+	}
+
+	// The TAP commitment key is the group key for grouped assets and the
+	// asset ID for non-grouped assets, which is exactly the grouping we
+	// want.
+	groups := make(map[[32]byte]*coinGroup)
+	for _, coin := range coins {
+		// Collectibles can't be merged, so there's nothing to
+		// consolidate.
+		if coin.Asset.Type != asset.Normal {
+			continue
+		}
+
+		key := coin.Asset.TapCommitmentKey()
+		group, ok := groups[key]
+		if !ok {
+			group = &coinGroup{
+				specifier: asset.NewSpecifierOptionalGroupKey(
+					coin.Asset.ID(), coin.Asset.GroupKey,
+				),
+			}
+			groups[key] = group
+		}
+
+		group.coins = append(group.coins, coin)
+	}
+
+	var consolidations []*PlannedConsolidation
+	for _, group := range groups {
+		anchorPoints := fn.NewSet[wire.OutPoint]()
+		consolidation := &PlannedConsolidation{
+			AssetSpecifier: group.specifier,
+			Inputs: make(
+				map[asset.ID][]*AnchoredCommitment,
+			),
+		}
+		for _, coin := range group.coins {
+			anchorPoints.Add(coin.AnchorPoint)
+
+			assetID := coin.Asset.ID()
+			consolidation.Inputs[assetID] = append(
+				consolidation.Inputs[assetID], coin,
+			)
+			consolidation.TotalAmount += coin.Asset.Amount
+		}
+
+		if uint32(len(anchorPoints)) < threshold {
+			continue
+		}
+
+		consolidation.AnchorPoints = anchorPoints.ToSlice()
+		sort.Slice(consolidation.AnchorPoints, func(i, j int) bool {
+			return consolidation.AnchorPoints[i].String() <
+				consolidation.AnchorPoints[j].String()
+		})
+
+		consolidations = append(consolidations, consolidation)
+	}
+
+	// Make the order deterministic, largest consolidations first.
+	sort.Slice(consolidations, func(i, j int) bool {
+		ci, cj := consolidations[i], consolidations[j]
+		if len(ci.AnchorPoints) != len(cj.AnchorPoints) {
+			return len(ci.AnchorPoints) > len(cj.AnchorPoints)
+		}
+
+		return ci.AssetSpecifier.String() < cj.AssetSpecifier.String()
+	})
+
+	return consolidations
+}
+
+// estimateConsolidationFee estimates the on-chain fee of a consolidation
+// anchor transaction that spends the given number of asset anchor outputs. We
+// assume a single P2TR BTC input for funding the fee and a P2TR BTC change
+// output next to the single asset anchor output.
+func estimateConsolidationFee(numAnchorInputs int,
+	feeRate chainfee.SatPerKWeight) btcutil.Amount {
+
+	var weightEstimator input.TxWeightEstimator
+	for i := 0; i < numAnchorInputs+1; i++ {
+		weightEstimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	}
+
+	// The asset anchor output and the BTC change output.
+	weightEstimator.AddP2TROutput()
+	weightEstimator.AddP2TROutput()
+
+	return feeRate.FeeForWeight(weightEstimator.Weight())
+}
+
+// sortedAssetIDs returns the asset IDs of the given map in a deterministic
+// order.
+func sortedAssetIDs(m map[asset.ID][]*AnchoredCommitment) []asset.ID {
+	ids := make([]asset.ID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].String() < ids[j].String()
+	})
+
+	return ids
+}
@@ -0,0 +1,439 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// randAnchorPoint returns a random anchor outpoint.
+func randAnchorPoint(t *testing.T) wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  chainhash.Hash(test.RandBytes(32)),
+		Index: test.RandInt[uint32](),
+	}
+}
+
+// newConsolidationCoin creates an anchored commitment for the given asset
+// genesis, group key, amount and anchor point.
+func newConsolidationCoin(t *testing.T, genesis asset.Genesis,
+	groupKey *asset.GroupKey, amount uint64,
+	anchorPoint wire.OutPoint) *AnchoredCommitment {
+
+	return &AnchoredCommitment{
+		AnchorPoint: anchorPoint,
+		Asset: asset.NewAssetNoErr(
+			t, genesis, amount, 0, 0, asset.RandScriptKey(t),
+			groupKey,
+		),
+	}
+}
+
+// TestPlanConsolidations tests that asset coins are grouped by asset ID or
+// asset group and only planned for consolidation once they are spread over
+// enough anchor outputs.
+func TestPlanConsolidations(t *testing.T) {
+	t.Parallel()
+
+	// A non-grouped asset spread over three anchor outputs, with two coins
+	// sharing the same anchor output.
+	genA := asset.RandGenesis(t, asset.Normal)
+	anchorA1, anchorA2 := randAnchorPoint(t), randAnchorPoint(t)
+	anchorA3 := randAnchorPoint(t)
+	coinsA := []*AnchoredCommitment{
+		newConsolidationCoin(t, genA, nil, 10, anchorA1),
+		newConsolidationCoin(t, genA, nil, 20, anchorA1),
+		newConsolidationCoin(t, genA, nil, 30, anchorA2),
+		newConsolidationCoin(t, genA, nil, 40, anchorA3),
+	}
+
+	// Two asset IDs of the same asset group, each in their own anchor
+	// output.
+	genB := asset.RandGenesis(t, asset.Normal)
+	genC := asset.RandGenesis(t, asset.Normal)
+	groupKey := asset.RandGroupKey(
+		t, genB, asset.RandAssetWithValues(
+			t, genB, nil, asset.RandScriptKey(t),
+		),
+	)
+	coinsGroup := []*AnchoredCommitment{
+		newConsolidationCoin(t, genB, groupKey, 5, randAnchorPoint(t)),
+		newConsolidationCoin(t, genC, groupKey, 7, randAnchorPoint(t)),
+	}
+
+	// A single coin of yet another asset, which should never be
+	// consolidated.
+	genD := asset.RandGenesis(t, asset.Normal)
+	coinD := newConsolidationCoin(t, genD, nil, 100, randAnchorPoint(t))
+
+	// Collectibles can't be merged, no matter how many there are.
+	genE := asset.RandGenesis(t, asset.Collectible)
+	coinsE := []*AnchoredCommitment{
+		newConsolidationCoin(t, genE, nil, 1, randAnchorPoint(t)),
+		newConsolidationCoin(t, genE, nil, 1, randAnchorPoint(t)),
+		newConsolidationCoin(t, genE, nil, 1, randAnchorPoint(t)),
+	}
+
+	var coins []*AnchoredCommitment
+	coins = append(coins, coinsGroup...)
+	coins = append(coins, coinD)
+	coins = append(coins, coinsE...)
+	coins = append(coins, coinsA...)
+
+	// With the minimum threshold, both the non-grouped asset and the
+	// asset group are consolidated, the larger one first. A threshold
+	// below the minimum is clamped.
+	for _, threshold := range []uint32{0, MinConsolidationThreshold} {
+		plans := planConsolidations(coins, threshold)
+		require.Len(t, plans, 2)
+
+		planA := plans[0]
+		require.True(t, planA.AssetSpecifier.HasId())
+		require.False(t, planA.AssetSpecifier.HasGroupPubKey())
+		require.Equal(
+			t, genA.ID(), *planA.AssetSpecifier.UnwrapIdToPtr(),
+		)
+		require.ElementsMatch(
+			t, []wire.OutPoint{anchorA1, anchorA2, anchorA3},
+			planA.AnchorPoints,
+		)
+		require.Len(t, planA.Inputs, 1)
+		require.ElementsMatch(t, coinsA, planA.Inputs[genA.ID()])
+		require.EqualValues(t, 100, planA.TotalAmount)
+
+		planGroup := plans[1]
+		require.True(t, planGroup.AssetSpecifier.HasGroupPubKey())
+		require.Equal(
+			t, groupKey.GroupPubKey,
+			*planGroup.AssetSpecifier.UnwrapGroupKeyToPtr(),
+		)
+		require.Len(t, planGroup.AnchorPoints, 2)
+		require.Len(t, planGroup.Inputs, 2)
+		require.Len(t, planGroup.Inputs[genB.ID()], 1)
+		require.Len(t, planGroup.Inputs[genC.ID()], 1)
+		require.EqualValues(t, 12, planGroup.TotalAmount)
+	}
+
+	// With a higher threshold, only the non-grouped asset qualifies.
+	plans := planConsolidations(coins, 3)
+	require.Len(t, plans, 1)
+	require.Equal(t, genA.ID(), *plans[0].AssetSpecifier.UnwrapIdToPtr())
+
+	// And with an even higher threshold, nothing is consolidated.
+	require.Empty(t, planConsolidations(coins, 4))
+	require.Empty(t, planConsolidations(nil, MinConsolidationThreshold))
+}
+
+// TestEstimateConsolidationFee tests that the estimated consolidation fee
+// grows with the number of anchor inputs and the fee rate.
+func TestEstimateConsolidationFee(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = chainfee.SatPerKWeight(1_000)
+
+	require.Zero(t, estimateConsolidationFee(5, 0))
+
+	fee2 := estimateConsolidationFee(2, feeRate)
+	fee3 := estimateConsolidationFee(3, feeRate)
+	require.Positive(t, fee2)
+	require.Greater(t, fee3, fee2)
+
+	// Each additional anchor input adds exactly one taproot key spend
+	// input's worth of fee.
+	fee4 := estimateConsolidationFee(4, feeRate)
+	require.Equal(t, fee4-fee3, fee3-fee2)
+
+	require.Equal(
+		t, 2*estimateConsolidationFee(3, feeRate),
+		estimateConsolidationFee(3, 2*feeRate),
+	)
+}
+
+// consolidationKeyRing is a key ring that derives random keys.
+type consolidationKeyRing struct {
+	KeyRing
+}
+
+// DeriveNextKey derives a random key.
+func (k *consolidationKeyRing) DeriveNextKey(context.Context,
+	keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(nil),
+	}, nil
+}
+
+// consolidationCoinLister is a coin lister that only lists coins that aren't
+// leased and records the leased and released anchor outputs.
+type consolidationCoinLister struct {
+	CoinLister
+
+	coins    []*AnchoredCommitment
+	leased   [][]wire.OutPoint
+	released []wire.OutPoint
+
+	// numExpiredDeletes is the number of times expired leases were
+	// deleted.
+	numExpiredDeletes int
+}
+
+// ListEligibleCoins returns all coins that aren't leased.
+func (l *consolidationCoinLister) ListEligibleCoins(context.Context,
+	CommitmentConstraints) ([]*AnchoredCommitment, error) {
+
+	leased := fn.NewSet[wire.OutPoint]()
+	for _, outpoints := range l.leased {
+		for _, outpoint := range outpoints {
+			leased.Add(outpoint)
+		}
+	}
+	for _, outpoint := range l.released {
+		leased.Remove(outpoint)
+	}
+
+	return fn.Filter(l.coins, func(c *AnchoredCommitment) bool {
+		return !leased.Contains(c.AnchorPoint)
+	}), nil
+}
+
+// LeaseCoins records the leased anchor outputs.
+func (l *consolidationCoinLister) LeaseCoins(_ context.Context, _ [32]byte,
+	_ time.Time, outpoints ...wire.OutPoint) error {
+
+	l.leased = append(l.leased, outpoints)
+	return nil
+}
+
+// ReleaseCoins records the released anchor outputs.
+func (l *consolidationCoinLister) ReleaseCoins(_ context.Context,
+	outpoints ...wire.OutPoint) error {
+
+	l.released = append(l.released, outpoints...)
+	return nil
+}
+
+// DeleteExpiredLeases records that expired leases were deleted.
+func (l *consolidationCoinLister) DeleteExpiredLeases(context.Context) error {
+	l.numExpiredDeletes++
+	return nil
+}
+
+// consolidationChainBridge is a chain bridge that estimates a fixed fee rate.
+type consolidationChainBridge struct {
+	ChainBridge
+}
+
+// EstimateFee returns a fixed fee rate.
+func (c *consolidationChainBridge) EstimateFee(context.Context,
+	uint32) (chainfee.SatPerKWeight, error) {
+
+	return chainfee.FeePerKwFloor, nil
+}
+
+// newTestConsolidator creates a consolidator that consolidates the given coins
+// into fake parcels.
+func newTestConsolidator(coins []*AnchoredCommitment) (*Consolidator,
+	*consolidationCoinLister, *consolidationWallet, *consolidationPorter) {
+
+	var (
+		coinLister = &consolidationCoinLister{
+			coins: coins,
+		}
+		wallet = &consolidationWallet{
+			funded: make(map[asset.ID][]*AnchoredCommitment),
+		}
+		porter = &consolidationPorter{}
+	)
+	consolidator := NewConsolidator(&ConsolidatorConfig{
+		CoinSelector: NewCoinSelect(coinLister),
+		AssetWallet:  wallet,
+		KeyRing:      &consolidationKeyRing{},
+		ChainBridge:  &consolidationChainBridge{},
+		Porter:       porter,
+		ChainParams:  &address.RegressionNetTap,
+		Threshold:    MinConsolidationThreshold,
+		MaxFeeRate:   chainfee.FeePerKwFloor,
+	})
+
+	return consolidator, coinLister, wallet, porter
+}
+
+// consolidationWallet is an asset wallet that records the coins each virtual
+// packet is funded with.
+type consolidationWallet struct {
+	Wallet
+
+	funded map[asset.ID][]*AnchoredCommitment
+}
+
+// FundPacketWithInputs records the coins the packet is funded with.
+func (w *consolidationWallet) FundPacketWithInputs(_ context.Context,
+	fundDesc *tapsend.FundingDescriptor, vPkt *tappsbt.VPacket,
+	inputs []*AnchoredCommitment) (*FundedVPacket, error) {
+
+	assetID, err := fundDesc.AssetSpecifier.UnwrapIdOrErr()
+	if err != nil {
+		return nil, err
+	}
+	w.funded[assetID] = inputs
+
+	return &FundedVPacket{
+		VPacket:          vPkt,
+		InputCommitments: make(tappsbt.InputCommitments),
+	}, nil
+}
+
+// SignVirtualPacket pretends to sign the packet.
+func (w *consolidationWallet) SignVirtualPacket(*tappsbt.VPacket,
+	...SignVirtualPacketOption) ([]uint32, error) {
+
+	return nil, nil
+}
+
+// consolidationPorter is a porter that records the requested shipments.
+type consolidationPorter struct {
+	Porter
+
+	parcels []Parcel
+}
+
+// RequestShipment records the requested shipment.
+func (p *consolidationPorter) RequestShipment(
+	parcel Parcel) (*OutboundParcel, error) {
+
+	p.parcels = append(p.parcels, parcel)
+	return &OutboundParcel{}, nil
+}
+
+// TestConsolidateSharedAnchor tests that a grouped asset whose asset IDs share
+// an anchor output is consolidated with a single lease of all anchor outputs,
+// and that each virtual packet spends exactly the planned coins of its asset
+// ID.
+func TestConsolidateSharedAnchor(t *testing.T) {
+	t.Parallel()
+
+	genB := asset.RandGenesis(t, asset.Normal)
+	genC := asset.RandGenesis(t, asset.Normal)
+	groupKey := asset.RandGroupKey(
+		t, genB, asset.RandAssetWithValues(
+			t, genB, nil, asset.RandScriptKey(t),
+		),
+	)
+
+	// The two asset IDs of the group share the first anchor output.
+	sharedAnchor, otherAnchor := randAnchorPoint(t), randAnchorPoint(t)
+	coinB1 := newConsolidationCoin(t, genB, groupKey, 5, sharedAnchor)
+	coinC := newConsolidationCoin(t, genC, groupKey, 7, sharedAnchor)
+	coinB2 := newConsolidationCoin(t, genB, groupKey, 3, otherAnchor)
+
+	consolidator, coinLister, wallet, porter := newTestConsolidator(
+		[]*AnchoredCommitment{coinB1, coinC, coinB2},
+	)
+
+	plan, parcels, err := consolidator.Consolidate(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Consolidations, 1)
+	require.Len(t, parcels, 1)
+
+	// All anchor outputs are leased at once, and none of them are
+	// released, as the consolidation was shipped.
+	require.Len(t, coinLister.leased, 1)
+	require.ElementsMatch(
+		t, []wire.OutPoint{sharedAnchor, otherAnchor},
+		coinLister.leased[0],
+	)
+	require.Empty(t, coinLister.released)
+
+	// Both asset IDs are funded with their planned coins, including the
+	// ones in the shared anchor output.
+	require.Len(t, wallet.funded, 2)
+	require.ElementsMatch(
+		t, []*AnchoredCommitment{coinB1, coinB2},
+		wallet.funded[genB.ID()],
+	)
+	require.ElementsMatch(
+		t, []*AnchoredCommitment{coinC}, wallet.funded[genC.ID()],
+	)
+
+	require.Len(t, porter.parcels, 1)
+	parcel, ok := porter.parcels[0].(*PreSignedParcel)
+	require.True(t, ok)
+	require.Len(t, parcel.vPackets, 2)
+}
+
+// TestConsolidateReplans tests that each consolidation is selected from the
+// coins that are still available after the previous consolidation was
+// shipped, and that coins leased by others are never consolidated.
+func TestConsolidateReplans(t *testing.T) {
+	t.Parallel()
+
+	// Asset A is spread over three anchor outputs, asset D over two. One
+	// of the anchor outputs carries both assets.
+	genA := asset.RandGenesis(t, asset.Normal)
+	genD := asset.RandGenesis(t, asset.Normal)
+	anchor1, anchor2 := randAnchorPoint(t), randAnchorPoint(t)
+	anchor3, shared := randAnchorPoint(t), randAnchorPoint(t)
+	coins := []*AnchoredCommitment{
+		newConsolidationCoin(t, genA, nil, 10, anchor1),
+		newConsolidationCoin(t, genA, nil, 20, anchor2),
+		newConsolidationCoin(t, genA, nil, 30, shared),
+		newConsolidationCoin(t, genD, nil, 40, shared),
+		newConsolidationCoin(t, genD, nil, 50, anchor3),
+	}
+
+	ctx := context.Background()
+	consolidator, coinLister, wallet, porter := newTestConsolidator(coins)
+
+	// Up front, both assets would be consolidated.
+	plan, err := consolidator.PlanConsolidations(ctx)
+	require.NoError(t, err)
+	require.Len(t, plan.Consolidations, 2)
+	require.Empty(t, coinLister.leased)
+
+	// Once asset A was consolidated, the shared anchor output is spent
+	// by that transfer. Asset D is only left in a single anchor output,
+	// so it isn't consolidated with a stale plan.
+	plan, parcels, err := consolidator.Consolidate(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Len(t, plan.Consolidations, 1)
+	specifier := plan.Consolidations[0].AssetSpecifier
+	require.Equal(t, genA.ID(), *specifier.UnwrapIdToPtr())
+	require.Positive(t, plan.Consolidations[0].EstimatedFee)
+	require.Len(t, porter.parcels, 1)
+	require.Len(t, wallet.funded, 1)
+	require.Len(t, coinLister.leased, 1)
+	require.ElementsMatch(
+		t, []wire.OutPoint{anchor1, anchor2, shared},
+		coinLister.leased[0],
+	)
+
+	// Expired leases are cleaned up before every selection.
+	require.Equal(t, 4, coinLister.numExpiredDeletes)
+
+	// Coins leased by a concurrent send are never consolidated.
+	consolidator, coinLister, _, porter = newTestConsolidator(coins)
+	coinLister.leased = append(coinLister.leased, []wire.OutPoint{shared})
+
+	plan, parcels, err = consolidator.Consolidate(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Len(t, plan.Consolidations, 1)
+	require.ElementsMatch(
+		t, []wire.OutPoint{anchor1, anchor2},
+		plan.Consolidations[0].AnchorPoints,
+	)
+	require.Len(t, porter.parcels, 1)
+}
@@ -131,13 +131,23 @@ type CoinLister interface {
 
 // MultiCommitmentSelectStrategy is an enum that describes the strategy that
 // should be used when preferentially selecting multiple commitments.
-type MultiCommitmentSelectStrategy uint8
+type MultiCommitmentSelectStrategy = tapsend.MultiCommitmentSelectStrategy
 
 const (
 	// PreferMaxAmount is a strategy which considers commitments in order of
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
-	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+	PreferMaxAmount = tapsend.PreferMaxAmount
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount.
+	PreferMinAmount = tapsend.PreferMinAmount
+
+	// ExactMatch is a strategy which searches for a subset of commitments
+	// that sums to exactly the target amount, falling back to
+	// PreferMaxAmount if no such subset exists.
+	ExactMatch = tapsend.ExactMatch
 )
 
 // CoinSelector is an interface that describes the functionality used in
@@ -156,6 +166,26 @@ type CoinSelector interface {
 	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
 }
 
+// ConsolidationCoinSelector is an interface that describes the functionality
+// used in selecting the coins of asset UTXO consolidations.
+type ConsolidationCoinSelector interface {
+	// ListConsolidations returns the consolidations of all currently
+	// eligible coins for the given threshold, without leasing any coins.
+	ListConsolidations(ctx context.Context,
+		threshold uint32) ([]*PlannedConsolidation, error)
+
+	// SelectConsolidation returns the largest consolidation of all
+	// currently eligible coins for the given threshold and leases its
+	// anchor outputs for the default lease duration. If no asset needs to
+	// be consolidated, nil is returned.
+	SelectConsolidation(ctx context.Context,
+		threshold uint32) (*PlannedConsolidation, error)
+
+	// ReleaseCoins releases/unlocks coins that were previously leased and
+	// makes them available for coin selection again.
+	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
+}
+
 // TransferInput represents the database level input to an asset transfer.
 type TransferInput struct {
 	// PrevID contains the anchor point, ID and script key of the asset that
@@ -138,6 +138,10 @@ type AddressParcel struct {
 	// transferFeeRate is an optional manually-set feerate specified when
 	// requesting an asset transfer.
 	transferFeeRate *chainfee.SatPerKWeight
+
+	// selectStrategy is the strategy that should be used when selecting
+	// the asset coins to fund the transfer.
+	selectStrategy MultiCommitmentSelectStrategy
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -146,6 +150,7 @@ var _ Parcel = (*AddressParcel)(nil)
 
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
+	selectStrategy MultiCommitmentSelectStrategy,
 	destAddrs ...*address.Tap) *AddressParcel {
 
 	return &AddressParcel{
@@ -155,6 +160,7 @@ func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
 		},
 		destAddrs:       destAddrs,
 		transferFeeRate: feeRate,
+		selectStrategy:  selectStrategy,
 	}
 }
 
@@ -70,6 +70,7 @@ type Wallet interface {
 	// selected assets.
 	FundAddressSend(ctx context.Context,
 		coinSelectType tapsend.CoinSelectType,
+		selectStrategy tapsend.MultiCommitmentSelectStrategy,
 		receiverAddrs ...*address.Tap) (*FundedVPacket, error)
 
 	// FundPacket funds a virtual transaction, selecting assets to spend
@@ -78,6 +79,13 @@ type Wallet interface {
 	FundPacket(ctx context.Context, fundDesc *tapsend.FundingDescriptor,
 		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
 
+	// FundPacketWithInputs funds a virtual transaction with the given
+	// asset coins instead of selecting them. The caller is responsible
+	// for leasing the anchor outputs of the coins.
+	FundPacketWithInputs(ctx context.Context,
+		fundDesc *tapsend.FundingDescriptor, vPkt *tappsbt.VPacket,
+		inputs []*AnchoredCommitment) (*FundedVPacket, error)
+
 	// FundBurn funds a virtual transaction for burning the given amount of
 	// units of the given asset.
 	FundBurn(ctx context.Context,
@@ -241,6 +249,7 @@ type FundedVPacket struct {
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	coinSelectType tapsend.CoinSelectType,
+	selectStrategy tapsend.MultiCommitmentSelectStrategy,
 	receiverAddrs ...*address.Tap) (*FundedVPacket, error) {
 
 	// We start by creating a new virtual transaction that will be used to
@@ -259,6 +268,7 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	}
 
 	fundDesc.CoinSelectType = coinSelectType
+	fundDesc.SelectStrategy = selectStrategy
 	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
 	if err != nil {
 		return nil, err
@@ -369,26 +379,8 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	fundDesc *tapsend.FundingDescriptor,
 	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
 
-	// The input and address networks must match.
-	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
-		return nil, address.ErrMismatchedHRP
-	}
-
-	// Each anchor output must have a valid set of AltLeaves at this point.
-	outputAltLeaves := make(map[uint32][]asset.AltLeaf[asset.Asset])
-	for _, vOut := range vPkt.Outputs {
-		outputAltLeaves[vOut.AnchorOutputIndex] = append(
-			outputAltLeaves[vOut.AnchorOutputIndex],
-			asset.CopyAltLeaves(vOut.AltLeaves)...,
-		)
-	}
-
-	for anchorIdx, leaves := range outputAltLeaves {
-		err := asset.ValidAltLeaves(leaves)
-		if err != nil {
-			return nil, fmt.Errorf("anchor output %d invalid alt "+
-				"leaves: %w", anchorIdx, err)
-		}
+	if err := f.validateFundPacket(vPkt); err != nil {
+		return nil, err
 	}
 
 	// We need to find a commitment that has enough assets to satisfy this
@@ -410,7 +402,7 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	}
 
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount, *anchorVersion,
+		ctx, constraints, fundDesc.SelectStrategy, *anchorVersion,
 	)
 	if err != nil {
 		return nil, err
@@ -447,6 +439,52 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	return pkt, nil
 }
 
+// FundPacketWithInputs funds a virtual transaction with the given asset coins
+// instead of selecting them. The caller is responsible for leasing the anchor
+// outputs of the coins.
+func (f *AssetWallet) FundPacketWithInputs(ctx context.Context,
+	fundDesc *tapsend.FundingDescriptor, vPkt *tappsbt.VPacket,
+	inputs []*AnchoredCommitment) (*FundedVPacket, error) {
+
+	if err := f.validateFundPacket(vPkt); err != nil {
+		return nil, err
+	}
+
+	if len(inputs) == 0 {
+		return nil, ErrMatchingAssetsNotFound
+	}
+
+	return f.fundPacketWithInputs(ctx, fundDesc, vPkt, inputs)
+}
+
+// validateFundPacket makes sure the given virtual transaction can be funded by
+// this wallet.
+func (f *AssetWallet) validateFundPacket(vPkt *tappsbt.VPacket) error {
+	// The input and address networks must match.
+	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
+		return address.ErrMismatchedHRP
+	}
+
+	// Each anchor output must have a valid set of AltLeaves at this point.
+	outputAltLeaves := make(map[uint32][]asset.AltLeaf[asset.Asset])
+	for _, vOut := range vPkt.Outputs {
+		outputAltLeaves[vOut.AnchorOutputIndex] = append(
+			outputAltLeaves[vOut.AnchorOutputIndex],
+			asset.CopyAltLeaves(vOut.AltLeaves)...,
+		)
+	}
+
+	for anchorIdx, leaves := range outputAltLeaves {
+		err := asset.ValidAltLeaves(leaves)
+		if err != nil {
+			return fmt.Errorf("anchor output %d invalid alt "+
+				"leaves: %w", anchorIdx, err)
+		}
+	}
+
+	return nil
+}
+
 // FundBurn funds a virtual transaction for burning the given amount of units of
 // the given asset.
 func (f *AssetWallet) FundBurn(ctx context.Context,
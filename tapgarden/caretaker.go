@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -825,7 +824,7 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// On restart, we'll get an error that the output has already
 		// been added to the wallet, so we'll catch this now and move
 		// along if so.
-		case strings.Contains(err.Error(), "already exists"):
+		case errors.Is(err, ErrOutputAlreadyImported):
 			break
 
 		default:
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -92,6 +91,10 @@ type CustodianConfig struct {
 	// backend.
 	ChainBridge ChainBridge
 
+	// KeyRing is used to derive the keys of the Taproot Assets key family
+	// when recovering assets from the seed of the backing lnd node.
+	KeyRing KeyRing
+
 	// GroupVerifier is used to verify the validity of the group key for an
 	// asset.
 	GroupVerifier proof.GroupVerifier
@@ -737,7 +740,7 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 	// On restart, we'll get an error that the output has already
 	// been added to the wallet, so we'll catch this now and move
 	// along if so.
-	case strings.Contains(err.Error(), "already exists"):
+	case errors.Is(err, ErrOutputAlreadyImported):
 
 	default:
 		return err
@@ -305,6 +305,13 @@ type ChainBridge interface {
 		reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent,
 		chan error, error)
 
+	// RegisterSpendNtfn registers an intent to be notified once the given
+	// outpoint is spent. If it was already spent after the given height
+	// hint, the spend is dispatched right away.
+	RegisterSpendNtfn(ctx context.Context, outpoint *wire.OutPoint,
+		pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent,
+		chan error, error)
+
 	// RegisterBlockEpochNtfn registers an intent to be notified of each
 	// new block connected to the main chain.
 	RegisterBlockEpochNtfn(ctx context.Context) (chan int32, chan error,
@@ -384,6 +391,18 @@ type WalletAnchor interface {
 	MinRelayFee(ctx context.Context) (chainfee.SatPerKWeight, error)
 }
 
+// CommitmentWallet is the wallet interface used to fund and sign universe
+// commitment transactions. Besides the wallet inputs, these also spend the
+// output of the previous commitment, which the wallet only signs for but
+// doesn't finalize.
+type CommitmentWallet interface {
+	WalletAnchor
+
+	// SignPsbt signs all the inputs it can in the passed-in PSBT packet,
+	// returning a new one with updated signature/witness data.
+	SignPsbt(ctx context.Context, packet *psbt.Packet) (*psbt.Packet, error)
+}
+
 // KeyRing is a mirror of the keychain.KeyRing interface, with the addition of
 // a passed context which allows for cancellation of requests.
 type KeyRing interface {
@@ -412,4 +431,8 @@ var (
 	// ErrBatchAlreadySealed is returned when a minting batch is already
 	// sealed.
 	ErrBatchAlreadySealed = errors.New("batch is already sealed")
+
+	// ErrOutputAlreadyImported is returned by the wallet when a Taproot
+	// output that is imported is already known to it.
+	ErrOutputAlreadyImported = errors.New("output already imported")
 )
@@ -216,6 +216,32 @@ func (m *MockWalletAnchor) SignAndFinalizePsbt(ctx context.Context,
 	return pkt, nil
 }
 
+// SignPsbt attaches a dummy key spend signature to all inputs of the PSBT, so
+// they can be finalized.
+func (m *MockWalletAnchor) SignPsbt(ctx context.Context,
+	pkt *psbt.Packet) (*psbt.Packet, error) {
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("shutting down")
+	default:
+	}
+
+	for idx := range pkt.Inputs {
+		pkt.Inputs[idx].TaprootKeySpendSig = make(
+			[]byte, schnorr.SignatureSize,
+		)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("shutting down")
+	case m.SignPsbtSignal <- struct{}{}:
+	}
+
+	return pkt, nil
+}
+
 func (m *MockWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	pub *btcec.PublicKey) (btcutil.Address, error) {
 
@@ -416,6 +442,24 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, m.confErr, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent. The mock never reports any spends.
+func (m *MockChainBridge) RegisterSpendNtfn(ctx context.Context,
+	_ *wire.OutPoint, _ []byte, _ uint32) (*chainntnfs.SpendEvent,
+	chan error, error) {
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("shutting down")
+	default:
+	}
+
+	return &chainntnfs.SpendEvent{
+		Spend:  make(chan *chainntnfs.SpendDetail),
+		Cancel: func() {},
+	}, make(chan error), nil
+}
+
 func (m *MockChainBridge) RegisterBlockEpochNtfn(
 	ctx context.Context) (chan int32, chan error, error) {
 
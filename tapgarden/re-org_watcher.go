@@ -70,6 +70,11 @@ type ReOrgWatcherConfig struct {
 	// updated proofs.
 	ProofArchive proof.Archiver
 
+	// VerifiedProofCache is an optional cache of verified proofs. Proofs
+	// anchored in blocks that are re-organized out of the chain are removed
+	// from it.
+	VerifiedProofCache proof.VerifiedProofCache
+
 	// NonBuriedAssetFetcher is a function that returns all assets that are
 	// not yet sufficiently deep buried.
 	NonBuriedAssetFetcher func(ctx context.Context,
@@ -345,6 +350,21 @@ func (w *ReOrgWatcher) updateProofs(proofNtfn *anchorTxNotification,
 	return nil
 }
 
+// purgeVerifiedProofs removes all proofs anchored in the given block from the
+// verified proof cache, if there is one.
+func (w *ReOrgWatcher) purgeVerifiedProofs(ctx context.Context,
+	blockHash chainhash.Hash) error {
+
+	if w.cfg.VerifiedProofCache == nil {
+		return nil
+	}
+
+	log.Infof("Purging verified proofs of re-organized block %v",
+		blockHash)
+
+	return w.cfg.VerifiedProofCache.PurgeBlock(ctx, blockHash)
+}
+
 // watchTransactions processes new proofs given to the watcher and watches their
 // anchor transactions until they reach a safe confirmation depth.
 func (w *ReOrgWatcher) watchTransactions() {
@@ -437,6 +457,16 @@ func (w *ReOrgWatcher) watchTransactions() {
 				continue
 			}
 
+			// The block the proofs were anchored in is no longer
+			// part of the chain, so any proof we verified with it
+			// needs to be verified again.
+			err = w.purgeVerifiedProofs(runCtx, firstReg.blockHash)
+			if err != nil {
+				w.reportErr(fmt.Errorf("error purging "+
+					"verified proofs: %w", err))
+				return
+			}
+
 			// We can now update the proofs with the new block and
 			// inform the caller if necessary.
 			err = w.updateProofs(txNtfn, conf)
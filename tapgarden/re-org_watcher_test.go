@@ -2,6 +2,7 @@ package tapgarden
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -25,11 +26,53 @@ const (
 	testReOrgBlockHeight   = 123_654
 )
 
+// mockVerifiedProofCache is a verified proof cache that only records which
+// blocks were purged.
+type mockVerifiedProofCache struct {
+	purged []chainhash.Hash
+	mu     sync.Mutex
+}
+
+// IsVerified always returns false.
+func (c *mockVerifiedProofCache) IsVerified(context.Context,
+	[32]byte) (bool, error) {
+
+	return false, nil
+}
+
+// InsertVerified ignores the given proofs.
+func (c *mockVerifiedProofCache) InsertVerified(context.Context,
+	...proof.VerifiedProof) error {
+
+	return nil
+}
+
+// PurgeBlock records the purged block hash.
+func (c *mockVerifiedProofCache) PurgeBlock(_ context.Context,
+	blockHash chainhash.Hash) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.purged = append(c.purged, blockHash)
+
+	return nil
+}
+
+// purgedBlocks returns the hashes of all purged blocks.
+func (c *mockVerifiedProofCache) purgedBlocks() []chainhash.Hash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]chainhash.Hash(nil), c.purged...)
+}
+
 type reOrgWatcherHarness struct {
 	t           *testing.T
 	w           *ReOrgWatcher
 	cfg         *ReOrgWatcherConfig
 	chainBridge *MockChainBridge
+	proofCache  *mockVerifiedProofCache
 }
 
 // assertStartup makes sure the custodian was started correctly.
@@ -49,9 +92,11 @@ func (h *reOrgWatcherHarness) eventually(fn func() bool) {
 
 func newReOrgWatcherHarness(t *testing.T) *reOrgWatcherHarness {
 	chainBridge := NewMockChainBridge()
+	proofCache := &mockVerifiedProofCache{}
 	cfg := &ReOrgWatcherConfig{
-		ChainBridge:   chainBridge,
-		GroupVerifier: GenMockGroupVerifier(),
+		ChainBridge:        chainBridge,
+		GroupVerifier:      GenMockGroupVerifier(),
+		VerifiedProofCache: proofCache,
 		NonBuriedAssetFetcher: func(ctx context.Context,
 			minHeight int32) ([]*asset.ChainAsset, error) {
 
@@ -65,6 +110,7 @@ func newReOrgWatcherHarness(t *testing.T) *reOrgWatcherHarness {
 		w:           NewReOrgWatcher(cfg),
 		cfg:         cfg,
 		chainBridge: chainBridge,
+		proofCache:  proofCache,
 	}
 }
 
@@ -168,7 +214,9 @@ func TestWatchProofs(t *testing.T) {
 	_, err = fn.RecvOrTimeout(h.chainBridge.ConfReqSignal, testTimeout)
 	require.NoError(h.t, err)
 
-	// Let's now re-org the chain for anchor TX 1.
+	// Let's now re-org the chain for anchor TX 1. The proofs are updated in
+	// place, so we need to remember the block they were anchored in first.
+	oldBlockHash := proofSlice1[0].BlockHeader.BlockHash()
 	conf1Chan := h.chainBridge.ConfReqs[*conf1]
 	conf1Chan.Confirmed <- &chainntnfs.TxConfirmation{
 		BlockHash:   &newBlockHash,
@@ -188,6 +236,12 @@ func TestWatchProofs(t *testing.T) {
 	// not have been called.
 	require.EqualValues(t, 0, cb2Called.Load())
 
+	// The proofs verified with the re-organized block must have been
+	// purged from the verified proof cache.
+	require.Equal(
+		t, []chainhash.Hash{oldBlockHash}, h.proofCache.purgedBlocks(),
+	)
+
 	// We now "mine" a block that is sufficiently higher than the safe depth
 	// to cause the proofs to all be removed from the watcher.
 	h.chainBridge.NewBlocks <- testReOrgBlockHeight + (testSafeDepth * 2)
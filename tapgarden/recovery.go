@@ -0,0 +1,805 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// DefaultRecoveryGapLimit is the default number of consecutive unused
+	// key indexes after which the key derivation of an asset recovery
+	// stops.
+	DefaultRecoveryGapLimit = 100
+
+	// DefaultRecoverySpendCheckTimeout is the default time we wait for
+	// the chain backend to report spends of the anchor outputs of
+	// recovered assets.
+	DefaultRecoverySpendCheckTimeout = 10 * time.Second
+)
+
+// RecoveryRequest describes the parameters of a seed based asset recovery.
+type RecoveryRequest struct {
+	// GapLimit is the number of consecutive unused key indexes after
+	// which the key derivation stops.
+	GapLimit uint32
+
+	// Sources is the set of universes that are scanned for leaves with a
+	// script key that was derived from the seed of the backing lnd node.
+	Sources []universe.DiffEngine
+
+	// CourierAddr is the address of the universe RPC proof courier the
+	// full proof files of all matching leaves are fetched from.
+	CourierAddr *url.URL
+
+	// SpendCheckTimeout is the time we wait for the chain backend to
+	// report spends of the anchor outputs of recovered assets. The blocks
+	// are scanned for spends of all outputs without a reported spend.
+	SpendCheckTimeout time.Duration
+}
+
+// RecoveryResult is the result of a seed based asset recovery.
+type RecoveryResult struct {
+	// NumKeysScanned is the number of keys that were derived from the
+	// Taproot Assets key family and matched against the universe leaves.
+	NumKeysScanned uint32
+
+	// LastUsedKeyIndex is the highest key index that was found to be in
+	// use, if any.
+	LastUsedKeyIndex fn.Option[uint32]
+
+	// NumAssetsRecovered is the number of assets that were recovered and
+	// imported into the proof archive.
+	NumAssetsRecovered int
+
+	// NumAssetsKnown is the number of matching assets that were already
+	// present in the proof archive.
+	NumAssetsKnown int
+
+	// NumAssetsSpent is the number of matching assets whose anchor output
+	// was already spent on chain.
+	NumAssetsSpent int
+
+	// NumAssetsSkipped is the number of matching assets that were skipped
+	// because the internal key of their anchor output couldn't be derived
+	// within the gap limit.
+	NumAssetsSkipped int
+}
+
+// recoveryCandidate is a universe leaf with a script key that was derived
+// from the seed of the backing lnd node.
+type recoveryCandidate struct {
+	// source is the universe the leaf was found in.
+	source universe.DiffEngine
+
+	// id is the identifier of the universe the leaf was found in.
+	id universe.Identifier
+
+	// key is the key of the leaf.
+	key universe.LeafKey
+}
+
+// recoveredProof is a proof file fetched for a recovery candidate that still
+// needs to be imported.
+type recoveredProof struct {
+	// annotatedProof is the full proof file of the recovered asset.
+	annotatedProof *proof.AnnotatedProof
+
+	// lastProof is the last proof of the proof file.
+	lastProof *proof.Proof
+
+	// scriptKey is the script key of the asset, including its derivation
+	// information.
+	scriptKey asset.ScriptKey
+
+	// internalKey is the internal key of the asset's anchor output,
+	// including its derivation information.
+	internalKey keychain.KeyDescriptor
+}
+
+// derivedKeys is the set of keys derived from the Taproot Assets key family
+// during a recovery.
+type derivedKeys struct {
+	// scriptKeys maps the x-only serialized BIP-0086 script key of each
+	// derived key to the full script key.
+	scriptKeys map[[32]byte]asset.ScriptKey
+
+	// internalKeys maps the compressed serialized derived keys to their
+	// key descriptors.
+	internalKeys map[[33]byte]keychain.KeyDescriptor
+
+	// numKeys is the number of keys derived so far, which is also the
+	// index of the next key to derive.
+	numKeys uint32
+}
+
+// newDerivedKeys creates a new empty set of derived keys.
+func newDerivedKeys() *derivedKeys {
+	return &derivedKeys{
+		scriptKeys:   make(map[[32]byte]asset.ScriptKey),
+		internalKeys: make(map[[33]byte]keychain.KeyDescriptor),
+	}
+}
+
+// deriveNext derives the next key of the Taproot Assets key family and adds
+// it to the set. The BIP-0086 script key of the derived key is returned.
+func (d *derivedKeys) deriveNext(ctx context.Context,
+	keyRing KeyRing) (asset.ScriptKey, error) {
+
+	keyDesc, err := keyRing.DeriveKey(ctx, keychain.KeyLocator{
+		Family: asset.TaprootAssetsKeyFamily,
+		Index:  d.numKeys,
+	})
+	if err != nil {
+		return asset.ScriptKey{}, fmt.Errorf("unable to derive key "+
+			"%d: %w", d.numKeys, err)
+	}
+	d.numKeys++
+
+	var internalKey [33]byte
+	copy(internalKey[:], keyDesc.PubKey.SerializeCompressed())
+	d.internalKeys[internalKey] = keyDesc
+
+	scriptKey := asset.NewScriptKeyBip86(keyDesc)
+	d.scriptKeys[xOnlyKey(scriptKey.PubKey)] = scriptKey
+
+	return scriptKey, nil
+}
+
+// xOnlyKey returns the x-only serialization of the given public key.
+func xOnlyKey(key *btcec.PublicKey) [32]byte {
+	var xOnly [32]byte
+	copy(xOnly[:], schnorr.SerializePubKey(key))
+
+	return xOnly
+}
+
+// scanKeys derives the keys of the Taproot Assets key family and matches their
+// BIP-0086 script keys against the given set of candidate script keys. The
+// derivation stops once gapLimit consecutive keys didn't match any candidate.
+// The highest index of a matching key is returned, if any.
+func scanKeys(ctx context.Context, keyRing KeyRing, keys *derivedKeys,
+	candidates map[[32]byte][]recoveryCandidate,
+	gapLimit uint32) (fn.Option[uint32], error) {
+
+	var (
+		lastUsed   fn.Option[uint32]
+		unusedKeys uint32
+	)
+	for unusedKeys < gapLimit {
+		index := keys.numKeys
+		scriptKey, err := keys.deriveNext(ctx, keyRing)
+		if err != nil {
+			return lastUsed, err
+		}
+
+		if _, ok := candidates[xOnlyKey(scriptKey.PubKey)]; !ok {
+			unusedKeys++
+			continue
+		}
+
+		log.Debugf("Found universe leaves for script key %x at key "+
+			"index %d", scriptKey.PubKey.SerializeCompressed(),
+			index)
+
+		lastUsed = fn.Some(index)
+		unusedKeys = 0
+	}
+
+	return lastUsed, nil
+}
+
+// fetchAllRoots fetches all universe roots from the given universe.
+func fetchAllRoots(ctx context.Context,
+	source universe.DiffEngine) ([]universe.Root, error) {
+
+	var (
+		offset int32
+		roots  []universe.Root
+	)
+	for {
+		page, err := source.RootNodes(ctx, universe.RootNodesQuery{
+			SortDirection: universe.SortAscending,
+			Offset:        offset,
+			Limit:         universe.RequestPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch universe "+
+				"roots: %w", err)
+		}
+
+		roots = append(roots, page...)
+
+		// If we're getting a partial page, then we know we're done.
+		if len(page) < universe.RequestPageSize {
+			return roots, nil
+		}
+
+		offset += universe.RequestPageSize
+	}
+}
+
+// fetchAllLeafKeys fetches all leaf keys of the given universe.
+func fetchAllLeafKeys(ctx context.Context, source universe.DiffEngine,
+	id universe.Identifier) ([]universe.LeafKey, error) {
+
+	var (
+		offset int32
+		keys   []universe.LeafKey
+	)
+	for {
+		page, err := source.UniverseLeafKeys(
+			ctx, universe.UniverseLeafKeysQuery{
+				Id:            id,
+				SortDirection: universe.SortAscending,
+				Offset:        offset,
+				Limit:         universe.RequestPageSize,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys of "+
+				"universe %v: %w", id.String(), err)
+		}
+
+		keys = append(keys, page...)
+
+		if len(page) < universe.RequestPageSize {
+			return keys, nil
+		}
+
+		offset += universe.RequestPageSize
+	}
+}
+
+// collectCandidates fetches all leaf keys of all universes of the given
+// sources, keyed by their x-only serialized script key.
+func collectCandidates(ctx context.Context,
+	sources []universe.DiffEngine) (map[[32]byte][]recoveryCandidate,
+	error) {
+
+	candidates := make(map[[32]byte][]recoveryCandidate)
+	for _, source := range sources {
+		roots, err := fetchAllRoots(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, root := range roots {
+			leafKeys, err := fetchAllLeafKeys(ctx, source, root.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, leafKey := range leafKeys {
+				if leafKey.ScriptKey == nil ||
+					leafKey.ScriptKey.PubKey == nil {
+
+					continue
+				}
+
+				key := xOnlyKey(leafKey.ScriptKey.PubKey)
+				candidates[key] = append(
+					candidates[key], recoveryCandidate{
+						source: source,
+						id:     root.ID,
+						key:    leafKey,
+					},
+				)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// anchorOutput is the anchor output of a recovered asset that needs to be
+// checked for spends.
+type anchorOutput struct {
+	// pkScript is the output script of the anchor output.
+	pkScript []byte
+
+	// heightHint is the height of the block the anchor output was
+	// confirmed in, which is the earliest height it can be spent at.
+	heightHint uint32
+}
+
+// findSpentOutpoints returns the subset of the given anchor outputs that were
+// already spent. Spends reported through spend notifications within the given
+// timeout are taken as is. The chain backend never signals that an output is
+// unspent though, so the spend state of all outputs without a reported spend
+// is unknown at that point. Those are checked by scanning the blocks from the
+// lowest of their height hints up to the chain tip, which is the only way to
+// make sure we never consider a spent output to be unspent.
+func findSpentOutpoints(ctx context.Context, chain ChainBridge,
+	anchors map[wire.OutPoint]anchorOutput,
+	timeout time.Duration) (fn.Set[wire.OutPoint], error) {
+
+	spent, err := notifiedSpends(ctx, chain, anchors, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		unknown     = fn.NewSet[wire.OutPoint]()
+		startHeight uint32
+	)
+	for outpoint, anchor := range anchors {
+		if spent.Contains(outpoint) {
+			continue
+		}
+
+		if len(unknown) == 0 || anchor.heightHint < startHeight {
+			startHeight = anchor.heightHint
+		}
+		unknown.Add(outpoint)
+	}
+
+	if len(unknown) == 0 {
+		return spent, nil
+	}
+
+	log.Infof("No spend reported for %d recovered anchor outputs within "+
+		"%v, scanning blocks from height %d", len(unknown), timeout,
+		startHeight)
+
+	scanned, err := scanForSpends(ctx, chain, startHeight, unknown)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine spend state of "+
+			"recovered anchor outputs: %w", err)
+	}
+
+	return spent.Union(scanned), nil
+}
+
+// notifiedSpends registers a spend notification for each of the given anchor
+// outputs and returns the subset of them for which a spend was dispatched
+// within the given timeout. The chain backend looks up each outpoint and
+// dispatches spends that happened after its height hint right away. The wait
+// ends early once every output was reported spent.
+func notifiedSpends(ctx context.Context, chain ChainBridge,
+	anchors map[wire.OutPoint]anchorOutput,
+	timeout time.Duration) (fn.Set[wire.OutPoint], error) {
+
+	spent := fn.NewSet[wire.OutPoint]()
+	if len(anchors) == 0 {
+		return spent, nil
+	}
+
+	log.Infof("Checking %d recovered anchor outputs for spends",
+		len(anchors))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type spendResult struct {
+		outpoint wire.OutPoint
+		err      error
+	}
+	results := make(chan spendResult, len(anchors))
+
+	for outpoint, anchor := range anchors {
+		spendEvent, errChan, err := chain.RegisterSpendNtfn(
+			ctx, &outpoint, anchor.pkScript, anchor.heightHint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to register spend "+
+				"notification for %v: %w", outpoint, err)
+		}
+
+		go func(outpoint wire.OutPoint) {
+			defer spendEvent.Cancel()
+
+			select {
+			case detail := <-spendEvent.Spend:
+				if detail != nil {
+					results <- spendResult{
+						outpoint: outpoint,
+					}
+				}
+
+			case err := <-errChan:
+				results <- spendResult{
+					outpoint: outpoint,
+					err:      err,
+				}
+
+			case <-ctx.Done():
+			}
+		}(outpoint)
+	}
+
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+
+	for len(spent) < len(anchors) {
+		select {
+		case result := <-results:
+			if result.err != nil {
+				return nil, fmt.Errorf("unable to check spend "+
+					"of %v: %w", result.outpoint,
+					result.err)
+			}
+
+			spent.Add(result.outpoint)
+
+		case <-timeoutTimer.C:
+			return spent, nil
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return spent, nil
+}
+
+// scanForSpends scans all blocks from the given start height up to the chain
+// tip and returns the subset of the given outpoints that are spent by any of
+// the transactions in those blocks. Blocks that are mined while we scan are
+// included as well.
+func scanForSpends(ctx context.Context, chain ChainBridge, startHeight uint32,
+	outpoints fn.Set[wire.OutPoint]) (fn.Set[wire.OutPoint], error) {
+
+	spent := fn.NewSet[wire.OutPoint]()
+	height := startHeight
+	for {
+		bestHeight, err := chain.CurrentHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch current "+
+				"height: %w", err)
+		}
+
+		if height > bestHeight {
+			return spent, nil
+		}
+
+		for ; height <= bestHeight; height++ {
+			hash, err := chain.GetBlockHash(ctx, int64(height))
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch block "+
+					"hash at height %d: %w", height, err)
+			}
+
+			block, err := chain.GetBlock(ctx, hash)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch block "+
+					"%v: %w", hash, err)
+			}
+
+			for _, tx := range block.Transactions {
+				for _, txIn := range tx.TxIn {
+					prevOut := txIn.PreviousOutPoint
+					if outpoints.Contains(prevOut) {
+						spent.Add(prevOut)
+					}
+				}
+			}
+		}
+	}
+}
+
+// RecoverAssets attempts to recover all assets owned by keys derived from the
+// seed of the backing lnd node. The keys of the Taproot Assets key family are
+// derived up to the gap limit and their script keys are matched against the
+// leaves of the given universes. The full proof files of all matching leaves
+// that aren't spent yet are then fetched from the universe RPC proof courier,
+// verified and imported into the proof archive.
+func (c *Custodian) RecoverAssets(ctx context.Context,
+	req *RecoveryRequest) (*RecoveryResult, error) {
+
+	if req.CourierAddr == nil {
+		return nil, fmt.Errorf("proof courier address must be set")
+	}
+	if req.CourierAddr.Scheme != proof.UniverseRpcCourierType {
+		return nil, fmt.Errorf("proof courier address must be a "+
+			"universe RPC courier address, got %v",
+			req.CourierAddr.Scheme)
+	}
+
+	gapLimit := req.GapLimit
+	if gapLimit == 0 {
+		gapLimit = DefaultRecoveryGapLimit
+	}
+
+	// We first collect all leaf keys of all universes, so we can match
+	// the derived script keys against them.
+	candidates, err := collectCandidates(ctx, req.Sources)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Scanning key family %d for %d distinct universe script "+
+		"keys with gap limit %d", asset.TaprootAssetsKeyFamily,
+		len(candidates), gapLimit)
+
+	keys := newDerivedKeys()
+	lastUsed, err := scanKeys(
+		ctx, c.cfg.KeyRing, keys, candidates, gapLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RecoveryResult{
+		NumKeysScanned:   keys.numKeys,
+		LastUsedKeyIndex: lastUsed,
+	}
+
+	// We now fetch the full proof files of all leaves that match one of
+	// our script keys and aren't known to us yet.
+	courier, err := c.cfg.ProofCourierDispatcher.NewCourier(
+		ctx, req.CourierAddr, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initiate proof courier "+
+			"service handle: %w", err)
+	}
+	defer courier.Close()
+
+	var (
+		recovered []*recoveredProof
+		seen      = fn.NewSet[[32]byte]()
+		anchors   = make(map[wire.OutPoint]anchorOutput)
+	)
+	for xOnly, scriptKey := range keys.scriptKeys {
+		for _, candidate := range candidates[xOnly] {
+			p, err := c.fetchRecoveryProof(
+				ctx, courier, candidate, scriptKey, keys, seen,
+				result,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if p == nil {
+				continue
+			}
+
+			recovered = append(recovered, p)
+
+			lastProof := p.lastProof
+			outputIndex := lastProof.InclusionProof.OutputIndex
+			anchorOut := lastProof.AnchorTx.TxOut[outputIndex]
+			anchors[lastProof.OutPoint()] = anchorOutput{
+				pkScript:   anchorOut.PkScript,
+				heightHint: lastProof.BlockHeight,
+			}
+		}
+	}
+
+	// Before importing anything, we make sure we don't import assets that
+	// were already spent.
+	spendTimeout := req.SpendCheckTimeout
+	if spendTimeout == 0 {
+		spendTimeout = DefaultRecoverySpendCheckTimeout
+	}
+	spent, err := findSpentOutpoints(
+		ctx, c.cfg.ChainBridge, anchors, spendTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range recovered {
+		if spent.Contains(p.lastProof.OutPoint()) {
+			log.Debugf("Skipping recovered asset %v, anchor "+
+				"output %v was already spent",
+				p.lastProof.Asset.ID(), p.lastProof.OutPoint())
+
+			result.NumAssetsSpent++
+			continue
+		}
+
+		if err := c.importRecoveredProof(ctx, p); err != nil {
+			return nil, err
+		}
+
+		result.NumAssetsRecovered++
+	}
+
+	// Finally, we make sure the key ring won't hand out any of the keys
+	// we found to be in use again.
+	err = fn.MapOptionZ(lastUsed, func(lastIndex uint32) error {
+		return c.advanceKeyRing(ctx, lastIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Asset recovery complete: keys_scanned=%d, recovered=%d, "+
+		"known=%d, spent=%d, skipped=%d", result.NumKeysScanned,
+		result.NumAssetsRecovered, result.NumAssetsKnown,
+		result.NumAssetsSpent, result.NumAssetsSkipped)
+
+	return result, nil
+}
+
+// fetchRecoveryProof fetches the full proof file of the given recovery
+// candidate. Nil is returned if the proof doesn't need to be imported.
+func (c *Custodian) fetchRecoveryProof(ctx context.Context,
+	courier proof.Courier, candidate recoveryCandidate,
+	scriptKey asset.ScriptKey, keys *derivedKeys, seen fn.Set[[32]byte],
+	result *RecoveryResult) (*recoveredProof, error) {
+
+	// The leaf key alone doesn't tell us the asset ID of grouped assets,
+	// so we fetch the leaf itself first.
+	leaves, err := candidate.source.FetchProofLeaf(
+		ctx, candidate.id, candidate.key,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe leaf %v of "+
+			"universe %v: %w", candidate.key.OutPoint,
+			candidate.id.String(), err)
+	}
+	if len(leaves) == 0 || leaves[0].Leaf == nil ||
+		leaves[0].Leaf.Asset == nil {
+
+		return nil, fmt.Errorf("universe leaf %v of universe %v not "+
+			"found", candidate.key.OutPoint, candidate.id.String())
+	}
+
+	leafAsset := leaves[0].Leaf.Asset
+	assetID := leafAsset.ID()
+	loc := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *leafAsset.ScriptKey.PubKey,
+		OutPoint:  &candidate.key.OutPoint,
+	}
+	if leafAsset.GroupKey != nil {
+		loc.GroupKey = &leafAsset.GroupKey.GroupPubKey
+	}
+
+	// The same leaf can be found in multiple universes.
+	locHash, err := loc.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if seen.Contains(locHash) {
+		return nil, nil
+	}
+	seen.Add(locHash)
+
+	haveProof, err := c.cfg.ProofArchive.HasProof(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check for proof: %w", err)
+	}
+	if haveProof {
+		result.NumAssetsKnown++
+		return nil, nil
+	}
+
+	annotatedProof, err := courier.ReceiveProof(
+		ctx, proof.Recipient{
+			ScriptKey: leafAsset.ScriptKey.PubKey,
+			AssetID:   assetID,
+			Amount:    leafAsset.Amount,
+		}, loc,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to receive proof for asset %v "+
+			"at %v: %w", assetID, candidate.key.OutPoint, err)
+	}
+
+	proofFile, err := annotatedProof.Blob.AsFile()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch last proof: %w", err)
+	}
+
+	// We can only spend the asset if we can also sign for its anchor
+	// output, which requires the internal key to be derived from our seed
+	// as well.
+	internalKey := lastProof.InclusionProof.InternalKey
+	var internalKeyBytes [33]byte
+	copy(internalKeyBytes[:], internalKey.SerializeCompressed())
+	internalKeyDesc, ok := keys.internalKeys[internalKeyBytes]
+	if !ok {
+		log.Warnf("Skipping recovered asset %v at %v, unable to "+
+			"derive anchor internal key %x", assetID,
+			candidate.key.OutPoint, internalKeyBytes[:])
+
+		result.NumAssetsSkipped++
+		return nil, nil
+	}
+
+	return &recoveredProof{
+		annotatedProof: annotatedProof,
+		lastProof:      lastProof,
+		scriptKey:      scriptKey,
+		internalKey:    internalKeyDesc,
+	}, nil
+}
+
+// importRecoveredProof imports the keys and the proof of a recovered asset
+// and makes sure the backing lnd wallet watches its anchor output.
+func (c *Custodian) importRecoveredProof(ctx context.Context,
+	p *recoveredProof) error {
+
+	// The keys need to be inserted before the proof, so the asset is
+	// recognized as ours when it is imported.
+	err := c.cfg.AddrBook.InsertInternalKey(ctx, p.internalKey)
+	if err != nil {
+		return fmt.Errorf("unable to insert internal key: %w", err)
+	}
+	err = c.cfg.AddrBook.InsertScriptKey(ctx, p.scriptKey, true)
+	if err != nil {
+		return fmt.Errorf("unable to insert script key: %w", err)
+	}
+
+	headerVerifier := GenHeaderVerifier(ctx, c.cfg.ChainBridge)
+	err = c.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, proof.DefaultMerkleVerifier,
+		c.cfg.GroupVerifier, c.cfg.ChainBridge, false, p.annotatedProof,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import proof for asset %v: %w",
+			p.lastProof.Asset.ID(), err)
+	}
+
+	outputIndex := p.lastProof.InclusionProof.OutputIndex
+	if int(outputIndex) >= len(p.lastProof.AnchorTx.TxOut) {
+		return fmt.Errorf("invalid anchor output index %d",
+			outputIndex)
+	}
+	pkScript := p.lastProof.AnchorTx.TxOut[outputIndex].PkScript
+	if !txscript.IsPayToTaproot(pkScript) {
+		return fmt.Errorf("anchor output %v is not a P2TR output",
+			p.lastProof.OutPoint())
+	}
+	outputKey, err := schnorr.ParsePubKey(pkScript[2:])
+	if err != nil {
+		return fmt.Errorf("unable to parse anchor output key: %w", err)
+	}
+
+	_, err = c.cfg.WalletAnchor.ImportTaprootOutput(ctx, outputKey)
+	switch {
+	case err == nil:
+
+	// The output might have been imported into the wallet before the
+	// database was lost.
+	case errors.Is(err, ErrOutputAlreadyImported):
+
+	default:
+		return fmt.Errorf("unable to import anchor output %v into "+
+			"wallet: %w", p.lastProof.OutPoint(), err)
+	}
+
+	log.Infof("Recovered asset %v at %v", p.lastProof.Asset.ID(),
+		p.lastProof.OutPoint())
+
+	return nil
+}
+
+// advanceKeyRing derives new keys of the Taproot Assets key family until the
+// key ring hands out keys beyond the given index, to make sure recovered keys
+// aren't re-used.
+func (c *Custodian) advanceKeyRing(ctx context.Context,
+	lastIndex uint32) error {
+
+	for {
+		keyDesc, err := c.cfg.KeyRing.DeriveNextKey(
+			ctx, asset.TaprootAssetsKeyFamily,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to advance key ring: %w", err)
+		}
+
+		if keyDesc.Index >= lastIndex {
+			return nil
+		}
+	}
+}
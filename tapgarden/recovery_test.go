@@ -0,0 +1,363 @@
+package tapgarden
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// seedKeyRing is a key ring that deterministically derives keys from their
+// index, similar to a wallet derived from a seed.
+type seedKeyRing struct {
+	nextIndex uint32
+}
+
+// DeriveNextKey derives the key with the next unused index.
+func (s *seedKeyRing) DeriveNextKey(ctx context.Context,
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	keyDesc, err := s.DeriveKey(ctx, keychain.KeyLocator{
+		Family: keyFam,
+		Index:  s.nextIndex,
+	})
+	s.nextIndex++
+
+	return keyDesc, err
+}
+
+// DeriveKey derives the key with the given index.
+func (s *seedKeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	var indexBytes [4]byte
+	binary.BigEndian.PutUint32(indexBytes[:], loc.Index)
+	privKeyBytes := sha256.Sum256(indexBytes[:])
+	privKey, _ := btcec.PrivKeyFromBytes(privKeyBytes[:])
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     privKey.PubKey(),
+	}, nil
+}
+
+// IsLocalKey returns true for all keys.
+func (s *seedKeyRing) IsLocalKey(context.Context, keychain.KeyDescriptor) bool {
+	return true
+}
+
+// spendChainBridge is a chain bridge that reports a fixed set of spends.
+type spendChainBridge struct {
+	*MockChainBridge
+
+	mu sync.Mutex
+
+	// spends maps the spent outpoints to their spending transaction.
+	spends map[wire.OutPoint]*wire.MsgTx
+
+	// heightHints records the height hint of each registered outpoint.
+	heightHints map[wire.OutPoint]uint32
+
+	// err is the error reported for all registrations, if set.
+	err error
+
+	// blocks is the chain the spend notifications are dispatched for,
+	// indexed by height. Spends that only show up in the blocks simulate
+	// a backend that is too slow to dispatch them.
+	blocks []*wire.MsgBlock
+
+	// blockErr is the error returned when fetching a block, if set.
+	blockErr error
+}
+
+// CurrentHeight returns the height of the last block of the chain.
+func (c *spendChainBridge) CurrentHeight(context.Context) (uint32, error) {
+	return uint32(len(c.blocks) - 1), nil
+}
+
+// GetBlockHash returns the hash of the block at the given height.
+func (c *spendChainBridge) GetBlockHash(_ context.Context,
+	height int64) (chainhash.Hash, error) {
+
+	if height < 0 || height >= int64(len(c.blocks)) {
+		return chainhash.Hash{}, fmt.Errorf("unknown height %d",
+			height)
+	}
+
+	return c.blocks[height].BlockHash(), nil
+}
+
+// GetBlock returns the block with the given hash.
+func (c *spendChainBridge) GetBlock(_ context.Context,
+	hash chainhash.Hash) (*wire.MsgBlock, error) {
+
+	if c.blockErr != nil {
+		return nil, c.blockErr
+	}
+
+	for _, block := range c.blocks {
+		if block.BlockHash() == hash {
+			return block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown block %v", hash)
+}
+
+// RegisterSpendNtfn dispatches the spend of the given outpoint right away if
+// it's known to be spent.
+func (c *spendChainBridge) RegisterSpendNtfn(_ context.Context,
+	outpoint *wire.OutPoint, _ []byte, heightHint uint32) (
+	*chainntnfs.SpendEvent, chan error, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.heightHints[*outpoint] = heightHint
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	if spendTx, ok := c.spends[*outpoint]; ok {
+		spendChan <- &chainntnfs.SpendDetail{
+			SpentOutPoint: outpoint,
+			SpendingTx:    spendTx,
+		}
+	}
+
+	errChan := make(chan error, 1)
+	if c.err != nil {
+		errChan <- c.err
+	}
+
+	return &chainntnfs.SpendEvent{
+		Spend:  spendChan,
+		Cancel: func() {},
+	}, errChan, nil
+}
+
+// scriptKeyCandidates returns a candidate set that contains the BIP-0086
+// script keys of the given key indexes.
+func scriptKeyCandidates(t *testing.T,
+	indexes ...uint32) map[[32]byte][]recoveryCandidate {
+
+	keyRing := &seedKeyRing{}
+	candidates := make(map[[32]byte][]recoveryCandidate)
+	for _, index := range indexes {
+		keyDesc, err := keyRing.DeriveKey(
+			context.Background(), keychain.KeyLocator{
+				Family: asset.TaprootAssetsKeyFamily,
+				Index:  index,
+			},
+		)
+		require.NoError(t, err)
+
+		scriptKey := asset.NewScriptKeyBip86(keyDesc)
+		candidates[xOnlyKey(scriptKey.PubKey)] = []recoveryCandidate{{
+			key: universe.LeafKey{
+				OutPoint:  test.RandOp(t),
+				ScriptKey: &scriptKey,
+			},
+		}}
+	}
+
+	return candidates
+}
+
+// TestScanKeys tests that the key derivation stops at the gap limit.
+func TestScanKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name             string
+		usedIndexes      []uint32
+		gapLimit         uint32
+		expectedLastUsed fn.Option[uint32]
+		expectedNumKeys  uint32
+	}{{
+		name:             "no keys used",
+		gapLimit:         5,
+		expectedLastUsed: fn.None[uint32](),
+		expectedNumKeys:  5,
+	}, {
+		name:             "second key beyond gap",
+		usedIndexes:      []uint32{3, 10},
+		gapLimit:         5,
+		expectedLastUsed: fn.Some[uint32](3),
+		expectedNumKeys:  9,
+	}, {
+		name:             "second key within gap",
+		usedIndexes:      []uint32{3, 10},
+		gapLimit:         7,
+		expectedLastUsed: fn.Some[uint32](10),
+		expectedNumKeys:  18,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			candidates := scriptKeyCandidates(t, tc.usedIndexes...)
+
+			keys := newDerivedKeys()
+			lastUsed, err := scanKeys(
+				ctx, &seedKeyRing{}, keys, candidates,
+				tc.gapLimit,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedLastUsed, lastUsed)
+			require.Equal(t, tc.expectedNumKeys, keys.numKeys)
+			require.Len(t, keys.scriptKeys, int(keys.numKeys))
+			require.Len(t, keys.internalKeys, int(keys.numKeys))
+
+			// Every used script key must be resolvable to its
+			// derivation information.
+			for xOnly := range candidates {
+				scriptKey, ok := keys.scriptKeys[xOnly]
+				if !ok {
+					continue
+				}
+				require.NotNil(t, scriptKey.TweakedScriptKey)
+			}
+		})
+	}
+}
+
+// TestFindSpentOutpoints tests that spent anchor outputs are found through
+// spend notifications and that outputs without a reported spend are only
+// considered unspent after scanning the blocks for spends.
+func TestFindSpentOutpoints(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	spentOp, unspentOp := test.RandOp(t), test.RandOp(t)
+	spendTx := wire.NewMsgTx(2)
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: spentOp})
+
+	// The spend of the late outpoint is confirmed in a block, but never
+	// dispatched as a notification.
+	lateOp := test.RandOp(t)
+	lateSpendTx := wire.NewMsgTx(2)
+	lateSpendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: lateOp})
+
+	newChain := func() *spendChainBridge {
+		blocks := make([]*wire.MsgBlock, 5)
+		for i := range blocks {
+			blocks[i] = &wire.MsgBlock{
+				Header: wire.BlockHeader{Nonce: uint32(i)},
+			}
+		}
+		blocks[1].Transactions = []*wire.MsgTx{spendTx}
+		blocks[3].Transactions = []*wire.MsgTx{lateSpendTx}
+
+		return &spendChainBridge{
+			spends: map[wire.OutPoint]*wire.MsgTx{
+				spentOp: spendTx,
+			},
+			heightHints: make(map[wire.OutPoint]uint32),
+			blocks:      blocks,
+		}
+	}
+	anchors := map[wire.OutPoint]anchorOutput{
+		spentOp: {
+			pkScript:   test.RandBytes(34),
+			heightHint: 1,
+		},
+		unspentOp: {
+			pkScript:   test.RandBytes(34),
+			heightHint: 2,
+		},
+		lateOp: {
+			pkScript:   test.RandBytes(34),
+			heightHint: 2,
+		},
+	}
+
+	// The spent outpoint is found through its notification. The late
+	// outpoint is found by the block scan after the timeout, which also
+	// establishes that the remaining outpoint is unspent.
+	chain := newChain()
+	spent, err := findSpentOutpoints(
+		ctx, chain, anchors, 50*time.Millisecond,
+	)
+	require.NoError(t, err)
+	require.Equal(t, fn.NewSet(spentOp, lateOp), spent)
+	require.Equal(t, map[wire.OutPoint]uint32{
+		spentOp:   1,
+		unspentOp: 2,
+		lateOp:    2,
+	}, chain.heightHints)
+
+	// If all outpoints are spent, we neither wait for the timeout nor
+	// scan any blocks.
+	spentAnchors := map[wire.OutPoint]anchorOutput{
+		spentOp: anchors[spentOp],
+	}
+	chain = newChain()
+	chain.blockErr = fmt.Errorf("no blocks expected")
+	spent, err = findSpentOutpoints(ctx, chain, spentAnchors, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, fn.NewSet(spentOp), spent)
+
+	// If the spend state of an outpoint can't be determined, because
+	// the blocks can't be scanned, we fail instead of treating it as
+	// unspent.
+	chain = newChain()
+	chain.blockErr = fmt.Errorf("block unavailable")
+	_, err = findSpentOutpoints(ctx, chain, anchors, 50*time.Millisecond)
+	require.ErrorContains(t, err, "block unavailable")
+
+	// Errors of the chain backend are returned.
+	chain = newChain()
+	chain.err = fmt.Errorf("backend error")
+	_, err = findSpentOutpoints(ctx, chain, anchors, time.Hour)
+	require.ErrorContains(t, err, "backend error")
+
+	// Without any outpoints, no spend notifications are registered.
+	chain = newChain()
+	spent, err = findSpentOutpoints(
+		ctx, chain, map[wire.OutPoint]anchorOutput{}, time.Hour,
+	)
+	require.NoError(t, err)
+	require.Empty(t, spent)
+	require.Empty(t, chain.heightHints)
+}
+
+// TestAdvanceKeyRing tests that the key ring is advanced beyond the last used
+// key index.
+func TestAdvanceKeyRing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyRing := &seedKeyRing{}
+	custodian := NewCustodian(&CustodianConfig{
+		KeyRing: keyRing,
+	})
+
+	require.NoError(t, custodian.advanceKeyRing(ctx, 5))
+
+	keyDesc, err := keyRing.DeriveNextKey(ctx, asset.TaprootAssetsKeyFamily)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, keyDesc.Index)
+
+	// A key ring that is already beyond the last used index is only
+	// advanced by a single key.
+	require.NoError(t, custodian.advanceKeyRing(ctx, 2))
+
+	keyDesc, err = keyRing.DeriveNextKey(ctx, asset.TaprootAssetsKeyFamily)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, keyDesc.Index)
+}
@@ -0,0 +1,825 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	// CommitmentConfTarget is the confirmation target used to estimate the
+	// fee rate of universe commitment transactions.
+	CommitmentConfTarget = 6
+
+	// CommitmentMaxConfDelay is the number of blocks after which a
+	// commitment that didn't confirm is replaced. This happens if its
+	// anchor transaction couldn't be published, its fee rate is too low
+	// or its inputs were double spent. The first commitment of a chain is
+	// abandoned, later ones are funded again at the current fee rate, as
+	// they must spend the output of the previous commitment.
+	CommitmentMaxConfDelay = 144
+)
+
+// UniverseCommitterConfig is the main config for the universe committer.
+type UniverseCommitterConfig struct {
+	// Multiverse is the multiverse whose roots are committed to.
+	Multiverse universe.MultiverseArchive
+
+	// CommitmentLog is used to store the commitments and the multiverse
+	// leaves they commit to.
+	CommitmentLog universe.CommitmentLog
+
+	// Wallet is used to fund and sign the commitment transactions.
+	Wallet CommitmentWallet
+
+	// KeyRing is used to derive the internal keys of the commitment
+	// outputs.
+	KeyRing KeyRing
+
+	// ChainBridge is used to publish the commitment transactions and to
+	// wait for their confirmation.
+	ChainBridge ChainBridge
+
+	// ChainParams are the Taproot Asset specific chain parameters, used
+	// to derive the key path of the commitment outputs that are spent.
+	ChainParams *address.ChainParams
+
+	// CommitInterval is the interval at which the multiverse roots are
+	// committed to in the chain, if they changed since the last
+	// commitment. If this is zero, no commitments are created.
+	CommitInterval time.Duration
+}
+
+// UniverseCommitter periodically commits the issuance and transfer multiverse
+// roots to the chain. Each commitment is a taproot output of a wallet funded
+// transaction, with the branch of both roots as the tweak of its internal key.
+// The commitments form a single chain: the anchor transaction of each
+// commitment spends the output of the previous one with a key path spend,
+// starting from the genesis outpoint of the first one. Along with the merkle
+// proof of the transaction, this lets universe clients verify that the
+// universe served them the same roots it served everybody else, by checking
+// that the commitment they're shown is the unspent tip of the chain.
+type UniverseCommitter struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *UniverseCommitterConfig
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewUniverseCommitter creates a new universe committer with the given config.
+func NewUniverseCommitter(cfg *UniverseCommitterConfig) *UniverseCommitter {
+	return &UniverseCommitter{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the universe committer, which resumes the pending commitments
+// before committing to the multiverse roots periodically.
+func (c *UniverseCommitter) Start() error {
+	c.startOnce.Do(func() {
+		if c.cfg.CommitInterval == 0 {
+			log.Infof("Universe chain commitments disabled")
+			return
+		}
+
+		log.Infof("Starting universe committer, commit_interval=%v",
+			c.cfg.CommitInterval)
+
+		c.Wg.Add(1)
+		go c.commitLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the universe committer.
+func (c *UniverseCommitter) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Info("Stopping universe committer")
+
+		close(c.Quit)
+		c.Wg.Wait()
+	})
+
+	return nil
+}
+
+// commitLoop commits to the multiverse roots each time the commit interval
+// elapsed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *UniverseCommitter) commitLoop() {
+	defer c.Wg.Done()
+
+	ticker := time.NewTicker(c.cfg.CommitInterval)
+	defer ticker.Stop()
+
+	for {
+		// Failing to create a commitment, for example because the
+		// wallet doesn't have enough funds, isn't critical, so we'll
+		// just try again after the next interval.
+		if err := c.commitMultiverse(); err != nil {
+			log.Errorf("Unable to commit multiverse roots: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// commitMultiverse waits for the confirmation of the pending commitments, or
+// commits to the multiverse roots if there are none and the roots changed
+// since the latest commitment. Commitments that don't confirm within the
+// commit interval are published again on the next call.
+func (c *UniverseCommitter) commitMultiverse() error {
+	ctx, cancel := c.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	pending, err := c.cfg.CommitmentLog.PendingCommitments(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch pending commitments: %w",
+			err)
+	}
+
+	// We only create a new commitment once all previous ones confirmed or
+	// were abandoned, as it spends the output of the latest confirmed
+	// one.
+	if len(pending) == 0 {
+		commitment, err := c.commitIfChanged(ctx)
+		if err != nil {
+			return err
+		}
+
+		if commitment != nil {
+			pending = append(pending, commitment)
+		}
+	}
+
+	// A commitment that doesn't confirm before the next interval is
+	// retried then, so we don't wait for newer ones either.
+	for _, commitment := range pending {
+		confirmed, err := c.confirmCommitment(ctx, commitment)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// commitIfChanged creates a new commitment to the multiverse roots if they
+// changed since the latest confirmed commitment. If they didn't, nil is
+// returned.
+func (c *UniverseCommitter) commitIfChanged(
+	ctx context.Context) (*universe.Commitment, error) {
+
+	leaves, err := universe.FetchMultiverseLeaves(ctx, c.cfg.Multiverse)
+	if err != nil {
+		return nil, err
+	}
+
+	issuanceRoot, transferRoot, err := universe.MultiverseRoots(
+		ctx, leaves,
+	)
+	if err != nil {
+		return nil, err
+	}
+	root := universe.NewCommitmentRoot(issuanceRoot, transferRoot)
+
+	latest, err := c.cfg.CommitmentLog.LatestCommitment(ctx)
+	switch {
+	case errors.Is(err, universe.ErrNoCommitment):
+		latest = nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch latest commitment: %w",
+			err)
+
+	case mssmt.IsEqualNode(latest.UniverseRoot, root):
+		log.Debugf("Multiverse roots unchanged since commitment at "+
+			"height %d, not committing", latest.BlockHeight)
+
+		return nil, nil
+	}
+
+	return c.commit(ctx, leaves, issuanceRoot, transferRoot, latest)
+}
+
+// CommitUniverse takes a snapshot of the multiverse leaves and returns a new,
+// not yet confirmed commitment to the multiverse roots they form in the main
+// chain. The commitment is stored before it's returned, but the anchor
+// transaction isn't published yet.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (c *UniverseCommitter) CommitUniverse(ctx context.Context,
+	leaves []universe.MultiverseLeaf) (*universe.Commitment, error) {
+
+	issuanceRoot, transferRoot, err := universe.MultiverseRoots(
+		ctx, leaves,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new commitment spends the output of the latest one, which must
+	// be confirmed, so we can't have two commitments that spend it.
+	pending, err := c.cfg.CommitmentLog.PendingCommitments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending commitments: "+
+			"%w", err)
+	}
+	if len(pending) > 0 {
+		return nil, fmt.Errorf("universe commitment %v is still "+
+			"pending", pending[0].AnchorTx.TxHash())
+	}
+
+	latest, err := c.cfg.CommitmentLog.LatestCommitment(ctx)
+	switch {
+	case errors.Is(err, universe.ErrNoCommitment):
+		latest = nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch latest commitment: %w",
+			err)
+	}
+
+	return c.commit(ctx, leaves, issuanceRoot, transferRoot, latest)
+}
+
+// commit creates and stores a new commitment to the given multiverse roots,
+// which are formed by the given leaves. The commitment spends the output of
+// the given previous commitment, or starts a new chain if it's nil.
+func (c *UniverseCommitter) commit(ctx context.Context,
+	leaves []universe.MultiverseLeaf, issuanceRoot,
+	transferRoot mssmt.Node,
+	prev *universe.Commitment) (*universe.Commitment, error) {
+
+	root := universe.NewCommitmentRoot(issuanceRoot, transferRoot)
+
+	internalKey, err := c.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive internal key: %w", err)
+	}
+
+	pkScript, err := universe.CommitmentPkScript(internalKey.PubKey, root)
+	if err != nil {
+		return nil, err
+	}
+
+	heightHint, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
+	}
+
+	anchorTx, err := c.fundAnchorTx(ctx, pkScript, prev)
+	if err != nil {
+		return nil, err
+	}
+
+	outputIndex, err := commitmentOutputIndex(anchorTx, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := &universe.Commitment{
+		UniverseRoot: root,
+		IssuanceRoot: issuanceRoot,
+		TransferRoot: transferRoot,
+		AnchorTx:     anchorTx,
+		OutputIndex:  outputIndex,
+		InternalKey:  internalKey,
+		HeightHint:   heightHint,
+	}
+
+	// The first commitment starts a new chain, all later ones continue
+	// the chain of the previous one.
+	commitment.GenesisOutPoint = commitment.OutPoint()
+	if prev != nil {
+		commitment.GenesisOutPoint = prev.GenesisOutPoint
+	}
+
+	err = c.cfg.CommitmentLog.InsertCommitment(ctx, commitment, leaves)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store commitment: %w", err)
+	}
+
+	log.Infof("Created universe commitment, txid=%v, root=%v, "+
+		"genesis_outpoint=%v", anchorTx.TxHash(), root.NodeHash(),
+		commitment.GenesisOutPoint)
+
+	return commitment, nil
+}
+
+// commitmentOutputIndex returns the index of the output of the given anchor
+// transaction that pays to the given commitment pk script.
+func commitmentOutputIndex(anchorTx *wire.MsgTx,
+	pkScript []byte) (uint32, error) {
+
+	for idx, txOut := range anchorTx.TxOut {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			return uint32(idx), nil
+		}
+	}
+
+	return 0, fmt.Errorf("commitment output not found in anchor tx %v",
+		anchorTx.TxHash())
+}
+
+// fundAnchorTx creates a signed transaction with a single output paying to the
+// given pk script, funded by the wallet. If a previous commitment is given,
+// its output is spent as the first input of the transaction.
+func (c *UniverseCommitter) fundAnchorTx(ctx context.Context, pkScript []byte,
+	prev *universe.Commitment) (*wire.MsgTx, error) {
+
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(&wire.TxOut{
+		Value:    int64(tapsend.DummyAmtSats),
+		PkScript: pkScript,
+	})
+	anchorPkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
+	}
+
+	if prev != nil {
+		c.addCommitmentInput(anchorPkt, prev)
+	}
+
+	feeRate, err := c.cfg.ChainBridge.EstimateFee(
+		ctx, CommitmentConfTarget,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	minRelayFee, err := c.cfg.Wallet.MinRelayFee(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain minrelayfee: %w", err)
+	}
+
+	// If the fee rate is below the minimum relay fee, we'll bump it up.
+	if feeRate < minRelayFee {
+		log.Infof("Bump fee rate of universe commitment to meet "+
+			"minrelayfee from %s to %s", feeRate.String(),
+			minRelayFee.String())
+
+		feeRate = minRelayFee
+	}
+
+	fundedPkt, err := c.cfg.Wallet.FundPsbt(ctx, anchorPkt, 1, feeRate, -1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// If anything goes wrong from here on, we'll release the inputs the
+	// wallet locked for us.
+	unlockInputs := func() {
+		for _, op := range fundedPkt.LockedUTXOs {
+			err := c.cfg.Wallet.UnlockInput(ctx, op)
+			if err != nil {
+				log.Warnf("Unable to unlock input %v: %v", op,
+					err)
+			}
+		}
+	}
+
+	// Clients follow the chain through the first input of each anchor
+	// transaction, so the wallet must keep the commitment input first.
+	fundedTx := fundedPkt.Pkt.UnsignedTx
+	if prev != nil && fundedTx.TxIn[0].PreviousOutPoint != prev.OutPoint() {
+		unlockInputs()
+		return nil, fmt.Errorf("commitment output %v isn't the first "+
+			"input of the funded anchor tx", prev.OutPoint())
+	}
+
+	signedPkt, err := c.cfg.Wallet.SignPsbt(ctx, fundedPkt.Pkt)
+	if err != nil {
+		unlockInputs()
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	err = psbt.MaybeFinalizeAll(signedPkt)
+	if err != nil {
+		unlockInputs()
+		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+
+	anchorTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		unlockInputs()
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	log.Tracef("Universe commitment anchor tx: %v", spew.Sdump(anchorTx))
+
+	return anchorTx, nil
+}
+
+// addCommitmentInput adds the output of the given commitment as an input to
+// the packet, along with the information the wallet needs to sign for it with
+// a key path spend.
+func (c *UniverseCommitter) addCommitmentInput(pkt *psbt.Packet,
+	commitment *universe.Commitment) {
+
+	bip32, trBip32 := tappsbt.Bip32DerivationFromKeyDesc(
+		commitment.InternalKey, c.cfg.ChainParams.HDCoinType,
+	)
+	rootHash := commitment.UniverseRoot.NodeHash()
+
+	pkt.Inputs = append(pkt.Inputs, psbt.PInput{
+		WitnessUtxo: commitment.AnchorTx.TxOut[commitment.OutputIndex],
+		SighashType: txscript.SigHashDefault,
+		Bip32Derivation: []*psbt.Bip32Derivation{
+			bip32,
+		},
+		TaprootBip32Derivation: []*psbt.TaprootBip32Derivation{
+			trBip32,
+		},
+		TaprootInternalKey: schnorr.SerializePubKey(
+			commitment.InternalKey.PubKey,
+		),
+		TaprootMerkleRoot: rootHash[:],
+	})
+	pkt.UnsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: commitment.OutPoint(),
+	})
+}
+
+// confirmCommitment publishes the anchor transaction of the given commitment,
+// waits for it to confirm and then marks the commitment as confirmed. If the
+// transaction doesn't confirm within the commit interval, false is returned,
+// so it's published again with the next interval. Once it didn't confirm for
+// CommitmentMaxConfDelay blocks, the commitment is replaced, so it's published
+// at the current fee rate.
+func (c *UniverseCommitter) confirmCommitment(ctx context.Context,
+	commitment *universe.Commitment) (bool, error) {
+
+	anchorTx := commitment.AnchorTx
+	txid := anchorTx.TxHash()
+
+	// The transaction might already be known to the network if we're
+	// resuming a commitment, which is fine as we'll just wait for it to
+	// confirm. Publishing it again also rebroadcasts it if it was dropped
+	// from the mempool.
+	err := c.cfg.ChainBridge.PublishTransaction(ctx, anchorTx)
+	if err != nil {
+		log.Warnf("Unable to publish universe commitment tx %v: %v",
+			txid, err)
+	}
+
+	// The first commitment of a chain only spends wallet inputs, so we
+	// wait for its anchor transaction to confirm. All later ones spend the
+	// output of the previous commitment, which might happen with an
+	// earlier version of the anchor transaction if it was replaced.
+	waitForConf := c.waitForAnchorConf
+	if !commitment.IsGenesis() {
+		waitForConf = c.waitForChainSpend
+	}
+
+	confEvent, err := waitForConf(ctx, commitment)
+	if err != nil {
+		return false, err
+	}
+
+	if confEvent == nil {
+		return false, c.replaceIfStale(ctx, commitment)
+	}
+
+	if confEvent.Tx != nil && confEvent.Tx.TxHash() != txid {
+		confirmedTx := confEvent.Tx
+		pkScript := anchorTx.TxOut[commitment.OutputIndex].PkScript
+		outputIndex, err := commitmentOutputIndex(confirmedTx, pkScript)
+		if err != nil {
+			return false, err
+		}
+
+		err = c.cfg.CommitmentLog.ReplaceAnchorTx(
+			ctx, txid, confirmedTx, outputIndex,
+			commitment.HeightHint,
+		)
+		if err != nil {
+			return false, fmt.Errorf("unable to replace "+
+				"commitment tx: %w", err)
+		}
+
+		log.Infof("Universe commitment tx %v replaced by confirmed "+
+			"tx %v", txid, confirmedTx.TxHash())
+
+		txid = confirmedTx.TxHash()
+	}
+
+	merkleProof, err := proof.NewTxMerkleProof(
+		confEvent.Block.Transactions, int(confEvent.TxIndex),
+	)
+	if err != nil {
+		return false, fmt.Errorf("unable to create merkle proof: %w",
+			err)
+	}
+
+	err = c.cfg.CommitmentLog.ConfirmCommitment(
+		ctx, txid, confEvent.BlockHeight, confEvent.Block.Header,
+		merkleProof,
+	)
+	if err != nil {
+		return false, fmt.Errorf("unable to confirm commitment: %w",
+			err)
+	}
+
+	log.Infof("Universe commitment confirmed, txid=%v, height=%d", txid,
+		confEvent.BlockHeight)
+
+	return true, nil
+}
+
+// waitForAnchorConf waits for the anchor transaction of the given commitment
+// to confirm. If it doesn't confirm within the commit interval, nil is
+// returned.
+func (c *UniverseCommitter) waitForAnchorConf(ctx context.Context,
+	commitment *universe.Commitment) (*chainntnfs.TxConfirmation, error) {
+
+	txid := commitment.AnchorTx.TxHash()
+	pkScript := commitment.AnchorTx.TxOut[commitment.OutputIndex].PkScript
+	confNtfn, errChan, err := c.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, &txid, pkScript, 1, commitment.HeightHint, true, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for commitment "+
+			"tx conf: %w", err)
+	}
+	defer confNtfn.Cancel()
+
+	confTimeout := time.NewTimer(c.cfg.CommitInterval)
+	defer confTimeout.Stop()
+
+	select {
+	case confEvent := <-confNtfn.Confirmed:
+		if confEvent == nil {
+			return nil, fmt.Errorf("empty confirmation for "+
+				"commitment tx %v", txid)
+		}
+
+		return confEvent, nil
+
+	case err := <-errChan:
+		return nil, fmt.Errorf("error getting confirmation: %w", err)
+
+	case <-confTimeout.C:
+		return nil, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// waitForChainSpend waits for the output of the commitment preceding the given
+// one to be spent by a version of the anchor transaction of the given
+// commitment. If it isn't spent within the commit interval, nil is returned.
+func (c *UniverseCommitter) waitForChainSpend(ctx context.Context,
+	commitment *universe.Commitment) (*chainntnfs.TxConfirmation, error) {
+
+	prev, err := c.prevCommitment(ctx, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	prevOutPoint := prev.OutPoint()
+	prevPkScript := prev.AnchorTx.TxOut[prev.OutputIndex].PkScript
+	spendNtfn, errChan, err := c.cfg.ChainBridge.RegisterSpendNtfn(
+		ctx, &prevOutPoint, prevPkScript, prev.BlockHeight,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for commitment "+
+			"spend: %w", err)
+	}
+	defer spendNtfn.Cancel()
+
+	confTimeout := time.NewTimer(c.cfg.CommitInterval)
+	defer confTimeout.Stop()
+
+	var spend *chainntnfs.SpendDetail
+	select {
+	case spend = <-spendNtfn.Spend:
+		if spend == nil {
+			return nil, fmt.Errorf("empty spend of commitment "+
+				"output %v", prevOutPoint)
+		}
+
+	case err := <-errChan:
+		return nil, fmt.Errorf("error getting spend: %w", err)
+
+	case <-confTimeout.C:
+		return nil, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	// Only we can spend the output of the previous commitment, but if it
+	// was spent without creating this commitment, the chain is broken.
+	spendingTx := spend.SpendingTx
+	pkScript := commitment.AnchorTx.TxOut[commitment.OutputIndex].PkScript
+	_, err = commitmentOutputIndex(spendingTx, pkScript)
+	if err != nil {
+		return nil, fmt.Errorf("commitment output %v spent by "+
+			"unexpected tx: %w", prevOutPoint, err)
+	}
+
+	blockHeight := uint32(spend.SpendingHeight)
+	blockHash, err := c.cfg.ChainBridge.GetBlockHash(
+		ctx, int64(blockHeight),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch block hash: %w", err)
+	}
+
+	block, err := c.cfg.ChainBridge.GetBlock(ctx, blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch block: %w", err)
+	}
+
+	spendingTxid := spendingTx.TxHash()
+	for idx, tx := range block.Transactions {
+		if tx.TxHash() != spendingTxid {
+			continue
+		}
+
+		return &chainntnfs.TxConfirmation{
+			BlockHash:   &blockHash,
+			BlockHeight: blockHeight,
+			TxIndex:     uint32(idx),
+			Tx:          spendingTx,
+			Block:       block,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("commitment tx %v not found in block %v",
+		spendingTxid, blockHash)
+}
+
+// prevCommitment returns the confirmed commitment whose output the anchor
+// transaction of the given commitment spends. As we only create a commitment
+// once all previous ones confirmed, this is the latest confirmed one.
+func (c *UniverseCommitter) prevCommitment(ctx context.Context,
+	commitment *universe.Commitment) (*universe.Commitment, error) {
+
+	prev, err := c.cfg.CommitmentLog.LatestCommitment(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch previous commitment: "+
+			"%w", err)
+	}
+
+	prevOutPoint := commitment.PrevOutPoint().UnwrapOr(wire.OutPoint{})
+	if prev.OutPoint() != prevOutPoint {
+		return nil, fmt.Errorf("universe commitment tx %v spends %v "+
+			"instead of the latest commitment output %v",
+			commitment.AnchorTx.TxHash(), prevOutPoint,
+			prev.OutPoint())
+	}
+
+	return prev, nil
+}
+
+// replaceIfStale replaces the given unconfirmed commitment if it was created
+// more than CommitmentMaxConfDelay blocks ago. The first commitment of a chain
+// is abandoned and the wallet inputs of its anchor transaction are released.
+// If the abandoned transaction confirms after all, its output is simply never
+// used as a commitment. All later commitments are funded again at the current
+// fee rate instead, as abandoning them would break the chain.
+func (c *UniverseCommitter) replaceIfStale(ctx context.Context,
+	commitment *universe.Commitment) error {
+
+	txid := commitment.AnchorTx.TxHash()
+
+	height, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch current height: %w", err)
+	}
+
+	if height < commitment.HeightHint+CommitmentMaxConfDelay {
+		log.Debugf("Universe commitment tx %v not confirmed yet, "+
+			"retrying", txid)
+
+		return nil
+	}
+
+	if !commitment.IsGenesis() {
+		return c.bumpCommitment(ctx, commitment, height)
+	}
+
+	log.Warnf("Abandoning universe commitment tx %v, not confirmed "+
+		"after %d blocks", txid, height-commitment.HeightHint)
+
+	err = c.cfg.CommitmentLog.AbandonCommitment(ctx, txid)
+	if err != nil {
+		return fmt.Errorf("unable to abandon commitment: %w", err)
+	}
+
+	c.unlockWalletInputs(ctx, commitment.AnchorTx, wire.OutPoint{})
+
+	return nil
+}
+
+// bumpCommitment funds the anchor transaction of the given stale commitment
+// again at the current fee rate. The new transaction still spends the output
+// of the previous commitment, so it replaces the old one and the commitment
+// stays part of the chain.
+func (c *UniverseCommitter) bumpCommitment(ctx context.Context,
+	commitment *universe.Commitment, height uint32) error {
+
+	prev, err := c.prevCommitment(ctx, commitment)
+	if err != nil {
+		return err
+	}
+
+	oldTx := commitment.AnchorTx
+	oldTxid := oldTx.TxHash()
+	numBlocks := height - commitment.HeightHint
+	prevOutPoint := prev.OutPoint()
+
+	// We release the wallet inputs of the old transaction, so the wallet
+	// can use them again to fund the new one.
+	c.unlockWalletInputs(ctx, oldTx, prevOutPoint)
+
+	pkScript := oldTx.TxOut[commitment.OutputIndex].PkScript
+	anchorTx, err := c.fundAnchorTx(ctx, pkScript, prev)
+	if err != nil {
+		return err
+	}
+
+	outputIndex, err := commitmentOutputIndex(anchorTx, pkScript)
+	if err != nil {
+		c.unlockWalletInputs(ctx, anchorTx, prevOutPoint)
+		return err
+	}
+
+	err = c.cfg.CommitmentLog.ReplaceAnchorTx(
+		ctx, oldTxid, anchorTx, outputIndex, height,
+	)
+	if err != nil {
+		c.unlockWalletInputs(ctx, anchorTx, prevOutPoint)
+		return fmt.Errorf("unable to replace commitment tx: %w", err)
+	}
+
+	log.Warnf("Replaced universe commitment tx %v with %v, not confirmed "+
+		"after %d blocks", oldTxid, anchorTx.TxHash(), numBlocks)
+
+	return nil
+}
+
+// unlockWalletInputs releases the inputs of the given transaction, except for
+// the given commitment output, which isn't owned by the wallet.
+func (c *UniverseCommitter) unlockWalletInputs(ctx context.Context,
+	tx *wire.MsgTx, commitmentOutPoint wire.OutPoint) {
+
+	for _, txIn := range tx.TxIn {
+		op := txIn.PreviousOutPoint
+		if op == commitmentOutPoint {
+			continue
+		}
+
+		if err := c.cfg.Wallet.UnlockInput(ctx, op); err != nil {
+			log.Warnf("Unable to unlock input %v: %v", op, err)
+		}
+	}
+}
+
+// A compile-time assertion to ensure UniverseCommitter satisfies the
+// universe.ChainCommitter interface.
+var _ universe.ChainCommitter = (*UniverseCommitter)(nil)
@@ -0,0 +1,719 @@
+package tapgarden
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fmt"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMultiverse is a multiverse that only returns a fixed set of leaves.
+type mockMultiverse struct {
+	universe.MultiverseArchive
+
+	leaves []universe.MultiverseLeaf
+}
+
+func (m *mockMultiverse) FetchLeaves(_ context.Context,
+	_ []universe.MultiverseLeafDesc,
+	proofType universe.ProofType) ([]universe.MultiverseLeaf, error) {
+
+	return fn.Filter(m.leaves, func(leaf universe.MultiverseLeaf) bool {
+		return leaf.ID.ProofType == proofType
+	}), nil
+}
+
+// mockCommitmentLog is a commitment log that keeps the commitments in memory.
+type mockCommitmentLog struct {
+	sync.Mutex
+
+	commitments []*universe.Commitment
+}
+
+func (m *mockCommitmentLog) LatestCommitment(
+	context.Context) (*universe.Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for i := len(m.commitments) - 1; i >= 0; i-- {
+		if m.commitments[i].Confirmed() {
+			return m.commitments[i], nil
+		}
+	}
+
+	return nil, universe.ErrNoCommitment
+}
+
+func (m *mockCommitmentLog) CommittedProof(context.Context,
+	universe.Identifier,
+	*universe.Proof) (*universe.CommittedIssuanceProof, error) {
+
+	return nil, universe.ErrNoCommitment
+}
+
+func (m *mockCommitmentLog) InsertCommitment(_ context.Context,
+	commitment *universe.Commitment, _ []universe.MultiverseLeaf) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.commitments = append(m.commitments, commitment)
+
+	return nil
+}
+
+func (m *mockCommitmentLog) ConfirmCommitment(_ context.Context,
+	txid chainhash.Hash, blockHeight uint32, header wire.BlockHeader,
+	merkleProof *proof.TxMerkleProof) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, commitment := range m.commitments {
+		if commitment.AnchorTx.TxHash() != txid {
+			continue
+		}
+
+		commitment.BlockHeight = blockHeight
+		commitment.BlockHeader = header
+		commitment.MerkleProof = merkleProof
+
+		return nil
+	}
+
+	return universe.ErrNoCommitment
+}
+
+func (m *mockCommitmentLog) PendingCommitments(
+	context.Context) ([]*universe.Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return fn.Filter(m.commitments, func(c *universe.Commitment) bool {
+		return !c.Confirmed()
+	}), nil
+}
+
+func (m *mockCommitmentLog) AbandonCommitment(_ context.Context,
+	txid chainhash.Hash) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for i, commitment := range m.commitments {
+		if commitment.AnchorTx.TxHash() != txid {
+			continue
+		}
+
+		m.commitments = append(m.commitments[:i], m.commitments[i+1:]...)
+
+		return nil
+	}
+
+	return universe.ErrNoCommitment
+}
+
+func (m *mockCommitmentLog) ReplaceAnchorTx(_ context.Context,
+	txid chainhash.Hash, anchorTx *wire.MsgTx, outputIndex,
+	heightHint uint32) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, commitment := range m.commitments {
+		if commitment.Confirmed() ||
+			commitment.AnchorTx.TxHash() != txid {
+
+			continue
+		}
+
+		commitment.AnchorTx = anchorTx
+		commitment.OutputIndex = outputIndex
+		commitment.HeightHint = heightHint
+
+		return nil
+	}
+
+	return universe.ErrNoCommitment
+}
+
+var _ universe.CommitmentLog = (*mockCommitmentLog)(nil)
+
+// spendRequest is a spend notification registered with the heightChainBridge.
+type spendRequest struct {
+	outPoint wire.OutPoint
+	event    *chainntnfs.SpendEvent
+}
+
+// heightChainBridge is a mock chain bridge with a configurable block height,
+// which lets the test dispatch spend notifications for blocks it added.
+type heightChainBridge struct {
+	*MockChainBridge
+
+	height atomic.Uint32
+
+	spendReqs chan spendRequest
+
+	mu     sync.Mutex
+	blocks map[int64]*wire.MsgBlock
+}
+
+func newHeightChainBridge() *heightChainBridge {
+	return &heightChainBridge{
+		MockChainBridge: NewMockChainBridge(),
+		spendReqs:       make(chan spendRequest),
+		blocks:          make(map[int64]*wire.MsgBlock),
+	}
+}
+
+func (h *heightChainBridge) CurrentHeight(context.Context) (uint32, error) {
+	return h.height.Load(), nil
+}
+
+func (h *heightChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outPoint *wire.OutPoint, _ []byte, _ uint32) (*chainntnfs.SpendEvent,
+	chan error, error) {
+
+	event := &chainntnfs.SpendEvent{
+		Spend:  make(chan *chainntnfs.SpendDetail, 1),
+		Cancel: func() {},
+	}
+
+	select {
+	case h.spendReqs <- spendRequest{outPoint: *outPoint, event: event}:
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+
+	return event, make(chan error), nil
+}
+
+func (h *heightChainBridge) GetBlockHash(_ context.Context,
+	height int64) (chainhash.Hash, error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	block, ok := h.blocks[height]
+	if !ok {
+		return chainhash.Hash{}, fmt.Errorf("no block at height %d",
+			height)
+	}
+
+	return block.BlockHash(), nil
+}
+
+func (h *heightChainBridge) GetBlock(_ context.Context,
+	hash chainhash.Hash) (*wire.MsgBlock, error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, block := range h.blocks {
+		if block.BlockHash() == hash {
+			return block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown block %v", hash)
+}
+
+// addBlock adds a block at the given height that contains the given
+// transaction after another one, and returns the block.
+func (h *heightChainBridge) addBlock(t *testing.T, height int64,
+	tx *wire.MsgTx) *wire.MsgBlock {
+
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{otherTx, tx},
+	}
+	block.Header.MerkleRoot = blockchain.CalcMerkleRoot([]*btcutil.Tx{
+		btcutil.NewTx(otherTx), btcutil.NewTx(tx),
+	}, false)
+
+	h.mu.Lock()
+	h.blocks[height] = block
+	h.mu.Unlock()
+
+	return block
+}
+
+// unlockWalletAnchor is a mock wallet that records the unlocked inputs.
+type unlockWalletAnchor struct {
+	*MockWalletAnchor
+
+	mu       sync.Mutex
+	unlocked []wire.OutPoint
+}
+
+func (u *unlockWalletAnchor) UnlockInput(_ context.Context,
+	op wire.OutPoint) error {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.unlocked = append(u.unlocked, op)
+
+	return nil
+}
+
+// TestUniverseCommitter tests that the universe committer funds, publishes and
+// confirms a commitment to the multiverse roots, and that it doesn't commit
+// again as long as the roots didn't change.
+func TestUniverseCommitter(t *testing.T) {
+	t.Parallel()
+
+	var id universe.Identifier
+	copy(id.AssetID[:], test.RandBytes(32))
+	id.ProofType = universe.ProofTypeIssuance
+
+	rootHash := test.RandHash()
+	multiverse := &mockMultiverse{
+		leaves: []universe.MultiverseLeaf{{
+			ID:       id,
+			LeafNode: mssmt.NewLeafNode(rootHash[:], 1),
+		}},
+	}
+	commitmentLog := &mockCommitmentLog{}
+	wallet := NewMockWalletAnchor()
+	keyRing := NewMockKeyRing()
+	chainBridge := NewMockChainBridge()
+
+	committer := NewUniverseCommitter(&UniverseCommitterConfig{
+		Multiverse:     multiverse,
+		CommitmentLog:  commitmentLog,
+		Wallet:         wallet,
+		KeyRing:        keyRing,
+		ChainBridge:    chainBridge,
+		ChainParams:    &address.RegressionNetTap,
+		CommitInterval: time.Hour,
+	})
+	require.NoError(t, committer.Start())
+	t.Cleanup(func() {
+		require.NoError(t, committer.Stop())
+	})
+
+	// The committer immediately creates a commitment on startup, which
+	// takes a new internal key and a funded and signed transaction.
+	_, err := fn.RecvOrTimeout(keyRing.ReqKeys, testTimeout)
+	require.NoError(t, err)
+	_, err = fn.RecvOrTimeout(chainBridge.FeeEstimateSignal, testTimeout)
+	require.NoError(t, err)
+	_, err = fn.RecvOrTimeout(wallet.FundPsbtSignal, testTimeout)
+	require.NoError(t, err)
+	_, err = fn.RecvOrTimeout(wallet.SignPsbtSignal, testTimeout)
+	require.NoError(t, err)
+
+	anchorTx, err := fn.RecvOrTimeout(chainBridge.PublishReq, testTimeout)
+	require.NoError(t, err)
+
+	pending, err := commitmentLog.PendingCommitments(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, (*anchorTx).TxHash(), pending[0].AnchorTx.TxHash())
+
+	// Once the anchor transaction confirms, the commitment is valid.
+	reqNo, err := fn.RecvOrTimeout(chainBridge.ConfReqSignal, testTimeout)
+	require.NoError(t, err)
+
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{otherTx, *anchorTx},
+	}
+	block.Header.MerkleRoot = blockchain.CalcMerkleRoot([]*btcutil.Tx{
+		btcutil.NewTx(otherTx), btcutil.NewTx(*anchorTx),
+	}, false)
+	blockHash := block.BlockHash()
+	chainBridge.SendConfNtfn(*reqNo, &blockHash, 100, 1, block, *anchorTx)
+
+	var latest *universe.Commitment
+	require.Eventually(t, func() bool {
+		latest, err = commitmentLog.LatestCommitment(
+			context.Background(),
+		)
+		return err == nil
+	}, testTimeout, testPollInterval)
+
+	// It's the first commitment of the chain, so its output is the
+	// genesis outpoint.
+	genesis := wire.OutPoint{
+		Hash:  (*anchorTx).TxHash(),
+		Index: latest.OutputIndex,
+	}
+	require.EqualValues(t, 100, latest.BlockHeight)
+	require.Equal(t, genesis, latest.GenesisOutPoint)
+	require.True(t, latest.IsGenesis())
+	require.NoError(t, latest.Verify(
+		func(wire.BlockHeader, uint32) error {
+			return nil
+		}, genesis, func(outPoint wire.OutPoint, _ []byte) error {
+			require.Equal(t, genesis, outPoint)
+			return nil
+		},
+	))
+
+	// As long as the multiverse roots don't change, there's no need for
+	// another commitment.
+	require.NoError(t, committer.commitMultiverse())
+
+	commitmentLog.Lock()
+	require.Len(t, commitmentLog.commitments, 1)
+	commitmentLog.Unlock()
+}
+
+// TestUniverseCommitterAbandon tests that a commitment that doesn't confirm is
+// published again on every interval, and that it's abandoned and replaced by
+// a new one once it didn't confirm for CommitmentMaxConfDelay blocks.
+func TestUniverseCommitterAbandon(t *testing.T) {
+	t.Parallel()
+
+	var id universe.Identifier
+	copy(id.AssetID[:], test.RandBytes(32))
+	id.ProofType = universe.ProofTypeIssuance
+
+	rootHash := test.RandHash()
+	multiverse := &mockMultiverse{
+		leaves: []universe.MultiverseLeaf{{
+			ID:       id,
+			LeafNode: mssmt.NewLeafNode(rootHash[:], 1),
+		}},
+	}
+	commitmentLog := &mockCommitmentLog{}
+	wallet := &unlockWalletAnchor{
+		MockWalletAnchor: NewMockWalletAnchor(),
+	}
+	keyRing := NewMockKeyRing()
+	chainBridge := newHeightChainBridge()
+	chainBridge.height.Store(100)
+
+	// We don't start the committer but drive it manually, so each call
+	// waits for the confirmation for a short time only.
+	committer := NewUniverseCommitter(&UniverseCommitterConfig{
+		Multiverse:     multiverse,
+		CommitmentLog:  commitmentLog,
+		Wallet:         wallet,
+		KeyRing:        keyRing,
+		ChainBridge:    chainBridge,
+		ChainParams:    &address.RegressionNetTap,
+		CommitInterval: 50 * time.Millisecond,
+	})
+
+	runCommit := func(newCommitment bool) *wire.MsgTx {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- committer.commitMultiverse()
+		}()
+
+		if newCommitment {
+			_, err := fn.RecvOrTimeout(keyRing.ReqKeys, testTimeout)
+			require.NoError(t, err)
+			_, err = fn.RecvOrTimeout(
+				chainBridge.FeeEstimateSignal, testTimeout,
+			)
+			require.NoError(t, err)
+			_, err = fn.RecvOrTimeout(
+				wallet.FundPsbtSignal, testTimeout,
+			)
+			require.NoError(t, err)
+			_, err = fn.RecvOrTimeout(
+				wallet.SignPsbtSignal, testTimeout,
+			)
+			require.NoError(t, err)
+		}
+
+		anchorTx, err := fn.RecvOrTimeout(
+			chainBridge.PublishReq, testTimeout,
+		)
+		require.NoError(t, err)
+		_, err = fn.RecvOrTimeout(chainBridge.ConfReqSignal, testTimeout)
+		require.NoError(t, err)
+
+		commitErr, err := fn.RecvOrTimeout(errChan, testTimeout)
+		require.NoError(t, err)
+		require.NoError(t, *commitErr)
+
+		return *anchorTx
+	}
+
+	// The first commitment never confirms, so it's published again on
+	// the next interval.
+	firstTx := runCommit(true)
+	require.Equal(t, firstTx.TxHash(), runCommit(false).TxHash())
+
+	pending, err := commitmentLog.PendingCommitments(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// Once it didn't confirm for too long, it's abandoned and its inputs
+	// are released.
+	chainBridge.height.Store(100 + CommitmentMaxConfDelay)
+	require.Equal(t, firstTx.TxHash(), runCommit(false).TxHash())
+
+	pending, err = commitmentLog.PendingCommitments(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	wallet.mu.Lock()
+	require.Len(t, wallet.unlocked, len(firstTx.TxIn))
+	require.Equal(t, firstTx.TxIn[0].PreviousOutPoint, wallet.unlocked[0])
+	wallet.mu.Unlock()
+
+	// The next interval creates a new commitment to the same roots.
+	secondTx := runCommit(true)
+	require.NotEqual(t, firstTx.TxHash(), secondTx.TxHash())
+
+	pending, err = commitmentLog.PendingCommitments(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, secondTx.TxHash(), pending[0].AnchorTx.TxHash())
+}
+
+// TestUniverseCommitterChain tests that each commitment spends the output of
+// the previous one, that a stale commitment is funded again instead of being
+// abandoned, and that it's confirmed with whichever version of its anchor
+// transaction spent the previous commitment output.
+func TestUniverseCommitterChain(t *testing.T) {
+	t.Parallel()
+
+	var id universe.Identifier
+	copy(id.AssetID[:], test.RandBytes(32))
+	id.ProofType = universe.ProofTypeIssuance
+
+	setRoot := func(multiverse *mockMultiverse) {
+		rootHash := test.RandHash()
+		multiverse.leaves = []universe.MultiverseLeaf{{
+			ID:       id,
+			LeafNode: mssmt.NewLeafNode(rootHash[:], 1),
+		}}
+	}
+
+	multiverse := &mockMultiverse{}
+	setRoot(multiverse)
+
+	commitmentLog := &mockCommitmentLog{}
+	wallet := &unlockWalletAnchor{
+		MockWalletAnchor: NewMockWalletAnchor(),
+	}
+	keyRing := NewMockKeyRing()
+	chainBridge := newHeightChainBridge()
+	chainBridge.height.Store(100)
+
+	// We don't start the committer but drive it manually. The commit
+	// interval is only lowered to let a commitment go stale.
+	committer := NewUniverseCommitter(&UniverseCommitterConfig{
+		Multiverse:     multiverse,
+		CommitmentLog:  commitmentLog,
+		Wallet:         wallet,
+		KeyRing:        keyRing,
+		ChainBridge:    chainBridge,
+		ChainParams:    &address.RegressionNetTap,
+		CommitInterval: time.Hour,
+	})
+
+	ctx := context.Background()
+	acceptAnyHeader := func(wire.BlockHeader, uint32) error {
+		return nil
+	}
+
+	startCommit := func() chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- committer.commitMultiverse()
+		}()
+
+		return errChan
+	}
+	recvFunding := func() {
+		_, err := fn.RecvOrTimeout(
+			chainBridge.FeeEstimateSignal, testTimeout,
+		)
+		require.NoError(t, err)
+		_, err = fn.RecvOrTimeout(wallet.FundPsbtSignal, testTimeout)
+		require.NoError(t, err)
+		_, err = fn.RecvOrTimeout(wallet.SignPsbtSignal, testTimeout)
+		require.NoError(t, err)
+	}
+	recvNewCommitment := func() {
+		_, err := fn.RecvOrTimeout(keyRing.ReqKeys, testTimeout)
+		require.NoError(t, err)
+		recvFunding()
+	}
+	recvPublish := func() *wire.MsgTx {
+		anchorTx, err := fn.RecvOrTimeout(
+			chainBridge.PublishReq, testTimeout,
+		)
+		require.NoError(t, err)
+
+		return *anchorTx
+	}
+	recvSpendReq := func(outPoint wire.OutPoint) *chainntnfs.SpendEvent {
+		req, err := fn.RecvOrTimeout(chainBridge.spendReqs, testTimeout)
+		require.NoError(t, err)
+		require.Equal(t, outPoint, req.outPoint)
+
+		return req.event
+	}
+	sendSpend := func(event *chainntnfs.SpendEvent, tx *wire.MsgTx,
+		height int32) {
+
+		chainBridge.addBlock(t, int64(height), tx)
+
+		txid := tx.TxHash()
+		event.Spend <- &chainntnfs.SpendDetail{
+			SpentOutPoint:  &tx.TxIn[0].PreviousOutPoint,
+			SpenderTxHash:  &txid,
+			SpendingTx:     tx,
+			SpendingHeight: height,
+		}
+	}
+	recvDone := func(errChan chan error) {
+		commitErr, err := fn.RecvOrTimeout(errChan, testTimeout)
+		require.NoError(t, err)
+		require.NoError(t, *commitErr)
+	}
+
+	// The first commitment is funded by the wallet only and confirmed
+	// like any other transaction. Its output is the genesis outpoint.
+	errChan := startCommit()
+	recvNewCommitment()
+	genesisTx := recvPublish()
+
+	reqNo, err := fn.RecvOrTimeout(chainBridge.ConfReqSignal, testTimeout)
+	require.NoError(t, err)
+	block := chainBridge.addBlock(t, 100, genesisTx)
+	blockHash := block.BlockHash()
+	chainBridge.SendConfNtfn(*reqNo, &blockHash, 100, 1, block, genesisTx)
+	recvDone(errChan)
+
+	first, err := commitmentLog.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.True(t, first.IsGenesis())
+	require.True(t, first.PrevOutPoint().IsNone())
+
+	genesis := first.OutPoint()
+	tip := genesis
+	isTip := func(outPoint wire.OutPoint, _ []byte) error {
+		if outPoint != tip {
+			return fmt.Errorf("outpoint %v was spent", outPoint)
+		}
+
+		return nil
+	}
+	require.NoError(t, first.Verify(acceptAnyHeader, genesis, isTip))
+
+	// Once the roots changed, the next commitment spends the output of
+	// the first one with a key path spend. It's confirmed once that output
+	// is spent.
+	setRoot(multiverse)
+	errChan = startCommit()
+	recvNewCommitment()
+	secondTx := recvPublish()
+	require.Equal(t, genesis, secondTx.TxIn[0].PreviousOutPoint)
+	require.Len(t, secondTx.TxIn[0].Witness, 1)
+
+	spendEvent := recvSpendReq(genesis)
+	sendSpend(spendEvent, secondTx, 101)
+	recvDone(errChan)
+
+	second, err := commitmentLog.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, secondTx.TxHash(), second.AnchorTx.TxHash())
+	require.EqualValues(t, 101, second.BlockHeight)
+	require.Equal(t, genesis, second.GenesisOutPoint)
+	require.False(t, second.IsGenesis())
+	require.NoError(t, second.VerifyLink(first))
+
+	// The first commitment is no longer the tip of the chain, so only the
+	// second one verifies.
+	tip = second.OutPoint()
+	require.NoError(t, second.Verify(acceptAnyHeader, genesis, isTip))
+	require.ErrorIs(
+		t, first.Verify(acceptAnyHeader, genesis, isTip),
+		universe.ErrInvalidCommitment,
+	)
+
+	// The third commitment doesn't confirm in time, so it's published
+	// again on the next interval.
+	committer.cfg.CommitInterval = 50 * time.Millisecond
+	setRoot(multiverse)
+	errChan = startCommit()
+	recvNewCommitment()
+	staleTx := recvPublish()
+	recvSpendReq(second.OutPoint())
+	recvDone(errChan)
+
+	// Once it didn't confirm for too long, it's funded again instead of
+	// being abandoned, so it still spends the output of the second one.
+	// Only its wallet inputs are released.
+	chainBridge.height.Store(100 + CommitmentMaxConfDelay)
+	errChan = startCommit()
+	require.Equal(t, staleTx.TxHash(), recvPublish().TxHash())
+	recvSpendReq(second.OutPoint())
+	recvFunding()
+	recvDone(errChan)
+
+	wallet.mu.Lock()
+	require.Equal(t, []wire.OutPoint{
+		staleTx.TxIn[1].PreviousOutPoint,
+	}, wallet.unlocked)
+	wallet.mu.Unlock()
+
+	pending, err := commitmentLog.PendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	bumpedTx := pending[0].AnchorTx
+	require.NotEqual(t, staleTx.TxHash(), bumpedTx.TxHash())
+	require.Equal(
+		t, second.OutPoint(), bumpedTx.TxIn[0].PreviousOutPoint,
+	)
+	require.EqualValues(
+		t, 100+CommitmentMaxConfDelay, pending[0].HeightHint,
+	)
+	require.Equal(t, genesis, pending[0].GenesisOutPoint)
+
+	// The bumped transaction is published from now on, but the stale one
+	// might still confirm, which confirms the commitment as well.
+	committer.cfg.CommitInterval = time.Hour
+	errChan = startCommit()
+	require.Equal(t, bumpedTx.TxHash(), recvPublish().TxHash())
+	spendEvent = recvSpendReq(second.OutPoint())
+	sendSpend(spendEvent, staleTx, 250)
+	recvDone(errChan)
+
+	third, err := commitmentLog.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, staleTx.TxHash(), third.AnchorTx.TxHash())
+	require.EqualValues(t, 250, third.BlockHeight)
+	require.NoError(t, third.VerifyLink(second))
+
+	tip = third.OutPoint()
+	require.NoError(t, third.Verify(acceptAnyHeader, genesis, isTip))
+
+	pending, err = commitmentLog.PendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
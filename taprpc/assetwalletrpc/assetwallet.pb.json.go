@@ -345,4 +345,29 @@ func RegisterAssetWalletJSONCallbacks(registry map[string]func(ctx context.Conte
 		}
 		callback(string(respBytes), nil)
 	}
+
+	registry["assetwalletrpc.AssetWallet.ConsolidateAssetUtxos"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ConsolidateAssetUtxosRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewAssetWalletClient(conn)
+		resp, err := client.ConsolidateAssetUtxos(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
 }
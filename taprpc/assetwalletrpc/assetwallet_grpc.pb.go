@@ -77,6 +77,13 @@ type AssetWalletClient interface {
 	// recognized by the wallet automatically. Declaring a script key will make any
 	// assets sent to the script key be recognized as being local assets.
 	DeclareScriptKey(ctx context.Context, in *DeclareScriptKeyRequest, opts ...grpc.CallOption) (*DeclareScriptKeyResponse, error)
+	// ConsolidateAssetUtxos merges all anchor outputs that carry the same asset
+	// ID or asset group into a single anchor output, for every asset that is
+	// spread over at least the configured threshold of anchor outputs. The
+	// consolidation is only performed if the current on-chain fee rate is at or
+	// below the configured ceiling. If dry_run is set, only the planned
+	// consolidations and their estimated fees are returned.
+	ConsolidateAssetUtxos(ctx context.Context, in *ConsolidateAssetUtxosRequest, opts ...grpc.CallOption) (*ConsolidateAssetUtxosResponse, error)
 }
 
 type assetWalletClient struct {
@@ -204,6 +211,15 @@ func (c *assetWalletClient) DeclareScriptKey(ctx context.Context, in *DeclareScr
 	return out, nil
 }
 
+func (c *assetWalletClient) ConsolidateAssetUtxos(ctx context.Context, in *ConsolidateAssetUtxosRequest, opts ...grpc.CallOption) (*ConsolidateAssetUtxosResponse, error) {
+	out := new(ConsolidateAssetUtxosResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ConsolidateAssetUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetWalletServer is the server API for AssetWallet service.
 // All implementations must embed UnimplementedAssetWalletServer
 // for forward compatibility
@@ -266,6 +282,13 @@ type AssetWalletServer interface {
 	// recognized by the wallet automatically. Declaring a script key will make any
 	// assets sent to the script key be recognized as being local assets.
 	DeclareScriptKey(context.Context, *DeclareScriptKeyRequest) (*DeclareScriptKeyResponse, error)
+	// ConsolidateAssetUtxos merges all anchor outputs that carry the same asset
+	// ID or asset group into a single anchor output, for every asset that is
+	// spread over at least the configured threshold of anchor outputs. The
+	// consolidation is only performed if the current on-chain fee rate is at or
+	// below the configured ceiling. If dry_run is set, only the planned
+	// consolidations and their estimated fees are returned.
+	ConsolidateAssetUtxos(context.Context, *ConsolidateAssetUtxosRequest) (*ConsolidateAssetUtxosResponse, error)
 	mustEmbedUnimplementedAssetWalletServer()
 }
 
@@ -312,6 +335,9 @@ func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUT
 func (UnimplementedAssetWalletServer) DeclareScriptKey(context.Context, *DeclareScriptKeyRequest) (*DeclareScriptKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeclareScriptKey not implemented")
 }
+func (UnimplementedAssetWalletServer) ConsolidateAssetUtxos(context.Context, *ConsolidateAssetUtxosRequest) (*ConsolidateAssetUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateAssetUtxos not implemented")
+}
 func (UnimplementedAssetWalletServer) mustEmbedUnimplementedAssetWalletServer() {}
 
 // UnsafeAssetWalletServer may be embedded to opt out of forward compatibility for this service.
@@ -559,6 +585,24 @@ func _AssetWallet_DeclareScriptKey_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_ConsolidateAssetUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateAssetUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ConsolidateAssetUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ConsolidateAssetUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ConsolidateAssetUtxos(ctx, req.(*ConsolidateAssetUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetWallet_ServiceDesc is the grpc.ServiceDesc for AssetWallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -618,6 +662,10 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclareScriptKey",
 			Handler:    _AssetWallet_DeclareScriptKey_Handler,
 		},
+		{
+			MethodName: "ConsolidateAssetUtxos",
+			Handler:    _AssetWallet_ConsolidateAssetUtxos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assetwalletrpc/assetwallet.proto",
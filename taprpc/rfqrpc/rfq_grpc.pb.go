@@ -61,6 +61,11 @@ type RfqClient interface {
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAcceptedQuotesRequest, opts ...grpc.CallOption) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq quotehistory`
+	// QueryQuoteHistory is used to query the history of the quotes that were
+	// accepted by our node or by our peers, including the expired ones that
+	// were not pruned yet.
+	QueryQuoteHistory(ctx context.Context, in *QueryQuoteHistoryRequest, opts ...grpc.CallOption) (*QueryQuoteHistoryResponse, error)
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error)
 }
@@ -118,6 +123,15 @@ func (c *rfqClient) QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAc
 	return out, nil
 }
 
+func (c *rfqClient) QueryQuoteHistory(ctx context.Context, in *QueryQuoteHistoryRequest, opts ...grpc.CallOption) (*QueryQuoteHistoryResponse, error) {
+	out := new(QueryQuoteHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rfqrpc.Rfq/QueryQuoteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rfqClient) SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Rfq_ServiceDesc.Streams[0], "/rfqrpc.Rfq/SubscribeRfqEventNtfns", opts...)
 	if err != nil {
@@ -197,6 +211,11 @@ type RfqServer interface {
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq quotehistory`
+	// QueryQuoteHistory is used to query the history of the quotes that were
+	// accepted by our node or by our peers, including the expired ones that
+	// were not pruned yet.
+	QueryQuoteHistory(context.Context, *QueryQuoteHistoryRequest) (*QueryQuoteHistoryResponse, error)
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error
 	mustEmbedUnimplementedRfqServer()
@@ -221,6 +240,9 @@ func (UnimplementedRfqServer) AddAssetBuyOffer(context.Context, *AddAssetBuyOffe
 func (UnimplementedRfqServer) QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryPeerAcceptedQuotes not implemented")
 }
+func (UnimplementedRfqServer) QueryQuoteHistory(context.Context, *QueryQuoteHistoryRequest) (*QueryQuoteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuoteHistory not implemented")
+}
 func (UnimplementedRfqServer) SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeRfqEventNtfns not implemented")
 }
@@ -327,6 +349,24 @@ func _Rfq_QueryPeerAcceptedQuotes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rfq_QueryQuoteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RfqServer).QueryQuoteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rfqrpc.Rfq/QueryQuoteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RfqServer).QueryQuoteHistory(ctx, req.(*QueryQuoteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Rfq_SubscribeRfqEventNtfns_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRfqEventNtfnsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -375,6 +415,10 @@ var Rfq_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPeerAcceptedQuotes",
 			Handler:    _Rfq_QueryPeerAcceptedQuotes_Handler,
 		},
+		{
+			MethodName: "QueryQuoteHistory",
+			Handler:    _Rfq_QueryQuoteHistory_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -40,6 +40,19 @@ type TaprootAssetChannelsClient interface {
 	// asset ID and returns the invoice amount expressed in asset units along side
 	// the normal information.
 	DecodeAssetPayReq(ctx context.Context, in *AssetPayReq, opts ...grpc.CallOption) (*AssetPayReqResponse, error)
+	// ExportChannelBackup creates an encrypted backup of the asset state of the
+	// given Taproot Asset channels, or of all channels if none are specified. This
+	// is the asset equivalent of lnd's static channel backup. If the channel state
+	// is lost and the channels are restored from lnd's static channel backup, the
+	// asset channel backup allows the assets to be swept from the remote party's
+	// commitment once it force closes the channel. A backup only covers the remote
+	// commitment that is current at the time of the export. The assets of a newer
+	// commitment can't be swept with a stale backup, so a backup should be
+	// exported again after each channel update.
+	ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ExportChannelBackupResponse, error)
+	// RestoreChannelBackup restores an encrypted asset channel backup that was
+	// created with ExportChannelBackup by a node with the same seed.
+	RestoreChannelBackup(ctx context.Context, in *RestoreChannelBackupRequest, opts ...grpc.CallOption) (*RestoreChannelBackupResponse, error)
 }
 
 type taprootAssetChannelsClient struct {
@@ -118,6 +131,24 @@ func (c *taprootAssetChannelsClient) DecodeAssetPayReq(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *taprootAssetChannelsClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ExportChannelBackupResponse, error) {
+	out := new(ExportChannelBackupResponse)
+	err := c.cc.Invoke(ctx, "/tapchannelrpc.TaprootAssetChannels/ExportChannelBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetChannelsClient) RestoreChannelBackup(ctx context.Context, in *RestoreChannelBackupRequest, opts ...grpc.CallOption) (*RestoreChannelBackupResponse, error) {
+	out := new(RestoreChannelBackupResponse)
+	err := c.cc.Invoke(ctx, "/tapchannelrpc.TaprootAssetChannels/RestoreChannelBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaprootAssetChannelsServer is the server API for TaprootAssetChannels service.
 // All implementations must embed UnimplementedTaprootAssetChannelsServer
 // for forward compatibility
@@ -144,6 +175,19 @@ type TaprootAssetChannelsServer interface {
 	// asset ID and returns the invoice amount expressed in asset units along side
 	// the normal information.
 	DecodeAssetPayReq(context.Context, *AssetPayReq) (*AssetPayReqResponse, error)
+	// ExportChannelBackup creates an encrypted backup of the asset state of the
+	// given Taproot Asset channels, or of all channels if none are specified. This
+	// is the asset equivalent of lnd's static channel backup. If the channel state
+	// is lost and the channels are restored from lnd's static channel backup, the
+	// asset channel backup allows the assets to be swept from the remote party's
+	// commitment once it force closes the channel. A backup only covers the remote
+	// commitment that is current at the time of the export. The assets of a newer
+	// commitment can't be swept with a stale backup, so a backup should be
+	// exported again after each channel update.
+	ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ExportChannelBackupResponse, error)
+	// RestoreChannelBackup restores an encrypted asset channel backup that was
+	// created with ExportChannelBackup by a node with the same seed.
+	RestoreChannelBackup(context.Context, *RestoreChannelBackupRequest) (*RestoreChannelBackupResponse, error)
 	mustEmbedUnimplementedTaprootAssetChannelsServer()
 }
 
@@ -166,6 +210,12 @@ func (UnimplementedTaprootAssetChannelsServer) AddInvoice(context.Context, *AddI
 func (UnimplementedTaprootAssetChannelsServer) DecodeAssetPayReq(context.Context, *AssetPayReq) (*AssetPayReqResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeAssetPayReq not implemented")
 }
+func (UnimplementedTaprootAssetChannelsServer) ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ExportChannelBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChannelBackup not implemented")
+}
+func (UnimplementedTaprootAssetChannelsServer) RestoreChannelBackup(context.Context, *RestoreChannelBackupRequest) (*RestoreChannelBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreChannelBackup not implemented")
+}
 func (UnimplementedTaprootAssetChannelsServer) mustEmbedUnimplementedTaprootAssetChannelsServer() {}
 
 // UnsafeTaprootAssetChannelsServer may be embedded to opt out of forward compatibility for this service.
@@ -272,6 +322,42 @@ func _TaprootAssetChannels_DecodeAssetPayReq_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssetChannels_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChannelBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetChannelsServer).ExportChannelBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tapchannelrpc.TaprootAssetChannels/ExportChannelBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetChannelsServer).ExportChannelBackup(ctx, req.(*ExportChannelBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssetChannels_RestoreChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChannelBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetChannelsServer).RestoreChannelBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tapchannelrpc.TaprootAssetChannels/RestoreChannelBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetChannelsServer).RestoreChannelBackup(ctx, req.(*RestoreChannelBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaprootAssetChannels_ServiceDesc is the grpc.ServiceDesc for TaprootAssetChannels service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +381,14 @@ var TaprootAssetChannels_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeAssetPayReq",
 			Handler:    _TaprootAssetChannels_DecodeAssetPayReq_Handler,
 		},
+		{
+			MethodName: "ExportChannelBackup",
+			Handler:    _TaprootAssetChannels_ExportChannelBackup_Handler,
+		},
+		{
+			MethodName: "RestoreChannelBackup",
+			Handler:    _TaprootAssetChannels_RestoreChannelBackup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -162,4 +162,54 @@ func RegisterTaprootAssetChannelsJSONCallbacks(registry map[string]func(ctx cont
 		}
 		callback(string(respBytes), nil)
 	}
+
+	registry["tapchannelrpc.TaprootAssetChannels.ExportChannelBackup"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ExportChannelBackupRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetChannelsClient(conn)
+		resp, err := client.ExportChannelBackup(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["tapchannelrpc.TaprootAssetChannels.RestoreChannelBackup"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &RestoreChannelBackupRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetChannelsClient(conn)
+		resp, err := client.RestoreChannelBackup(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
 }
@@ -371,6 +371,31 @@ func RegisterTaprootAssetsJSONCallbacks(registry map[string]func(ctx context.Con
 		callback(string(respBytes), nil)
 	}
 
+	registry["taprpc.TaprootAssets.ConvertProofFile"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ConvertProofFileRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetsClient(conn)
+		resp, err := client.ConvertProofFile(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["taprpc.TaprootAssets.SendAsset"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -396,6 +421,31 @@ func RegisterTaprootAssetsJSONCallbacks(registry map[string]func(ctx context.Con
 		callback(string(respBytes), nil)
 	}
 
+	registry["taprpc.TaprootAssets.SendAssetBatch"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SendAssetBatchRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetsClient(conn)
+		resp, err := client.SendAssetBatch(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["taprpc.TaprootAssets.BurnAsset"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -446,6 +496,31 @@ func RegisterTaprootAssetsJSONCallbacks(registry map[string]func(ctx context.Con
 		callback(string(respBytes), nil)
 	}
 
+	registry["taprpc.TaprootAssets.RecoverAssets"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &RecoverAssetsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetsClient(conn)
+		resp, err := client.RecoverAssets(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["taprpc.TaprootAssets.GetInfo"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -579,4 +654,54 @@ func RegisterTaprootAssetsJSONCallbacks(registry map[string]func(ctx context.Con
 			}
 		}()
 	}
+
+	registry["taprpc.TaprootAssets.ExportBackup"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ExportBackupRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetsClient(conn)
+		resp, err := client.ExportBackup(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["taprpc.TaprootAssets.RestoreBackup"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &RestoreBackupRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTaprootAssetsClient(conn)
+		resp, err := client.RestoreBackup(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
 }
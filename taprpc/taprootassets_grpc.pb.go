@@ -72,12 +72,26 @@ type TaprootAssetsClient interface {
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error)
+	// tapcli: `proofs convert`
+	// ConvertProofFile converts a proof file to the given proof file version.
+	// Version 1 is a compressed encoding that only contains block headers and
+	// exclusion proofs that are used multiple times within the file once. The
+	// response also reports the size of the file in both encodings.
+	ConvertProofFile(ctx context.Context, in *ConvertProofFileRequest, opts ...grpc.CallOption) (*ConvertProofFileResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tapcli: `assets sendbatch`
+	// SendAssetBatch sends to an arbitrary set of Taproot Asset addresses, which
+	// may be for different assets. The addresses are split into batches, each of
+	// which is sent within its own anchor transaction that stays below the given
+	// size limit. A failure to send one batch does not affect the other batches.
+	// The proof delivery state of each recipient is reported through the
+	// SubscribeSendEvents RPC.
+	SendAssetBatch(ctx context.Context, in *SendAssetBatchRequest, opts ...grpc.CallOption) (*SendAssetBatchResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
@@ -90,6 +104,15 @@ type TaprootAssetsClient interface {
 	// are not recoverable in any way. Filters may be applied to return more
 	// specific results.
 	ListBurns(ctx context.Context, in *ListBurnsRequest, opts ...grpc.CallOption) (*ListBurnsResponse, error)
+	// tapcli: `assets recover`
+	// RecoverAssets attempts to recover all assets owned by keys derived from the
+	// seed of the backing lnd node, for example after the database was lost. The
+	// script keys of the Taproot Assets key family are derived up to the gap
+	// limit and matched against the leaves of the local universe or of the
+	// universe federation. The full proof files of all matching assets that
+	// aren't spent yet are then fetched from the universe proof courier, verified
+	// and imported.
+	RecoverAssets(ctx context.Context, in *RecoverAssetsRequest, opts ...grpc.CallOption) (*RecoverAssetsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
@@ -105,6 +128,18 @@ type TaprootAssetsClient interface {
 	// SubscribeSendEvents allows a caller to subscribe to send events for outgoing
 	// asset transfers.
 	SubscribeSendEvents(ctx context.Context, in *SubscribeSendEventsRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeSendEventsClient, error)
+	// tapcli: `backup export`
+	// ExportBackup exports an encrypted backup of all assets owned by the daemon,
+	// including their proof files and key derivation information, as well as all
+	// address book entries and pending transfers. The backup is encrypted with a
+	// key derived from the seed of the backing lnd node.
+	ExportBackup(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (*ExportBackupResponse, error)
+	// tapcli: `backup restore`
+	// RestoreBackup restores an encrypted backup that was created with
+	// ExportBackup. All proofs contained in the backup are fully verified before
+	// they are imported. Entries that already exist in the database are skipped,
+	// so a backup can safely be restored multiple times.
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
 }
 
 type taprootAssetsClient struct {
@@ -241,6 +276,15 @@ func (c *taprootAssetsClient) ExportProof(ctx context.Context, in *ExportProofRe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ConvertProofFile(ctx context.Context, in *ConvertProofFileRequest, opts ...grpc.CallOption) (*ConvertProofFileResponse, error) {
+	out := new(ConvertProofFileResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ConvertProofFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
 	out := new(SendAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAsset", in, out, opts...)
@@ -250,6 +294,15 @@ func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SendAssetBatch(ctx context.Context, in *SendAssetBatchRequest, opts ...grpc.CallOption) (*SendAssetBatchResponse, error) {
+	out := new(SendAssetBatchResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAssetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error) {
 	out := new(BurnAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BurnAsset", in, out, opts...)
@@ -268,6 +321,15 @@ func (c *taprootAssetsClient) ListBurns(ctx context.Context, in *ListBurnsReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) RecoverAssets(ctx context.Context, in *RecoverAssetsRequest, opts ...grpc.CallOption) (*RecoverAssetsResponse, error) {
+	out := new(RecoverAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RecoverAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
@@ -350,6 +412,24 @@ func (x *taprootAssetsSubscribeSendEventsClient) Recv() (*SendEvent, error) {
 	return m, nil
 }
 
+func (c *taprootAssetsClient) ExportBackup(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (*ExportBackupResponse, error) {
+	out := new(ExportBackupResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RestoreBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaprootAssetsServer is the server API for TaprootAssets service.
 // All implementations must embed UnimplementedTaprootAssetsServer
 // for forward compatibility
@@ -408,12 +488,26 @@ type TaprootAssetsServer interface {
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error)
+	// tapcli: `proofs convert`
+	// ConvertProofFile converts a proof file to the given proof file version.
+	// Version 1 is a compressed encoding that only contains block headers and
+	// exclusion proofs that are used multiple times within the file once. The
+	// response also reports the size of the file in both encodings.
+	ConvertProofFile(context.Context, *ConvertProofFileRequest) (*ConvertProofFileResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tapcli: `assets sendbatch`
+	// SendAssetBatch sends to an arbitrary set of Taproot Asset addresses, which
+	// may be for different assets. The addresses are split into batches, each of
+	// which is sent within its own anchor transaction that stays below the given
+	// size limit. A failure to send one batch does not affect the other batches.
+	// The proof delivery state of each recipient is reported through the
+	// SubscribeSendEvents RPC.
+	SendAssetBatch(context.Context, *SendAssetBatchRequest) (*SendAssetBatchResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
@@ -426,6 +520,15 @@ type TaprootAssetsServer interface {
 	// are not recoverable in any way. Filters may be applied to return more
 	// specific results.
 	ListBurns(context.Context, *ListBurnsRequest) (*ListBurnsResponse, error)
+	// tapcli: `assets recover`
+	// RecoverAssets attempts to recover all assets owned by keys derived from the
+	// seed of the backing lnd node, for example after the database was lost. The
+	// script keys of the Taproot Assets key family are derived up to the gap
+	// limit and matched against the leaves of the local universe or of the
+	// universe federation. The full proof files of all matching assets that
+	// aren't spent yet are then fetched from the universe proof courier, verified
+	// and imported.
+	RecoverAssets(context.Context, *RecoverAssetsRequest) (*RecoverAssetsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
@@ -441,6 +544,18 @@ type TaprootAssetsServer interface {
 	// SubscribeSendEvents allows a caller to subscribe to send events for outgoing
 	// asset transfers.
 	SubscribeSendEvents(*SubscribeSendEventsRequest, TaprootAssets_SubscribeSendEventsServer) error
+	// tapcli: `backup export`
+	// ExportBackup exports an encrypted backup of all assets owned by the daemon,
+	// including their proof files and key derivation information, as well as all
+	// address book entries and pending transfers. The backup is encrypted with a
+	// key derived from the seed of the backing lnd node.
+	ExportBackup(context.Context, *ExportBackupRequest) (*ExportBackupResponse, error)
+	// tapcli: `backup restore`
+	// RestoreBackup restores an encrypted backup that was created with
+	// ExportBackup. All proofs contained in the backup are fully verified before
+	// they are imported. Entries that already exist in the database are skipped,
+	// so a backup can safely be restored multiple times.
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
 	mustEmbedUnimplementedTaprootAssetsServer()
 }
 
@@ -490,15 +605,24 @@ func (UnimplementedTaprootAssetsServer) DecodeProof(context.Context, *DecodeProo
 func (UnimplementedTaprootAssetsServer) ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportProof not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ConvertProofFile(context.Context, *ConvertProofFileRequest) (*ConvertProofFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertProofFile not implemented")
+}
 func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SendAssetBatch(context.Context, *SendAssetBatchRequest) (*SendAssetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAssetBatch not implemented")
+}
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
 func (UnimplementedTaprootAssetsServer) ListBurns(context.Context, *ListBurnsRequest) (*ListBurnsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBurns not implemented")
 }
+func (UnimplementedTaprootAssetsServer) RecoverAssets(context.Context, *RecoverAssetsRequest) (*RecoverAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverAssets not implemented")
+}
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
@@ -511,6 +635,12 @@ func (UnimplementedTaprootAssetsServer) SubscribeReceiveEvents(*SubscribeReceive
 func (UnimplementedTaprootAssetsServer) SubscribeSendEvents(*SubscribeSendEventsRequest, TaprootAssets_SubscribeSendEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeSendEvents not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ExportBackup(context.Context, *ExportBackupRequest) (*ExportBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBackup not implemented")
+}
+func (UnimplementedTaprootAssetsServer) RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
 func (UnimplementedTaprootAssetsServer) mustEmbedUnimplementedTaprootAssetsServer() {}
 
 // UnsafeTaprootAssetsServer may be embedded to opt out of forward compatibility for this service.
@@ -776,6 +906,24 @@ func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_ConvertProofFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertProofFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ConvertProofFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ConvertProofFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ConvertProofFile(ctx, req.(*ConvertProofFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
@@ -794,6 +942,24 @@ func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_SendAssetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAssetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SendAssetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SendAssetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SendAssetBatch(ctx, req.(*SendAssetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BurnAssetRequest)
 	if err := dec(in); err != nil {
@@ -830,6 +996,24 @@ func _TaprootAssets_ListBurns_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_RecoverAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RecoverAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RecoverAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RecoverAssets(ctx, req.(*RecoverAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -908,6 +1092,42 @@ func (x *taprootAssetsSubscribeSendEventsServer) Send(m *SendEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TaprootAssets_ExportBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportBackup(ctx, req.(*ExportBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RestoreBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaprootAssets_ServiceDesc is the grpc.ServiceDesc for TaprootAssets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -971,10 +1191,18 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportProof",
 			Handler:    _TaprootAssets_ExportProof_Handler,
 		},
+		{
+			MethodName: "ConvertProofFile",
+			Handler:    _TaprootAssets_ConvertProofFile_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _TaprootAssets_SendAsset_Handler,
 		},
+		{
+			MethodName: "SendAssetBatch",
+			Handler:    _TaprootAssets_SendAssetBatch_Handler,
+		},
 		{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
@@ -983,6 +1211,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBurns",
 			Handler:    _TaprootAssets_ListBurns_Handler,
 		},
+		{
+			MethodName: "RecoverAssets",
+			Handler:    _TaprootAssets_RecoverAssets_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
@@ -991,6 +1223,14 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchAssetMeta",
 			Handler:    _TaprootAssets_FetchAssetMeta_Handler,
 		},
+		{
+			MethodName: "ExportBackup",
+			Handler:    _TaprootAssets_ExportBackup_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _TaprootAssets_RestoreBackup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
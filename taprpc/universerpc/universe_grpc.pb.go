@@ -43,6 +43,12 @@ type UniverseClient interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(ctx context.Context, in *AssetLeafKeysRequest, opts ...grpc.CallOption) (*AssetLeafKeyResponse, error)
+	// BranchNodes queries for the nodes of the Universe MS-SMT of a given
+	// asset_id or group_key that are a number of levels below the branch
+	// identified by a key prefix. Comparing these nodes with the ones of another
+	// Universe allows finding the subtrees that differ without fetching the
+	// full set of Universe keys.
+	BranchNodes(ctx context.Context, in *BranchNodesRequest, opts ...grpc.CallOption) (*BranchNodesResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -58,6 +64,12 @@ type UniverseClient interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// SubscribeUniverseLeaves subscribes to the issuance and transfer leaves that
+	// are inserted into the local Universe, optionally filtered by asset_id,
+	// group_key or proof type. Each leaf is sent together with its proofs and a
+	// cursor that can be used to resume the subscription after a disconnect
+	// without missing any leaves.
+	SubscribeUniverseLeaves(ctx context.Context, in *SubscribeUniverseLeavesRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseLeavesClient, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
@@ -72,6 +84,13 @@ type UniverseClient interface {
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// tapcli: `universe signedroots`
+	// SignedMultiverseRoots returns the multiverse root snapshot signed by this
+	// Universe server for the current epoch, along with all signed snapshots of
+	// other federation members it has collected since the given epoch. Federation
+	// members exchange these snapshots to detect a server that equivocates by
+	// signing two conflicting multiverse roots for the same epoch.
+	SignedMultiverseRoots(ctx context.Context, in *SignedMultiverseRootsRequest, opts ...grpc.CallOption) (*SignedMultiverseRootsResponse, error)
 	// tapcli: `universe sync`
 	// SyncUniverse takes host information for a remote Universe server, then
 	// attempts to synchronize either only the set of specified asset_ids, or all
@@ -104,6 +123,12 @@ type UniverseClient interface {
 	// asset type. Pagination is supported via the offset and limit params.
 	// Results can also be sorted based on any of the main query params.
 	QueryAssetStats(ctx context.Context, in *AssetStatsQuery, opts ...grpc.CallOption) (*UniverseAssetStats, error)
+	// tapcli: `universe search`
+	// SearchAssets searches the assets of the issuance universes by their name
+	// and the keys and values of their revealed JSON meta data. The results can
+	// be filtered by asset type, group key and decimal display. Pagination is
+	// supported via the offset and limit params.
+	SearchAssets(ctx context.Context, in *SearchAssetsRequest, opts ...grpc.CallOption) (*SearchAssetsResponse, error)
 	// tapcli `universe stats events`
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
@@ -115,6 +140,18 @@ type UniverseClient interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(ctx context.Context, in *QueryFederationSyncConfigRequest, opts ...grpc.CallOption) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe snapshot export`
+	// ExportSnapshot writes every leaf of every known universe, along with the
+	// universe and multiverse roots, to a versioned, chunked and checksummed
+	// snapshot. The snapshot is streamed back in pieces, the last of which also
+	// carries a summary of the snapshot.
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (Universe_ExportSnapshotClient, error)
+	// tapcli: `universe snapshot import`
+	// ImportSnapshot reads a snapshot created by ExportSnapshot, which is streamed
+	// to the server in pieces. The leaves of each universe are only inserted once
+	// they match the universe root stored in the snapshot, and their proofs are
+	// verified in parallel.
+	ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportSnapshotClient, error)
 }
 
 type universeClient struct {
@@ -170,6 +207,15 @@ func (c *universeClient) AssetLeafKeys(ctx context.Context, in *AssetLeafKeysReq
 	return out, nil
 }
 
+func (c *universeClient) BranchNodes(ctx context.Context, in *BranchNodesRequest, opts ...grpc.CallOption) (*BranchNodesResponse, error) {
+	out := new(BranchNodesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/BranchNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error) {
 	out := new(AssetLeafResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeaves", in, out, opts...)
@@ -188,6 +234,38 @@ func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts .
 	return out, nil
 }
 
+func (c *universeClient) SubscribeUniverseLeaves(ctx context.Context, in *SubscribeUniverseLeavesRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseLeavesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[0], "/universerpc.Universe/SubscribeUniverseLeaves", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeUniverseLeavesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeUniverseLeavesClient interface {
+	Recv() (*UniverseLeafEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeUniverseLeavesClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeUniverseLeavesClient) Recv() (*UniverseLeafEvent, error) {
+	m := new(UniverseLeafEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/InsertProof", in, out, opts...)
@@ -215,6 +293,15 @@ func (c *universeClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *universeClient) SignedMultiverseRoots(ctx context.Context, in *SignedMultiverseRootsRequest, opts ...grpc.CallOption) (*SignedMultiverseRootsResponse, error) {
+	out := new(SignedMultiverseRootsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SignedMultiverseRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) SyncUniverse(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
 	out := new(SyncResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/SyncUniverse", in, out, opts...)
@@ -269,6 +356,15 @@ func (c *universeClient) QueryAssetStats(ctx context.Context, in *AssetStatsQuer
 	return out, nil
 }
 
+func (c *universeClient) SearchAssets(ctx context.Context, in *SearchAssetsRequest, opts ...grpc.CallOption) (*SearchAssetsResponse, error) {
+	out := new(SearchAssetsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SearchAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error) {
 	out := new(QueryEventsResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryEvents", in, out, opts...)
@@ -296,6 +392,72 @@ func (c *universeClient) QueryFederationSyncConfig(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *universeClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (Universe_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[1], "/universerpc.Universe/ExportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_ExportSnapshotClient interface {
+	Recv() (*ExportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type universeExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeExportSnapshotClient) Recv() (*ExportSnapshotResponse, error) {
+	m := new(ExportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[2], "/universerpc.Universe/ImportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeImportSnapshotClient{stream}
+	return x, nil
+}
+
+type Universe_ImportSnapshotClient interface {
+	Send(*ImportSnapshotRequest) error
+	CloseAndRecv() (*ImportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type universeImportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeImportSnapshotClient) Send(m *ImportSnapshotRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *universeImportSnapshotClient) CloseAndRecv() (*ImportSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UniverseServer is the server API for Universe service.
 // All implementations must embed UnimplementedUniverseServer
 // for forward compatibility
@@ -325,6 +487,12 @@ type UniverseServer interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error)
+	// BranchNodes queries for the nodes of the Universe MS-SMT of a given
+	// asset_id or group_key that are a number of levels below the branch
+	// identified by a key prefix. Comparing these nodes with the ones of another
+	// Universe allows finding the subtrees that differ without fetching the
+	// full set of Universe keys.
+	BranchNodes(context.Context, *BranchNodesRequest) (*BranchNodesResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -340,6 +508,12 @@ type UniverseServer interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error)
+	// SubscribeUniverseLeaves subscribes to the issuance and transfer leaves that
+	// are inserted into the local Universe, optionally filtered by asset_id,
+	// group_key or proof type. Each leaf is sent together with its proofs and a
+	// cursor that can be used to resume the subscription after a disconnect
+	// without missing any leaves.
+	SubscribeUniverseLeaves(*SubscribeUniverseLeavesRequest, Universe_SubscribeUniverseLeavesServer) error
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
@@ -354,6 +528,13 @@ type UniverseServer interface {
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	// tapcli: `universe signedroots`
+	// SignedMultiverseRoots returns the multiverse root snapshot signed by this
+	// Universe server for the current epoch, along with all signed snapshots of
+	// other federation members it has collected since the given epoch. Federation
+	// members exchange these snapshots to detect a server that equivocates by
+	// signing two conflicting multiverse roots for the same epoch.
+	SignedMultiverseRoots(context.Context, *SignedMultiverseRootsRequest) (*SignedMultiverseRootsResponse, error)
 	// tapcli: `universe sync`
 	// SyncUniverse takes host information for a remote Universe server, then
 	// attempts to synchronize either only the set of specified asset_ids, or all
@@ -386,6 +567,12 @@ type UniverseServer interface {
 	// asset type. Pagination is supported via the offset and limit params.
 	// Results can also be sorted based on any of the main query params.
 	QueryAssetStats(context.Context, *AssetStatsQuery) (*UniverseAssetStats, error)
+	// tapcli: `universe search`
+	// SearchAssets searches the assets of the issuance universes by their name
+	// and the keys and values of their revealed JSON meta data. The results can
+	// be filtered by asset type, group key and decimal display. Pagination is
+	// supported via the offset and limit params.
+	SearchAssets(context.Context, *SearchAssetsRequest) (*SearchAssetsResponse, error)
 	// tapcli `universe stats events`
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
@@ -397,6 +584,18 @@ type UniverseServer interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe snapshot export`
+	// ExportSnapshot writes every leaf of every known universe, along with the
+	// universe and multiverse roots, to a versioned, chunked and checksummed
+	// snapshot. The snapshot is streamed back in pieces, the last of which also
+	// carries a summary of the snapshot.
+	ExportSnapshot(*ExportSnapshotRequest, Universe_ExportSnapshotServer) error
+	// tapcli: `universe snapshot import`
+	// ImportSnapshot reads a snapshot created by ExportSnapshot, which is streamed
+	// to the server in pieces. The leaves of each universe are only inserted once
+	// they match the universe root stored in the snapshot, and their proofs are
+	// verified in parallel.
+	ImportSnapshot(Universe_ImportSnapshotServer) error
 	mustEmbedUnimplementedUniverseServer()
 }
 
@@ -419,12 +618,18 @@ func (UnimplementedUniverseServer) DeleteAssetRoot(context.Context, *DeleteRootQ
 func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeafKeys not implemented")
 }
+func (UnimplementedUniverseServer) BranchNodes(context.Context, *BranchNodesRequest) (*BranchNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BranchNodes not implemented")
+}
 func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeaves not implemented")
 }
 func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProof not implemented")
 }
+func (UnimplementedUniverseServer) SubscribeUniverseLeaves(*SubscribeUniverseLeavesRequest, Universe_SubscribeUniverseLeavesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeUniverseLeaves not implemented")
+}
 func (UnimplementedUniverseServer) InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertProof not implemented")
 }
@@ -434,6 +639,9 @@ func (UnimplementedUniverseServer) PushProof(context.Context, *PushProofRequest)
 func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
+func (UnimplementedUniverseServer) SignedMultiverseRoots(context.Context, *SignedMultiverseRootsRequest) (*SignedMultiverseRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedMultiverseRoots not implemented")
+}
 func (UnimplementedUniverseServer) SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncUniverse not implemented")
 }
@@ -452,6 +660,9 @@ func (UnimplementedUniverseServer) UniverseStats(context.Context, *StatsRequest)
 func (UnimplementedUniverseServer) QueryAssetStats(context.Context, *AssetStatsQuery) (*UniverseAssetStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetStats not implemented")
 }
+func (UnimplementedUniverseServer) SearchAssets(context.Context, *SearchAssetsRequest) (*SearchAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAssets not implemented")
+}
 func (UnimplementedUniverseServer) QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
 }
@@ -461,6 +672,12 @@ func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *Set
 func (UnimplementedUniverseServer) QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFederationSyncConfig not implemented")
 }
+func (UnimplementedUniverseServer) ExportSnapshot(*ExportSnapshotRequest, Universe_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedUniverseServer) ImportSnapshot(Universe_ImportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSnapshot not implemented")
+}
 func (UnimplementedUniverseServer) mustEmbedUnimplementedUniverseServer() {}
 
 // UnsafeUniverseServer may be embedded to opt out of forward compatibility for this service.
@@ -564,6 +781,24 @@ func _Universe_AssetLeafKeys_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_BranchNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).BranchNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/BranchNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).BranchNodes(ctx, req.(*BranchNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -600,6 +835,27 @@ func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SubscribeUniverseLeaves_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeUniverseLeavesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeUniverseLeaves(m, &universeSubscribeUniverseLeavesServer{stream})
+}
+
+type Universe_SubscribeUniverseLeavesServer interface {
+	Send(*UniverseLeafEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeUniverseLeavesServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeUniverseLeavesServer) Send(m *UniverseLeafEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AssetProof)
 	if err := dec(in); err != nil {
@@ -654,6 +910,24 @@ func _Universe_Info_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SignedMultiverseRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedMultiverseRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SignedMultiverseRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SignedMultiverseRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SignedMultiverseRoots(ctx, req.(*SignedMultiverseRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_SyncUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncRequest)
 	if err := dec(in); err != nil {
@@ -762,6 +1036,24 @@ func _Universe_QueryAssetStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SearchAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SearchAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SearchAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SearchAssets(ctx, req.(*SearchAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_QueryEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEventsRequest)
 	if err := dec(in); err != nil {
@@ -816,6 +1108,53 @@ func _Universe_QueryFederationSyncConfig_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).ExportSnapshot(m, &universeExportSnapshotServer{stream})
+}
+
+type Universe_ExportSnapshotServer interface {
+	Send(*ExportSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type universeExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeExportSnapshotServer) Send(m *ExportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_ImportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UniverseServer).ImportSnapshot(&universeImportSnapshotServer{stream})
+}
+
+type Universe_ImportSnapshotServer interface {
+	SendAndClose(*ImportSnapshotResponse) error
+	Recv() (*ImportSnapshotRequest, error)
+	grpc.ServerStream
+}
+
+type universeImportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeImportSnapshotServer) SendAndClose(m *ImportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *universeImportSnapshotServer) Recv() (*ImportSnapshotRequest, error) {
+	m := new(ImportSnapshotRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Universe_ServiceDesc is the grpc.ServiceDesc for Universe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -843,6 +1182,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssetLeafKeys",
 			Handler:    _Universe_AssetLeafKeys_Handler,
 		},
+		{
+			MethodName: "BranchNodes",
+			Handler:    _Universe_BranchNodes_Handler,
+		},
 		{
 			MethodName: "AssetLeaves",
 			Handler:    _Universe_AssetLeaves_Handler,
@@ -863,6 +1206,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _Universe_Info_Handler,
 		},
+		{
+			MethodName: "SignedMultiverseRoots",
+			Handler:    _Universe_SignedMultiverseRoots_Handler,
+		},
 		{
 			MethodName: "SyncUniverse",
 			Handler:    _Universe_SyncUniverse_Handler,
@@ -887,6 +1234,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAssetStats",
 			Handler:    _Universe_QueryAssetStats_Handler,
 		},
+		{
+			MethodName: "SearchAssets",
+			Handler:    _Universe_SearchAssets_Handler,
+		},
 		{
 			MethodName: "QueryEvents",
 			Handler:    _Universe_QueryEvents_Handler,
@@ -900,6 +1251,22 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeUniverseLeaves",
+			Handler:       _Universe_SubscribeUniverseLeaves_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _Universe_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSnapshot",
+			Handler:       _Universe_ImportSnapshot_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "universerpc/universe.proto",
 }
@@ -193,6 +193,48 @@ const (
 	DefaultCoinSelectType = ScriptTreesAllowed
 )
 
+// MultiCommitmentSelectStrategy is an enum that describes the strategy that
+// should be used when preferentially selecting multiple commitments. This type
+// is defined in the tapsend package to avoid a circular dependency with the
+// freighter package.
+type MultiCommitmentSelectStrategy uint8
+
+const (
+	// PreferMaxAmount is a strategy which considers commitments in order of
+	// descending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount.
+	PreferMaxAmount MultiCommitmentSelectStrategy = 0
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments over time.
+	PreferMinAmount MultiCommitmentSelectStrategy = 1
+
+	// ExactMatch is a strategy which runs a branch-and-bound search for a
+	// subset of commitments that sums to exactly the target amount, so no
+	// change output is required. If no such subset can be found, the
+	// PreferMaxAmount strategy is used instead.
+	ExactMatch MultiCommitmentSelectStrategy = 2
+)
+
+// String returns a human-readable representation of the strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "PreferMaxAmount"
+
+	case PreferMinAmount:
+		return "PreferMinAmount"
+
+	case ExactMatch:
+		return "ExactMatch"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", uint8(s))
+	}
+}
+
 // FundingDescriptor describes the information that is needed to select and
 // verify input assets in order to send to a specific recipient. It is a subset
 // of the information contained in a Taproot Asset address.
@@ -205,6 +247,10 @@ type FundingDescriptor struct {
 
 	// CoinSelectType specifies the type of coins that should be selected.
 	CoinSelectType CoinSelectType
+
+	// SelectStrategy specifies the strategy that should be used when
+	// selecting multiple coins.
+	SelectStrategy MultiCommitmentSelectStrategy
 }
 
 // TapCommitmentKey is the key that maps to the root commitment for the asset
@@ -12,7 +12,6 @@ import (
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
-	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/vm"
 )
@@ -71,11 +70,22 @@ func (v *WitnessValidatorV0) ValidateWitnesses(newAsset *asset.Asset,
 // tapscript.WitnessValidator interface.
 var _ tapscript.WitnessValidator = (*WitnessValidatorV0)(nil)
 
+// BlockInfoSource is the source of the block information that is needed to
+// validate proofs, such as a chain backend or the SPV header chain.
+type BlockInfoSource interface {
+	// CurrentHeight return the current height of the main chain.
+	CurrentHeight(context.Context) (uint32, error)
+
+	// GetBlockTimestamp returns the timestamp of the block at the given
+	// height.
+	GetBlockTimestamp(context.Context, uint32) int64
+}
+
 // ProofChainLookup is an implementation of the asset.ChainLookup interface
 // that uses a proof file to look up block height information of previous inputs
 // while validating proofs.
 type ProofChainLookup struct {
-	chainBridge tapgarden.ChainBridge
+	chainBridge BlockInfoSource
 
 	assetStore *tapdb.AssetStore
 
@@ -83,7 +93,7 @@ type ProofChainLookup struct {
 }
 
 // NewProofChainLookup creates a new ProofChainLookup instance.
-func NewProofChainLookup(chainBridge tapgarden.ChainBridge,
+func NewProofChainLookup(chainBridge BlockInfoSource,
 	assetStore *tapdb.AssetStore, proofFile *proof.File) *ProofChainLookup {
 
 	return &ProofChainLookup{
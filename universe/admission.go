@@ -0,0 +1,615 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultInsertInvoiceExpiry is the time after which an unpaid
+	// invoice for a proof insertion expires.
+	DefaultInsertInvoiceExpiry = time.Hour
+
+	// clientLimiterExpiry is the time after which the token bucket of a
+	// client that didn't insert any proofs is forgotten.
+	clientLimiterExpiry = 10 * time.Minute
+
+	// DefaultMaxPendingInsertInvoices is the maximum number of unpaid
+	// invoices for proof insertions that are kept at the same time. Once
+	// reached, no new invoices are created until some of them are paid
+	// or expire.
+	DefaultMaxPendingInsertInvoices = 10_000
+)
+
+// RejectReason is the reason a proof insertion was rejected by the
+// admission policy.
+type RejectReason uint8
+
+const (
+	// RejectRateLimited indicates that the client exceeded its rate of
+	// proof insertions.
+	RejectRateLimited RejectReason = iota
+
+	// RejectAmount indicates that the amount of the asset is below the
+	// minimum amount.
+	RejectAmount
+
+	// RejectFeeRate indicates that the fee rate of the anchor transaction
+	// couldn't be determined or is below the minimum fee rate.
+	RejectFeeRate
+
+	// RejectPaymentRequired indicates that the client needs to pay an
+	// invoice before the proof is inserted.
+	RejectPaymentRequired
+)
+
+// String returns a human-readable string for the reject reason.
+func (r RejectReason) String() string {
+	switch r {
+	case RejectRateLimited:
+		return "rate_limited"
+
+	case RejectAmount:
+		return "amount"
+
+	case RejectFeeRate:
+		return "fee_rate"
+
+	case RejectPaymentRequired:
+		return "payment_required"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(r))
+	}
+}
+
+// AllRejectReasons is the set of all reasons a proof insertion can be
+// rejected for.
+var AllRejectReasons = []RejectReason{
+	RejectRateLimited, RejectAmount, RejectFeeRate, RejectPaymentRequired,
+}
+
+// InsertRejectedError is returned if a proof insertion is rejected by the
+// admission policy.
+type InsertRejectedError struct {
+	// Reason is the reason the insertion was rejected.
+	Reason RejectReason
+
+	// Err describes why the insertion was rejected.
+	Err error
+}
+
+// Error returns the error message of the rejected insertion.
+func (e *InsertRejectedError) Error() string {
+	return fmt.Sprintf("proof insertion rejected: %v", e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *InsertRejectedError) Unwrap() error {
+	return e.Err
+}
+
+// PaymentRequiredError is returned if the client needs to pay an invoice
+// before its proof is inserted. Once paid, the insertion needs to be retried
+// with the preimage of the invoice.
+type PaymentRequiredError struct {
+	// Invoice is the BOLT 11 invoice that needs to be paid.
+	Invoice string
+
+	// PaymentHash is the payment hash of the invoice.
+	PaymentHash lntypes.Hash
+}
+
+// Error returns the error message of the required payment.
+func (e *PaymentRequiredError) Error() string {
+	return fmt.Sprintf("payment required, retry with the preimage of "+
+		"invoice %v", e.Invoice)
+}
+
+// AdmissionPolicy describes which proofs a public universe server accepts
+// through proof insertions. The zero value admits all proofs.
+type AdmissionPolicy struct {
+	// ClientRate is the number of proof insertions per second a single
+	// client is allowed to make. If this is zero, the insertions of a
+	// client aren't rate limited.
+	ClientRate rate.Limit
+
+	// ClientBurst is the number of proof insertions a single client is
+	// allowed to make at once.
+	ClientBurst int
+
+	// MinAnchorFeeRate is the minimum fee rate of the anchor transaction
+	// of an inserted proof. If this is non-zero, the client needs to
+	// provide the outputs spent by the anchor transaction, so its fee can
+	// be computed.
+	MinAnchorFeeRate chainfee.SatPerKWeight
+
+	// MinTransferAmount is the minimum amount of a transferred normal
+	// asset.
+	MinTransferAmount uint64
+
+	// MinIssuanceAmount is the minimum amount of an issued normal asset.
+	MinIssuanceAmount uint64
+
+	// InsertFee is the amount the client needs to pay for each proof
+	// insertion. If this is zero, no payment is required.
+	InsertFee lnwire.MilliSatoshi
+}
+
+// IsEmpty returns true if the policy admits all proofs.
+func (p *AdmissionPolicy) IsEmpty() bool {
+	return p.ClientRate == 0 && p.MinAnchorFeeRate == 0 &&
+		p.MinTransferAmount == 0 && p.MinIssuanceAmount == 0 &&
+		p.InsertFee == 0
+}
+
+// InsertRequest is a request to insert a proof into a universe that is
+// subject to the admission policy.
+type InsertRequest struct {
+	// ClientID identifies the client that inserts the proof, e.g. by its
+	// IP address or macaroon.
+	ClientID string
+
+	// ID is the ID of the universe the proof is inserted into.
+	ID Identifier
+
+	// Leaf is the leaf that contains the inserted proof.
+	Leaf *Leaf
+
+	// AnchorPrevOuts are the outputs spent by the anchor transaction of
+	// the proof, in the order of its inputs.
+	AnchorPrevOuts []*wire.TxOut
+
+	// Preimage is the preimage of a paid invoice for the insertion.
+	Preimage fn.Option[lntypes.Preimage]
+}
+
+// AdmissionConfig is the main config for the proof insertion admitter.
+type AdmissionConfig struct {
+	// Policy is the admission policy that's applied.
+	Policy AdmissionPolicy
+
+	// AddInvoice creates an invoice over the given amount that expires
+	// after the given duration, and returns its payment hash and payment
+	// request. This is only needed if the policy requires a payment.
+	AddInvoice func(ctx context.Context, amt lnwire.MilliSatoshi,
+		memo string, expiry time.Duration) (lntypes.Hash, string,
+		error)
+
+	// Clock is used to expire token buckets and invoices.
+	Clock clock.Clock
+}
+
+// insertInvoice is an unpaid invoice for a proof insertion.
+type insertInvoice struct {
+	// clientID is the ID of the client the invoice was created for.
+	clientID string
+
+	// invoice is the BOLT 11 payment request of the invoice.
+	invoice string
+
+	// expiry is the time the invoice expires.
+	expiry time.Time
+}
+
+// clientLimiter is the token bucket of a single client.
+type clientLimiter struct {
+	limiter *rate.Limiter
+
+	lastSeen time.Time
+}
+
+// InsertAdmitter decides whether proofs inserted by remote clients are
+// admitted into the local universes, to protect a public universe server
+// from spam.
+type InsertAdmitter struct {
+	cfg *AdmissionConfig
+
+	mu sync.Mutex
+
+	// clients maps the ID of a client to its token bucket.
+	clients map[string]*clientLimiter
+
+	// lastCleanup is the time the idle token buckets and expired invoices
+	// were last removed.
+	lastCleanup time.Time
+
+	// invoices maps the payment hash of an unused insertion invoice to the
+	// invoice.
+	invoices map[lntypes.Hash]*insertInvoice
+
+	// clientInvoices maps the ID of a client to the payment hash of its
+	// pending invoice, which is handed out again until it is used or
+	// expires, so a client can't make us create an invoice per request.
+	clientInvoices map[string]lntypes.Hash
+
+	// maxPendingInvoices is the maximum number of unused invoices that
+	// are kept at the same time.
+	maxPendingInvoices int
+
+	// rejected counts the rejected insertions by their reason.
+	rejected map[RejectReason]uint64
+}
+
+// NewInsertAdmitter creates a new proof insertion admitter with the given
+// config.
+func NewInsertAdmitter(cfg *AdmissionConfig) *InsertAdmitter {
+	return &InsertAdmitter{
+		cfg:                cfg,
+		clients:            make(map[string]*clientLimiter),
+		lastCleanup:        cfg.Clock.Now(),
+		invoices:           make(map[lntypes.Hash]*insertInvoice),
+		clientInvoices:     make(map[string]lntypes.Hash),
+		maxPendingInvoices: DefaultMaxPendingInsertInvoices,
+		rejected:           make(map[RejectReason]uint64),
+	}
+}
+
+// AdmitInsert returns an InsertRejectedError if the proof insertion isn't
+// admitted by the policy, or a PaymentRequiredError if the client needs to pay
+// for it first.
+func (a *InsertAdmitter) AdmitInsert(ctx context.Context,
+	req *InsertRequest) error {
+
+	policy := &a.cfg.Policy
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	// We first run the stateless checks, so neither the rate limit of the
+	// client is used up, nor an invoice is created for a proof that's
+	// rejected anyway.
+	if err := checkInsertAmount(policy, req); err != nil {
+		return a.reject(req, RejectAmount, err)
+	}
+
+	if policy.MinAnchorFeeRate != 0 {
+		err := checkAnchorFeeRate(policy.MinAnchorFeeRate, req)
+		if err != nil {
+			return a.reject(req, RejectFeeRate, err)
+		}
+	}
+
+	admitted, err := a.admitClient(req)
+	if err != nil || admitted {
+		return err
+	}
+
+	return a.requestPayment(ctx, req)
+}
+
+// admitClient applies the rate limit of the client and checks whether the
+// client paid for the insertion. It returns true if the insertion is admitted
+// without requesting a payment.
+func (a *InsertAdmitter) admitClient(req *InsertRequest) (bool, error) {
+	policy := &a.cfg.Policy
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.cfg.Clock.Now()
+	a.cleanup(now)
+
+	if policy.ClientRate != 0 {
+		limiter := a.clientLimiter(req, now)
+		if !limiter.AllowN(now, 1) {
+			return false, a.rejectLocked(
+				req, RejectRateLimited, fmt.Errorf("client "+
+					"exceeded rate of %v insertions per "+
+					"second", policy.ClientRate),
+			)
+		}
+	}
+
+	if policy.InsertFee == 0 {
+		return true, nil
+	}
+
+	// A paid invoice can only be used for a single insertion.
+	if req.Preimage.IsSome() {
+		hash := req.Preimage.UnwrapToPtr().Hash()
+		invoice, ok := a.invoices[hash]
+		if ok && now.Before(invoice.expiry) {
+			a.removeInvoice(hash)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// requestPayment returns a PaymentRequiredError with an invoice for the
+// insertion. A client that still has an unused invoice gets the same invoice
+// again, so invoices are only created at the rate at which they're paid or
+// expire.
+func (a *InsertAdmitter) requestPayment(ctx context.Context,
+	req *InsertRequest) error {
+
+	if a.cfg.AddInvoice == nil {
+		return fmt.Errorf("unable to create invoice for proof " +
+			"insertion")
+	}
+
+	a.mu.Lock()
+	if hash, ok := a.clientInvoices[req.ClientID]; ok {
+		invoice := a.invoices[hash]
+		if a.cfg.Clock.Now().Before(invoice.expiry) {
+			a.rejected[RejectPaymentRequired]++
+			a.mu.Unlock()
+
+			return &PaymentRequiredError{
+				Invoice:     invoice.invoice,
+				PaymentHash: hash,
+			}
+		}
+
+		a.removeInvoice(hash)
+	}
+
+	if len(a.invoices) >= a.maxPendingInvoices {
+		err := a.rejectLocked(req, RejectRateLimited, fmt.Errorf("too "+
+			"many pending invoices for proof insertions"))
+		a.mu.Unlock()
+
+		return err
+	}
+	a.mu.Unlock()
+
+	memo := "Proof insertion into universe server"
+	hash, invoice, err := a.cfg.AddInvoice(
+		ctx, a.cfg.Policy.InsertFee, memo, DefaultInsertInvoiceExpiry,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create invoice for proof "+
+			"insertion: %w", err)
+	}
+
+	a.mu.Lock()
+	a.invoices[hash] = &insertInvoice{
+		clientID: req.ClientID,
+		invoice:  invoice,
+		expiry:   a.cfg.Clock.Now().Add(DefaultInsertInvoiceExpiry),
+	}
+	a.clientInvoices[req.ClientID] = hash
+	a.rejected[RejectPaymentRequired]++
+	a.mu.Unlock()
+
+	log.Debugf("Requesting payment from client %v for proof insertion "+
+		"into universe %v", req.ClientID, req.ID.StringForLog())
+
+	return &PaymentRequiredError{
+		Invoice:     invoice,
+		PaymentHash: hash,
+	}
+}
+
+// removeInvoice removes the invoice with the given payment hash, and the
+// reference of its client to it.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (a *InsertAdmitter) removeInvoice(hash lntypes.Hash) {
+	invoice, ok := a.invoices[hash]
+	if !ok {
+		return
+	}
+	delete(a.invoices, hash)
+
+	if a.clientInvoices[invoice.clientID] == hash {
+		delete(a.clientInvoices, invoice.clientID)
+	}
+}
+
+// clientLimiter returns the token bucket of the client of the request.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (a *InsertAdmitter) clientLimiter(req *InsertRequest,
+	now time.Time) *rate.Limiter {
+
+	client, ok := a.clients[req.ClientID]
+	if !ok {
+		policy := &a.cfg.Policy
+		client = &clientLimiter{
+			limiter: rate.NewLimiter(
+				policy.ClientRate, policy.ClientBurst,
+			),
+		}
+		a.clients[req.ClientID] = client
+	}
+	client.lastSeen = now
+
+	return client.limiter
+}
+
+// cleanup removes the token buckets of idle clients and the expired
+// invoices.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (a *InsertAdmitter) cleanup(now time.Time) {
+	if now.Sub(a.lastCleanup) < clientLimiterExpiry {
+		return
+	}
+	a.lastCleanup = now
+
+	for clientID, client := range a.clients {
+		if now.Sub(client.lastSeen) >= clientLimiterExpiry {
+			delete(a.clients, clientID)
+		}
+	}
+
+	for hash, invoice := range a.invoices {
+		if !now.Before(invoice.expiry) {
+			a.removeInvoice(hash)
+		}
+	}
+}
+
+// reject counts the rejected insertion and returns the error for it.
+func (a *InsertAdmitter) reject(req *InsertRequest, reason RejectReason,
+	err error) error {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.rejectLocked(req, reason, err)
+}
+
+// rejectLocked counts the rejected insertion and returns the error for it.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (a *InsertAdmitter) rejectLocked(req *InsertRequest,
+	reason RejectReason, err error) error {
+
+	a.rejected[reason]++
+
+	log.Debugf("Rejected proof insertion of client %v into universe %v: "+
+		"%v", req.ClientID, req.ID.StringForLog(), err)
+
+	return &InsertRejectedError{
+		Reason: reason,
+		Err:    err,
+	}
+}
+
+// RejectedInserts returns the number of rejected proof insertions by their
+// reason.
+func (a *InsertAdmitter) RejectedInserts() map[RejectReason]uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rejected := make(map[RejectReason]uint64, len(a.rejected))
+	for reason, count := range a.rejected {
+		rejected[reason] = count
+	}
+
+	return rejected
+}
+
+// checkInsertAmount returns an error if the amount of the inserted asset is
+// below the minimum amount of the policy. Collectibles always have an amount
+// of one, so they aren't checked.
+func checkInsertAmount(policy *AdmissionPolicy, req *InsertRequest) error {
+	leafAsset := req.Leaf.Asset
+	if leafAsset.Type != asset.Normal {
+		return nil
+	}
+
+	minAmount := policy.MinTransferAmount
+	if req.ID.ProofType == ProofTypeIssuance {
+		minAmount = policy.MinIssuanceAmount
+	}
+
+	if leafAsset.Amount < minAmount {
+		return fmt.Errorf("asset amount %d is below the minimum of %d",
+			leafAsset.Amount, minAmount)
+	}
+
+	return nil
+}
+
+// checkAnchorFeeRate returns an error if the fee rate of the anchor
+// transaction of the inserted proof is below the given minimum fee rate.
+func checkAnchorFeeRate(minFeeRate chainfee.SatPerKWeight,
+	req *InsertRequest) error {
+
+	var leafProof proof.Proof
+	err := leafProof.Decode(bytes.NewReader(req.Leaf.RawProof))
+	if err != nil {
+		return fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	feeRate, err := AnchorFeeRate(&leafProof.AnchorTx, req.AnchorPrevOuts)
+	if err != nil {
+		return err
+	}
+
+	if feeRate < minFeeRate {
+		return fmt.Errorf("anchor transaction fee rate %v is below "+
+			"the minimum of %v", feeRate, minFeeRate)
+	}
+
+	return nil
+}
+
+// AnchorFeeRate returns the fee rate of the given anchor transaction, given
+// the outputs it spends. As the amounts of the spent outputs can't be looked
+// up, they're authenticated by verifying the witness of each input against
+// them. This only works for segwit inputs, as their signatures commit to the
+// amount they spend.
+func AnchorFeeRate(anchorTx *wire.MsgTx,
+	prevOuts []*wire.TxOut) (chainfee.SatPerKWeight, error) {
+
+	if len(prevOuts) != len(anchorTx.TxIn) {
+		return 0, fmt.Errorf("expected %d spent outputs of anchor "+
+			"transaction, got %d", len(anchorTx.TxIn),
+			len(prevOuts))
+	}
+
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	var totalIn int64
+	for idx, txIn := range anchorTx.TxIn {
+		prevOut := prevOuts[idx]
+		if prevOut == nil {
+			return 0, fmt.Errorf("missing spent output of input "+
+				"%d", idx)
+		}
+
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return 0, fmt.Errorf("input %d doesn't spend a segwit "+
+				"output", idx)
+		}
+
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, prevOut)
+		totalIn += prevOut.Value
+	}
+
+	sigHashes := txscript.NewTxSigHashes(anchorTx, prevOutFetcher)
+	for idx, prevOut := range prevOuts {
+		engine, err := txscript.NewEngine(
+			prevOut.PkScript, anchorTx, idx,
+			txscript.StandardVerifyFlags, nil, sigHashes,
+			prevOut.Value, prevOutFetcher,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to create script engine "+
+				"for input %d: %w", idx, err)
+		}
+
+		if err := engine.Execute(); err != nil {
+			return 0, fmt.Errorf("spent output of input %d "+
+				"doesn't match its witness: %w", idx, err)
+		}
+	}
+
+	var totalOut int64
+	for _, txOut := range anchorTx.TxOut {
+		totalOut += txOut.Value
+	}
+
+	if totalIn < totalOut {
+		return 0, errors.New("anchor transaction spends more than " +
+			"its inputs")
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(anchorTx))
+
+	return chainfee.NewSatPerKWeight(
+		btcutil.Amount(totalIn-totalOut), lntypes.WeightUnit(weight),
+	), nil
+}
@@ -0,0 +1,221 @@
+package universe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// signedAnchorTx returns a transaction that spends the given outputs, which
+// all pay to the BIP-0086 taproot key of the given private key, and is signed
+// by it.
+func signedAnchorTx(t *testing.T, privKey *btcec.PrivateKey,
+	prevOuts []*wire.TxOut, outValue int64) *wire.MsgTx {
+
+	tx := wire.NewMsgTx(2)
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for _, prevOut := range prevOuts {
+		outPoint := wire.OutPoint{
+			Hash:  chainhash.Hash(test.RandHash()),
+			Index: test.RandInt[uint32]() % 10,
+		}
+		tx.AddTxIn(wire.NewTxIn(&outPoint, nil, nil))
+		prevOutFetcher.AddPrevOut(outPoint, prevOut)
+	}
+	tx.AddTxOut(wire.NewTxOut(outValue, prevOuts[0].PkScript))
+
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+	for idx, prevOut := range prevOuts {
+		witness, err := txscript.TaprootWitnessSignature(
+			tx, sigHashes, idx, prevOut.Value, prevOut.PkScript,
+			txscript.SigHashDefault, privKey,
+		)
+		require.NoError(t, err)
+
+		tx.TxIn[idx].Witness = witness
+	}
+
+	return tx
+}
+
+// TestAnchorFeeRate tests that the fee rate of an anchor transaction is only
+// computed if the provided spent outputs match the witnesses of its inputs.
+func TestAnchorFeeRate(t *testing.T) {
+	t.Parallel()
+
+	privKey := test.RandPrivKey()
+	pkScript, err := txscript.PayToTaprootScript(
+		txscript.ComputeTaprootKeyNoScript(privKey.PubKey()),
+	)
+	require.NoError(t, err)
+
+	prevOuts := []*wire.TxOut{
+		wire.NewTxOut(60_000, pkScript),
+		wire.NewTxOut(50_000, pkScript),
+	}
+	anchorTx := signedAnchorTx(t, privKey, prevOuts, 100_000)
+
+	feeRate, err := AnchorFeeRate(anchorTx, prevOuts)
+	require.NoError(t, err)
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(anchorTx))
+	expectedFeeRate := chainfee.NewSatPerKWeight(
+		10_000, lntypes.WeightUnit(weight),
+	)
+	require.Equal(t, expectedFeeRate, feeRate)
+
+	// Claiming a higher value of a spent output makes the signature
+	// invalid.
+	inflatedPrevOuts := []*wire.TxOut{
+		wire.NewTxOut(70_000, pkScript),
+		wire.NewTxOut(50_000, pkScript),
+	}
+	_, err = AnchorFeeRate(anchorTx, inflatedPrevOuts)
+	require.ErrorContains(t, err, "doesn't match its witness")
+
+	// All spent outputs need to be provided.
+	_, err = AnchorFeeRate(anchorTx, prevOuts[:1])
+	require.ErrorContains(t, err, "expected 2 spent outputs")
+
+	// Outputs that aren't segwit outputs can't be authenticated.
+	p2pkhScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+		AddData(test.RandBytes(20)).AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	require.NoError(t, err)
+
+	_, err = AnchorFeeRate(anchorTx, []*wire.TxOut{
+		wire.NewTxOut(60_000, p2pkhScript), prevOuts[1],
+	})
+	require.ErrorContains(t, err, "doesn't spend a segwit output")
+}
+
+// TestInsertAdmitter tests that proof insertions are rejected by the
+// admission policy because of their amount, the rate of the client, and
+// missing payments.
+func TestInsertAdmitter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Now())
+
+	var invoices []lntypes.Preimage
+	admitter := NewInsertAdmitter(&AdmissionConfig{
+		Policy: AdmissionPolicy{
+			ClientRate:        rate.Every(time.Minute),
+			ClientBurst:       1,
+			MinTransferAmount: 10,
+			InsertFee:         lnwire.MilliSatoshi(1000),
+		},
+		AddInvoice: func(_ context.Context, _ lnwire.MilliSatoshi,
+			_ string, _ time.Duration) (lntypes.Hash, string,
+			error) {
+
+			preimage := lntypes.Preimage(test.RandHash())
+			invoices = append(invoices, preimage)
+
+			return preimage.Hash(), "lnbcrt1", nil
+		},
+		Clock: testClock,
+	})
+
+	newRequest := func(clientID string, amount uint64,
+		preimage fn.Option[lntypes.Preimage]) *InsertRequest {
+
+		return &InsertRequest{
+			ClientID: clientID,
+			ID: Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeTransfer,
+			},
+			Leaf: &Leaf{
+				Asset: &asset.Asset{
+					Genesis: asset.Genesis{
+						Type: asset.Normal,
+					},
+					Amount: amount,
+				},
+			},
+			Preimage: preimage,
+		}
+	}
+	noPreimage := fn.None[lntypes.Preimage]()
+
+	// Proofs of small amounts are rejected before the rate limit of the
+	// client is used up.
+	err := admitter.AdmitInsert(ctx, newRequest("alice", 5, noPreimage))
+	var rejectedErr *InsertRejectedError
+	require.ErrorAs(t, err, &rejectedErr)
+	require.Equal(t, RejectAmount, rejectedErr.Reason)
+
+	// Without a preimage, the client is asked to pay first.
+	err = admitter.AdmitInsert(ctx, newRequest("alice", 20, noPreimage))
+	var paymentErr *PaymentRequiredError
+	require.ErrorAs(t, err, &paymentErr)
+	require.Len(t, invoices, 1)
+	require.Equal(t, invoices[0].Hash(), paymentErr.PaymentHash)
+
+	// The next insertion of the same client is rate limited, even with a
+	// valid preimage.
+	paid := fn.Some(invoices[0])
+	err = admitter.AdmitInsert(ctx, newRequest("alice", 20, paid))
+	require.ErrorAs(t, err, &rejectedErr)
+	require.Equal(t, RejectRateLimited, rejectedErr.Reason)
+
+	// Other clients have their own rate limit, and the preimage of the
+	// paid invoice admits a single insertion.
+	err = admitter.AdmitInsert(ctx, newRequest("bob", 20, paid))
+	require.NoError(t, err)
+
+	testClock.SetTime(testClock.Now().Add(time.Minute))
+	err = admitter.AdmitInsert(ctx, newRequest("bob", 20, paid))
+	require.ErrorAs(t, err, &paymentErr)
+	require.Len(t, invoices, 2)
+
+	// Preimages of unknown or expired invoices aren't accepted. As long
+	// as the pending invoice of the client isn't used, it is handed out
+	// again instead of creating a new one.
+	testClock.SetTime(testClock.Now().Add(time.Minute))
+	unknown := fn.Some(lntypes.Preimage(test.RandHash()))
+	err = admitter.AdmitInsert(ctx, newRequest("bob", 20, unknown))
+	require.ErrorAs(t, err, &paymentErr)
+	require.Len(t, invoices, 2)
+	require.Equal(t, invoices[1].Hash(), paymentErr.PaymentHash)
+
+	testClock.SetTime(testClock.Now().Add(DefaultInsertInvoiceExpiry))
+	expired := fn.Some(invoices[1])
+	err = admitter.AdmitInsert(ctx, newRequest("bob", 20, expired))
+	require.ErrorAs(t, err, &paymentErr)
+	require.Len(t, invoices, 3)
+	require.Equal(t, invoices[2].Hash(), paymentErr.PaymentHash)
+
+	// Once the maximum number of pending invoices is reached, no new
+	// invoices are created for other clients.
+	admitter.maxPendingInvoices = 1
+	err = admitter.AdmitInsert(ctx, newRequest("carol", 20, noPreimage))
+	require.ErrorAs(t, err, &rejectedErr)
+	require.Equal(t, RejectRateLimited, rejectedErr.Reason)
+	require.Len(t, invoices, 3)
+
+	require.Equal(t, map[RejectReason]uint64{
+		RejectAmount:          1,
+		RejectRateLimited:     2,
+		RejectPaymentRequired: 4,
+	}, admitter.RejectedInserts())
+}
@@ -54,8 +54,24 @@ type FederationConfig struct {
 	StaticFederationMembers []string
 
 	// ServerChecker is a function that can be used to check if a server is
-	// operational and not the local daemon.
-	ServerChecker func(ServerAddr) error
+	// operational and not the local daemon. It returns the server address
+	// with the identity key the server authenticated with.
+	ServerChecker func(ServerAddr) (ServerAddr, error)
+
+	// LiveSync indicates whether we subscribe to the leaves that are
+	// inserted into the universes of the federation servers, to receive
+	// them as soon as they are inserted instead of only with the next
+	// periodic sync.
+	LiveSync bool
+
+	// NewRemoteLeafSubscriber is a function that returns a new leaf
+	// subscriber for the target remote Universe. This'll be used to live
+	// sync with the federation servers.
+	NewRemoteLeafSubscriber func(ServerAddr) (LeafSubscriber, error)
+
+	// BanThreshold is the reputation score below which a federation
+	// server is banned from syncing.
+	BanThreshold int32
 }
 
 // FederationPushReq is used to push out new updates to all or some members of
@@ -114,6 +130,14 @@ type FederationEnvoy struct {
 	// batchPushRequests is a channel that will be sent new requests to push
 	// out batch proof leaves to the federation.
 	batchPushRequests chan *FederationProofBatchPushReq
+
+	// liveSyncs holds the function to stop the live sync with each
+	// federation server we're live syncing with, keyed by the host of the
+	// server.
+	liveSyncs map[string]context.CancelFunc
+
+	// liveSyncMtx guards the liveSyncs map.
+	liveSyncMtx sync.Mutex
 }
 
 // A compile-time check to ensure that FederationEnvoy meets the
@@ -126,6 +150,7 @@ func NewFederationEnvoy(cfg FederationConfig) *FederationEnvoy {
 		cfg:               cfg,
 		pushRequests:      make(chan *FederationPushReq),
 		batchPushRequests: make(chan *FederationProofBatchPushReq),
+		liveSyncs:         make(map[string]context.CancelFunc),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -140,22 +165,23 @@ func (f *FederationEnvoy) Start() error {
 
 		// Before we start the main goroutine, we'll add the set of
 		// static Universe servers.
-		addrs := f.cfg.StaticFederationMembers
-		serverAddrs := fn.Map(addrs, NewServerAddrFromStr)
-
-		serverAddrs = fn.Filter(serverAddrs, func(a ServerAddr) bool {
+		var serverAddrs []ServerAddr
+		for _, host := range f.cfg.StaticFederationMembers {
 			// Before we add the server as a federation member, we
 			// check that we can actually connect to it and that it
 			// isn't ourselves.
-			if err := f.cfg.ServerChecker(a); err != nil {
+			addr, err := f.cfg.ServerChecker(
+				NewServerAddrFromStr(host),
+			)
+			if err != nil {
 				log.Warnf("Not adding server to federation: %v",
 					err)
 
-				return false
+				continue
 			}
 
-			return true
-		})
+			serverAddrs = append(serverAddrs, addr)
+		}
 
 		err := f.AddServer(serverAddrs...)
 		// On restart, we'll get an error for universe servers already
@@ -165,6 +191,14 @@ func (f *FederationEnvoy) Start() error {
 			log.Warnf("Unable to add universe servers: %v", err)
 		}
 
+		// Now that the static servers were added, we start live
+		// syncing with all servers of the federation.
+		fedServers, err := f.tryFetchServers()
+		if err != nil {
+			log.Warnf("Unable to fetch universe servers: %v", err)
+		}
+		f.updateLiveSyncs(fedServers, true)
+
 		f.Wg.Add(1)
 
 		go f.syncer()
@@ -463,6 +497,11 @@ func (f *FederationEnvoy) handleTickEvent() error {
 			"%w", err)
 	}
 
+	// Make sure we're live syncing with exactly the current set of
+	// federation servers, as servers might have been removed in the
+	// meantime.
+	f.updateLiveSyncs(fedServers, true)
+
 	log.Infof("Synchronizing with %v federation members", len(fedServers))
 	err = f.SyncServers(fedServers)
 	if err != nil {
@@ -715,6 +754,8 @@ func (f *FederationEnvoy) AddServer(addrs ...ServerAddr) error {
 		return err
 	}
 
+	f.updateLiveSyncs(addrs, false)
+
 	return f.SyncServers(addrs)
 }
 
@@ -749,7 +790,11 @@ func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 
 	syncServer := func(ctx context.Context, serverAddr ServerAddr) error {
 		err := f.syncServerState(ctx, serverAddr, *syncConfigs)
-		if err != nil {
+		switch {
+		case errors.Is(err, ErrServerBanned):
+			log.Debugf("Not syncing with banned server: %v", err)
+
+		case err != nil:
 			log.Warnf("encountered an error whilst syncing with "+
 				"server=%v: %v", spew.Sdump(serverAddr), err)
 		}
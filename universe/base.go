@@ -48,6 +48,10 @@ type ArchiveConfig struct {
 	// lookup interface that is required to validate proofs.
 	ChainLookupGenerator proof.ChainLookupGenerator
 
+	// Retention is used to skip leaves that the universe retention policy
+	// would prune right away. If this is nil, all leaves are inserted.
+	Retention RetentionChecker
+
 	// TODO(roasbeef): query re genesis asset known?
 
 	// TODO(roasbeef): load all at once, or lazy load dynamic?
@@ -67,14 +71,19 @@ type Archive struct {
 	// instances for the archive.
 	baseUniverses map[Identifier]BaseBackend
 
+	// leafSubscribers is notified each time new leaves are inserted into
+	// a universe tree.
+	leafSubscribers *fn.EventDistributor[Identifier]
+
 	sync.RWMutex
 }
 
 // NewArchive creates a new universe archive based on the passed config.
 func NewArchive(cfg ArchiveConfig) *Archive {
 	a := &Archive{
-		cfg:           cfg,
-		baseUniverses: make(map[Identifier]BaseBackend),
+		cfg:             cfg,
+		baseUniverses:   make(map[Identifier]BaseBackend),
+		leafSubscribers: fn.NewEventDistributor[Identifier](),
 	}
 
 	return a
@@ -218,6 +227,13 @@ func (a *Archive) MultiverseRoot(ctx context.Context, proofType ProofType,
 	return fn.Some(multiverseRoot), nil
 }
 
+// retainsLeaf returns true if the retention policy keeps the leaf of the given
+// universe with a proof anchored in the block with the given height.
+func (a *Archive) retainsLeaf(id Identifier, blockHeight uint32) bool {
+	return a.cfg.Retention == nil ||
+		a.cfg.Retention.RetainsLeaf(id, blockHeight)
+}
+
 // UpsertProofLeaf attempts to upsert a proof for an asset issuance or transfer
 // event. This method will return an error if the passed proof is invalid. If
 // the leaf is already known, then no action is taken and the existing
@@ -251,6 +267,15 @@ func (a *Archive) UpsertProofLeaf(ctx context.Context, id Identifier,
 		return nil, err
 	}
 
+	// A leaf that the retention policy would prune right away isn't
+	// inserted, otherwise pruned leaves would come back with the next
+	// sync or push.
+	if !a.retainsLeaf(id, newProof.BlockHeight) {
+		return nil, fmt.Errorf("%w: id=%v, block_height=%d",
+			ErrLeafNotRetained, id.StringForLog(),
+			newProof.BlockHeight)
+	}
+
 	// We'll first check to see if we already know of this leaf within the
 	// multiverse. If so, then we'll return the existing issuance proof.
 	issuanceProofs, err := a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
@@ -317,6 +342,9 @@ func (a *Archive) UpsertProofLeaf(ctx context.Context, id Identifier,
 			"issuance: %w", err)
 	}
 
+	// Let any leaf subscribers know that a new leaf is available.
+	a.leafSubscribers.NotifySubscribers(id)
+
 	// Log a sync event for the newly inserted leaf in the background as an
 	// async goroutine.
 	go func() {
@@ -353,8 +381,8 @@ func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
 		if key.ScriptKey != nil {
 			skBytes = key.ScriptKey.PubKey.SerializeCompressed()
 		}
-		return nil, fmt.Errorf("unable to verify proof (%v, "+
-			"outpoint=%v, scriptKey=%x): %w", id.StringForLog(),
+		return nil, fmt.Errorf("%w (%v, outpoint=%v, scriptKey=%x): "+
+			"%w", ErrInvalidProof, id.StringForLog(),
 			key.OutPoint.String(), skBytes, err)
 	}
 
@@ -369,19 +397,21 @@ func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
 		schnorr.SerializePubKey(id.GroupKey),
 		schnorr.SerializePubKey(&newAsset.GroupKey.GroupPubKey),
 	):
-		return nil, fmt.Errorf("group key mismatch: expected %x, "+
-			"got %x", id.GroupKey.SerializeCompressed(),
+		return nil, fmt.Errorf("%w: group key mismatch: expected "+
+			"%x, got %x", ErrInvalidProof,
+			id.GroupKey.SerializeCompressed(),
 			newAsset.GroupKey.GroupPubKey.SerializeCompressed())
 
 	// If the group key is nil, then the asset ID should match.
 	case id.GroupKey == nil && id.AssetID != newAsset.ID():
-		return nil, fmt.Errorf("asset id mismatch: expected %v, got %v",
-			id.AssetID, newAsset.ID())
+		return nil, fmt.Errorf("%w: asset id mismatch: expected %v, "+
+			"got %v", ErrInvalidProof, id.AssetID, newAsset.ID())
 
 	// The script key should also match exactly.
 	case !newAsset.ScriptKey.PubKey.IsEqual(key.ScriptKey.PubKey):
-		return nil, fmt.Errorf("script key mismatch: expected %v, got "+
-			"%v", key.ScriptKey.PubKey.SerializeCompressed(),
+		return nil, fmt.Errorf("%w: script key mismatch: expected "+
+			"%v, got %v", ErrInvalidProof,
+			key.ScriptKey.PubKey.SerializeCompressed(),
 			newAsset.ScriptKey.PubKey.SerializeCompressed())
 	}
 
@@ -446,6 +476,15 @@ func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 			return fmt.Errorf("unable to decode proof: %w", err)
 		}
 
+		// Leaves that the retention policy would prune right away are
+		// skipped.
+		if !a.retainsLeaf(item.ID, assetProof.BlockHeight) {
+			log.Debugf("Skipping leaf of universe %v not retained "+
+				"by retention policy: block_height=%d",
+				item.ID.StringForLog(), assetProof.BlockHeight)
+			continue
+		}
+
 		assetProofs[item.Key] = &assetProof
 
 		// Any group anchor issuance proof must have a group key reveal
@@ -528,11 +567,18 @@ func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 			err)
 	}
 
-	// Log a sync event for the newly inserted leaf in the background as an
-	// async goroutine.
-	ids := fn.Map(items, func(item *Item) Identifier {
+	// Let any leaf subscribers know that new leaves are available. Leaves
+	// that weren't retained were skipped above.
+	insertedItems := append(anchorItems, nonAnchorItems...)
+	ids := fn.Map(insertedItems, func(item *Item) Identifier {
 		return item.ID
 	})
+	if len(ids) > 0 {
+		a.leafSubscribers.NotifySubscribers(ids...)
+	}
+
+	// Log a sync event for the newly inserted leaf in the background as an
+	// async goroutine.
 	go func() {
 		err := a.cfg.UniverseStats.LogNewProofEvents(
 			context.Background(), ids...,
@@ -657,6 +703,130 @@ type UniverseLeafKeysQuery struct {
 	SortDirection SortDirection
 	Offset        int32
 	Limit         int32
+
+	// KeyPrefix is an optional prefix of the universe keys (the keys of
+	// the leaves in the universe tree) that the returned leaf keys are
+	// restricted to.
+	KeyPrefix []byte
+}
+
+// BranchNodesQuery is a query for the nodes of a universe tree that are a
+// number of levels below the branch identified by a key prefix.
+type BranchNodesQuery struct {
+	// Id is the identifier of the universe tree.
+	Id Identifier
+
+	// KeyPrefix is the prefix of the universe keys that identifies the
+	// branch. An empty prefix identifies the root of the tree.
+	KeyPrefix []byte
+
+	// Depth is the number of levels below the branch that the nodes are
+	// returned for. With a depth of 8, the node at index i of the result
+	// is the root of the subtree of all keys that continue the key prefix
+	// with a byte of value i.
+	Depth int
+}
+
+// Validate returns an error if the query is invalid.
+func (q BranchNodesQuery) Validate() error {
+	prefixLen := len(q.KeyPrefix) * 8
+	switch {
+	case q.Depth < 0 || q.Depth > MaxBranchNodesDepth:
+		return fmt.Errorf("invalid depth %d, must be between 0 and %d",
+			q.Depth, MaxBranchNodesDepth)
+
+	case prefixLen+q.Depth > mssmt.MaxTreeLevels:
+		return fmt.Errorf("depth %d below key prefix of %d bytes "+
+			"exceeds tree height", q.Depth, len(q.KeyPrefix))
+	}
+
+	return nil
+}
+
+// BranchNodes returns the nodes of a universe tree that are a number of
+// levels below the branch identified by a key prefix.
+func (a *Archive) BranchNodes(ctx context.Context,
+	q BranchNodesQuery) ([]mssmt.Node, error) {
+
+	log.Tracef("Retrieving branch nodes for Universe: id=%v, "+
+		"prefix=%x, depth=%v", q.Id.StringForLog(), q.KeyPrefix,
+		q.Depth)
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	return a.cfg.Multiverse.BranchNodes(ctx, q)
+}
+
+// LeafEvent describes a leaf that was inserted into a universe tree.
+type LeafEvent struct {
+	// Cursor identifies the position of the leaf in the sequence of all
+	// inserted leaves. Cursors are strictly increasing, so a subscriber
+	// can resume from the cursor of the last leaf it has seen.
+	Cursor uint64
+
+	// ID is the identifier of the universe tree the leaf was inserted
+	// into.
+	ID Identifier
+
+	// Key is the key of the inserted leaf.
+	Key LeafKey
+}
+
+// LeafEventsQuery is a query for the leaves that were inserted after a given
+// cursor.
+type LeafEventsQuery struct {
+	// AfterCursor is the cursor of the last leaf that is already known.
+	// Only leaves with a greater cursor are returned.
+	AfterCursor uint64
+
+	// AssetID, if set, only matches leaves of the asset with this ID.
+	AssetID *asset.ID
+
+	// GroupKey, if set, only matches leaves of the universe of this asset
+	// group.
+	GroupKey *btcec.PublicKey
+
+	// ProofType, if specified, only matches leaves of this proof type.
+	ProofType ProofType
+
+	// Limit is the maximum number of leaves to return.
+	Limit int32
+}
+
+// FetchLeafEvents returns the leaves that were inserted after the cursor of
+// the given query, ordered by their cursor.
+func (a *Archive) FetchLeafEvents(ctx context.Context,
+	q LeafEventsQuery) ([]LeafEvent, error) {
+
+	log.Tracef("Retrieving leaf events after cursor=%v", q.AfterCursor)
+
+	return a.cfg.Multiverse.FetchLeafEvents(ctx, q)
+}
+
+// LatestLeafCursor returns the cursor of the most recently inserted leaf, or
+// zero if no leaves were inserted yet.
+func (a *Archive) LatestLeafCursor(ctx context.Context) (uint64, error) {
+	return a.cfg.Multiverse.LatestLeafCursor(ctx)
+}
+
+// RegisterLeafSubscriber adds a new subscriber that is notified with the
+// universe identifier each time new leaves are inserted into a universe tree.
+// The notification only signals that new leaves are available, the leaves
+// themselves should be fetched with FetchLeafEvents.
+func (a *Archive) RegisterLeafSubscriber(
+	receiver *fn.EventReceiver[Identifier]) {
+
+	a.leafSubscribers.RegisterSubscriber(receiver)
+}
+
+// RemoveLeafSubscriber removes the given leaf subscriber and stops it from
+// processing events.
+func (a *Archive) RemoveLeafSubscriber(
+	receiver *fn.EventReceiver[Identifier]) error {
+
+	return a.leafSubscribers.RemoveSubscriber(receiver)
 }
 
 // UniverseLeafKeys returns the set of leaf keys known for the specified
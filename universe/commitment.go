@@ -0,0 +1,307 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// ErrNoCommitment is returned when there is no chain commitment that
+	// commits to the requested universe root.
+	ErrNoCommitment = errors.New("no universe commitment found")
+
+	// ErrInvalidCommitment is returned when a chain commitment fails
+	// verification.
+	ErrInvalidCommitment = errors.New("invalid universe commitment")
+)
+
+// CommitmentTipVerifier verifies that the commitment output with the given
+// outpoint and pk script isn't spent in the main chain, which means that the
+// commitment is the tip of its chain. Clients implement this with their own
+// view of the chain, as the universe server can't be trusted to tell.
+type CommitmentTipVerifier func(outPoint wire.OutPoint, pkScript []byte) error
+
+// NewCommitmentRoot returns the root that is committed to in the chain for the
+// given issuance and transfer multiverse roots.
+func NewCommitmentRoot(issuanceRoot, transferRoot mssmt.Node) mssmt.Node {
+	return mssmt.NewBranch(issuanceRoot, transferRoot)
+}
+
+// CommitmentPkScript returns the pk script of the taproot output that commits
+// to the given root. The output key is the internal key tweaked with the hash
+// of the root, so the output can still be spent with the internal key.
+func CommitmentPkScript(internalKey *btcec.PublicKey,
+	root mssmt.Node) ([]byte, error) {
+
+	rootHash := root.NodeHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, rootHash[:])
+
+	return txscript.PayToTaprootScript(outputKey)
+}
+
+// multiverseLeafNode returns the leaf of the multiverse tree for the universe
+// with the given ID and root.
+func multiverseLeafNode(id Identifier, root mssmt.Node) *mssmt.LeafNode {
+	rootHash := root.NodeHash()
+
+	// The leaves of the issuance multiverse tree all have a sum of one, so
+	// the root sum is the number of asset universes.
+	sum := root.NodeSum()
+	if id.ProofType == ProofTypeIssuance {
+		sum = 1
+	}
+
+	return mssmt.NewLeafNode(rootHash[:], sum)
+}
+
+// Verify verifies that the commitment is anchored in a block that is part of
+// the chain, that the anchor output commits to the universe root, and that the
+// commitment is the unspent tip of the commitment chain that starts at the
+// given genesis outpoint. A newer commitment might have confirmed since the
+// commitment was fetched, in which case the tip verifier fails and the caller
+// should fetch the commitment again.
+func (c *Commitment) Verify(headerVerifier proof.HeaderVerifier,
+	genesis wire.OutPoint, tipVerifier CommitmentTipVerifier) error {
+
+	if !c.Confirmed() {
+		return fmt.Errorf("%w: commitment isn't confirmed",
+			ErrInvalidCommitment)
+	}
+
+	if c.GenesisOutPoint != genesis {
+		return fmt.Errorf("%w: commitment belongs to chain with "+
+			"genesis %v, expected %v", ErrInvalidCommitment,
+			c.GenesisOutPoint, genesis)
+	}
+
+	root := NewCommitmentRoot(c.IssuanceRoot, c.TransferRoot)
+	if !mssmt.IsEqualNode(root, c.UniverseRoot) {
+		return fmt.Errorf("%w: universe root doesn't match multiverse "+
+			"roots", ErrInvalidCommitment)
+	}
+
+	if int(c.OutputIndex) >= len(c.AnchorTx.TxOut) {
+		return fmt.Errorf("%w: output index %d out of range",
+			ErrInvalidCommitment, c.OutputIndex)
+	}
+
+	pkScript, err := CommitmentPkScript(c.InternalKey.PubKey, root)
+	if err != nil {
+		return err
+	}
+
+	anchorOut := c.AnchorTx.TxOut[c.OutputIndex]
+	if !bytes.Equal(anchorOut.PkScript, pkScript) {
+		return fmt.Errorf("%w: anchor output doesn't commit to the "+
+			"universe root", ErrInvalidCommitment)
+	}
+
+	if !c.MerkleProof.Verify(c.AnchorTx, c.BlockHeader.MerkleRoot) {
+		return fmt.Errorf("%w: invalid merkle proof for anchor tx",
+			ErrInvalidCommitment)
+	}
+
+	err = headerVerifier(c.BlockHeader, c.BlockHeight)
+	if err != nil {
+		return err
+	}
+
+	// Each output of the chain can only be spent once, so if the
+	// commitment output is unspent, there's no newer commitment the
+	// server could show to others.
+	if err := tipVerifier(c.OutPoint(), pkScript); err != nil {
+		return fmt.Errorf("%w: commitment isn't the tip of its "+
+			"chain: %w", ErrInvalidCommitment, err)
+	}
+
+	return nil
+}
+
+// VerifyLink verifies that the commitment directly follows the given
+// commitment in the same chain, which means that its anchor transaction
+// spends the output of the given commitment. This lets a client that knows a
+// previous commitment of the chain check that a newer one descends from it.
+func (c *Commitment) VerifyLink(prev *Commitment) error {
+	if c.GenesisOutPoint != prev.GenesisOutPoint {
+		return fmt.Errorf("%w: commitments belong to different chains",
+			ErrInvalidCommitment)
+	}
+
+	prevOutPoint := c.PrevOutPoint().UnwrapOr(wire.OutPoint{})
+	if prevOutPoint != prev.OutPoint() {
+		return fmt.Errorf("%w: commitment doesn't spend the previous "+
+			"commitment output %v", ErrInvalidCommitment,
+			prev.OutPoint())
+	}
+
+	return nil
+}
+
+// Verify verifies that the universe proof is valid and that its universe root
+// is included in the multiverse root that was committed to by the tip of the
+// commitment chain with the given genesis outpoint.
+func (c *CommittedIssuanceProof) Verify(id Identifier,
+	headerVerifier proof.HeaderVerifier, genesis wire.OutPoint,
+	tipVerifier CommitmentTipVerifier) error {
+
+	err := c.ChainProof.Verify(headerVerifier, genesis, tipVerifier)
+	if err != nil {
+		return err
+	}
+
+	leafProof := c.TaprootAssetProof
+	if !leafProof.VerifyRoot(leafProof.UniverseRoot) {
+		return fmt.Errorf("%w: universe inclusion proof doesn't match "+
+			"universe root", ErrInvalidCommitment)
+	}
+
+	var multiverseRoot mssmt.Node
+	switch id.ProofType {
+	case ProofTypeIssuance:
+		multiverseRoot = c.ChainProof.IssuanceRoot
+
+	case ProofTypeTransfer:
+		multiverseRoot = c.ChainProof.TransferRoot
+
+	default:
+		return fmt.Errorf("unknown proof type: %v", id.ProofType)
+	}
+
+	leaf := multiverseLeafNode(id, leafProof.UniverseRoot)
+	if !mssmt.VerifyMerkleProof(
+		id.Bytes(), leaf, c.MultiverseInclusionProof, multiverseRoot,
+	) {
+
+		return fmt.Errorf("%w: universe root isn't included in the "+
+			"committed multiverse root", ErrInvalidCommitment)
+	}
+
+	return nil
+}
+
+// MultiverseSnapshot is an in-memory copy of the issuance and transfer
+// multiverse trees, built from the multiverse leaves at a point in time.
+type MultiverseSnapshot struct {
+	trees map[ProofType]mssmt.Tree
+}
+
+// NewMultiverseSnapshot builds the multiverse trees from the given leaves.
+func NewMultiverseSnapshot(ctx context.Context,
+	leaves []MultiverseLeaf) (*MultiverseSnapshot, error) {
+
+	trees := map[ProofType]mssmt.Tree{
+		ProofTypeIssuance: mssmt.NewCompactedTree(
+			mssmt.NewDefaultStore(),
+		),
+		ProofTypeTransfer: mssmt.NewCompactedTree(
+			mssmt.NewDefaultStore(),
+		),
+	}
+
+	for _, leaf := range leaves {
+		tree, ok := trees[leaf.ID.ProofType]
+		if !ok {
+			return nil, fmt.Errorf("unknown proof type: %v",
+				leaf.ID.ProofType)
+		}
+
+		_, err := tree.Insert(ctx, leaf.ID.Bytes(), leaf.LeafNode)
+		if err != nil {
+			return nil, fmt.Errorf("unable to insert multiverse "+
+				"leaf: %w", err)
+		}
+	}
+
+	return &MultiverseSnapshot{
+		trees: trees,
+	}, nil
+}
+
+// FetchMultiverseLeaves returns all issuance and transfer leaves of the given
+// multiverse.
+func FetchMultiverseLeaves(ctx context.Context,
+	multiverse MultiverseArchive) ([]MultiverseLeaf, error) {
+
+	var leaves []MultiverseLeaf
+	for _, proofType := range []ProofType{
+		ProofTypeIssuance, ProofTypeTransfer,
+	} {
+
+		typeLeaves, err := multiverse.FetchLeaves(ctx, nil, proofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch %v multiverse "+
+				"leaves: %w", proofType, err)
+		}
+
+		leaves = append(leaves, typeLeaves...)
+	}
+
+	return leaves, nil
+}
+
+// MultiverseRoots returns the roots of the issuance and transfer multiverse
+// trees formed by the given leaves.
+func MultiverseRoots(ctx context.Context,
+	leaves []MultiverseLeaf) (mssmt.Node, mssmt.Node, error) {
+
+	snapshot, err := NewMultiverseSnapshot(ctx, leaves)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issuanceRoot, err := snapshot.Root(ctx, ProofTypeIssuance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transferRoot, err := snapshot.Root(ctx, ProofTypeTransfer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return issuanceRoot, transferRoot, nil
+}
+
+// Root returns the root of the multiverse tree of the given proof type.
+func (s *MultiverseSnapshot) Root(ctx context.Context,
+	proofType ProofType) (mssmt.Node, error) {
+
+	tree, ok := s.trees[proofType]
+	if !ok {
+		return nil, fmt.Errorf("unknown proof type: %v", proofType)
+	}
+
+	return tree.Root(ctx)
+}
+
+// Proof returns the inclusion proof of the universe with the given ID in the
+// multiverse tree of its proof type, if the universe root in the snapshot
+// matches the given root. Otherwise, ErrNoCommitment is returned.
+func (s *MultiverseSnapshot) Proof(ctx context.Context, id Identifier,
+	universeRoot mssmt.Node) (*mssmt.Proof, error) {
+
+	tree, ok := s.trees[id.ProofType]
+	if !ok {
+		return nil, fmt.Errorf("unknown proof type: %v", id.ProofType)
+	}
+
+	leaf, err := tree.Get(ctx, id.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	expectedLeaf := multiverseLeafNode(id, universeRoot)
+	if leaf.IsEmpty() || !mssmt.IsEqualNode(leaf, expectedLeaf) {
+		return nil, ErrNoCommitment
+	}
+
+	return tree.MerkleProof(ctx, id.Bytes())
+}
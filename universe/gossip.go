@@ -0,0 +1,531 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// SignedRootEpochDuration is the duration of an epoch of signed
+	// multiverse roots. A universe server only ever signs a single
+	// snapshot of its multiverse roots per epoch, so two different signed
+	// roots of the same server for the same epoch prove that it showed
+	// different states to different parties.
+	SignedRootEpochDuration = time.Hour
+
+	// EquivocationPenalty is the amount the reputation score of a universe
+	// server is lowered by if it equivocated, which bans it right away.
+	EquivocationPenalty = MaxReputationScore
+)
+
+var (
+	// signedRootTag is the domain separation tag that is prepended to the
+	// multiverse roots before they're signed.
+	signedRootTag = []byte("taproot-assets/universe/signed-root")
+
+	// ErrNoSignedRoot is returned when no signed multiverse root is known
+	// for a universe server and epoch.
+	ErrNoSignedRoot = errors.New("no signed multiverse root found")
+
+	// ErrInvalidRootSig is returned when the signature of a signed
+	// multiverse root isn't valid for the key of its universe server.
+	ErrInvalidRootSig = errors.New("invalid multiverse root signature")
+)
+
+// SignedRootEpoch returns the epoch of signed multiverse roots the given time
+// falls into.
+func SignedRootEpoch(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(SignedRootEpochDuration/time.Second))
+}
+
+// SignedRootMsg returns the message a universe server signs with its node key
+// to sign a snapshot of its multiverse roots for the given epoch.
+func SignedRootMsg(epoch uint64, issuanceRoot,
+	transferRoot mssmt.Node) []byte {
+
+	var b bytes.Buffer
+	b.Write(signedRootTag)
+	_ = binary.Write(&b, binary.BigEndian, epoch)
+
+	for _, root := range []mssmt.Node{issuanceRoot, transferRoot} {
+		rootHash := root.NodeHash()
+		b.Write(rootHash[:])
+		_ = binary.Write(&b, binary.BigEndian, root.NodeSum())
+	}
+
+	return b.Bytes()
+}
+
+// SignedMultiverseRoot is a snapshot of the issuance and transfer multiverse
+// roots of a universe server for an epoch, signed with the node key of the
+// server.
+type SignedMultiverseRoot struct {
+	// ServerKey is the node key of the universe server that signed the
+	// roots.
+	ServerKey *btcec.PublicKey
+
+	// Epoch is the epoch the snapshot was taken in.
+	Epoch uint64
+
+	// IssuanceRoot is the root of the issuance multiverse tree.
+	IssuanceRoot mssmt.Node
+
+	// TransferRoot is the root of the transfer multiverse tree.
+	TransferRoot mssmt.Node
+
+	// Signature is the signature of the server key over the message
+	// returned by SignedRootMsg.
+	Signature []byte
+}
+
+// Verify returns ErrInvalidRootSig if the signature of the root wasn't created
+// by the key of its universe server.
+func (r *SignedMultiverseRoot) Verify() error {
+	msg := SignedRootMsg(r.Epoch, r.IssuanceRoot, r.TransferRoot)
+	valid, err := verifyNodeSig(r.ServerKey, msg, r.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidRootSig, err)
+	}
+
+	if !valid {
+		return ErrInvalidRootSig
+	}
+
+	return nil
+}
+
+// Conflicts returns true if both roots were signed by the same universe server
+// for the same epoch, but differ in their multiverse roots.
+func (r *SignedMultiverseRoot) Conflicts(other *SignedMultiverseRoot) bool {
+	if !r.ServerKey.IsEqual(other.ServerKey) || r.Epoch != other.Epoch {
+		return false
+	}
+
+	return !mssmt.IsEqualNode(r.IssuanceRoot, other.IssuanceRoot) ||
+		!mssmt.IsEqualNode(r.TransferRoot, other.TransferRoot)
+}
+
+// Equivocation is the evidence of a universe server equivocating, which are two
+// conflicting multiverse roots it signed for the same epoch.
+type Equivocation struct {
+	// First is the signed root that was known first.
+	First SignedMultiverseRoot
+
+	// Second is the signed root that conflicts with the first one.
+	Second SignedMultiverseRoot
+
+	// Reporter is the host of the universe server the second root was
+	// received from.
+	Reporter string
+
+	// DetectedAt is the time the equivocation was detected.
+	DetectedAt time.Time
+}
+
+// RootGossiperConfig is the main config for the root gossiper.
+type RootGossiperConfig struct {
+	// Multiverse is the local multiverse whose roots are signed.
+	Multiverse MultiverseArchive
+
+	// GossipLog is used to store the signed roots and the evidence of
+	// equivocations.
+	GossipLog RootGossipLog
+
+	// FederationDB is used to look up the federation servers and their
+	// identity keys, and to lower the reputation of servers that
+	// equivocated.
+	FederationDB FederationDB
+
+	// NodeKey is the node key of the local universe server.
+	NodeKey *btcec.PublicKey
+
+	// SignMessage signs the given message with the node key of the local
+	// universe server.
+	SignMessage func(ctx context.Context, msg []byte) ([]byte, error)
+
+	// NewSignedRootFetcher is a function that returns a new signed root
+	// fetcher for the target remote Universe.
+	NewSignedRootFetcher func(ServerAddr) (SignedRootFetcher, error)
+
+	// GossipInterval is the interval at which the signed roots are
+	// exchanged with the federation servers. If this is zero, signed
+	// roots are only served, but never fetched.
+	GossipInterval time.Duration
+
+	// Clock is used to determine the current epoch.
+	Clock clock.Clock
+}
+
+// RootGossiper exchanges signed snapshots of the multiverse roots with the
+// federation servers, to detect servers that show different states to
+// different parties. Each server signs a single snapshot per epoch, which it
+// serves along with the snapshots of other servers it received. Two
+// conflicting snapshots of the same server for the same epoch are stored as
+// evidence of the server equivocating, and the server is banned.
+type RootGossiper struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *RootGossiperConfig
+
+	// signMtx makes sure we never sign more than one snapshot per epoch.
+	signMtx sync.Mutex
+
+	// alertSubscribers is notified each time a universe server is
+	// detected equivocating.
+	alertSubscribers *fn.EventDistributor[*Equivocation]
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewRootGossiper creates a new root gossiper with the given config.
+func NewRootGossiper(cfg *RootGossiperConfig) *RootGossiper {
+	return &RootGossiper{
+		cfg:              cfg,
+		alertSubscribers: fn.NewEventDistributor[*Equivocation](),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts exchanging signed roots with the federation servers.
+func (g *RootGossiper) Start() error {
+	g.startOnce.Do(func() {
+		if g.cfg.GossipInterval == 0 {
+			log.Infof("Multiverse root gossip disabled")
+			return
+		}
+
+		log.Infof("Starting multiverse root gossiper, interval=%v",
+			g.cfg.GossipInterval)
+
+		g.Wg.Add(1)
+		go g.gossipLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the root gossiper.
+func (g *RootGossiper) Stop() error {
+	g.stopOnce.Do(func() {
+		log.Info("Stopping multiverse root gossiper")
+
+		close(g.Quit)
+		g.Wg.Wait()
+	})
+
+	return nil
+}
+
+// gossipLoop exchanges signed roots with the federation servers each time the
+// gossip interval elapsed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (g *RootGossiper) gossipLoop() {
+	defer g.Wg.Done()
+
+	ticker := time.NewTicker(g.cfg.GossipInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := g.WithCtxQuitNoTimeout()
+			err := g.gossipWithFederation(ctx)
+			cancel()
+			if err != nil {
+				log.Warnf("Unable to gossip multiverse roots: "+
+					"%v", err)
+			}
+
+		case <-g.Quit:
+			return
+		}
+	}
+}
+
+// LocalSignedRoot returns the signed snapshot of the local multiverse roots for
+// the current epoch. The snapshot is taken and signed the first time it's
+// requested within an epoch, every later request returns the same snapshot.
+func (g *RootGossiper) LocalSignedRoot(
+	ctx context.Context) (*SignedMultiverseRoot, error) {
+
+	g.signMtx.Lock()
+	defer g.signMtx.Unlock()
+
+	epoch := SignedRootEpoch(g.cfg.Clock.Now())
+	signedRoot, err := g.cfg.GossipLog.FetchSignedRoot(
+		ctx, g.cfg.NodeKey, epoch,
+	)
+	switch {
+	case err == nil:
+		return signedRoot, nil
+
+	case !errors.Is(err, ErrNoSignedRoot):
+		return nil, fmt.Errorf("unable to fetch signed root: %w", err)
+	}
+
+	leaves, err := FetchMultiverseLeaves(ctx, g.cfg.Multiverse)
+	if err != nil {
+		return nil, err
+	}
+
+	issuanceRoot, transferRoot, err := MultiverseRoots(ctx, leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := g.cfg.SignMessage(
+		ctx, SignedRootMsg(epoch, issuanceRoot, transferRoot),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign multiverse roots: %w",
+			err)
+	}
+
+	log.Debugf("Signed multiverse roots for epoch %d, issuance_root=%v, "+
+		"transfer_root=%v", epoch, issuanceRoot.NodeHash(),
+		transferRoot.NodeHash())
+
+	return g.cfg.GossipLog.InsertSignedRoot(ctx, &SignedMultiverseRoot{
+		ServerKey:    g.cfg.NodeKey,
+		Epoch:        epoch,
+		IssuanceRoot: issuanceRoot,
+		TransferRoot: transferRoot,
+		Signature:    sig,
+	})
+}
+
+// SignedRoots returns the signed snapshot of the local multiverse roots for the
+// current epoch, followed by all other known signed roots for all epochs
+// starting at the given one.
+func (g *RootGossiper) SignedRoots(ctx context.Context,
+	minEpoch uint64) ([]SignedMultiverseRoot, error) {
+
+	localRoot, err := g.LocalSignedRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	knownRoots, err := g.cfg.GossipLog.QuerySignedRoots(ctx, minEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query signed roots: %w", err)
+	}
+
+	roots := []SignedMultiverseRoot{*localRoot}
+	for _, root := range knownRoots {
+		if root.ServerKey.IsEqual(localRoot.ServerKey) &&
+			root.Epoch == localRoot.Epoch {
+
+			continue
+		}
+
+		roots = append(roots, root)
+	}
+
+	return roots, nil
+}
+
+// ProcessSignedRoot verifies and stores a signed root that was received from
+// the universe server with the given host. If the root conflicts with a known
+// root of the same server for the same epoch, the evidence of the server
+// equivocating is stored and returned. Otherwise, nil is returned.
+func (g *RootGossiper) ProcessSignedRoot(ctx context.Context,
+	root *SignedMultiverseRoot, reporter string) (*Equivocation, error) {
+
+	if err := root.Verify(); err != nil {
+		return nil, err
+	}
+
+	knownRoot, err := g.cfg.GossipLog.InsertSignedRoot(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store signed root: %w", err)
+	}
+
+	if !knownRoot.Conflicts(root) {
+		return nil, nil
+	}
+
+	equivocation := &Equivocation{
+		First:      *knownRoot,
+		Second:     *root,
+		Reporter:   reporter,
+		DetectedAt: g.cfg.Clock.Now(),
+	}
+	isNew, err := g.cfg.GossipLog.LogEquivocation(ctx, equivocation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to log equivocation: %w", err)
+	}
+
+	// We'll keep receiving the conflicting root with each gossip round,
+	// but only need to raise the alert once.
+	if !isNew {
+		return equivocation, nil
+	}
+
+	serverKey := root.ServerKey.SerializeCompressed()
+	log.Criticalf("Universe server with key %x equivocated in epoch %d, "+
+		"reported by %v: signed issuance_root=%v, transfer_root=%v "+
+		"and issuance_root=%v, transfer_root=%v", serverKey,
+		root.Epoch, reporter, knownRoot.IssuanceRoot.NodeHash(),
+		knownRoot.TransferRoot.NodeHash(), root.IssuanceRoot.NodeHash(),
+		root.TransferRoot.NodeHash())
+
+	g.alertSubscribers.NotifySubscribers(equivocation)
+
+	g.penalizeServer(ctx, root.ServerKey, fmt.Sprintf("equivocated in "+
+		"epoch %d, reported by %v", root.Epoch, reporter))
+
+	return equivocation, nil
+}
+
+// penalizeServer lowers the reputation of the federation server with the given
+// identity key, so it's banned from syncing.
+func (g *RootGossiper) penalizeServer(ctx context.Context,
+	serverKey *btcec.PublicKey, reason string) {
+
+	servers, err := g.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		log.Warnf("Unable to fetch federation servers: %v", err)
+		return
+	}
+
+	for _, server := range servers {
+		if server.IdentityKey == nil ||
+			!server.IdentityKey.IsEqual(serverKey) {
+
+			continue
+		}
+
+		err := g.cfg.FederationDB.LogServerFailure(
+			ctx, server, reason, EquivocationPenalty,
+		)
+		if err != nil {
+			log.Warnf("Unable to update reputation of server=%v: "+
+				"%v", server.HostStr(), err)
+		}
+	}
+}
+
+// gossipWithFederation fetches the signed roots from all authenticated
+// federation servers and processes the roots of the federation servers among
+// them.
+func (g *RootGossiper) gossipWithFederation(ctx context.Context) error {
+	servers, err := g.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch federation servers: %w", err)
+	}
+
+	// We only care about the roots of the servers we know, as we can't
+	// attribute a root to a server otherwise. This includes ourselves, in
+	// case our own node key was used to sign a conflicting root.
+	trustedKeys := map[asset.SerializedKey]struct{}{
+		asset.ToSerialized(g.cfg.NodeKey): {},
+	}
+	for _, server := range servers {
+		if server.IdentityKey != nil {
+			trustedKeys[asset.ToSerialized(server.IdentityKey)] =
+				struct{}{}
+		}
+	}
+
+	// We also accept the roots of the previous epoch, as the clocks of
+	// the servers might not be in sync.
+	minEpoch := SignedRootEpoch(g.cfg.Clock.Now())
+	if minEpoch > 0 {
+		minEpoch--
+	}
+
+	for _, server := range servers {
+		if server.IdentityKey == nil {
+			continue
+		}
+
+		err := g.gossipWithServer(ctx, server, trustedKeys, minEpoch)
+		if err != nil {
+			log.Warnf("Unable to gossip multiverse roots with "+
+				"server=%v: %v", server.HostStr(), err)
+		}
+	}
+
+	return nil
+}
+
+// gossipWithServer fetches the signed roots from the given server and processes
+// the roots signed by one of the trusted keys.
+func (g *RootGossiper) gossipWithServer(ctx context.Context, server ServerAddr,
+	trustedKeys map[asset.SerializedKey]struct{}, minEpoch uint64) error {
+
+	fetcher, err := g.cfg.NewSignedRootFetcher(server)
+	if err != nil {
+		return err
+	}
+	defer fetcher.Close()
+
+	roots, err := fetcher.FetchSignedRoots(ctx, minEpoch)
+	if err != nil {
+		return fmt.Errorf("unable to fetch signed roots: %w", err)
+	}
+
+	for idx := range roots {
+		root := &roots[idx]
+
+		serverKey := asset.ToSerialized(root.ServerKey)
+		if _, ok := trustedKeys[serverKey]; !ok {
+			continue
+		}
+
+		_, err := g.ProcessSignedRoot(ctx, root, server.HostStr())
+		switch {
+		case errors.Is(err, ErrInvalidRootSig):
+			log.Warnf("Server=%v served signed root with invalid "+
+				"signature for key %x: %v", server.HostStr(),
+				serverKey[:], err)
+
+		case err != nil:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// QueryEquivocations returns the evidence of equivocations that were detected
+// within the given time range.
+func (g *RootGossiper) QueryEquivocations(ctx context.Context, startTime,
+	endTime time.Time) ([]Equivocation, error) {
+
+	return g.cfg.GossipLog.QueryEquivocations(ctx, startTime, endTime)
+}
+
+// RegisterAlertSubscriber adds a new subscriber that is notified with the
+// evidence each time a universe server is detected equivocating.
+func (g *RootGossiper) RegisterAlertSubscriber(
+	receiver *fn.EventReceiver[*Equivocation]) {
+
+	g.alertSubscribers.RegisterSubscriber(receiver)
+}
+
+// RemoveAlertSubscriber removes the given alert subscriber and stops it from
+// processing events.
+func (g *RootGossiper) RemoveAlertSubscriber(
+	receiver *fn.EventReceiver[*Equivocation]) error {
+
+	return g.alertSubscribers.RemoveSubscriber(receiver)
+}
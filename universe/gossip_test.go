@@ -0,0 +1,384 @@
+package universe
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// signMsg signs the given message the way lnd signs messages.
+func signMsg(t *testing.T, privKey *btcec.PrivateKey, msg []byte) []byte {
+	wireSig, err := lnwire.NewSigFromSignature(
+		ecdsa.Sign(privKey, chainhash.HashB(msg)),
+	)
+	require.NoError(t, err)
+
+	return wireSig.RawBytes()
+}
+
+// randSignedRoot returns a signed root with random multiverse roots for the
+// given epoch.
+func randSignedRoot(t *testing.T, privKey *btcec.PrivateKey,
+	epoch uint64) SignedMultiverseRoot {
+
+	issuanceHash, transferHash := test.RandHash(), test.RandHash()
+	root := SignedMultiverseRoot{
+		ServerKey: privKey.PubKey(),
+		Epoch:     epoch,
+		IssuanceRoot: mssmt.NewComputedBranch(
+			mssmt.NodeHash(issuanceHash), test.RandInt[uint64](),
+		),
+		TransferRoot: mssmt.NewComputedBranch(
+			mssmt.NodeHash(transferHash), test.RandInt[uint64](),
+		),
+	}
+	root.Signature = signMsg(
+		t, privKey, SignedRootMsg(
+			epoch, root.IssuanceRoot, root.TransferRoot,
+		),
+	)
+
+	return root
+}
+
+// mockGossipLog is a root gossip log that keeps the signed roots and the
+// evidence of equivocations in memory.
+type mockGossipLog struct {
+	sync.Mutex
+
+	roots []SignedMultiverseRoot
+
+	equivocations []Equivocation
+}
+
+func (m *mockGossipLog) FetchSignedRoot(_ context.Context,
+	serverKey *btcec.PublicKey, epoch uint64) (*SignedMultiverseRoot,
+	error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, root := range m.roots {
+		if root.ServerKey.IsEqual(serverKey) && root.Epoch == epoch {
+			return &root, nil
+		}
+	}
+
+	return nil, ErrNoSignedRoot
+}
+
+func (m *mockGossipLog) InsertSignedRoot(ctx context.Context,
+	root *SignedMultiverseRoot) (*SignedMultiverseRoot, error) {
+
+	storedRoot, err := m.FetchSignedRoot(ctx, root.ServerKey, root.Epoch)
+	if err == nil {
+		return storedRoot, nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.roots = append(m.roots, *root)
+
+	return root, nil
+}
+
+func (m *mockGossipLog) QuerySignedRoots(_ context.Context,
+	minEpoch uint64) ([]SignedMultiverseRoot, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return fn.Filter(m.roots, func(root SignedMultiverseRoot) bool {
+		return root.Epoch >= minEpoch
+	}), nil
+}
+
+func (m *mockGossipLog) LogEquivocation(_ context.Context,
+	equivocation *Equivocation) (bool, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, e := range m.equivocations {
+		if e.First.ServerKey.IsEqual(equivocation.First.ServerKey) &&
+			e.First.Epoch == equivocation.First.Epoch {
+
+			return false, nil
+		}
+	}
+
+	m.equivocations = append(m.equivocations, *equivocation)
+
+	return true, nil
+}
+
+func (m *mockGossipLog) QueryEquivocations(_ context.Context, _,
+	_ time.Time) ([]Equivocation, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.equivocations, nil
+}
+
+var _ RootGossipLog = (*mockGossipLog)(nil)
+
+// mockRootFetcher is a signed root fetcher that returns a fixed set of roots.
+type mockRootFetcher struct {
+	roots []SignedMultiverseRoot
+}
+
+func (m *mockRootFetcher) FetchSignedRoots(context.Context,
+	uint64) ([]SignedMultiverseRoot, error) {
+
+	return m.roots, nil
+}
+
+func (m *mockRootFetcher) Close() error {
+	return nil
+}
+
+var _ SignedRootFetcher = (*mockRootFetcher)(nil)
+
+// mockGossipMultiverse is a multiverse that only returns a fixed set of
+// leaves.
+type mockGossipMultiverse struct {
+	MultiverseArchive
+
+	leaves []MultiverseLeaf
+}
+
+func (m *mockGossipMultiverse) FetchLeaves(_ context.Context,
+	_ []MultiverseLeafDesc, proofType ProofType) ([]MultiverseLeaf,
+	error) {
+
+	return fn.Filter(m.leaves, func(leaf MultiverseLeaf) bool {
+		return leaf.ID.ProofType == proofType
+	}), nil
+}
+
+// mockGossipFederationDB is a federation DB that returns a fixed set of
+// servers and records the penalties of their failures.
+type mockGossipFederationDB struct {
+	FederationDB
+
+	servers []ServerAddr
+
+	penalties map[string]int32
+}
+
+func (m *mockGossipFederationDB) UniverseServers(
+	context.Context) ([]ServerAddr, error) {
+
+	return m.servers, nil
+}
+
+func (m *mockGossipFederationDB) LogServerFailure(_ context.Context,
+	addr ServerAddr, _ string, penalty int32) error {
+
+	m.penalties[addr.HostStr()] += penalty
+
+	return nil
+}
+
+// TestLocalSignedRoot tests that the local multiverse roots are signed only
+// once per epoch.
+func TestLocalSignedRoot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey := test.RandPrivKey()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+
+	var id Identifier
+	copy(id.AssetID[:], test.RandBytes(32))
+	id.ProofType = ProofTypeIssuance
+
+	rootHash := test.RandHash()
+	multiverse := &mockGossipMultiverse{
+		leaves: []MultiverseLeaf{{
+			ID:       id,
+			LeafNode: mssmt.NewLeafNode(rootHash[:], 1),
+		}},
+	}
+	gossiper := NewRootGossiper(&RootGossiperConfig{
+		Multiverse: multiverse,
+		GossipLog:  &mockGossipLog{},
+		NodeKey:    privKey.PubKey(),
+		SignMessage: func(_ context.Context, msg []byte) ([]byte,
+			error) {
+
+			return signMsg(t, privKey, msg), nil
+		},
+		Clock: testClock,
+	})
+
+	signedRoot, err := gossiper.LocalSignedRoot(ctx)
+	require.NoError(t, err)
+	require.NoError(t, signedRoot.Verify())
+	require.Equal(t, SignedRootEpoch(testClock.Now()), signedRoot.Epoch)
+	require.EqualValues(t, 1, signedRoot.IssuanceRoot.NodeSum())
+
+	// A signature over any other epoch or roots isn't valid.
+	tamperedRoot := *signedRoot
+	tamperedRoot.Epoch++
+	require.ErrorIs(t, tamperedRoot.Verify(), ErrInvalidRootSig)
+
+	// Even if the multiverse changes, the same root is returned for the
+	// rest of the epoch.
+	id.ProofType = ProofTypeTransfer
+	multiverse.leaves = append(multiverse.leaves, MultiverseLeaf{
+		ID:       id,
+		LeafNode: mssmt.NewLeafNode(rootHash[:], 5),
+	})
+
+	sameRoot, err := gossiper.LocalSignedRoot(ctx)
+	require.NoError(t, err)
+	require.False(t, signedRoot.Conflicts(sameRoot))
+	require.Equal(t, signedRoot.Signature, sameRoot.Signature)
+
+	// Only in the next epoch the new roots are signed.
+	testClock.SetTime(testClock.Now().Add(SignedRootEpochDuration))
+
+	newRoot, err := gossiper.LocalSignedRoot(ctx)
+	require.NoError(t, err)
+	require.NoError(t, newRoot.Verify())
+	require.Equal(t, signedRoot.Epoch+1, newRoot.Epoch)
+	require.EqualValues(t, 5, newRoot.TransferRoot.NodeSum())
+
+	roots, err := gossiper.SignedRoots(ctx, signedRoot.Epoch)
+	require.NoError(t, err)
+	require.Len(t, roots, 2)
+	require.Equal(t, newRoot.Epoch, roots[0].Epoch)
+}
+
+// TestRootGossipEquivocation tests that two conflicting roots signed by a
+// federation server for the same epoch are detected, recorded and alerted
+// about once, and that the server is penalized.
+func TestRootGossipEquivocation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	epoch := SignedRootEpoch(testClock.Now())
+
+	localKey := test.RandPrivKey()
+	equivocatorKey := test.RandPrivKey()
+	reporterKey := test.RandPrivKey()
+	unknownKey := test.RandPrivKey()
+
+	equivocator := NewServerAddrFromStr("equivocator:10029")
+	equivocator.IdentityKey = equivocatorKey.PubKey()
+	reporter := NewServerAddrFromStr("reporter:10029")
+	reporter.IdentityKey = reporterKey.PubKey()
+
+	// The equivocator serves one root to us, but another one to the
+	// reporter, which gossips it to us. Roots of unknown servers are
+	// ignored.
+	servedRoot := randSignedRoot(t, equivocatorKey, epoch)
+	otherRoot := randSignedRoot(t, equivocatorKey, epoch)
+	fetchers := map[string]*mockRootFetcher{
+		equivocator.HostStr(): {
+			roots: []SignedMultiverseRoot{servedRoot},
+		},
+		reporter.HostStr(): {
+			roots: []SignedMultiverseRoot{
+				randSignedRoot(t, reporterKey, epoch),
+				otherRoot,
+				randSignedRoot(t, unknownKey, epoch),
+			},
+		},
+	}
+
+	gossipLog := &mockGossipLog{}
+	federationDB := &mockGossipFederationDB{
+		servers:   []ServerAddr{equivocator, reporter},
+		penalties: make(map[string]int32),
+	}
+	gossiper := NewRootGossiper(&RootGossiperConfig{
+		GossipLog:    gossipLog,
+		FederationDB: federationDB,
+		NodeKey:      localKey.PubKey(),
+		NewSignedRootFetcher: func(
+			addr ServerAddr) (SignedRootFetcher, error) {
+
+			return fetchers[addr.HostStr()], nil
+		},
+		Clock: testClock,
+	})
+
+	alerts := fn.NewEventReceiver[*Equivocation](fn.DefaultQueueSize)
+	gossiper.RegisterAlertSubscriber(alerts)
+	t.Cleanup(func() {
+		require.NoError(t, gossiper.RemoveAlertSubscriber(alerts))
+	})
+
+	require.NoError(t, gossiper.gossipWithFederation(ctx))
+
+	alert, err := fn.RecvOrTimeout(
+		alerts.NewItemCreated.ChanOut(), time.Second,
+	)
+	require.NoError(t, err)
+	require.Equal(t, reporter.HostStr(), (*alert).Reporter)
+	require.False(t, (*alert).First.Conflicts(&servedRoot))
+	require.False(t, (*alert).Second.Conflicts(&otherRoot))
+	require.True(t, (*alert).First.Conflicts(&(*alert).Second))
+
+	require.Equal(t, map[string]int32{
+		equivocator.HostStr(): EquivocationPenalty,
+	}, federationDB.penalties)
+
+	equivocations, err := gossiper.QueryEquivocations(
+		ctx, time.Time{}, testClock.Now(),
+	)
+	require.NoError(t, err)
+	require.Len(t, equivocations, 1)
+
+	// Only the roots of the federation servers were stored.
+	roots, err := gossipLog.QuerySignedRoots(ctx, epoch)
+	require.NoError(t, err)
+	require.Len(t, roots, 2)
+	for _, root := range roots {
+		require.NotEqual(
+			t, asset.ToSerialized(unknownKey.PubKey()),
+			asset.ToSerialized(root.ServerKey),
+		)
+	}
+
+	// The conflicting root is gossiped again with the next round, but we
+	// already know about the equivocation.
+	require.NoError(t, gossiper.gossipWithFederation(ctx))
+
+	select {
+	case <-alerts.NewItemCreated.ChanOut():
+		t.Fatalf("unexpected second alert")
+
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.Equal(
+		t, EquivocationPenalty,
+		federationDB.penalties[equivocator.HostStr()],
+	)
+
+	// Roots with an invalid signature are rejected.
+	invalidRoot := randSignedRoot(t, equivocatorKey, epoch)
+	invalidRoot.ServerKey = reporterKey.PubKey()
+	_, err = gossiper.ProcessSignedRoot(
+		ctx, &invalidRoot, reporter.HostStr(),
+	)
+	require.ErrorIs(t, err, ErrInvalidRootSig)
+}
@@ -11,11 +11,13 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
 )
 
 var (
@@ -33,6 +35,14 @@ var (
 	// ErrNoUniverseProofFound is returned when a user attempts to look up
 	// a key in the universe that actually points to the empty leaf.
 	ErrNoUniverseProofFound = fmt.Errorf("no universe proof found")
+
+	// ErrInvalidProof is returned when a proof that should be inserted
+	// into a universe fails verification.
+	ErrInvalidProof = fmt.Errorf("invalid proof")
+
+	// ErrServerBanned is returned when attempting to sync with a universe
+	// server whose reputation score fell below the ban threshold.
+	ErrServerBanned = fmt.Errorf("universe server is banned")
 )
 
 const (
@@ -46,6 +56,10 @@ const (
 	// TODO(guggero): Bump this to the value of MaxPageSize once the
 	// universe servers have been updated to v0.5.0-rc1 or later.
 	RequestPageSize = 512
+
+	// MaxBranchNodesDepth is the maximum number of levels below a branch
+	// that the nodes of a universe tree can be queried for at once.
+	MaxBranchNodesDepth = 8
 )
 
 // IdentifierKey is the compact representation of a universe identifier that can
@@ -451,6 +465,20 @@ type MultiverseArchive interface {
 	UniverseLeafKeys(ctx context.Context,
 		q UniverseLeafKeysQuery) ([]LeafKey, error)
 
+	// BranchNodes returns the nodes of a universe tree that are a number
+	// of levels below the branch identified by a key prefix.
+	BranchNodes(ctx context.Context,
+		q BranchNodesQuery) ([]mssmt.Node, error)
+
+	// FetchLeafEvents returns the leaves that were inserted after the
+	// cursor of the given query, ordered by their cursor.
+	FetchLeafEvents(ctx context.Context,
+		q LeafEventsQuery) ([]LeafEvent, error)
+
+	// LatestLeafCursor returns the cursor of the most recently inserted
+	// leaf, or zero if no leaves were inserted yet.
+	LatestLeafCursor(ctx context.Context) (uint64, error)
+
 	// FetchLeaves returns the set of multiverse leaves that satisfy the set
 	// of universe targets. If the set of targets is empty, all leaves for
 	// the given proof type will be returned.
@@ -474,6 +502,32 @@ type Registrar interface {
 	Close() error
 }
 
+// RemoteLeafEvent is a leaf that was inserted into a remote universe.
+type RemoteLeafEvent struct {
+	LeafEvent
+
+	// Leaf is the inserted leaf. This is nil for the first event of a
+	// subscription, which only carries the cursor the subscription starts
+	// after.
+	Leaf *Leaf
+}
+
+// LeafSubscriber is an interface that allows a caller to subscribe to the
+// leaves that are inserted into a remote universe.
+type LeafSubscriber interface {
+	// SubscribeLeaves subscribes to the leaves that are inserted into the
+	// remote universe after the cursor of the given query, and calls the
+	// handler for each of them in order. If the cursor is zero, only
+	// leaves inserted after the subscription was started are delivered.
+	// This call blocks until the context is canceled, the subscription
+	// fails or the handler returns an error.
+	SubscribeLeaves(ctx context.Context, q LeafEventsQuery,
+		handler func(*RemoteLeafEvent) error) error
+
+	// Close is used to shutdown the active leaf subscriber instance.
+	Close() error
+}
+
 // Item contains the data fields necessary to insert/update a proof leaf
 // within a multiverse and the related asset (group) specific universe.
 type Item struct {
@@ -554,6 +608,10 @@ type ServerAddr struct {
 	// ID is the unique identifier of the remote universe.
 	ID int64
 
+	// IdentityKey is the node key the remote universe must authenticate
+	// with. If this is nil, the server isn't authenticated.
+	IdentityKey *btcec.PublicKey
+
 	// addrStr is the pure string version of the address before any name
 	// resolution has taken place.
 	addrStr string
@@ -668,6 +726,11 @@ type DiffEngine interface {
 	UniverseLeafKeys(ctx context.Context,
 		q UniverseLeafKeysQuery) ([]LeafKey, error)
 
+	// BranchNodes returns the nodes of a universe tree that are a number
+	// of levels below the branch identified by a key prefix.
+	BranchNodes(ctx context.Context,
+		q BranchNodesQuery) ([]mssmt.Node, error)
+
 	// FetchProofLeaf attempts to fetch a proof leaf for the target leaf key
 	// and given a universe identifier (assetID/groupKey).
 	//
@@ -683,6 +746,13 @@ type DiffEngine interface {
 
 // Commitment is an on chain universe commitment. This includes the merkle
 // proof for a transaction which anchors the target universe root.
+//
+// The commitments of a universe server form a single chain: the anchor
+// transaction of each commitment after the first one spends the output of the
+// previous commitment as its first input. As an output can only be spent
+// once, the server can't show different commitments to different clients
+// without them noticing, as long as they know the genesis outpoint of the
+// chain and check that the commitment they're shown is its unspent tip.
 type Commitment struct {
 	// BlockHeight is the height of the block that the commitment is
 	// contained within.
@@ -692,11 +762,71 @@ type Commitment struct {
 	BlockHeader wire.BlockHeader
 
 	// MerkleProof is a merkle proof for the above transaction that the
-	// anchor output was included.
+	// anchor output was included. This is nil as long as the commitment
+	// isn't confirmed yet.
 	MerkleProof *proof.TxMerkleProof
 
-	// UniverseRoot is the full Universe root for this commitment.
+	// UniverseRoot is the full Universe root for this commitment. This is
+	// the branch of the issuance and transfer multiverse roots below.
 	UniverseRoot mssmt.Node
+
+	// IssuanceRoot is the root of the issuance multiverse tree that was
+	// committed to.
+	IssuanceRoot mssmt.Node
+
+	// TransferRoot is the root of the transfer multiverse tree that was
+	// committed to.
+	TransferRoot mssmt.Node
+
+	// AnchorTx is the transaction that anchors the commitment.
+	AnchorTx *wire.MsgTx
+
+	// OutputIndex is the index of the output of the anchor transaction
+	// that commits to the universe root.
+	OutputIndex uint32
+
+	// InternalKey is the internal key of the anchor output. The output key
+	// is the internal key tweaked with the hash of the universe root.
+	InternalKey keychain.KeyDescriptor
+
+	// HeightHint is the height of the chain at the time the anchor
+	// transaction was created.
+	HeightHint uint32
+
+	// GenesisOutPoint is the outpoint of the first commitment output of
+	// the chain this commitment belongs to. For the first commitment,
+	// this is its own output.
+	GenesisOutPoint wire.OutPoint
+}
+
+// OutPoint returns the outpoint of the commitment output.
+func (c *Commitment) OutPoint() wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  c.AnchorTx.TxHash(),
+		Index: c.OutputIndex,
+	}
+}
+
+// IsGenesis returns true if the commitment is the first one of its chain.
+func (c *Commitment) IsGenesis() bool {
+	return c.OutPoint() == c.GenesisOutPoint
+}
+
+// PrevOutPoint returns the outpoint of the previous commitment output that the
+// anchor transaction spends, or None if this is the first commitment of its
+// chain.
+func (c *Commitment) PrevOutPoint() fn.Option[wire.OutPoint] {
+	if c.IsGenesis() || len(c.AnchorTx.TxIn) == 0 {
+		return fn.None[wire.OutPoint]()
+	}
+
+	return fn.Some(c.AnchorTx.TxIn[0].PreviousOutPoint)
+}
+
+// Confirmed returns true if the anchor transaction of the commitment was
+// confirmed in the chain.
+func (c *Commitment) Confirmed() bool {
+	return c.MerkleProof != nil
 }
 
 // CommittedIssuanceProof couples together a Bitcoin level merkle proof
@@ -709,31 +839,113 @@ type CommittedIssuanceProof struct {
 
 	// TaprootAssetProof is a proof of new asset issuance.
 	TaprootAssetProof *Proof
+
+	// MultiverseInclusionProof is the inclusion proof of the universe root
+	// of the above proof within the multiverse root of its proof type that
+	// was committed to in the chain.
+	MultiverseInclusionProof *mssmt.Proof
 }
 
 // ChainCommitter is used to commit a Universe backend in the chain.
 type ChainCommitter interface {
-	// CommitUniverse takes a Universe and returns a new commitment to that
-	// Universe in the main chain.
-	CommitUniverse(universe BaseBackend) (*Commitment, error)
+	// CommitUniverse takes a snapshot of the multiverse leaves and returns
+	// a new, not yet confirmed commitment to the multiverse roots they
+	// form in the main chain.
+	CommitUniverse(ctx context.Context,
+		leaves []MultiverseLeaf) (*Commitment, error)
 }
 
 // Canonical is an interface that allows a caller to query for the latest
 // canonical Universe information related to an asset.
-//
-// TODO(roasbeef): sync methods too, divide into read/write?
 type Canonical interface {
-	BaseBackend
+	// LatestCommitment returns the latest confirmed chain commitment. If
+	// there is none, ErrNoCommitment is returned.
+	LatestCommitment(ctx context.Context) (*Commitment, error)
+
+	// CommittedProof returns the given universe proof of the universe
+	// with the given ID together with the latest chain commitment to its
+	// universe root. If the universe root changed since the latest
+	// commitment, ErrNoCommitment is returned.
+	CommittedProof(ctx context.Context, id Identifier,
+		leafProof *Proof) (*CommittedIssuanceProof, error)
+}
 
-	// Query returns a fully proved response for the target base key.
-	Query(context.Context, LeafKey) (*CommittedIssuanceProof, error)
+// CommitmentLog is used to keep track of the chain commitments to the
+// multiverse roots, along with the multiverse leaves they commit to.
+type CommitmentLog interface {
+	Canonical
+
+	// InsertCommitment stores a new, not yet confirmed commitment along
+	// with the multiverse leaves it commits to.
+	InsertCommitment(ctx context.Context, commitment *Commitment,
+		leaves []MultiverseLeaf) error
+
+	// ConfirmCommitment marks the commitment with the given anchor
+	// transaction as confirmed.
+	ConfirmCommitment(ctx context.Context, txid chainhash.Hash,
+		blockHeight uint32, header wire.BlockHeader,
+		merkleProof *proof.TxMerkleProof) error
+
+	// PendingCommitments returns all commitments that aren't confirmed
+	// yet.
+	PendingCommitments(ctx context.Context) ([]*Commitment, error)
+
+	// AbandonCommitment deletes the pending commitment with the given
+	// anchor transaction along with all newer pending commitments.
+	AbandonCommitment(ctx context.Context, txid chainhash.Hash) error
+
+	// ReplaceAnchorTx replaces the anchor transaction of the pending
+	// commitment with the given anchor transaction by a new one that pays
+	// to the same commitment output, for example to bump its fee.
+	ReplaceAnchorTx(ctx context.Context, txid chainhash.Hash,
+		anchorTx *wire.MsgTx, outputIndex, heightHint uint32) error
+}
 
-	// LatestCommitment returns the latest chain commitment.
-	LatestCommitment() (*Commitment, error)
+// SignedRootFetcher is used to fetch the signed multiverse roots a remote
+// universe server knows about.
+type SignedRootFetcher interface {
+	// FetchSignedRoots returns the signed multiverse root of the remote
+	// universe server for the current epoch, along with the signed roots
+	// of other universe servers it received, for all epochs starting at
+	// the given one.
+	FetchSignedRoots(ctx context.Context,
+		minEpoch uint64) ([]SignedMultiverseRoot, error)
+
+	// Close is used to shutdown the active signed root fetcher instance.
+	Close() error
+}
 
-	// UpdateChainCommitment takes in a series of chain commitments and
-	// updates the commitment on chain.
-	UpdateChainCommitment(chainCommits ...ChainCommitter) (*Commitment, error)
+// RootGossipLog is used to keep track of the signed multiverse roots of the
+// universe servers of the federation, and of the evidence of any of them
+// equivocating.
+type RootGossipLog interface {
+	// FetchSignedRoot returns the signed root of the universe server with
+	// the given key for the given epoch. ErrNoSignedRoot is returned if
+	// there is no such root.
+	FetchSignedRoot(ctx context.Context, serverKey *btcec.PublicKey,
+		epoch uint64) (*SignedMultiverseRoot, error)
+
+	// InsertSignedRoot stores the given signed root, unless there already
+	// is a signed root of the same universe server for the same epoch. The
+	// stored root is returned in either case.
+	InsertSignedRoot(ctx context.Context,
+		root *SignedMultiverseRoot) (*SignedMultiverseRoot, error)
+
+	// QuerySignedRoots returns all stored signed roots for all epochs
+	// starting at the given one.
+	QuerySignedRoots(ctx context.Context,
+		minEpoch uint64) ([]SignedMultiverseRoot, error)
+
+	// LogEquivocation stores the given evidence of a universe server
+	// equivocating. False is returned if evidence for the same universe
+	// server and epoch was already stored.
+	LogEquivocation(ctx context.Context,
+		equivocation *Equivocation) (bool, error)
+
+	// QueryEquivocations returns the evidence of equivocations that were
+	// detected within the given time range.
+	QueryEquivocations(ctx context.Context, startTime,
+		endTime time.Time) ([]Equivocation, error)
 }
 
 // FederationLog is used to keep track of the set Universe servers that
@@ -754,6 +966,47 @@ type FederationLog interface {
 	LogNewSyncs(ctx context.Context, addrs ...ServerAddr) error
 }
 
+// ServerFailure is a failure of a universe server that lowered its reputation.
+type ServerFailure struct {
+	// Time is the time the failure happened.
+	Time time.Time
+
+	// Reason is a human-readable description of the failure.
+	Reason string
+}
+
+// ServerReputation is the reputation of a universe server of the federation.
+type ServerReputation struct {
+	// Addr is the address of the server.
+	Addr ServerAddr
+
+	// Score is the current reputation score of the server.
+	Score int32
+
+	// Failures is the list of the most recent failures of the server,
+	// newest first.
+	Failures []ServerFailure
+}
+
+// FederationReputationLog is used to keep track of the reputation of the
+// servers of the federation.
+type FederationReputationLog interface {
+	// QueryServerReputations returns the reputation of the given servers,
+	// or of all servers of the federation if none are given. Servers that
+	// aren't part of the federation are skipped.
+	QueryServerReputations(ctx context.Context,
+		addrs ...ServerAddr) ([]ServerReputation, error)
+
+	// LogServerFailure records a failure of the given server and lowers
+	// its reputation score by the given penalty.
+	LogServerFailure(ctx context.Context, addr ServerAddr, reason string,
+		penalty int32) error
+
+	// RewardServer raises the reputation score of the given server by the
+	// given reward, up to MaxReputationScore.
+	RewardServer(ctx context.Context, addr ServerAddr, reward int32) error
+}
+
 // ProofType is an enum that describes the type of proof which can be stored in
 // a given universe.
 type ProofType uint8
@@ -963,6 +1216,7 @@ type FederationProofSyncLog interface {
 // configuration.
 type FederationDB interface {
 	FederationLog
+	FederationReputationLog
 	FederationProofSyncLog
 	FederationSyncConfigDB
 }
@@ -1132,6 +1386,94 @@ type GroupedStats struct {
 	Date string
 }
 
+// AssetSearchSort is an enum used to specify the sort order of the assets
+// returned by an asset search.
+type AssetSearchSort uint8
+
+const (
+	// SearchSortByRelevance sorts the returned assets by how well they
+	// match the search text, with the best match first. The sort
+	// direction is ignored for this sort order.
+	SearchSortByRelevance AssetSearchSort = iota
+
+	// SearchSortByAssetName sorts the returned assets by their name.
+	SearchSortByAssetName
+
+	// SearchSortByAssetID sorts the returned assets by their ID.
+	SearchSortByAssetID
+
+	// SearchSortByGenesisHeight sorts the returned assets by the height of
+	// their genesis block.
+	SearchSortByGenesisHeight
+)
+
+// AssetSearchQuery packages the parameters of a search over the assets of the
+// issuance universes. The search text is matched against the asset name and
+// the keys and values of the revealed JSON meta data. All filters that are set
+// need to match. The offset and limit fields can be used to implement
+// pagination.
+type AssetSearchQuery struct {
+	// Text is the search text. Every word of the text needs to be
+	// contained in the name or the JSON meta data of a matching asset. If
+	// empty, all assets that match the filters are returned.
+	Text string
+
+	// AssetTypeFilter can be used to only return assets of the given type.
+	AssetTypeFilter *asset.Type
+
+	// GroupKeyFilter can be used to only return assets of the given group.
+	GroupKeyFilter *btcec.PublicKey
+
+	// DecimalDisplayFilter can be used to only return assets with the
+	// given decimal display.
+	DecimalDisplayFilter *uint32
+
+	// SortBy is the sort order of the returned assets.
+	SortBy AssetSearchSort
+
+	// SortDirection is the sort direction of the returned assets.
+	SortDirection SortDirection
+
+	// Offset is the number of matching assets to skip. This can be used to
+	// paginate the response.
+	Offset int
+
+	// Limit is the maximum number of assets to return. This can be used to
+	// paginate the response.
+	Limit int
+}
+
+// AssetSearchResult is a single asset that matched an asset search.
+type AssetSearchResult struct {
+	// AssetID is the ID of the asset.
+	AssetID asset.ID
+
+	// AssetName is the name of the asset.
+	AssetName string
+
+	// AssetType is the type of the asset.
+	AssetType asset.Type
+
+	// GroupKey is the optional group key of the asset.
+	GroupKey *btcec.PublicKey
+
+	// DecimalDisplay is the decimal display of the asset, if its JSON meta
+	// data specifies one.
+	DecimalDisplay fn.Option[uint32]
+
+	// MetaJSON is the revealed meta data of the asset, if it is JSON.
+	MetaJSON string
+
+	// GenesisHeight is the height of the block that the asset was created
+	// in.
+	GenesisHeight uint32
+
+	// Rank is the relevance of the asset for the search text. A higher
+	// rank means a better match. The ranks of different backends aren't
+	// comparable.
+	Rank float64
+}
+
 // Telemetry it a type used by the Universe syncer and base universe to export
 // telemetry information about the sync process. This logs events of new
 // proofs, and also sync events for entire asset trees.
@@ -1176,3 +1518,11 @@ type Telemetry interface {
 	QueryAssetStatsPerDay(ctx context.Context,
 		q GroupedStatsQuery) ([]*GroupedStats, error)
 }
+
+// AssetSearcher is used to search the assets of the issuance universes by
+// their name and their revealed meta data.
+type AssetSearcher interface {
+	// SearchAssets returns the assets that match the given query.
+	SearchAssets(ctx context.Context,
+		q AssetSearchQuery) ([]AssetSearchResult, error)
+}
@@ -0,0 +1,220 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// minLiveSyncBackoff is the time we wait before re-subscribing to the
+	// leaves of a federation server after the subscription failed.
+	minLiveSyncBackoff = time.Second
+
+	// maxLiveSyncBackoff is the maximum time we wait before re-subscribing
+	// to the leaves of a federation server after repeated failures.
+	maxLiveSyncBackoff = 5 * time.Minute
+)
+
+// updateLiveSyncs starts a live sync with each of the given federation servers
+// that isn't live synced with yet. If stopOthers is true, the live syncs with
+// any other servers are stopped.
+func (f *FederationEnvoy) updateLiveSyncs(servers []ServerAddr,
+	stopOthers bool) {
+
+	if !f.cfg.LiveSync {
+		return
+	}
+
+	f.liveSyncMtx.Lock()
+	defer f.liveSyncMtx.Unlock()
+
+	activeServers := make(map[string]struct{}, len(servers))
+	for _, server := range servers {
+		host := server.HostStr()
+		activeServers[host] = struct{}{}
+
+		if _, ok := f.liveSyncs[host]; ok {
+			continue
+		}
+
+		ctx, cancel := f.WithCtxQuitNoTimeout()
+		f.liveSyncs[host] = cancel
+
+		f.Wg.Add(1)
+		go f.liveSync(ctx, server)
+	}
+
+	if !stopOthers {
+		return
+	}
+
+	for host, cancel := range f.liveSyncs {
+		if _, ok := activeServers[host]; ok {
+			continue
+		}
+
+		log.Infof("Stopping live sync with server=%v", host)
+
+		cancel()
+		delete(f.liveSyncs, host)
+	}
+}
+
+// liveSync subscribes to the leaves that are inserted into the universe of the
+// given federation server, and inserts them into the local universe as soon as
+// they arrive. If the subscription fails, we re-subscribe from the cursor of
+// the last received leaf, so no leaves are missed in between.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (f *FederationEnvoy) liveSync(ctx context.Context, addr ServerAddr) {
+	defer f.Wg.Done()
+
+	log.Infof("Starting live sync with server=%v", addr.HostStr())
+
+	var (
+		cursor  uint64
+		backoff = minLiveSyncBackoff
+	)
+	for {
+		connected, err := f.subscribeServerLeaves(ctx, addr, &cursor)
+		switch {
+		case ctx.Err() != nil:
+			return
+
+		// We stop live syncing with servers that served invalid proofs
+		// too often. The live sync isn't restarted until the server is
+		// re-added to the federation.
+		case errors.Is(err, ErrServerBanned):
+			log.Infof("Stopping live sync with banned server: %v",
+				err)
+
+			return
+
+		// Servers that don't support the leaf subscription yet are
+		// only synced with periodically.
+		case status.Code(err) == codes.Unimplemented:
+			log.Infof("Server=%v doesn't support live sync, only "+
+				"syncing periodically", addr.HostStr())
+
+			return
+		}
+
+		// If the subscription was established, we start over with the
+		// minimum backoff.
+		if connected {
+			backoff = minLiveSyncBackoff
+		}
+
+		log.Warnf("Live sync with server=%v failed, re-subscribing "+
+			"in %v: %v", addr.HostStr(), backoff, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		backoff *= 2
+		if backoff > maxLiveSyncBackoff {
+			backoff = maxLiveSyncBackoff
+		}
+	}
+}
+
+// subscribeServerLeaves subscribes to the leaves of the given federation server
+// that were inserted after the given cursor, and inserts them into the local
+// universe. The cursor is updated with each received leaf. This blocks until
+// the subscription fails, and returns true if the subscription was established
+// before.
+func (f *FederationEnvoy) subscribeServerLeaves(ctx context.Context,
+	addr ServerAddr, cursor *uint64) (bool, error) {
+
+	err := checkServerBan(
+		ctx, f.cfg.FederationDB, addr, f.cfg.BanThreshold,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	subscriber, err := f.cfg.NewRemoteLeafSubscriber(addr)
+	if err != nil {
+		return false, fmt.Errorf("unable to connect to remote "+
+			"server(%v): %w", addr.HostStr(), err)
+	}
+	defer subscriber.Close()
+
+	var connected bool
+	err = subscriber.SubscribeLeaves(
+		ctx, LeafEventsQuery{
+			AfterCursor: *cursor,
+		}, func(event *RemoteLeafEvent) error {
+			connected = true
+
+			if event.Leaf != nil {
+				err := f.insertRemoteLeaf(ctx, addr, event)
+				if err != nil {
+					return err
+				}
+			}
+
+			*cursor = event.Cursor
+
+			return nil
+		},
+	)
+	if err == nil {
+		err = errors.New("subscription ended")
+	}
+
+	return connected, err
+}
+
+// insertRemoteLeaf inserts a leaf received from a federation server into the
+// local universe, if the sync configs allow inserting leaves of its universe.
+// Leaves that can't be inserted are skipped, as the periodic sync will pick
+// them up again. An error is only returned if the server got banned because
+// the leaf was invalid.
+func (f *FederationEnvoy) insertRemoteLeaf(ctx context.Context,
+	addr ServerAddr, event *RemoteLeafEvent) error {
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		log.Warnf("Unable to query sync configs: %v", err)
+		return nil
+	}
+
+	if !syncConfigs.IsSyncInsertEnabled(event.ID) {
+		return nil
+	}
+
+	log.Debugf("Inserting live synced leaf from server=%v: id=%v, "+
+		"cursor=%v", addr.HostStr(), event.ID.StringForLog(),
+		event.Cursor)
+
+	_, err = f.cfg.LocalRegistrar.UpsertProofLeaf(
+		ctx, event.ID, event.Key, event.Leaf,
+	)
+	if err == nil || errors.Is(err, ErrLeafNotRetained) {
+		return nil
+	}
+
+	log.Warnf("Unable to insert live synced leaf from server=%v (id=%v): "+
+		"%v", addr.HostStr(), event.ID.StringForLog(), err)
+
+	// If the leaf was invalid, the server loses reputation, which might
+	// get it banned.
+	if !errors.Is(err, ErrInvalidProof) {
+		return nil
+	}
+
+	updateServerReputation(ctx, f.cfg.FederationDB, addr, err)
+
+	return checkServerBan(
+		ctx, f.cfg.FederationDB, addr, f.cfg.BanThreshold,
+	)
+}
@@ -0,0 +1,235 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLeafServer is a remote universe that leaves can be subscribed to. The
+// first subscription fails after delivering a number of leaves.
+type mockLeafServer struct {
+	events []*RemoteLeafEvent
+
+	// numBeforeFailure is the number of leaves that are delivered before
+	// the first subscription fails.
+	numBeforeFailure int
+
+	// subscriptions is sent the cursor of each new subscription.
+	subscriptions chan uint64
+
+	sync.Mutex
+	numSubscriptions int
+}
+
+// mockLeafSubscriber is a subscriber to the leaves of a mock leaf server.
+type mockLeafSubscriber struct {
+	server *mockLeafServer
+}
+
+func (m *mockLeafSubscriber) SubscribeLeaves(ctx context.Context,
+	q LeafEventsQuery, handler func(*RemoteLeafEvent) error) error {
+
+	m.server.Lock()
+	m.server.numSubscriptions++
+	isFirst := m.server.numSubscriptions == 1
+	m.server.Unlock()
+
+	m.server.subscriptions <- q.AfterCursor
+
+	err := handler(&RemoteLeafEvent{
+		LeafEvent: LeafEvent{
+			Cursor: q.AfterCursor,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var numDelivered int
+	for _, event := range m.server.events {
+		if event.Cursor <= q.AfterCursor {
+			continue
+		}
+
+		if isFirst && numDelivered == m.server.numBeforeFailure {
+			return errors.New("connection lost")
+		}
+
+		if err := handler(event); err != nil {
+			return err
+		}
+		numDelivered++
+	}
+
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func (m *mockLeafSubscriber) Close() error {
+	return nil
+}
+
+var _ LeafSubscriber = (*mockLeafSubscriber)(nil)
+
+// mockRegistrar is a local registrar that records the inserted leaves.
+type mockRegistrar struct {
+	inserted chan LeafKey
+}
+
+func (m *mockRegistrar) UpsertProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey, _ *Leaf) (*Proof, error) {
+
+	m.inserted <- key
+
+	return &Proof{}, nil
+}
+
+func (m *mockRegistrar) UpsertProofLeafBatch(context.Context, []*Item) error {
+	return nil
+}
+
+func (m *mockRegistrar) Close() error {
+	return nil
+}
+
+var _ BatchRegistrar = (*mockRegistrar)(nil)
+
+// mockFederationDB is a federation DB that only returns the global sync
+// configs and the reputation of a single server.
+type mockFederationDB struct {
+	FederationDB
+
+	globalConfigs []*FedGlobalSyncConfig
+
+	reputationLog mockReputationLog
+}
+
+func (m *mockFederationDB) QueryServerReputations(ctx context.Context,
+	addrs ...ServerAddr) ([]ServerReputation, error) {
+
+	return m.reputationLog.QueryServerReputations(ctx, addrs...)
+}
+
+func (m *mockFederationDB) QueryFederationSyncConfigs(
+	context.Context) ([]*FedGlobalSyncConfig, []*FedUniSyncConfig, error) {
+
+	return m.globalConfigs, nil, nil
+}
+
+// TestLiveSync tests that the leaves received from a federation server are
+// inserted into the local universe if allowed, and that the subscription is
+// resumed from the last received leaf after it failed.
+func TestLiveSync(t *testing.T) {
+	t.Parallel()
+
+	server := &mockLeafServer{
+		numBeforeFailure: 2,
+		subscriptions:    make(chan uint64, 10),
+	}
+	for i := 1; i <= 5; i++ {
+		proofType := ProofTypeIssuance
+		if i == 2 {
+			proofType = ProofTypeTransfer
+		}
+
+		server.events = append(server.events, &RemoteLeafEvent{
+			LeafEvent: LeafEvent{
+				Cursor: uint64(i),
+				ID: Identifier{
+					ProofType: proofType,
+				},
+				Key: randLeafKey(t),
+			},
+			Leaf: &Leaf{},
+		})
+	}
+
+	registrar := &mockRegistrar{
+		inserted: make(chan LeafKey, 10),
+	}
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationDB{
+			globalConfigs: []*FedGlobalSyncConfig{{
+				ProofType:       ProofTypeIssuance,
+				AllowSyncInsert: true,
+			}, {
+				ProofType:       ProofTypeTransfer,
+				AllowSyncInsert: false,
+			}},
+			reputationLog: mockReputationLog{
+				score: MaxReputationScore,
+			},
+		},
+		LocalRegistrar: registrar,
+		LiveSync:       true,
+		NewRemoteLeafSubscriber: func(ServerAddr) (LeafSubscriber,
+			error) {
+
+			return &mockLeafSubscriber{
+				server: server,
+			}, nil
+		},
+	})
+	t.Cleanup(func() {
+		require.NoError(t, envoy.Stop())
+	})
+
+	addr := NewServerAddrFromStr("localhost:10029")
+	envoy.updateLiveSyncs([]ServerAddr{addr}, true)
+
+	// Live syncing with a server that is already live synced with doesn't
+	// start another subscription.
+	envoy.updateLiveSyncs([]ServerAddr{addr}, false)
+
+	recvCursor := func() uint64 {
+		cursor, err := fn.RecvOrTimeout(
+			server.subscriptions, 5*time.Second,
+		)
+		require.NoError(t, err)
+
+		return *cursor
+	}
+
+	// The first subscription starts from scratch and fails after the
+	// second leaf. The leaf of the transfer universe isn't inserted, as
+	// the sync config doesn't allow it.
+	require.Zero(t, recvCursor())
+
+	// After the failure, the subscription is resumed after the last leaf
+	// that was received, so all other leaves are inserted exactly once.
+	require.EqualValues(t, 2, recvCursor())
+
+	for _, idx := range []int{0, 2, 3, 4} {
+		key, err := fn.RecvOrTimeout(registrar.inserted, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(
+			t, server.events[idx].Key.UniverseKey(),
+			key.UniverseKey(),
+		)
+	}
+
+	// Once the server is no longer part of the federation, the live sync
+	// with it is stopped.
+	envoy.updateLiveSyncs(nil, true)
+
+	envoy.liveSyncMtx.Lock()
+	require.Empty(t, envoy.liveSyncs)
+	envoy.liveSyncMtx.Unlock()
+
+	select {
+	case cursor := <-server.subscriptions:
+		t.Fatalf("unexpected subscription after cursor %d", cursor)
+
+	case key := <-registrar.inserted:
+		t.Fatalf("unexpected leaf inserted: %v", key)
+
+	default:
+	}
+}
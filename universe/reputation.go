@@ -0,0 +1,204 @@
+package universe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// MaxReputationScore is the reputation score a universe server starts
+	// with, which is also the highest score it can reach.
+	MaxReputationScore int32 = 100
+
+	// DefaultBanThreshold is the default reputation score below which a
+	// universe server is banned from syncing.
+	DefaultBanThreshold int32 = 50
+
+	// InvalidProofPenalty is the amount the reputation score of a universe
+	// server is lowered by each time it serves invalid proofs.
+	InvalidProofPenalty int32 = 20
+
+	// SyncSuccessReward is the amount the reputation score of a universe
+	// server is raised by with each successful sync.
+	SyncSuccessReward int32 = 1
+
+	// ChallengeSize is the size of the random challenge a universe server
+	// signs to prove its identity.
+	ChallengeSize = 32
+
+	// ChannelBindingSize is the size of the TLS channel binding that is
+	// signed along with the challenge.
+	ChannelBindingSize = 32
+
+	// channelBindingLabel is the label of the keying material that is
+	// exported from the TLS session as its channel binding, as described
+	// in RFC 5705.
+	channelBindingLabel = "EXPORTER-taproot-assets-universe-challenge"
+)
+
+var (
+	// challengeTag is the domain separation tag that is prepended to the
+	// challenge before it is signed, so the signature can't be used for
+	// anything other than authenticating a universe server.
+	challengeTag = []byte("taproot-assets/universe/challenge")
+
+	// ErrInvalidChallengeSig is returned when the signature of a universe
+	// server over a challenge isn't valid for its identity key.
+	ErrInvalidChallengeSig = errors.New("invalid challenge signature")
+)
+
+// TLSChannelBinding returns the channel binding of the given TLS session. Both
+// ends of a TLS session derive the same channel binding, while the sessions on
+// both sides of a man-in-the-middle have different ones.
+func TLSChannelBinding(
+	state tls.ConnectionState) ([ChannelBindingSize]byte, error) {
+
+	var binding [ChannelBindingSize]byte
+	keyingMaterial, err := state.ExportKeyingMaterial(
+		channelBindingLabel, nil, ChannelBindingSize,
+	)
+	if err != nil {
+		return binding, fmt.Errorf("unable to export TLS keying "+
+			"material: %w", err)
+	}
+	copy(binding[:], keyingMaterial)
+
+	return binding, nil
+}
+
+// ChallengeMsg returns the message a universe server signs with its node key
+// to prove its identity for the given challenge. The message commits to the
+// channel binding of the TLS session the challenge was received over, so the
+// signature can't be relayed to a client through a different TLS session.
+func ChallengeMsg(challenge [ChallengeSize]byte,
+	binding [ChannelBindingSize]byte) []byte {
+
+	msg := make(
+		[]byte, 0, len(challengeTag)+ChallengeSize+ChannelBindingSize,
+	)
+	msg = append(msg, challengeTag...)
+	msg = append(msg, challenge[:]...)
+
+	return append(msg, binding[:]...)
+}
+
+// VerifyChallengeSig verifies that the given signature over the challenge and
+// the TLS channel binding was created by the given identity key. The signature
+// is expected in the 64-byte wire format that lnd's SignMessage returns, which
+// signs the single SHA256 hash of the message.
+func VerifyChallengeSig(identityKey *btcec.PublicKey,
+	challenge [ChallengeSize]byte, binding [ChannelBindingSize]byte,
+	sig []byte) error {
+
+	valid, err := verifyNodeSig(
+		identityKey, ChallengeMsg(challenge, binding), sig,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to parse challenge signature: %w",
+			err)
+	}
+
+	if !valid {
+		return ErrInvalidChallengeSig
+	}
+
+	return nil
+}
+
+// verifyNodeSig returns true if the given signature over the message was
+// created by the given node key. The signature is expected in the 64-byte wire
+// format that lnd's SignMessage returns, which signs the single SHA256 hash of
+// the message.
+func verifyNodeSig(nodeKey *btcec.PublicKey, msg, sig []byte) (bool, error) {
+	wireSig, err := lnwire.NewSigFromWireECDSA(sig)
+	if err != nil {
+		return false, err
+	}
+
+	ecdsaSig, err := wireSig.ToSignature()
+	if err != nil {
+		return false, err
+	}
+
+	return ecdsaSig.Verify(chainhash.HashB(msg), nodeKey), nil
+}
+
+// IsBanned returns true if a universe server with the given reputation score
+// is banned from syncing.
+func IsBanned(score, banThreshold int32) bool {
+	return score < banThreshold
+}
+
+// checkServerBan returns ErrServerBanned if the reputation score of the given
+// server fell below the ban threshold. Servers that aren't part of the
+// federation don't have a reputation and are never banned.
+func checkServerBan(ctx context.Context, reputationLog FederationReputationLog,
+	addr ServerAddr, banThreshold int32) error {
+
+	if reputationLog == nil {
+		return nil
+	}
+
+	reputations, err := reputationLog.QueryServerReputations(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("unable to query reputation of server=%v: %w",
+			addr.HostStr(), err)
+	}
+
+	for _, reputation := range reputations {
+		if IsBanned(reputation.Score, banThreshold) {
+			return fmt.Errorf("%w: server=%v, score=%d",
+				ErrServerBanned, addr.HostStr(),
+				reputation.Score)
+		}
+	}
+
+	return nil
+}
+
+// updateServerReputation updates the reputation of the given server based on
+// the result of an interaction with it. The reputation is lowered if the server
+// served invalid proofs, and raised if there was no error at all. Any other
+// errors might not be the fault of the server, so they're ignored.
+func updateServerReputation(ctx context.Context,
+	reputationLog FederationReputationLog, addr ServerAddr, err error) {
+
+	if reputationLog == nil {
+		return
+	}
+
+	var dbErr error
+	switch {
+	case err == nil:
+		dbErr = reputationLog.RewardServer(ctx, addr, SyncSuccessReward)
+
+	// If we stopped the interaction ourselves, then the proof might not
+	// have been verified completely.
+	case errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded):
+
+		return
+
+	case errors.Is(err, ErrInvalidProof):
+		log.Warnf("Server=%v served invalid proofs, lowering its "+
+			"reputation: %v", addr.HostStr(), err)
+
+		dbErr = reputationLog.LogServerFailure(
+			ctx, addr, err.Error(), InvalidProofPenalty,
+		)
+
+	default:
+		return
+	}
+
+	if dbErr != nil {
+		log.Warnf("Unable to update reputation of server=%v: %v",
+			addr.HostStr(), dbErr)
+	}
+}
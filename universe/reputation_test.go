@@ -0,0 +1,157 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVerifyChallengeSig tests that a challenge signed the way lnd signs
+// messages can be verified against the identity key of the signer and the TLS
+// channel binding it was signed for only.
+func TestVerifyChallengeSig(t *testing.T) {
+	t.Parallel()
+
+	privKey := test.RandPrivKey()
+
+	var (
+		challenge [ChallengeSize]byte
+		binding   [ChannelBindingSize]byte
+	)
+	copy(challenge[:], test.RandBytes(ChallengeSize))
+	copy(binding[:], test.RandBytes(ChannelBindingSize))
+
+	digest := chainhash.HashB(ChallengeMsg(challenge, binding))
+	wireSig, err := lnwire.NewSigFromSignature(
+		ecdsa.Sign(privKey, digest),
+	)
+	require.NoError(t, err)
+	sig := wireSig.RawBytes()
+
+	err = VerifyChallengeSig(privKey.PubKey(), challenge, binding, sig)
+	require.NoError(t, err)
+
+	// The signature isn't valid for any other key or challenge.
+	err = VerifyChallengeSig(test.RandPubKey(t), challenge, binding, sig)
+	require.ErrorIs(t, err, ErrInvalidChallengeSig)
+
+	var otherChallenge [ChallengeSize]byte
+	err = VerifyChallengeSig(privKey.PubKey(), otherChallenge, binding, sig)
+	require.ErrorIs(t, err, ErrInvalidChallengeSig)
+
+	// A signature that was relayed from a different TLS session isn't
+	// valid either.
+	var otherBinding [ChannelBindingSize]byte
+	err = VerifyChallengeSig(privKey.PubKey(), challenge, otherBinding, sig)
+	require.ErrorIs(t, err, ErrInvalidChallengeSig)
+
+	// Signatures that aren't in the wire format are rejected.
+	err = VerifyChallengeSig(privKey.PubKey(), challenge, binding, sig[1:])
+	require.Error(t, err)
+}
+
+// mockReputationLog is a reputation log that keeps the reputation of a single
+// server in memory.
+type mockReputationLog struct {
+	score int32
+
+	failures []ServerFailure
+}
+
+func (m *mockReputationLog) QueryServerReputations(_ context.Context,
+	addrs ...ServerAddr) ([]ServerReputation, error) {
+
+	return []ServerReputation{{
+		Addr:     addrs[0],
+		Score:    m.score,
+		Failures: m.failures,
+	}}, nil
+}
+
+func (m *mockReputationLog) LogServerFailure(_ context.Context, _ ServerAddr,
+	reason string, penalty int32) error {
+
+	m.score -= penalty
+	m.failures = append(m.failures, ServerFailure{
+		Reason: reason,
+	})
+
+	return nil
+}
+
+func (m *mockReputationLog) RewardServer(_ context.Context, _ ServerAddr,
+	reward int32) error {
+
+	m.score = min(m.score+reward, MaxReputationScore)
+
+	return nil
+}
+
+var _ FederationReputationLog = (*mockReputationLog)(nil)
+
+// TestSyncServerBan tests that servers lose reputation when serving invalid
+// proofs, and are banned from syncing once their score falls below the ban
+// threshold.
+func TestSyncServerBan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	addr := NewServerAddrFromStr("localhost:10029")
+	reputationLog := &mockReputationLog{
+		score: MaxReputationScore,
+	}
+
+	// Only errors caused by invalid proofs lower the reputation, other
+	// errors might not be the fault of the server.
+	updateServerReputation(ctx, reputationLog, addr, context.Canceled)
+	updateServerReputation(ctx, reputationLog, addr, ErrNoUniverseRoot)
+	require.Equal(t, MaxReputationScore, reputationLog.score)
+
+	for i := 0; i < 3; i++ {
+		updateServerReputation(
+			ctx, reputationLog, addr, ErrInvalidProof,
+		)
+	}
+	require.Equal(
+		t, MaxReputationScore-3*InvalidProofPenalty,
+		reputationLog.score,
+	)
+	require.Len(t, reputationLog.failures, 3)
+
+	// The server is now below the default threshold, so we don't even try
+	// to connect to it.
+	var numConnects int
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		NewRemoteDiffEngine: func(ServerAddr) (DiffEngine, error) {
+			numConnects++
+			return newMockDiffEngine(), nil
+		},
+		ReputationLog: reputationLog,
+		BanThreshold:  DefaultBanThreshold,
+	})
+
+	_, err := syncer.SyncUniverse(ctx, addr, SyncFull, SyncConfigs{})
+	require.ErrorIs(t, err, ErrServerBanned)
+	require.Zero(t, numConnects)
+
+	// Successful syncs slowly restore the reputation, but a banned server
+	// isn't synced with anymore, so it stays banned.
+	updateServerReputation(ctx, reputationLog, addr, nil)
+	require.Equal(
+		t, MaxReputationScore-3*InvalidProofPenalty+SyncSuccessReward,
+		reputationLog.score,
+	)
+	require.True(t, IsBanned(reputationLog.score, DefaultBanThreshold))
+
+	// A ban threshold of zero disables bans.
+	syncer.cfg.BanThreshold = 0
+
+	_, err = syncer.SyncUniverse(ctx, addr, SyncFull, SyncConfigs{})
+	require.NoError(t, err)
+	require.Equal(t, 1, numConnects)
+}
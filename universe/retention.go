@@ -0,0 +1,396 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// ErrLeafNotRetained is returned when a leaf is inserted into a universe that
+// the retention policy would prune right away.
+var ErrLeafNotRetained = errors.New("leaf not retained by universe " +
+	"retention policy")
+
+// RetentionPolicy describes which leaves of the local transfer universes are
+// pruned. Issuance universes are never pruned, as they're needed to verify
+// the supply of an asset and every proof of it.
+type RetentionPolicy struct {
+	// TransferProofMaxAge is the number of blocks after which a transfer
+	// proof is pruned, counted from the block its anchor transaction
+	// confirmed in. If this is zero, transfer proofs are never pruned
+	// because of their age. Proofs of assets that weren't spent yet are
+	// kept regardless, as they're needed to verify the next transfer.
+	TransferProofMaxAge uint32
+
+	// MaxLeavesPerAsset is the maximum number of leaves that are kept in
+	// the transfer universe of an asset ID or asset group. If a universe
+	// has more leaves, the oldest spent ones are pruned. If this is zero,
+	// the number of leaves isn't capped.
+	MaxLeavesPerAsset uint64
+
+	// AllowedAssets is the set of assets whose transfer universes are
+	// never pruned.
+	AllowedAssets []MultiverseLeafDesc
+
+	// SpamAssets is the set of assets of which only the issuance universe
+	// is kept. Their transfer universes are removed entirely.
+	SpamAssets []MultiverseLeafDesc
+}
+
+// IsEmpty returns true if the policy doesn't prune any leaves.
+func (p *RetentionPolicy) IsEmpty() bool {
+	return p.TransferProofMaxAge == 0 && p.MaxLeavesPerAsset == 0 &&
+		len(p.SpamAssets) == 0
+}
+
+// pruneQuery returns the query that applies the policy to the transfer
+// universe with the given ID at the given block height, or None if the policy
+// doesn't prune the universe.
+func (p *RetentionPolicy) pruneQuery(id Identifier,
+	height uint32) fn.Option[PruneQuery] {
+
+	if id.ProofType != ProofTypeTransfer ||
+		matchesLeafDesc(id, p.AllowedAssets) {
+
+		return fn.None[PruneQuery]()
+	}
+
+	if matchesLeafDesc(id, p.SpamAssets) {
+		return fn.Some(PruneQuery{
+			ID:       id,
+			PruneAll: true,
+		})
+	}
+
+	query := PruneQuery{
+		ID:        id,
+		MaxLeaves: p.MaxLeavesPerAsset,
+	}
+	if p.TransferProofMaxAge != 0 && height > p.TransferProofMaxAge {
+		query.MinBlockHeight = height - p.TransferProofMaxAge
+	}
+
+	if query.MinBlockHeight == 0 && query.MaxLeaves == 0 {
+		return fn.None[PruneQuery]()
+	}
+
+	return fn.Some(query)
+}
+
+// matchesLeafDesc returns true if the universe with the given ID belongs to
+// any of the given assets.
+func matchesLeafDesc(id Identifier, descs []MultiverseLeafDesc) bool {
+	for _, desc := range descs {
+		var matches bool
+		desc.WhenLeft(func(assetID asset.ID) {
+			matches = id.GroupKey == nil && id.AssetID == assetID
+		})
+		desc.WhenRight(func(groupKey btcec.PublicKey) {
+			matches = id.GroupKey != nil && bytes.Equal(
+				schnorr.SerializePubKey(id.GroupKey),
+				schnorr.SerializePubKey(&groupKey),
+			)
+		})
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PruneQuery describes which leaves to prune from a universe. Unless the
+// universe is removed entirely, a leaf is only pruned if the asset it proves
+// was spent by another leaf of the universe. Otherwise, a later transfer of
+// the asset couldn't be verified anymore, as its previous proof is missing.
+type PruneQuery struct {
+	// ID is the ID of the universe to prune.
+	ID Identifier
+
+	// MinBlockHeight is the lowest block height of the anchor transaction
+	// of a proof that is kept. All spent leaves with a proof anchored in
+	// an earlier block are pruned. If this is zero, leaves aren't pruned
+	// because of their age.
+	MinBlockHeight uint32
+
+	// MaxLeaves is the maximum number of leaves that are kept. If the
+	// universe has more leaves, the oldest spent ones are pruned, so more
+	// leaves can be left if they weren't spent yet. If this is zero, the
+	// number of leaves isn't capped.
+	MaxLeaves uint64
+
+	// PruneAll indicates that the universe should be removed entirely.
+	PruneAll bool
+}
+
+// PruneResult is the summary of the leaves removed by pruning.
+type PruneResult struct {
+	// MinKeptHeight is the block height of the oldest leaf that is kept
+	// in the universe after the pruned ones, if any leaves were pruned
+	// from it. Older leaves that are kept weren't spent yet. This is only
+	// set for the result of a single universe.
+	MinKeptHeight uint32
+
+	// NumLeaves is the number of leaves that were removed.
+	NumLeaves uint64
+
+	// NumBytes is the total size of the proofs of the removed leaves.
+	NumBytes uint64
+
+	// NumUniverses is the number of universes that were removed entirely,
+	// because no leaves were left in them.
+	NumUniverses uint64
+}
+
+// Add adds the counts of the other result to this one.
+func (r *PruneResult) Add(other PruneResult) {
+	r.NumLeaves += other.NumLeaves
+	r.NumBytes += other.NumBytes
+	r.NumUniverses += other.NumUniverses
+}
+
+// RetentionChecker is used to check whether a leaf would be kept by the
+// retention policy of the local universes, so pruned leaves aren't inserted
+// again by the syncer or a proof push.
+type RetentionChecker interface {
+	// RetainsLeaf returns true if a leaf of the universe with the given
+	// ID, whose proof is anchored in the block with the given height,
+	// would be kept by the retention policy.
+	RetainsLeaf(id Identifier, blockHeight uint32) bool
+}
+
+// retainsUniverse returns true if the retention checker keeps any leaves of
+// the universe with the given ID. A nil checker keeps all universes.
+func retainsUniverse(checker RetentionChecker, id Identifier) bool {
+	// A leaf of the latest possible block is only dropped if the
+	// universe is pruned entirely.
+	return checker == nil || checker.RetainsLeaf(id, math.MaxUint32)
+}
+
+// LeafPruner is used to remove leaves from the local universe trees.
+type LeafPruner interface {
+	// PruneLeaves removes the leaves selected by the query from the
+	// universe and updates its multiverse leaf. If no leaves are left, the
+	// universe is removed entirely.
+	PruneLeaves(ctx context.Context, q PruneQuery) (PruneResult, error)
+}
+
+// PrunerConfig is the main config for the universe pruner.
+type PrunerConfig struct {
+	// Policy is the retention policy that's applied.
+	Policy RetentionPolicy
+
+	// Multiverse is the local multiverse whose universes are pruned.
+	Multiverse MultiverseArchive
+
+	// LeafPruner is used to remove the leaves from the universes.
+	LeafPruner LeafPruner
+
+	// CurrentHeight returns the current height of the main chain.
+	CurrentHeight func(context.Context) (uint32, error)
+
+	// PruneInterval is the interval at which the policy is applied. If
+	// this is zero, the universes are never pruned.
+	PruneInterval time.Duration
+}
+
+// Pruner periodically applies a retention policy to the local universes, to
+// keep a public universe server from growing forever.
+type Pruner struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *PrunerConfig
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// height is the block height the policy was last applied at.
+	height uint32
+
+	// minHeights maps the namespace of each pruned universe to the block
+	// height of the oldest leaf that was kept in it. Older leaves are
+	// rejected on insertion, as they'd be pruned again.
+	minHeights map[string]uint32
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewPruner creates a new universe pruner with the given config.
+func NewPruner(cfg *PrunerConfig) *Pruner {
+	return &Pruner{
+		cfg:        cfg,
+		minHeights: make(map[string]uint32),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts applying the retention policy in the background.
+func (p *Pruner) Start() error {
+	p.startOnce.Do(func() {
+		if p.cfg.PruneInterval == 0 || p.cfg.Policy.IsEmpty() {
+			log.Infof("Universe pruning disabled")
+			return
+		}
+
+		log.Infof("Starting universe pruner, interval=%v",
+			p.cfg.PruneInterval)
+
+		p.Wg.Add(1)
+		go p.pruneLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the universe pruner.
+func (p *Pruner) Stop() error {
+	p.stopOnce.Do(func() {
+		log.Info("Stopping universe pruner")
+
+		close(p.Quit)
+		p.Wg.Wait()
+	})
+
+	return nil
+}
+
+// pruneLoop applies the retention policy right away, and then each time the
+// prune interval elapsed. Applying it on startup restores the heights of the
+// oldest kept leaves, which aren't persisted.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Pruner) pruneLoop() {
+	defer p.Wg.Done()
+
+	ticker := time.NewTicker(p.cfg.PruneInterval)
+	defer ticker.Stop()
+
+	prune := func() {
+		ctx, cancel := p.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		if _, err := p.PruneUniverses(ctx); err != nil {
+			log.Warnf("Unable to prune universes: %v", err)
+		}
+	}
+
+	prune()
+	for {
+		select {
+		case <-ticker.C:
+			prune()
+
+		case <-p.Quit:
+			return
+		}
+	}
+}
+
+// RetainsLeaf returns true if a leaf of the universe with the given ID, whose
+// proof is anchored in the block with the given height, would be kept by the
+// retention policy. Leaves that are older than the oldest leaf kept by the
+// last pruning run are dropped as well, as the leaf cap would prune them.
+//
+// NOTE: This is part of the RetentionChecker interface.
+func (p *Pruner) RetainsLeaf(id Identifier, blockHeight uint32) bool {
+	if p.cfg.PruneInterval == 0 {
+		return true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	query := p.cfg.Policy.pruneQuery(id, p.height)
+	if query.IsNone() {
+		return true
+	}
+
+	q := query.UnwrapToPtr()
+	if q.PruneAll || blockHeight < q.MinBlockHeight {
+		return false
+	}
+
+	return blockHeight >= p.minHeights[id.String()]
+}
+
+// PruneUniverses applies the retention policy to all local transfer
+// universes once, and returns the summary of the removed leaves.
+func (p *Pruner) PruneUniverses(ctx context.Context) (*PruneResult, error) {
+	policy := &p.cfg.Policy
+
+	var height uint32
+	if policy.TransferProofMaxAge != 0 {
+		var err error
+		height, err = p.cfg.CurrentHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch current "+
+				"height: %w", err)
+		}
+	}
+
+	leaves, err := p.cfg.Multiverse.FetchLeaves(
+		ctx, nil, ProofTypeTransfer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch transfer universes: "+
+			"%w", err)
+	}
+
+	p.mu.Lock()
+	p.height = height
+	p.mu.Unlock()
+
+	var result PruneResult
+	for _, leaf := range leaves {
+		query := policy.pruneQuery(leaf.ID, height)
+		if query.IsNone() {
+			continue
+		}
+
+		q := query.UnwrapToPtr()
+		uniResult, err := p.cfg.LeafPruner.PruneLeaves(ctx, *q)
+		if err != nil {
+			return nil, fmt.Errorf("unable to prune universe %v: "+
+				"%w", leaf.ID.StringForLog(), err)
+		}
+
+		p.mu.Lock()
+		p.minHeights[leaf.ID.String()] = max(
+			q.MinBlockHeight, uniResult.MinKeptHeight,
+		)
+		p.mu.Unlock()
+
+		if uniResult.NumLeaves != 0 {
+			log.Debugf("Pruned %d leaves (%d bytes) from "+
+				"universe %v", uniResult.NumLeaves,
+				uniResult.NumBytes, leaf.ID.StringForLog())
+		}
+
+		result.Add(uniResult)
+	}
+
+	log.Infof("Pruned %d universe leaves (%d bytes) and %d empty "+
+		"universes", result.NumLeaves, result.NumBytes,
+		result.NumUniverses)
+
+	return &result, nil
+}
+
+// A compile-time check to ensure that Pruner meets the RetentionChecker
+// interface.
+var _ RetentionChecker = (*Pruner)(nil)
@@ -0,0 +1,421 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRetentionPolicyPruneQuery tests that the retention policy selects the
+// expected leaves of a universe.
+func TestRetentionPolicyPruneQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		assetID    = asset.RandID(t)
+		spamID     = asset.RandID(t)
+		groupKey   = test.RandPubKey(t)
+		transferID = Identifier{
+			AssetID:   assetID,
+			ProofType: ProofTypeTransfer,
+		}
+		spamTransferID = Identifier{
+			AssetID:   spamID,
+			ProofType: ProofTypeTransfer,
+		}
+		groupTransferID = Identifier{
+			GroupKey:  groupKey,
+			ProofType: ProofTypeTransfer,
+		}
+	)
+
+	// Group keys are matched by their x-only serialization, so a key
+	// parsed from its x-only encoding must match as well.
+	xOnlyGroupKey, err := schnorr.ParsePubKey(
+		schnorr.SerializePubKey(groupKey),
+	)
+	require.NoError(t, err)
+
+	policy := RetentionPolicy{
+		TransferProofMaxAge: 100,
+		MaxLeavesPerAsset:   10,
+		AllowedAssets: []MultiverseLeafDesc{
+			fn.NewRight[asset.ID](*xOnlyGroupKey),
+		},
+		SpamAssets: []MultiverseLeafDesc{
+			fn.NewLeft[asset.ID, btcec.PublicKey](spamID),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		policy   RetentionPolicy
+		id       Identifier
+		height   uint32
+		expected fn.Option[PruneQuery]
+	}{{
+		name:   "issuance universe",
+		policy: policy,
+		id: Identifier{
+			AssetID:   spamID,
+			ProofType: ProofTypeIssuance,
+		},
+		height:   1000,
+		expected: fn.None[PruneQuery](),
+	}, {
+		name:     "allowed asset group",
+		policy:   policy,
+		id:       groupTransferID,
+		height:   1000,
+		expected: fn.None[PruneQuery](),
+	}, {
+		name:   "spam asset",
+		policy: policy,
+		id:     spamTransferID,
+		height: 1000,
+		expected: fn.Some(PruneQuery{
+			ID:       spamTransferID,
+			PruneAll: true,
+		}),
+	}, {
+		name:   "max age and cap",
+		policy: policy,
+		id:     transferID,
+		height: 1000,
+		expected: fn.Some(PruneQuery{
+			ID:             transferID,
+			MinBlockHeight: 900,
+			MaxLeaves:      10,
+		}),
+	}, {
+		name:   "chain younger than max age",
+		policy: policy,
+		id:     transferID,
+		height: 50,
+		expected: fn.Some(PruneQuery{
+			ID:        transferID,
+			MaxLeaves: 10,
+		}),
+	}, {
+		name: "max age only",
+		policy: RetentionPolicy{
+			TransferProofMaxAge: 100,
+		},
+		id:     transferID,
+		height: 50,
+		// The chain is younger than the max age, so nothing is
+		// pruned yet.
+		expected: fn.None[PruneQuery](),
+	}, {
+		name:     "empty policy",
+		id:       transferID,
+		height:   1000,
+		expected: fn.None[PruneQuery](),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := tc.policy.pruneQuery(tc.id, tc.height)
+			require.Equal(t, tc.expected.IsSome(), query.IsSome())
+			if tc.expected.IsNone() {
+				return
+			}
+
+			expected := tc.expected.UnwrapToPtr()
+			actual := query.UnwrapToPtr()
+			require.Equal(t, expected.ID.Key(), actual.ID.Key())
+			require.Equal(
+				t, expected.MinBlockHeight,
+				actual.MinBlockHeight,
+			)
+			require.Equal(t, expected.MaxLeaves, actual.MaxLeaves)
+			require.Equal(t, expected.PruneAll, actual.PruneAll)
+		})
+	}
+}
+
+// mockLeafPruner is a leaf pruner that records the queries it receives and
+// prunes a fixed number of leaves per universe.
+type mockLeafPruner struct {
+	queries []PruneQuery
+
+	result PruneResult
+}
+
+func (m *mockLeafPruner) PruneLeaves(_ context.Context,
+	q PruneQuery) (PruneResult, error) {
+
+	m.queries = append(m.queries, q)
+
+	return m.result, nil
+}
+
+// TestPrunerPruneUniverses tests that the pruner applies the retention policy
+// to all transfer universes and sums up the removed leaves.
+func TestPrunerPruneUniverses(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		keptID   = asset.RandID(t)
+		spamID   = asset.RandID(t)
+		prunedID = asset.RandID(t)
+		leaves   []MultiverseLeaf
+	)
+	for _, assetID := range []asset.ID{keptID, spamID, prunedID} {
+		for _, proofType := range []ProofType{
+			ProofTypeIssuance, ProofTypeTransfer,
+		} {
+			leaves = append(leaves, MultiverseLeaf{
+				ID: Identifier{
+					AssetID:   assetID,
+					ProofType: proofType,
+				},
+				LeafNode: mssmt.NewLeafNode(
+					test.RandBytes(32), 1,
+				),
+			})
+		}
+	}
+
+	leafPruner := &mockLeafPruner{
+		result: PruneResult{
+			NumLeaves: 2,
+			NumBytes:  1000,
+		},
+	}
+	pruner := NewPruner(&PrunerConfig{
+		Policy: RetentionPolicy{
+			TransferProofMaxAge: 100,
+			AllowedAssets: []MultiverseLeafDesc{
+				fn.NewLeft[asset.ID, btcec.PublicKey](keptID),
+			},
+			SpamAssets: []MultiverseLeafDesc{
+				fn.NewLeft[asset.ID, btcec.PublicKey](spamID),
+			},
+		},
+		Multiverse: &mockGossipMultiverse{
+			leaves: leaves,
+		},
+		LeafPruner: leafPruner,
+		CurrentHeight: func(context.Context) (uint32, error) {
+			return 1000, nil
+		},
+	})
+
+	result, err := pruner.PruneUniverses(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &PruneResult{
+		NumLeaves: 4,
+		NumBytes:  2000,
+	}, result)
+
+	// Only the transfer universes of the spam asset and the asset that
+	// isn't allowed are pruned.
+	queries := make(map[asset.ID]PruneQuery)
+	for _, q := range leafPruner.queries {
+		require.Equal(t, ProofTypeTransfer, q.ID.ProofType)
+		queries[q.ID.AssetID] = q
+	}
+	require.Len(t, queries, 2)
+	require.NotContains(t, queries, keptID)
+	require.True(t, queries[spamID].PruneAll)
+	require.False(t, queries[prunedID].PruneAll)
+	require.EqualValues(t, 900, queries[prunedID].MinBlockHeight)
+}
+
+// proofDiffEngine is a diff engine backed by an in-memory universe tree that
+// also serves the proofs of its leaves.
+type proofDiffEngine struct {
+	*mockDiffEngine
+
+	leaves map[[32]byte]*Leaf
+
+	// numProofsFetched is the number of proofs returned so far.
+	numProofsFetched int
+}
+
+// insertLeaf adds a transfer leaf with a proof anchored at the given block
+// height to the universe tree.
+func (p *proofDiffEngine) insertLeaf(t *testing.T, key LeafKey,
+	blockHeight uint32) {
+
+	var rawProof bytes.Buffer
+	_, err := rawProof.Write(proof.PrefixMagicBytes[:])
+	require.NoError(t, err)
+
+	stream, err := tlv.NewStream(proof.BlockHeightRecord(&blockHeight))
+	require.NoError(t, err)
+	require.NoError(t, stream.Encode(&rawProof))
+
+	leaf := &Leaf{
+		Asset:    &asset.Asset{},
+		RawProof: rawProof.Bytes(),
+	}
+
+	uniKey := key.UniverseKey()
+	_, err = p.tree.Insert(
+		context.Background(), uniKey, leaf.SmtLeafNode(),
+	)
+	require.NoError(t, err)
+
+	p.keys[uniKey] = key
+	p.leaves[uniKey] = leaf
+}
+
+func (p *proofDiffEngine) FetchProofLeaf(ctx context.Context, _ Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	p.numProofsFetched++
+
+	uniKey := key.UniverseKey()
+	inclusionProof, err := p.tree.MerkleProof(ctx, uniKey)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := p.tree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Proof{{
+		Leaf:                   p.leaves[uniKey],
+		LeafKey:                key,
+		UniverseRoot:           root,
+		UniverseInclusionProof: inclusionProof,
+	}}, nil
+}
+
+// batchRegistrar is a local registrar that records the keys of the leaves
+// inserted in batches.
+type batchRegistrar struct {
+	mockRegistrar
+
+	batchKeys []LeafKey
+}
+
+func (b *batchRegistrar) UpsertProofLeafBatch(_ context.Context,
+	items []*Item) error {
+
+	for _, item := range items {
+		b.batchKeys = append(b.batchKeys, item.Key)
+	}
+
+	return nil
+}
+
+// TestPrunedLeafNotResynced tests that the leaves pruned by the retention
+// policy aren't synced again from a remote universe.
+func TestPrunedLeafNotResynced(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		prunedID = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeTransfer,
+		}
+		spamID = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeTransfer,
+		}
+	)
+
+	// The leaf cap pruned all leaves of the transfer universe older than
+	// block 950, and the max age all leaves older than block 900.
+	pruner := NewPruner(&PrunerConfig{
+		Policy: RetentionPolicy{
+			TransferProofMaxAge: 100,
+			MaxLeavesPerAsset:   2,
+			SpamAssets: []MultiverseLeafDesc{
+				fn.NewLeft[asset.ID, btcec.PublicKey](
+					spamID.AssetID,
+				),
+			},
+		},
+		Multiverse: &mockGossipMultiverse{
+			leaves: []MultiverseLeaf{{
+				ID: prunedID,
+				LeafNode: mssmt.NewLeafNode(
+					test.RandBytes(32), 2,
+				),
+			}},
+		},
+		LeafPruner: &mockLeafPruner{
+			result: PruneResult{
+				NumLeaves:     3,
+				MinKeptHeight: 950,
+			},
+		},
+		CurrentHeight: func(context.Context) (uint32, error) {
+			return 1000, nil
+		},
+		PruneInterval: time.Hour,
+	})
+	_, err := pruner.PruneUniverses(ctx)
+	require.NoError(t, err)
+
+	require.False(t, pruner.RetainsLeaf(prunedID, 899))
+	require.False(t, pruner.RetainsLeaf(prunedID, 949))
+	require.True(t, pruner.RetainsLeaf(prunedID, 950))
+	require.False(t, pruner.RetainsLeaf(spamID, 1000))
+
+	// Issuance universes are never pruned.
+	issuanceID := prunedID
+	issuanceID.ProofType = ProofTypeIssuance
+	require.True(t, pruner.RetainsLeaf(issuanceID, 1))
+
+	// The remote universe still has the pruned leaves along with a new
+	// one, which is the only leaf that is synced again.
+	remote := &proofDiffEngine{
+		mockDiffEngine: newMockDiffEngine(),
+		leaves:         make(map[[32]byte]*Leaf),
+	}
+	for _, height := range []uint32{880, 920, 940} {
+		remote.insertLeaf(t, randLeafKey(t), height)
+	}
+	newKey := randLeafKey(t)
+	remote.insertLeaf(t, newKey, 990)
+
+	registrar := &batchRegistrar{}
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: newMockDiffEngine(),
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   10,
+		Retention:       pruner,
+	})
+
+	// The root is passed as a computed node, as a remote server would
+	// return it.
+	remoteRoot, err := remote.RootNode(ctx, prunedID)
+	require.NoError(t, err)
+	remoteRoot.Node = mssmt.NewComputedNode(
+		remoteRoot.NodeHash(), remoteRoot.NodeSum(),
+	)
+
+	results := make(chan AssetSyncDiff, 1)
+	err = syncer.syncRoot(ctx, remoteRoot, remote, results)
+	require.NoError(t, err)
+	require.Equal(t, []LeafKey{newKey}, registrar.batchKeys)
+
+	// The transfer universe of a spam asset isn't synced at all.
+	remote.numProofsFetched = 0
+	remoteRoot.ID = spamID
+	err = syncer.syncRoot(ctx, remoteRoot, remote, results)
+	require.NoError(t, err)
+	require.Zero(t, remote.numProofsFetched)
+}
@@ -0,0 +1,892 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/tlvenc"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// SnapshotVersion is the current version of the snapshot format.
+	SnapshotVersion uint32 = 0
+
+	// DefaultSnapshotChunkSize is the default number of leaves that are
+	// written to a single chunk of a snapshot.
+	DefaultSnapshotChunkSize = RequestPageSize
+
+	// snapshotMaxRecordSize is the maximum size of a single record of a
+	// snapshot. A chunk holds up to a page of proofs, so this is in line
+	// with the maximum size of a proof file.
+	snapshotMaxRecordSize = proof.FileMaxSizeBytes
+)
+
+const (
+	snapshotHeaderRecord  uint8 = 0
+	snapshotChunkRecord   uint8 = 1
+	snapshotTrailerRecord uint8 = 2
+
+	snapshotCreationTimeType tlv.Type = 0
+
+	chunkProofTypeType tlv.Type = 0
+	chunkAssetIDType   tlv.Type = 2
+	chunkGroupKeyType  tlv.Type = 4
+	chunkLeavesType    tlv.Type = 6
+	chunkRootHashType  tlv.Type = 8
+	chunkRootSumType   tlv.Type = 10
+
+	leafOutPointType  tlv.Type = 0
+	leafScriptKeyType tlv.Type = 2
+	leafRawProofType  tlv.Type = 4
+
+	trailerIssuanceRootHashType tlv.Type = 0
+	trailerIssuanceRootSumType  tlv.Type = 2
+	trailerTransferRootHashType tlv.Type = 4
+	trailerTransferRootSumType  tlv.Type = 6
+	trailerNumUniversesType     tlv.Type = 8
+	trailerNumLeavesType        tlv.Type = 10
+)
+
+var (
+	// snapshotMagic are the magic bytes every snapshot starts with.
+	snapshotMagic = [4]byte{'t', 'a', 'p', 'u'}
+
+	// ErrSnapshotChecksum is returned when a record of a snapshot doesn't
+	// match its checksum.
+	ErrSnapshotChecksum = errors.New("snapshot record checksum mismatch")
+
+	// ErrSnapshotRootMismatch is returned when the roots that are
+	// recomputed from the leaves of a snapshot don't match the roots that
+	// are stored in it.
+	ErrSnapshotRootMismatch = errors.New("snapshot root mismatch")
+)
+
+// SnapshotSource is the set of universe queries a snapshot is exported from.
+type SnapshotSource interface {
+	// RootNodes returns the roots of the known universes.
+	RootNodes(ctx context.Context, q RootNodesQuery) ([]Root, error)
+
+	// UniverseLeafKeys returns the set of leaf keys for the given
+	// universe.
+	UniverseLeafKeys(ctx context.Context,
+		q UniverseLeafKeysQuery) ([]LeafKey, error)
+
+	// FetchProofLeaf returns a proof leaf for the target key.
+	FetchProofLeaf(ctx context.Context, id Identifier,
+		key LeafKey) ([]*Proof, error)
+}
+
+// SnapshotLeaf is a single universe leaf stored in a snapshot.
+type SnapshotLeaf struct {
+	// Key is the key of the leaf within its universe.
+	Key LeafKey
+
+	// RawProof is the issuance or transfer proof of the leaf.
+	RawProof proof.Blob
+}
+
+// SnapshotChunk is a batch of leaves of a single universe. The leaves of a
+// universe are stored in consecutive chunks, and the last one carries the
+// root of the universe.
+type SnapshotChunk struct {
+	// ID is the identifier of the universe the leaves belong to.
+	ID Identifier
+
+	// Leaves are the leaves of the chunk.
+	Leaves []SnapshotLeaf
+
+	// Root is the root of the universe. This is only set on the last chunk
+	// of a universe.
+	Root mssmt.Node
+}
+
+// SnapshotSummary describes the content of a snapshot.
+type SnapshotSummary struct {
+	// CreatedAt is the time the snapshot was created at.
+	CreatedAt time.Time
+
+	// IssuanceRoot is the root of the issuance multiverse tree.
+	IssuanceRoot mssmt.Node
+
+	// TransferRoot is the root of the transfer multiverse tree.
+	TransferRoot mssmt.Node
+
+	// NumUniverses is the number of universes in the snapshot.
+	NumUniverses uint64
+
+	// NumLeaves is the total number of leaves in the snapshot.
+	NumLeaves uint64
+}
+
+// writeSnapshotRecord writes a record of the given type. The payload is
+// prefixed by its type and length, and followed by the SHA256 checksum of the
+// type and the payload.
+func writeSnapshotRecord(w io.Writer, recordType uint8, payload []byte) error {
+	var header [5]byte
+	header[0] = recordType
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+
+	h := sha256.New()
+	h.Write(header[:1])
+	h.Write(payload)
+
+	for _, b := range [][]byte{header[:], payload, h.Sum(nil)} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSnapshotRecord reads the next record and verifies its checksum. If there
+// are no more records, io.EOF is returned.
+func readSnapshotRecord(r io.Reader) (uint8, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, nil, fmt.Errorf("snapshot truncated: %w", err)
+		}
+
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[1:])
+	if length > snapshotMaxRecordSize {
+		return 0, nil, fmt.Errorf("snapshot record of %d bytes "+
+			"exceeds maximum of %d bytes", length,
+			snapshotMaxRecordSize)
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, fmt.Errorf("snapshot truncated: %w", err)
+	}
+
+	var checksum [sha256.Size]byte
+	if _, err := io.ReadFull(r, checksum[:]); err != nil {
+		return 0, nil, fmt.Errorf("snapshot truncated: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write(header[:1])
+	h.Write(payload)
+	if !bytes.Equal(h.Sum(nil), checksum[:]) {
+		return 0, nil, ErrSnapshotChecksum
+	}
+
+	return header[0], payload, nil
+}
+
+// encodeSnapshotLeaf encodes a single leaf of a snapshot chunk as a TLV
+// stream.
+func encodeSnapshotLeaf(leaf SnapshotLeaf) ([]byte, error) {
+	outPoint := leaf.Key.OutPoint
+	scriptKey := leaf.Key.ScriptKey.PubKey
+	rawProof := []byte(leaf.RawProof)
+
+	return tlvenc.EncodeStream(
+		tlv.MakeStaticRecord(
+			leafOutPointType, &outPoint, 36,
+			asset.OutPointEncoder, asset.OutPointDecoder,
+		),
+		tlv.MakeStaticRecord(
+			leafScriptKeyType, &scriptKey,
+			btcec.PubKeyBytesLenCompressed,
+			asset.CompressedPubKeyEncoder,
+			asset.CompressedPubKeyDecoder,
+		),
+		tlv.MakePrimitiveRecord(leafRawProofType, &rawProof),
+	)
+}
+
+// decodeSnapshotLeaf decodes a single leaf that was encoded with
+// encodeSnapshotLeaf.
+func decodeSnapshotLeaf(b []byte) (SnapshotLeaf, error) {
+	var (
+		outPoint  wire.OutPoint
+		scriptKey *btcec.PublicKey
+		rawProof  []byte
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakeStaticRecord(
+			leafOutPointType, &outPoint, 36,
+			asset.OutPointEncoder, asset.OutPointDecoder,
+		),
+		tlv.MakeStaticRecord(
+			leafScriptKeyType, &scriptKey,
+			btcec.PubKeyBytesLenCompressed,
+			asset.CompressedPubKeyEncoder,
+			asset.CompressedPubKeyDecoder,
+		),
+		tlv.MakePrimitiveRecord(leafRawProofType, &rawProof),
+	)
+	if err != nil {
+		return SnapshotLeaf{}, err
+	}
+	if scriptKey == nil || len(rawProof) == 0 {
+		return SnapshotLeaf{}, fmt.Errorf("incomplete snapshot leaf")
+	}
+
+	return SnapshotLeaf{
+		Key: LeafKey{
+			OutPoint: outPoint,
+			ScriptKey: &asset.ScriptKey{
+				PubKey: scriptKey,
+			},
+		},
+		RawProof: rawProof,
+	}, nil
+}
+
+// encodeSnapshotChunk encodes the given chunk as a TLV stream.
+func encodeSnapshotChunk(c *SnapshotChunk) ([]byte, error) {
+	proofType := uint8(c.ID.ProofType)
+	assetID := [32]byte(c.ID.AssetID)
+	leaves, err := tlvenc.EncodeList(c.Leaves, encodeSnapshotLeaf)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(chunkProofTypeType, &proofType),
+		tlv.MakePrimitiveRecord(chunkAssetIDType, &assetID),
+	}
+	if c.ID.GroupKey != nil {
+		records = append(records, tlv.MakeStaticRecord(
+			chunkGroupKeyType, &c.ID.GroupKey,
+			btcec.PubKeyBytesLenCompressed,
+			asset.CompressedPubKeyEncoder,
+			asset.CompressedPubKeyDecoder,
+		))
+	}
+	records = append(
+		records, tlv.MakePrimitiveRecord(chunkLeavesType, &leaves),
+	)
+	if c.Root != nil {
+		rootHash := [32]byte(c.Root.NodeHash())
+		rootSum := c.Root.NodeSum()
+		records = append(
+			records,
+			tlv.MakePrimitiveRecord(chunkRootHashType, &rootHash),
+			tlv.MakePrimitiveRecord(chunkRootSumType, &rootSum),
+		)
+	}
+
+	return tlvenc.EncodeStream(records...)
+}
+
+// decodeSnapshotChunk decodes a chunk that was encoded with
+// encodeSnapshotChunk.
+func decodeSnapshotChunk(b []byte) (*SnapshotChunk, error) {
+	var (
+		proofType uint8
+		assetID   [32]byte
+		groupKey  *btcec.PublicKey
+		leaves    []byte
+		rootHash  [32]byte
+		rootSum   uint64
+	)
+	parsedTypes, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(chunkProofTypeType, &proofType),
+		tlv.MakePrimitiveRecord(chunkAssetIDType, &assetID),
+		tlv.MakeStaticRecord(
+			chunkGroupKeyType, &groupKey,
+			btcec.PubKeyBytesLenCompressed,
+			asset.CompressedPubKeyEncoder,
+			asset.CompressedPubKeyDecoder,
+		),
+		tlv.MakePrimitiveRecord(chunkLeavesType, &leaves),
+		tlv.MakePrimitiveRecord(chunkRootHashType, &rootHash),
+		tlv.MakePrimitiveRecord(chunkRootSumType, &rootSum),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &SnapshotChunk{
+		ID: Identifier{
+			AssetID:   asset.ID(assetID),
+			GroupKey:  groupKey,
+			ProofType: ProofType(proofType),
+		},
+	}
+	switch chunk.ID.ProofType {
+	case ProofTypeIssuance, ProofTypeTransfer:
+	default:
+		return nil, fmt.Errorf("invalid proof type: %v",
+			chunk.ID.ProofType)
+	}
+
+	chunk.Leaves, err = tlvenc.DecodeList(leaves, decodeSnapshotLeaf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode leaves: %w", err)
+	}
+
+	if _, ok := parsedTypes[chunkRootHashType]; ok {
+		chunk.Root = mssmt.NewComputedNode(rootHash, rootSum)
+	}
+
+	return chunk, nil
+}
+
+// encodeSnapshotTrailer encodes the multiverse roots and the totals of a
+// snapshot as a TLV stream.
+func encodeSnapshotTrailer(s *SnapshotSummary) ([]byte, error) {
+	issuanceHash := [32]byte(s.IssuanceRoot.NodeHash())
+	issuanceSum := s.IssuanceRoot.NodeSum()
+	transferHash := [32]byte(s.TransferRoot.NodeHash())
+	transferSum := s.TransferRoot.NodeSum()
+
+	return tlvenc.EncodeStream(
+		tlv.MakePrimitiveRecord(
+			trailerIssuanceRootHashType, &issuanceHash,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerIssuanceRootSumType, &issuanceSum,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerTransferRootHashType, &transferHash,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerTransferRootSumType, &transferSum,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerNumUniversesType, &s.NumUniverses,
+		),
+		tlv.MakePrimitiveRecord(trailerNumLeavesType, &s.NumLeaves),
+	)
+}
+
+// decodeSnapshotTrailer decodes a trailer that was encoded with
+// encodeSnapshotTrailer into the given summary.
+func decodeSnapshotTrailer(b []byte, s *SnapshotSummary) error {
+	var (
+		issuanceHash, transferHash [32]byte
+		issuanceSum, transferSum   uint64
+	)
+	_, err := tlvenc.DecodeStream(
+		b,
+		tlv.MakePrimitiveRecord(
+			trailerIssuanceRootHashType, &issuanceHash,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerIssuanceRootSumType, &issuanceSum,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerTransferRootHashType, &transferHash,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerTransferRootSumType, &transferSum,
+		),
+		tlv.MakePrimitiveRecord(
+			trailerNumUniversesType, &s.NumUniverses,
+		),
+		tlv.MakePrimitiveRecord(trailerNumLeavesType, &s.NumLeaves),
+	)
+	if err != nil {
+		return err
+	}
+
+	s.IssuanceRoot = mssmt.NewComputedNode(issuanceHash, issuanceSum)
+	s.TransferRoot = mssmt.NewComputedNode(transferHash, transferSum)
+
+	return nil
+}
+
+// fetchSnapshotLeafKeys returns all leaf keys of the given universe.
+func fetchSnapshotLeafKeys(ctx context.Context, src SnapshotSource,
+	id Identifier) ([]LeafKey, error) {
+
+	var (
+		offset   int32
+		leafKeys []LeafKey
+	)
+	for {
+		keys, err := src.UniverseLeafKeys(ctx, UniverseLeafKeysQuery{
+			Id:            id,
+			SortDirection: SortAscending,
+			Offset:        offset,
+			Limit:         defaultPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		leafKeys = append(leafKeys, keys...)
+		if len(keys) < int(defaultPageSize) {
+			return leafKeys, nil
+		}
+
+		offset += defaultPageSize
+	}
+}
+
+// ExportSnapshot writes every leaf of every known universe, along with the
+// universe and multiverse roots, to the given writer. The issuance universes
+// are written first, so the proofs they contain are known when the transfer
+// proofs are verified on import. The roots are computed from the exported
+// leaves, so the snapshot is consistent even if leaves are inserted while it
+// is being created.
+func ExportSnapshot(ctx context.Context, src SnapshotSource, w io.Writer,
+	createdAt time.Time, chunkSize int) (*SnapshotSummary, error) {
+
+	if chunkSize <= 0 {
+		chunkSize = DefaultSnapshotChunkSize
+	}
+
+	var (
+		offset int32
+		roots  []Root
+	)
+	for {
+		page, err := src.RootNodes(ctx, RootNodesQuery{
+			SortDirection: SortAscending,
+			Offset:        offset,
+			Limit:         defaultPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch universe "+
+				"roots: %w", err)
+		}
+
+		roots = append(roots, page...)
+		if len(page) < int(defaultPageSize) {
+			break
+		}
+
+		offset += defaultPageSize
+	}
+
+	sort.SliceStable(roots, func(i, j int) bool {
+		if roots[i].ID.ProofType != roots[j].ID.ProofType {
+			return roots[i].ID.ProofType == ProofTypeIssuance
+		}
+
+		iKey, jKey := roots[i].ID.Bytes(), roots[j].ID.Bytes()
+		return bytes.Compare(iKey[:], jKey[:]) < 0
+	})
+
+	summary := &SnapshotSummary{
+		CreatedAt: createdAt,
+	}
+
+	var preamble [8]byte
+	copy(preamble[:4], snapshotMagic[:])
+	binary.BigEndian.PutUint32(preamble[4:], SnapshotVersion)
+	if _, err := w.Write(preamble[:]); err != nil {
+		return nil, err
+	}
+
+	creationTime := uint64(createdAt.Unix())
+	header, err := tlvenc.EncodeStream(tlv.MakePrimitiveRecord(
+		snapshotCreationTimeType, &creationTime,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = writeSnapshotRecord(w, snapshotHeaderRecord, header)
+	if err != nil {
+		return nil, err
+	}
+
+	writeChunk := func(chunk *SnapshotChunk) error {
+		payload, err := encodeSnapshotChunk(chunk)
+		if err != nil {
+			return fmt.Errorf("unable to encode chunk: %w", err)
+		}
+
+		return writeSnapshotRecord(w, snapshotChunkRecord, payload)
+	}
+
+	multiverseLeaves := make([]MultiverseLeaf, 0, len(roots))
+	for _, root := range roots {
+		id := root.ID
+
+		leafKeys, err := fetchSnapshotLeafKeys(ctx, src, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys of "+
+				"universe %v: %w", id.String(), err)
+		}
+
+		// An empty universe doesn't have a leaf in the multiverse
+		// tree, so we don't export it either.
+		if len(leafKeys) == 0 {
+			continue
+		}
+
+		tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		chunk := &SnapshotChunk{
+			ID: id,
+		}
+		for _, key := range leafKeys {
+			proofs, err := src.FetchProofLeaf(ctx, id, key)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch leaf "+
+					"of universe %v: %w", id.String(), err)
+			}
+			if len(proofs) != 1 {
+				return nil, fmt.Errorf("expected one leaf "+
+					"for key, got %d", len(proofs))
+			}
+
+			leaf := proofs[0].Leaf
+			_, err = tree.Insert(
+				ctx, key.UniverseKey(), leaf.SmtLeafNode(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			chunk.Leaves = append(chunk.Leaves, SnapshotLeaf{
+				Key:      key,
+				RawProof: leaf.RawProof,
+			})
+			if len(chunk.Leaves) < chunkSize {
+				continue
+			}
+
+			if err := writeChunk(chunk); err != nil {
+				return nil, err
+			}
+			chunk = &SnapshotChunk{
+				ID: id,
+			}
+		}
+
+		chunk.Root, err = tree.Root(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeChunk(chunk); err != nil {
+			return nil, err
+		}
+
+		multiverseLeaves = append(multiverseLeaves, MultiverseLeaf{
+			ID:       id,
+			LeafNode: multiverseLeafNode(id, chunk.Root),
+		})
+		summary.NumUniverses++
+		summary.NumLeaves += uint64(len(leafKeys))
+
+		log.Debugf("Exported %d leaves of universe %v to snapshot",
+			len(leafKeys), id.StringForLog())
+	}
+
+	err = setSnapshotMultiverseRoots(ctx, summary, multiverseLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	trailer, err := encodeSnapshotTrailer(summary)
+	if err != nil {
+		return nil, err
+	}
+	err = writeSnapshotRecord(w, snapshotTrailerRecord, trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// setSnapshotMultiverseRoots sets the multiverse roots of the summary to the
+// roots of the multiverse trees built from the given leaves.
+func setSnapshotMultiverseRoots(ctx context.Context, s *SnapshotSummary,
+	leaves []MultiverseLeaf) error {
+
+	var err error
+	s.IssuanceRoot, s.TransferRoot, err = MultiverseRoots(ctx, leaves)
+
+	return err
+}
+
+// snapshotItem is a leaf of a snapshot that is ready to be inserted, along with
+// the block height of its proof.
+type snapshotItem struct {
+	*Item
+
+	blockHeight uint32
+}
+
+// newSnapshotItem decodes the asset and the block height from the proof of the
+// given snapshot leaf.
+func newSnapshotItem(id Identifier, leaf SnapshotLeaf) (*snapshotItem, error) {
+	var (
+		proofAsset  asset.Asset
+		blockHeight uint32
+	)
+	err := proof.SparseDecode(
+		bytes.NewReader(leaf.RawProof),
+		proof.AssetLeafRecord(&proofAsset),
+		proof.BlockHeightRecord(&blockHeight),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	return &snapshotItem{
+		Item: &Item{
+			ID:  id,
+			Key: leaf.Key,
+			Leaf: &Leaf{
+				GenesisWithGroup: GenesisWithGroup{
+					Genesis:  proofAsset.Genesis,
+					GroupKey: proofAsset.GroupKey,
+				},
+				RawProof: leaf.RawProof,
+				Asset:    &proofAsset,
+				Amt:      proofAsset.Amount,
+			},
+		},
+		blockHeight: blockHeight,
+	}, nil
+}
+
+// importSnapshotUniverse inserts the leaves of a single universe of a snapshot
+// in batches of the given size. The leaves are sorted by the block height of
+// their proofs, so the proofs of transfers are inserted after the proofs of
+// the assets they spend.
+func importSnapshotUniverse(ctx context.Context, registrar BatchRegistrar,
+	items []*snapshotItem, batchSize int) error {
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].blockHeight < items[j].blockHeight
+	})
+
+	for start := 0; start < len(items); start += batchSize {
+		end := min(start+batchSize, len(items))
+
+		batch := make([]*Item, 0, end-start)
+		for _, item := range items[start:end] {
+			batch = append(batch, item.Item)
+		}
+
+		err := registrar.UpsertProofLeafBatch(ctx, batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportSnapshot reads a snapshot that was written by ExportSnapshot and
+// inserts its leaves through the given registrar, which verifies the proofs in
+// parallel. The leaves of each universe are only inserted once the root
+// recomputed from them matches the universe root stored in the snapshot. The
+// multiverse roots are checked once all universes were read, which detects
+// universes that are missing from the snapshot.
+func ImportSnapshot(ctx context.Context, r io.Reader,
+	registrar BatchRegistrar) (*SnapshotSummary, error) {
+
+	var preamble [8]byte
+	if _, err := io.ReadFull(r, preamble[:]); err != nil {
+		return nil, fmt.Errorf("unable to read snapshot preamble: %w",
+			err)
+	}
+	if !bytes.Equal(preamble[:4], snapshotMagic[:]) {
+		return nil, fmt.Errorf("invalid snapshot magic bytes: %x",
+			preamble[:4])
+	}
+	version := binary.BigEndian.Uint32(preamble[4:])
+	if version > SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d",
+			version)
+	}
+
+	recordType, payload, err := readSnapshotRecord(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read snapshot header: %w",
+			err)
+	}
+	if recordType != snapshotHeaderRecord {
+		return nil, fmt.Errorf("expected snapshot header, got record "+
+			"type %d", recordType)
+	}
+
+	var creationTime uint64
+	_, err = tlvenc.DecodeStream(payload, tlv.MakePrimitiveRecord(
+		snapshotCreationTimeType, &creationTime,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode snapshot header: %w",
+			err)
+	}
+
+	var (
+		computed = &SnapshotSummary{
+			CreatedAt: time.Unix(int64(creationTime), 0),
+		}
+		multiverseLeaves []MultiverseLeaf
+		seen             = make(map[IdentifierKey]struct{})
+
+		// The universe whose chunks are currently being read, along
+		// with its leaves read so far.
+		current *Identifier
+		tree    mssmt.Tree
+		items   []*snapshotItem
+	)
+	for {
+		recordType, payload, err := readSnapshotRecord(r)
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("snapshot truncated: missing " +
+				"trailer")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if recordType == snapshotTrailerRecord {
+			if current != nil {
+				return nil, fmt.Errorf("universe %v is "+
+					"incomplete", current.String())
+			}
+
+			var expected SnapshotSummary
+			err := decodeSnapshotTrailer(payload, &expected)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"snapshot trailer: %w", err)
+			}
+
+			err = setSnapshotMultiverseRoots(
+				ctx, computed, multiverseLeaves,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if !mssmt.IsEqualNode(
+				computed.IssuanceRoot, expected.IssuanceRoot,
+			) || !mssmt.IsEqualNode(
+				computed.TransferRoot, expected.TransferRoot,
+			) {
+
+				return nil, fmt.Errorf("%w: multiverse roots "+
+					"don't match the imported universes",
+					ErrSnapshotRootMismatch)
+			}
+			if computed.NumUniverses != expected.NumUniverses ||
+				computed.NumLeaves != expected.NumLeaves {
+
+				return nil, fmt.Errorf("snapshot contains "+
+					"%d universes with %d leaves, "+
+					"expected %d with %d",
+					computed.NumUniverses,
+					computed.NumLeaves,
+					expected.NumUniverses,
+					expected.NumLeaves)
+			}
+
+			// Anything after the trailer can only be the result of
+			// corruption.
+			var extra [1]byte
+			if n, _ := r.Read(extra[:]); n != 0 {
+				return nil, fmt.Errorf("unexpected data " +
+					"after snapshot trailer")
+			}
+
+			return computed, nil
+		}
+
+		if recordType != snapshotChunkRecord {
+			return nil, fmt.Errorf("unknown snapshot record type "+
+				"%d", recordType)
+		}
+
+		chunk, err := decodeSnapshotChunk(payload)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode snapshot "+
+				"chunk: %w", err)
+		}
+
+		// The chunks of a universe need to be consecutive, so we can
+		// verify its root before inserting any of its leaves.
+		if current != nil && !current.IsEqual(chunk.ID) {
+			return nil, fmt.Errorf("universe %v is incomplete",
+				current.String())
+		}
+		if current == nil {
+			if _, ok := seen[chunk.ID.Key()]; ok {
+				return nil, fmt.Errorf("duplicate universe %v "+
+					"in snapshot", chunk.ID.String())
+			}
+
+			current = &chunk.ID
+			tree = mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+			items = nil
+		}
+
+		for _, leaf := range chunk.Leaves {
+			item, err := newSnapshotItem(chunk.ID, leaf)
+			if err != nil {
+				return nil, fmt.Errorf("invalid leaf in "+
+					"universe %v: %w", chunk.ID.String(),
+					err)
+			}
+
+			_, err = tree.Insert(
+				ctx, leaf.Key.UniverseKey(),
+				item.Leaf.SmtLeafNode(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, item)
+		}
+
+		if chunk.Root == nil {
+			continue
+		}
+
+		root, err := tree.Root(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !mssmt.IsEqualNode(root, chunk.Root) {
+			return nil, fmt.Errorf("%w: leaves don't match root "+
+				"of universe %v", ErrSnapshotRootMismatch,
+				chunk.ID.String())
+		}
+
+		err = importSnapshotUniverse(
+			ctx, registrar, items, DefaultSnapshotChunkSize,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to insert leaves of "+
+				"universe %v: %w", chunk.ID.String(), err)
+		}
+
+		log.Debugf("Imported %d leaves of universe %v from snapshot",
+			len(items), chunk.ID.StringForLog())
+
+		multiverseLeaves = append(multiverseLeaves, MultiverseLeaf{
+			ID:       chunk.ID,
+			LeafNode: multiverseLeafNode(chunk.ID, root),
+		})
+		seen[chunk.ID.Key()] = struct{}{}
+		computed.NumUniverses++
+		computed.NumLeaves += uint64(len(items))
+
+		current = nil
+		tree = nil
+		items = nil
+	}
+}
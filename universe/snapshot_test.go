@@ -0,0 +1,284 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/internal/tlvenc"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// randSnapshotLeaf returns a leaf with a random key and a proof at the given
+// block height. The proof is a transfer proof if transfer is true.
+func randSnapshotLeaf(t *testing.T, gen asset.Genesis, blockHeight uint32,
+	transfer bool) SnapshotLeaf {
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(wire.NewTxIn(&gen.FirstPrevOut, nil, nil))
+	anchorTx.AddTxOut(wire.NewTxOut(1000, test.RandBytes(34)))
+	block := wire.MsgBlock{
+		Transactions: []*wire.MsgTx{anchorTx},
+	}
+
+	leafProof := proof.RandProof(t, gen, test.RandPubKey(t), block, 0, 0)
+	leafProof.BlockHeight = blockHeight
+	if transfer {
+		leafProof.Asset.PrevWitnesses = []asset.Witness{{
+			PrevID: &asset.PrevID{
+				OutPoint: wire.OutPoint{
+					Hash: chainhash.Hash(test.RandHash()),
+				},
+				ID:        gen.ID(),
+				ScriptKey: asset.RandSerializedKey(t),
+			},
+		}}
+	}
+
+	rawProof, err := proof.Encode(&leafProof)
+	require.NoError(t, err)
+
+	return SnapshotLeaf{
+		Key: LeafKey{
+			OutPoint: wire.OutPoint{
+				Hash: chainhash.Hash(test.RandHash()),
+			},
+			ScriptKey: &leafProof.Asset.ScriptKey,
+		},
+		RawProof: rawProof,
+	}
+}
+
+// mockSnapshotSource is a snapshot source backed by in-memory universes.
+type mockSnapshotSource struct {
+	ids    []Identifier
+	leaves map[IdentifierKey][]SnapshotLeaf
+}
+
+func (m *mockSnapshotSource) RootNodes(_ context.Context,
+	q RootNodesQuery) ([]Root, error) {
+
+	var roots []Root
+	for idx, id := range m.ids {
+		if idx < int(q.Offset) || len(roots) == int(q.Limit) {
+			continue
+		}
+
+		roots = append(roots, Root{
+			ID: id,
+		})
+	}
+
+	return roots, nil
+}
+
+func (m *mockSnapshotSource) UniverseLeafKeys(_ context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	var keys []LeafKey
+	for idx, leaf := range m.leaves[q.Id.Key()] {
+		if idx < int(q.Offset) || len(keys) == int(q.Limit) {
+			continue
+		}
+
+		keys = append(keys, leaf.Key)
+	}
+
+	return keys, nil
+}
+
+func (m *mockSnapshotSource) FetchProofLeaf(_ context.Context, id Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	for _, leaf := range m.leaves[id.Key()] {
+		if leaf.Key.UniverseKey() != key.UniverseKey() {
+			continue
+		}
+
+		item, err := newSnapshotItem(id, leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*Proof{{
+			Leaf:    item.Leaf,
+			LeafKey: key,
+		}}, nil
+	}
+
+	return nil, ErrNoUniverseProofFound
+}
+
+// mockSnapshotRegistrar is a registrar that records the inserted batches.
+type mockSnapshotRegistrar struct {
+	mockRegistrar
+
+	batches [][]*Item
+}
+
+func (m *mockSnapshotRegistrar) UpsertProofLeafBatch(_ context.Context,
+	items []*Item) error {
+
+	m.batches = append(m.batches, items)
+
+	return nil
+}
+
+// TestSnapshotExportImport tests that the universes of a snapshot are imported
+// in the right order after their roots were verified, and that corrupted and
+// tampered snapshots are rejected.
+func TestSnapshotExportImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	gen := asset.RandGenesis(t, asset.Normal)
+	issuanceID := Identifier{
+		AssetID:   gen.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	transferID := Identifier{
+		AssetID:   gen.ID(),
+		ProofType: ProofTypeTransfer,
+	}
+
+	// The transfer universe is returned first by the source, but needs to
+	// be exported after the issuance universe.
+	src := &mockSnapshotSource{
+		ids: []Identifier{transferID, issuanceID},
+		leaves: map[IdentifierKey][]SnapshotLeaf{
+			issuanceID.Key(): {
+				randSnapshotLeaf(t, gen, 42, false),
+			},
+			transferID.Key(): {
+				randSnapshotLeaf(t, gen, 50, true),
+				randSnapshotLeaf(t, gen, 43, true),
+				randSnapshotLeaf(t, gen, 45, true),
+			},
+		},
+	}
+
+	createdAt := time.Unix(time.Now().Unix(), 0)
+	var snapshot bytes.Buffer
+	exported, err := ExportSnapshot(ctx, src, &snapshot, createdAt, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, exported.NumUniverses)
+	require.EqualValues(t, 4, exported.NumLeaves)
+
+	registrar := &mockSnapshotRegistrar{}
+	imported, err := ImportSnapshot(
+		ctx, bytes.NewReader(snapshot.Bytes()), registrar,
+	)
+	require.NoError(t, err)
+	require.Equal(t, createdAt, imported.CreatedAt)
+	require.True(t, mssmt.IsEqualNode(
+		exported.IssuanceRoot, imported.IssuanceRoot,
+	))
+	require.True(t, mssmt.IsEqualNode(
+		exported.TransferRoot, imported.TransferRoot,
+	))
+	require.Equal(t, exported.NumLeaves, imported.NumLeaves)
+
+	// The issuance universe is inserted first, and the transfers are
+	// inserted in the order of their block heights.
+	require.Len(t, registrar.batches, 2)
+	require.Len(t, registrar.batches[0], 1)
+	require.True(t, registrar.batches[0][0].ID.IsEqual(issuanceID))
+
+	transferLeaves := src.leaves[transferID.Key()]
+	require.Len(t, registrar.batches[1], 3)
+	for idx, leafIdx := range []int{1, 2, 0} {
+		item := registrar.batches[1][idx]
+		require.True(t, item.ID.IsEqual(transferID))
+		require.Equal(
+			t, transferLeaves[leafIdx].Key.UniverseKey(),
+			item.Key.UniverseKey(),
+		)
+		require.EqualValues(
+			t, transferLeaves[leafIdx].RawProof,
+			item.Leaf.RawProof,
+		)
+	}
+
+	// A snapshot of a newer version can't be imported.
+	newer := bytes.Clone(snapshot.Bytes())
+	binary.BigEndian.PutUint32(newer[4:8], SnapshotVersion+1)
+	_, err = ImportSnapshot(
+		ctx, bytes.NewReader(newer), &mockSnapshotRegistrar{},
+	)
+	require.ErrorContains(t, err, "unsupported snapshot version")
+
+	// Any corruption is detected by the checksums. The payload of the first
+	// chunk starts after the preamble, the header record and the type and
+	// length of the chunk record.
+	corrupted := bytes.Clone(snapshot.Bytes())
+	corrupted[8+5+10+sha256.Size+5+10] ^= 0x01
+	registrar = &mockSnapshotRegistrar{}
+	_, err = ImportSnapshot(ctx, bytes.NewReader(corrupted), registrar)
+	require.ErrorIs(t, err, ErrSnapshotChecksum)
+	require.Empty(t, registrar.batches)
+
+	// A truncated snapshot is rejected.
+	truncated := snapshot.Bytes()[:snapshot.Len()-10]
+	_, err = ImportSnapshot(
+		ctx, bytes.NewReader(truncated), &mockSnapshotRegistrar{},
+	)
+	require.ErrorContains(t, err, "snapshot truncated")
+
+	// The leaves of a universe aren't inserted if they don't match the
+	// universe root, even if the checksums of the records are valid.
+	var tampered bytes.Buffer
+	preamble := snapshot.Bytes()[:8]
+	_, err = tampered.Write(preamble)
+	require.NoError(t, err)
+
+	header, err := tlvenc.EncodeStream()
+	require.NoError(t, err)
+	err = writeSnapshotRecord(&tampered, snapshotHeaderRecord, header)
+	require.NoError(t, err)
+
+	chunk, err := encodeSnapshotChunk(&SnapshotChunk{
+		ID:     issuanceID,
+		Leaves: src.leaves[issuanceID.Key()],
+		Root: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()), 1,
+		),
+	})
+	require.NoError(t, err)
+	err = writeSnapshotRecord(&tampered, snapshotChunkRecord, chunk)
+	require.NoError(t, err)
+
+	registrar = &mockSnapshotRegistrar{}
+	_, err = ImportSnapshot(
+		ctx, bytes.NewReader(tampered.Bytes()), registrar,
+	)
+	require.ErrorIs(t, err, ErrSnapshotRootMismatch)
+	require.Empty(t, registrar.batches)
+
+	// A snapshot that is missing a universe doesn't match the multiverse
+	// roots of its trailer.
+	var incomplete bytes.Buffer
+	_, err = incomplete.Write(preamble)
+	require.NoError(t, err)
+	err = writeSnapshotRecord(&incomplete, snapshotHeaderRecord, header)
+	require.NoError(t, err)
+
+	trailer, err := encodeSnapshotTrailer(exported)
+	require.NoError(t, err)
+	err = writeSnapshotRecord(&incomplete, snapshotTrailerRecord, trailer)
+	require.NoError(t, err)
+
+	_, err = ImportSnapshot(
+		ctx, bytes.NewReader(incomplete.Bytes()),
+		&mockSnapshotRegistrar{},
+	)
+	require.ErrorIs(t, err, ErrSnapshotRootMismatch)
+}
@@ -14,10 +14,22 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	defaultPageSize = int32(RequestPageSize)
+
+	// branchDiffDepth is the number of levels of the universe trees that
+	// are compared at once when walking down the trees during a diff,
+	// which corresponds to one byte of the universe keys.
+	branchDiffDepth = 8
+
+	// maxBranchDiffPrefixLen is the length of the key prefix in bytes at
+	// which we stop walking down the universe trees and instead directly
+	// diff the leaf keys below the branch.
+	maxBranchDiffPrefixLen = 3
 )
 
 var (
@@ -44,6 +56,20 @@ type SimpleSyncCfg struct {
 
 	// SyncBatchSize is the number of items to sync in a single batch.
 	SyncBatchSize int
+
+	// ReputationLog is used to look up and update the reputation of the
+	// remote universe servers. If this is nil, the reputation of the
+	// servers isn't tracked.
+	ReputationLog FederationReputationLog
+
+	// BanThreshold is the reputation score below which a remote universe
+	// server is banned from syncing.
+	BanThreshold int32
+
+	// Retention is used to skip the leaves that the universe retention
+	// policy would prune right away, so pruned leaves aren't synced
+	// again. If this is nil, all leaves are synced.
+	Retention RetentionChecker
 }
 
 // SimpleSyncer is a simple implementation of the Syncer interface. It's based
@@ -238,14 +264,25 @@ func fetchRootsForIDs(ctx context.Context, idsToSync []Identifier,
 func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	diffEngine DiffEngine, result chan<- AssetSyncDiff) error {
 
-	// First, we'll compare the remote root against the local root.
+	// Universes that are pruned entirely by the retention policy aren't
+	// synced at all.
 	uniID := remoteRoot.ID
+	if !retainsUniverse(s.cfg.Retention, uniID) {
+		log.Debugf("Skipping sync of UniverseRoot(%v) not retained by "+
+			"retention policy", uniID.String())
+
+		return nil
+	}
+
+	// First, we'll compare the remote root against the local root.
+	haveLocalRoot := true
 	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
 	switch {
 	// If we don't have this root, then we don't have anything to compare
 	// to, so we'll proceed as normal.
 	case errors.Is(err, ErrNoUniverseRoot):
 		// Continue below, we don't have this root locally.
+		haveLocalRoot = false
 
 	// If the local root matches the remote root, then we're done here.
 	case err == nil && mssmt.IsEqualNode(localRoot, remoteRoot):
@@ -262,27 +299,36 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		uniID.String())
 
 	// Otherwise, we'll need to perform a diff operation to find the set of
-	// keys we need to fetch. We'll start by fetching the set of keys from
-	// both the local and remote Universe.
-	var (
-		remoteUniKeys []LeafKey
-		localUniKeys  []LeafKey
-	)
-
-	remoteUniKeys, err = s.fetchAllLeafKeys(ctx, diffEngine, uniID)
-	if err != nil {
-		return err
-	}
-
-	localUniKeys, err = s.fetchAllLeafKeys(ctx, s.cfg.LocalDiffEngine, uniID)
-	if err != nil {
-		return err
+	// keys we need to fetch. If we don't know the universe at all, then
+	// all the remote keys are missing.
+	var keysToFetch []LeafKey
+	if !haveLocalRoot {
+		keysToFetch, err = s.fetchAllLeafKeys(
+			ctx, diffEngine, uniID, nil,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		keysToFetch, err = s.diffBranch(ctx, diffEngine, uniID, nil)
+
+		// Remote universe servers that don't support querying the
+		// branch nodes of their trees yet require us to diff the full
+		// set of leaf keys instead.
+		if status.Code(err) == codes.Unimplemented {
+			log.Debugf("UniverseRoot(%v): remote doesn't support "+
+				"branch diff, diffing all leaf keys",
+				uniID.String())
+
+			keysToFetch, err = s.diffLeafKeys(
+				ctx, diffEngine, uniID, nil,
+			)
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
-
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
 	log.Tracef("UniverseRoot(%v): diff_size=%v, diff=%v", uniID.String(),
@@ -320,8 +366,9 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 			// given.
 			validRoot := leafProof.VerifyRoot(remoteRoot)
 			if !validRoot {
-				return fmt.Errorf("proof for key=%v is "+
-					"invalid", spew.Sdump(key))
+				return fmt.Errorf("%w: proof for key=%v "+
+					"isn't part of the remote root",
+					ErrInvalidProof, spew.Sdump(key))
 			}
 
 			// If this is an issuance proof, then we can send
@@ -366,6 +413,12 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 					Leaf: leafProof.Leaf,
 				}
 			} else {
+				// Transfer leaves that the retention policy
+				// would prune right away are skipped.
+				if !s.retainsLeaf(uniID, leafProof.Leaf) {
+					return nil
+				}
+
 				transferLeafProofs <- &Item{
 					ID:   uniID,
 					Key:  key,
@@ -470,6 +523,26 @@ func hasGroupKeyReveal(rawProof []byte) bool {
 	return dummyProof.GroupKeyReveal != nil
 }
 
+// retainsLeaf returns true if the retention policy keeps the given leaf of the
+// universe with the given ID. Leaves with a proof that can't be decoded are
+// kept, so their verification fails on insertion.
+func (s *SimpleSyncer) retainsLeaf(uniID Identifier, leaf *Leaf) bool {
+	if s.cfg.Retention == nil {
+		return true
+	}
+
+	var blockHeight uint32
+	err := proof.SparseDecode(
+		bytes.NewReader(leaf.RawProof),
+		proof.BlockHeightRecord(&blockHeight),
+	)
+	if err != nil {
+		return true
+	}
+
+	return s.cfg.Retention.RetainsLeaf(uniID, blockHeight)
+}
+
 // batchStreamNewItems streams the set of new items to the local registrar in
 // batches and returns the new leaf proofs.
 func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
@@ -529,6 +602,14 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 	log.Infof("Attempting to sync universe: host=%v, sync_type=%v, ids=%v",
 		host.HostStr(), syncType, spew.Sdump(idsToSync))
 
+	// We don't sync with servers that served invalid proofs too often.
+	err := checkServerBan(
+		ctx, s.cfg.ReputationLog, host, s.cfg.BanThreshold,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Next, we'll attempt to create a new diff engine for the remote
 	// Universe.
 	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
@@ -540,7 +621,14 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
-	return s.executeSync(ctx, diffEngine, syncType, syncConfigs, idsToSync)
+	syncDiff, err := s.executeSync(
+		ctx, diffEngine, syncType, syncConfigs, idsToSync,
+	)
+
+	// The outcome of the sync tells us whether we can trust the server.
+	updateServerReputation(ctx, s.cfg.ReputationLog, host, err)
+
+	return syncDiff, err
 }
 
 // fetchAllRoots fetches all the roots from the remote Universe. This function
@@ -582,11 +670,114 @@ func (s *SimpleSyncer) fetchAllRoots(ctx context.Context,
 	return roots, nil
 }
 
-// fetchAllLeafKeys fetches all the leaf keys from the remote Universe. This
-// function is used in order to isolate any logic related to the specifics of
-// how we fetch the data from the universe server.
+// diffBranch walks down the local and remote universe trees below the branch
+// identified by the given key prefix and returns the keys of the remote leaves
+// that are missing locally. Only the subtrees whose root nodes differ are
+// visited, so the cost of the diff depends on the number of differences rather
+// than the size of the universe.
+func (s *SimpleSyncer) diffBranch(ctx context.Context, diffEngine DiffEngine,
+	uniID Identifier, keyPrefix []byte) ([]LeafKey, error) {
+
+	query := BranchNodesQuery{
+		Id:        uniID,
+		KeyPrefix: keyPrefix,
+		Depth:     branchDiffDepth,
+	}
+	remoteNodes, err := diffEngine.BranchNodes(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	localNodes, err := s.cfg.LocalDiffEngine.BranchNodes(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(remoteNodes) != len(localNodes) {
+		return nil, fmt.Errorf("expected %d remote branch nodes, got "+
+			"%d", len(localNodes), len(remoteNodes))
+	}
+
+	height := len(keyPrefix)*8 + branchDiffDepth
+	emptyHash := mssmt.EmptyTree[height].NodeHash()
+
+	var keysToFetch []LeafKey
+	for i := range remoteNodes {
+		remoteNode, localNode := remoteNodes[i], localNodes[i]
+
+		// If the subtrees match, or the remote one is empty, then
+		// there's nothing to fetch below this node.
+		if mssmt.IsEqualNode(remoteNode, localNode) ||
+			remoteNode.NodeHash() == emptyHash {
+
+			continue
+		}
+
+		childPrefix := make([]byte, len(keyPrefix), len(keyPrefix)+1)
+		copy(childPrefix, keyPrefix)
+		childPrefix = append(childPrefix, byte(i))
+
+		var keys []LeafKey
+		switch {
+		// If we don't have any leaves in this subtree, then all the
+		// remote leaves below it are missing.
+		case localNode.NodeHash() == emptyHash:
+			keys, err = s.fetchAllLeafKeys(
+				ctx, diffEngine, uniID, childPrefix,
+			)
+
+		// Once the subtrees are small enough, diffing their leaf keys
+		// directly is cheaper than walking further down.
+		case len(childPrefix) >= maxBranchDiffPrefixLen:
+			keys, err = s.diffLeafKeys(
+				ctx, diffEngine, uniID, childPrefix,
+			)
+
+		default:
+			keys, err = s.diffBranch(
+				ctx, diffEngine, uniID, childPrefix,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		keysToFetch = append(keysToFetch, keys...)
+	}
+
+	return keysToFetch, nil
+}
+
+// diffLeafKeys fetches the leaf keys that start with the given key prefix from
+// both the local and remote Universe and returns the remote keys that are
+// missing locally. An empty prefix diffs all leaf keys.
+func (s *SimpleSyncer) diffLeafKeys(ctx context.Context, diffEngine DiffEngine,
+	uniID Identifier, keyPrefix []byte) ([]LeafKey, error) {
+
+	remoteUniKeys, err := s.fetchAllLeafKeys(
+		ctx, diffEngine, uniID, keyPrefix,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	localUniKeys, err := s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID, keyPrefix,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn.SetDiff(remoteUniKeys, localUniKeys), nil
+}
+
+// fetchAllLeafKeys fetches all the leaf keys that start with the given key
+// prefix from the remote Universe. This function is used in order to isolate
+// any logic related to the specifics of how we fetch the data from the
+// universe server.
 func (s *SimpleSyncer) fetchAllLeafKeys(ctx context.Context,
-	diffEngine DiffEngine, uniID Identifier) ([]LeafKey, error) {
+	diffEngine DiffEngine, uniID Identifier,
+	keyPrefix []byte) ([]LeafKey, error) {
 
 	// Initialize the offset to be used for the pages.
 	offset := int32(0)
@@ -600,6 +791,7 @@ func (s *SimpleSyncer) fetchAllLeafKeys(ctx context.Context,
 				Offset:        offset,
 				Limit:         pageSize,
 				SortDirection: SortAscending,
+				KeyPrefix:     keyPrefix,
 			},
 		)
 		if err != nil {
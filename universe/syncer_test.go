@@ -0,0 +1,175 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDiffEngine is a diff engine backed by an in-memory universe tree.
+type mockDiffEngine struct {
+	tree *mssmt.CompactedTree
+
+	keys map[[32]byte]LeafKey
+
+	// numKeysFetched is the total number of leaf keys returned so far.
+	numKeysFetched int
+}
+
+// newMockDiffEngine creates a new diff engine with an empty universe tree.
+func newMockDiffEngine() *mockDiffEngine {
+	return &mockDiffEngine{
+		tree: mssmt.NewCompactedTree(mssmt.NewDefaultStore()),
+		keys: make(map[[32]byte]LeafKey),
+	}
+}
+
+// insert adds a leaf with the given key to the universe tree. The value of the
+// leaf only depends on its key, so the same leaves are equal across trees.
+func (m *mockDiffEngine) insert(t *testing.T, key LeafKey) {
+	uniKey := key.UniverseKey()
+	leaf := mssmt.NewLeafNode(uniKey[:], 1)
+
+	_, err := m.tree.Insert(context.Background(), uniKey, leaf)
+	require.NoError(t, err)
+
+	m.keys[uniKey] = key
+}
+
+func (m *mockDiffEngine) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	root, err := m.tree.Root(ctx)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return Root{
+		ID:   id,
+		Node: root,
+	}, nil
+}
+
+func (m *mockDiffEngine) RootNodes(context.Context,
+	RootNodesQuery) ([]Root, error) {
+
+	return nil, nil
+}
+
+func (m *mockDiffEngine) UniverseLeafKeys(_ context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	var uniKeys [][32]byte
+	for uniKey := range m.keys {
+		if bytes.HasPrefix(uniKey[:], q.KeyPrefix) {
+			uniKeys = append(uniKeys, uniKey)
+		}
+	}
+
+	sort.Slice(uniKeys, func(i, j int) bool {
+		return bytes.Compare(uniKeys[i][:], uniKeys[j][:]) < 0
+	})
+
+	var keys []LeafKey
+	for i := int(q.Offset); i < len(uniKeys); i++ {
+		if len(keys) == int(q.Limit) {
+			break
+		}
+
+		keys = append(keys, m.keys[uniKeys[i]])
+	}
+
+	m.numKeysFetched += len(keys)
+
+	return keys, nil
+}
+
+func (m *mockDiffEngine) BranchNodes(ctx context.Context,
+	q BranchNodesQuery) ([]mssmt.Node, error) {
+
+	var prefix [32]byte
+	copy(prefix[:], q.KeyPrefix)
+
+	return m.tree.BranchNodes(ctx, prefix, len(q.KeyPrefix)*8, q.Depth)
+}
+
+func (m *mockDiffEngine) FetchProofLeaf(context.Context, Identifier,
+	LeafKey) ([]*Proof, error) {
+
+	return nil, nil
+}
+
+func (m *mockDiffEngine) Close() error {
+	return nil
+}
+
+var _ DiffEngine = (*mockDiffEngine)(nil)
+
+// randLeafKey returns a random leaf key.
+func randLeafKey(t *testing.T) LeafKey {
+	scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+
+	return LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}
+}
+
+// TestDiffBranch tests that walking down the universe trees finds the leaf
+// keys that are missing locally without fetching all the leaf keys.
+func TestDiffBranch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	local, remote := newMockDiffEngine(), newMockDiffEngine()
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: local,
+	})
+
+	const numShared = 1000
+	for i := 0; i < numShared; i++ {
+		key := randLeafKey(t)
+		local.insert(t, key)
+		remote.insert(t, key)
+	}
+
+	// Keys that only the local universe knows about aren't part of the
+	// diff.
+	for i := 0; i < 3; i++ {
+		local.insert(t, randLeafKey(t))
+	}
+
+	var missingKeys [][32]byte
+	for i := 0; i < 5; i++ {
+		key := randLeafKey(t)
+		remote.insert(t, key)
+		missingKeys = append(missingKeys, key.UniverseKey())
+	}
+
+	var id Identifier
+	keys, err := syncer.diffBranch(ctx, remote, id, nil)
+	require.NoError(t, err)
+
+	uniKeys := make([][32]byte, len(keys))
+	for i, key := range keys {
+		uniKeys[i] = key.UniverseKey()
+	}
+	require.ElementsMatch(t, missingKeys, uniKeys)
+
+	// Only the leaf keys below the differing branches were fetched.
+	require.Less(t, remote.numKeysFetched, numShared/10)
+
+	// Diffing all leaf keys leads to the same result, but requires all of
+	// them to be fetched.
+	remote.numKeysFetched = 0
+	keys, err = syncer.diffLeafKeys(ctx, remote, id, nil)
+	require.NoError(t, err)
+	require.Len(t, keys, len(missingKeys))
+	require.Equal(t, numShared+len(missingKeys), remote.numKeysFetched)
+}
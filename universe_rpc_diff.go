@@ -150,6 +150,7 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 			Direction: unirpc.SortDirection(q.SortDirection),
 			Offset:    q.Offset,
 			Limit:     q.Limit,
+			KeyPrefix: q.KeyPrefix,
 		},
 	)
 	if err != nil {
@@ -169,6 +170,47 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 	return keys, nil
 }
 
+// BranchNodes returns the nodes of a universe tree that are a number of
+// levels below the branch identified by a key prefix.
+func (r *RpcUniverseDiff) BranchNodes(ctx context.Context,
+	q universe.BranchNodesQuery) ([]mssmt.Node, error) {
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	uniID, err := MarshalUniID(q.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.conn.BranchNodes(ctx, &unirpc.BranchNodesRequest{
+		Id:        uniID,
+		KeyPrefix: q.KeyPrefix,
+		Depth:     uint32(q.Depth),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Nodes) != 1<<q.Depth {
+		return nil, fmt.Errorf("expected %d branch nodes, got %d",
+			1<<q.Depth, len(resp.Nodes))
+	}
+
+	nodes := make([]mssmt.Node, len(resp.Nodes))
+	for i, node := range resp.Nodes {
+		var nodeHash mssmt.NodeHash
+		copy(nodeHash[:], node.RootHash)
+
+		nodes[i] = mssmt.NewComputedNode(
+			nodeHash, uint64(node.RootSum),
+		)
+	}
+
+	return nodes, nil
+}
+
 // FetchProofLeaf attempts to fetch a proof leaf for the target leaf key
 // and given a universe identifier (assetID/groupKey).
 //
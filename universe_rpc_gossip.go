@@ -0,0 +1,99 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// RpcSignedRootFetcher is an implementation of the universe.SignedRootFetcher
+// interface that uses an RPC connection to the target Universe.
+type RpcSignedRootFetcher struct {
+	conn *universeClientConn
+}
+
+// NewRpcSignedRootFetcher creates a new RpcSignedRootFetcher instance that
+// dials out to the target remote universe server address.
+func NewRpcSignedRootFetcher(
+	serverAddr universe.ServerAddr) (universe.SignedRootFetcher, error) {
+
+	conn, err := ConnectUniverse(serverAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe RPC "+
+			"server: %w", err)
+	}
+
+	return &RpcSignedRootFetcher{
+		conn: conn,
+	}, nil
+}
+
+// FetchSignedRoots fetches the signed multiverse roots the remote universe
+// server knows of, starting at the given epoch.
+func (r *RpcSignedRootFetcher) FetchSignedRoots(ctx context.Context,
+	minEpoch uint64) ([]universe.SignedMultiverseRoot, error) {
+
+	resp, err := r.conn.SignedMultiverseRoots(
+		ctx, &unirpc.SignedMultiverseRootsRequest{
+			MinEpoch: minEpoch,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]universe.SignedMultiverseRoot, 0, len(resp.Roots))
+	for _, rpcRoot := range resp.Roots {
+		root, err := unmarshalSignedMultiverseRoot(rpcRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		roots = append(roots, *root)
+	}
+
+	return roots, nil
+}
+
+// Close closes the underlying RPC connection to the remote Universe server.
+func (r *RpcSignedRootFetcher) Close() error {
+	if err := r.conn.Close(); err != nil {
+		tapdLog.Warnf("unable to close universe RPC "+
+			"connection: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// unmarshalSignedMultiverseRoot unmarshals a signed multiverse root from its
+// RPC counterpart. The signature of the root is not verified.
+func unmarshalSignedMultiverseRoot(
+	root *unirpc.SignedMultiverseRoot) (*universe.SignedMultiverseRoot,
+	error) {
+
+	if root.IssuanceRoot == nil || root.TransferRoot == nil {
+		return nil, fmt.Errorf("signed root of epoch %d is missing a "+
+			"multiverse root", root.Epoch)
+	}
+
+	serverKey, err := btcec.ParsePubKey(root.ServerKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse server key: %w", err)
+	}
+
+	return &universe.SignedMultiverseRoot{
+		ServerKey:    serverKey,
+		Epoch:        root.Epoch,
+		IssuanceRoot: unmarshalMerkleSumNode(root.IssuanceRoot),
+		TransferRoot: unmarshalMerkleSumNode(root.TransferRoot),
+		Signature:    root.Signature,
+	}, nil
+}
+
+// A compile time interface to ensure that RpcSignedRootFetcher implements the
+// universe.SignedRootFetcher interface.
+var _ universe.SignedRootFetcher = (*RpcSignedRootFetcher)(nil)
@@ -3,16 +3,23 @@ package taprootassets
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 // RpcUniverseRegistrar is an implementation of the universe.Registrar interface
@@ -131,32 +138,161 @@ func (r *RpcUniverseRegistrar) Close() error {
 var _ universe.Registrar = (*RpcUniverseRegistrar)(nil)
 
 // CheckFederationServer attempts to connect to the target server and ensure
-// that it is a valid federation server that isn't the local daemon.
+// that it is a valid federation server that isn't the local daemon. If the
+// server has an identity key, the server must authenticate with it. Otherwise,
+// the key the server authenticates with is pinned, so the returned server
+// address contains the identity key of the server, if it supports
+// authentication.
 func CheckFederationServer(localRuntimeID int64, connectTimeout time.Duration,
-	server universe.ServerAddr) error {
+	server universe.ServerAddr) (universe.ServerAddr, error) {
 
 	srvrLog.Debugf("Attempting to connect to federation server %v",
 		server.HostStr())
 
-	conn, err := ConnectUniverse(server)
+	conn, err := dialUniverse(server)
 	if err != nil {
-		return fmt.Errorf("error connecting to server %v: %w",
+		return server, fmt.Errorf("error connecting to server %v: %w",
 			server.HostStr(), err)
 	}
+	defer conn.Close()
 
-	// We don't allow adding ourselves as a federation member.
 	ctxb := context.Background()
 	ctxt, cancel := context.WithTimeout(ctxb, connectTimeout)
 	defer cancel()
 
-	info, err := conn.Info(ctxt, &unirpc.InfoRequest{})
+	info, identityKey, err := authenticateUniverse(
+		ctxt, conn, server.IdentityKey,
+	)
 	if err != nil {
-		return fmt.Errorf("error getting info from server %v: %w",
+		return server, fmt.Errorf("error authenticating server %v: %w",
 			server.HostStr(), err)
 	}
 
+	// We don't allow adding ourselves as a federation member.
 	if info.RuntimeId == localRuntimeID {
-		return fmt.Errorf("cannot add ourselves as a federation member")
+		return server, fmt.Errorf("cannot add ourselves as a " +
+			"federation member")
+	}
+
+	server.IdentityKey = identityKey
+
+	return server, nil
+}
+
+// authenticateUniverse asks the remote universe server to sign a random
+// challenge with its node key, and verifies the signature. If an identity key
+// is given, the server must authenticate with that key. Otherwise, servers
+// that don't support authentication yet are accepted, in which case the
+// returned identity key is nil.
+func authenticateUniverse(ctx context.Context, client unirpc.UniverseClient,
+	identityKey *btcec.PublicKey) (*unirpc.InfoResponse, *btcec.PublicKey,
+	error) {
+
+	var challenge [universe.ChallengeSize]byte
+	if _, err := rand.Read(challenge[:]); err != nil {
+		return nil, nil, fmt.Errorf("unable to generate challenge: %w",
+			err)
+	}
+
+	var serverPeer peer.Peer
+	info, err := client.Info(ctx, &unirpc.InfoRequest{
+		Challenge: challenge[:],
+	}, grpc.Peer(&serverPeer))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting info: %w", err)
+	}
+
+	// Servers that don't know about challenges don't return their node
+	// key, so they can only be used without authentication.
+	if len(info.NodePubkey) == 0 {
+		if identityKey != nil {
+			return nil, nil, fmt.Errorf("server doesn't support " +
+				"authentication")
+		}
+
+		return info, nil, nil
+	}
+
+	nodeKey, err := btcec.ParsePubKey(info.NodePubkey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse node key: %w",
+			err)
+	}
+
+	if identityKey != nil && !nodeKey.IsEqual(identityKey) {
+		return nil, nil, fmt.Errorf("identity key mismatch: expected "+
+			"%x, got %x", identityKey.SerializeCompressed(),
+			info.NodePubkey)
+	}
+
+	// The server signs the channel binding of the TLS session it received
+	// the challenge over, so a man-in-the-middle can't relay the challenge
+	// to the server over its own TLS session.
+	binding, err := peerChannelBinding(&serverPeer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = universe.VerifyChallengeSig(
+		nodeKey, challenge, binding, info.ChallengeSig,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return info, nodeKey, nil
+}
+
+// peerChannelBinding returns the channel binding of the TLS session of the
+// given gRPC peer.
+func peerChannelBinding(
+	p *peer.Peer) ([universe.ChannelBindingSize]byte, error) {
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return [universe.ChannelBindingSize]byte{}, errors.New("peer " +
+			"isn't connected over TLS")
+	}
+
+	return universe.TLSChannelBinding(tlsInfo.State)
+}
+
+// certPin pins the TLS certificate of a universe server to the certificate the
+// server presented in the first TLS handshake of a connection. The server
+// authenticates over that first TLS session, so any later reconnect of the
+// same connection must present the same certificate.
+type certPin struct {
+	mu sync.Mutex
+
+	// leafHash is the SHA256 hash of the pinned leaf certificate, if a
+	// certificate was pinned yet.
+	leafHash fn.Option[[sha256.Size]byte]
+}
+
+// verifyPeerCertificate pins the leaf certificate of the first handshake and
+// rejects any other leaf certificate in later handshakes. It is used as the
+// VerifyPeerCertificate callback of the TLS config.
+func (c *certPin) verifyPeerCertificate(rawCerts [][]byte,
+	_ [][]*x509.Certificate) error {
+
+	if len(rawCerts) == 0 {
+		return errors.New("universe server didn't present a TLS " +
+			"certificate")
+	}
+	leafHash := sha256.Sum256(rawCerts[0])
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.leafHash.IsNone() {
+		c.leafHash = fn.Some(leafHash)
+		return nil
+	}
+
+	if c.leafHash.UnwrapOr([sha256.Size]byte{}) != leafHash {
+		return errors.New("universe server presented a different " +
+			"TLS certificate than when the connection was " +
+			"authenticated")
 	}
 
 	return nil
@@ -171,14 +307,51 @@ type universeClientConn struct {
 }
 
 // ConnectUniverse connects to a remote Universe server using the provided
-// server address.
+// server address. If the server address has an identity key, the server must
+// authenticate with it before the connection is returned.
 func ConnectUniverse(
 	serverAddr universe.ServerAddr) (*universeClientConn, error) {
 
+	conn, err := dialUniverse(serverAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverAddr.IdentityKey == nil {
+		return conn, nil
+	}
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, universe.DefaultTimeout)
+	defer cancel()
+
+	_, _, err = authenticateUniverse(ctxt, conn, serverAddr.IdentityKey)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("unable to authenticate universe "+
+			"server %v: %w", serverAddr.HostStr(), err)
+	}
+
+	return conn, nil
+}
+
+// dialUniverse creates a new connection to a remote Universe server using the
+// provided server address, without authenticating the server.
+func dialUniverse(
+	serverAddr universe.ServerAddr) (*universeClientConn, error) {
+
 	// TODO(roasbeef): all info is authenticated, but also want to allow
 	// brontide connect as well, can avoid TLS certs
+	//
+	// Universe servers usually use self-signed certificates, so we don't
+	// verify the certificate chain. Instead, servers with an identity key
+	// authenticate by signing a challenge bound to the TLS session, and
+	// the certificate of that session is pinned for the connection.
+	pin := &certPin{}
 	creds := credentials.NewTLS(&tls.Config{
-		InsecureSkipVerify: true,
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: pin.verifyPeerCertificate,
 	})
 
 	// Create a dial options array.
@@ -0,0 +1,117 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// RpcUniverseLeafSubscriber is an implementation of the
+// universe.LeafSubscriber interface that uses an RPC connection to the target
+// Universe.
+type RpcUniverseLeafSubscriber struct {
+	conn *universeClientConn
+}
+
+// NewRpcUniverseLeafSubscriber creates a new RpcUniverseLeafSubscriber
+// instance that dials out to the target remote universe server address.
+func NewRpcUniverseLeafSubscriber(
+	serverAddr universe.ServerAddr) (universe.LeafSubscriber, error) {
+
+	conn, err := ConnectUniverse(serverAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe RPC "+
+			"server: %w", err)
+	}
+
+	return &RpcUniverseLeafSubscriber{
+		conn: conn,
+	}, nil
+}
+
+// SubscribeLeaves subscribes to the leaves that are inserted into the remote
+// universe after the cursor of the given query, and calls the handler for each
+// of them in order.
+func (r *RpcUniverseLeafSubscriber) SubscribeLeaves(ctx context.Context,
+	q universe.LeafEventsQuery,
+	handler func(*universe.RemoteLeafEvent) error) error {
+
+	req := &unirpc.SubscribeUniverseLeavesRequest{
+		AfterCursor: q.AfterCursor,
+	}
+	if q.AssetID != nil {
+		req.AssetId = q.AssetID[:]
+	}
+	if q.GroupKey != nil {
+		req.GroupKey = schnorr.SerializePubKey(q.GroupKey)
+	}
+
+	proofType, err := MarshalUniProofType(q.ProofType)
+	if err != nil {
+		return err
+	}
+	req.ProofType = proofType
+
+	stream, err := r.conn.SubscribeUniverseLeaves(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		rpcEvent, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		event := &universe.RemoteLeafEvent{
+			LeafEvent: universe.LeafEvent{
+				Cursor: rpcEvent.Cursor,
+			},
+		}
+
+		if rpcEvent.Leaf != nil {
+			if rpcEvent.Leaf.AssetLeaf == nil {
+				return fmt.Errorf("leaf event %d without "+
+					"asset leaf", rpcEvent.Cursor)
+			}
+
+			event.ID, event.Key, err = unmarshalUniverseKey(
+				rpcEvent.Leaf.Req,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to unmarshal "+
+					"universe key: %w", err)
+			}
+
+			event.Leaf, err = unmarshalAssetLeaf(
+				rpcEvent.Leaf.AssetLeaf,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to unmarshal asset "+
+					"leaf: %w", err)
+			}
+		}
+
+		if err := handler(event); err != nil {
+			return err
+		}
+	}
+}
+
+// Close closes the underlying RPC connection to the remote Universe server.
+func (r *RpcUniverseLeafSubscriber) Close() error {
+	if err := r.conn.Close(); err != nil {
+		tapdLog.Warnf("unable to close universe RPC "+
+			"connection: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// A compile time interface to ensure that RpcUniverseLeafSubscriber implements
+// the universe.LeafSubscriber interface.
+var _ universe.LeafSubscriber = (*RpcUniverseLeafSubscriber)(nil)
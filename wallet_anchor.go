@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
@@ -145,7 +146,16 @@ func (l *LndRpcWalletAnchor) ImportTaprootOutput(ctx context.Context,
 			FullOutputKey: pub,
 		},
 	)
-	if err != nil {
+	switch {
+	case err == nil:
+
+	// lnd doesn't return a distinct error code for outputs that were
+	// already imported, so we map its error message to our own error.
+	case strings.Contains(err.Error(), "already exists"):
+		return nil, fmt.Errorf("%w: %w",
+			tapgarden.ErrOutputAlreadyImported, err)
+
+	default:
 		return nil, err
 	}
 